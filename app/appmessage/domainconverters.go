@@ -255,6 +255,19 @@ func RPCUTXOEntryToUTXOEntry(entry *RPCUTXOEntry) (externalapi.UTXOEntry, error)
 	), nil
 }
 
+// UTXOEntryToRPCUTXOEntry converts UTXOEntry to RPCUTXOEntry
+func UTXOEntryToRPCUTXOEntry(entry externalapi.UTXOEntry) *RPCUTXOEntry {
+	return &RPCUTXOEntry{
+		Amount: entry.Amount(),
+		ScriptPublicKey: &RPCScriptPublicKey{
+			Script:  hex.EncodeToString(entry.ScriptPublicKey().Script),
+			Version: entry.ScriptPublicKey().Version,
+		},
+		BlockDAAScore: entry.BlockDAAScore(),
+		IsCoinbase:    entry.IsCoinbase(),
+	}
+}
+
 // DomainTransactionToRPCTransaction converts DomainTransactions to RPCTransactions
 func DomainTransactionToRPCTransaction(transaction *externalapi.DomainTransaction) *RPCTransaction {
 	inputs := make([]*RPCTransactionInput, len(transaction.Inputs))
@@ -346,40 +359,65 @@ func DomainOutpointAndUTXOEntryPairsToOutpointAndUTXOEntryPairs(
 
 // DomainBlockToRPCBlock converts DomainBlocks to RPCBlocks
 func DomainBlockToRPCBlock(block *externalapi.DomainBlock) *RPCBlock {
-	parents := make([]*RPCBlockLevelParents, len(block.Header.Parents()))
-	for i, blockLevelParents := range block.Header.Parents() {
+	transactions := make([]*RPCTransaction, len(block.Transactions))
+	for i, transaction := range block.Transactions {
+		transactions[i] = DomainTransactionToRPCTransaction(transaction)
+	}
+	return &RPCBlock{
+		Header:       DomainBlockHeaderToRPCBlockHeader(block.Header),
+		Transactions: transactions,
+	}
+}
+
+// DomainBlockHeaderToRPCBlockHeader converts an externalapi.BlockHeader to an RPCBlockHeader
+func DomainBlockHeaderToRPCBlockHeader(header externalapi.BlockHeader) *RPCBlockHeader {
+	parents := make([]*RPCBlockLevelParents, len(header.Parents()))
+	for i, blockLevelParents := range header.Parents() {
 		parents[i] = &RPCBlockLevelParents{
 			ParentHashes: hashes.ToStrings(blockLevelParents),
 		}
 	}
-	header := &RPCBlockHeader{
-		Version:              uint32(block.Header.Version()),
+	return &RPCBlockHeader{
+		Version:              uint32(header.Version()),
 		Parents:              parents,
-		HashMerkleRoot:       block.Header.HashMerkleRoot().String(),
-		AcceptedIDMerkleRoot: block.Header.AcceptedIDMerkleRoot().String(),
-		UTXOCommitment:       block.Header.UTXOCommitment().String(),
-		Timestamp:            block.Header.TimeInMilliseconds(),
-		Bits:                 block.Header.Bits(),
-		Nonce:                block.Header.Nonce(),
-		DAAScore:             block.Header.DAAScore(),
-		BlueScore:            block.Header.BlueScore(),
-		BlueWork:             block.Header.BlueWork().Text(16),
-		PruningPoint:         block.Header.PruningPoint().String(),
+		HashMerkleRoot:       header.HashMerkleRoot().String(),
+		AcceptedIDMerkleRoot: header.AcceptedIDMerkleRoot().String(),
+		UTXOCommitment:       header.UTXOCommitment().String(),
+		Timestamp:            header.TimeInMilliseconds(),
+		Bits:                 header.Bits(),
+		Nonce:                header.Nonce(),
+		DAAScore:             header.DAAScore(),
+		BlueScore:            header.BlueScore(),
+		BlueWork:             header.BlueWork().Text(16),
+		PruningPoint:         header.PruningPoint().String(),
 	}
-	transactions := make([]*RPCTransaction, len(block.Transactions))
+}
+
+// RPCBlockToDomainBlock converts `block` into a DomainBlock
+func RPCBlockToDomainBlock(block *RPCBlock, powHash string) (*externalapi.DomainBlock, error) {
+	header, err := RPCBlockHeaderToDomainBlockHeader(block.Header)
+	if err != nil {
+		return nil, err
+	}
+	transactions := make([]*externalapi.DomainTransaction, len(block.Transactions))
 	for i, transaction := range block.Transactions {
-		transactions[i] = DomainTransactionToRPCTransaction(transaction)
+		domainTransaction, err := RPCTransactionToDomainTransaction(transaction)
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = domainTransaction
 	}
-	return &RPCBlock{
+	return &externalapi.DomainBlock{
 		Header:       header,
 		Transactions: transactions,
-	}
+		PoWHash:      powHash,
+	}, nil
 }
 
-// RPCBlockToDomainBlock converts `block` into a DomainBlock
-func RPCBlockToDomainBlock(block *RPCBlock, powHash string) (*externalapi.DomainBlock, error) {
-	parents := make([]externalapi.BlockLevelParents, len(block.Header.Parents))
-	for i, blockLevelParents := range block.Header.Parents {
+// RPCBlockHeaderToDomainBlockHeader converts `header` into an externalapi.BlockHeader
+func RPCBlockHeaderToDomainBlockHeader(header *RPCBlockHeader) (externalapi.BlockHeader, error) {
+	parents := make([]externalapi.BlockLevelParents, len(header.Parents))
+	for i, blockLevelParents := range header.Parents {
 		parents[i] = make(externalapi.BlockLevelParents, len(blockLevelParents.ParentHashes))
 		for j, parentHash := range blockLevelParents.ParentHashes {
 			var err error
@@ -389,54 +427,157 @@ func RPCBlockToDomainBlock(block *RPCBlock, powHash string) (*externalapi.Domain
 			}
 		}
 	}
-	hashMerkleRoot, err := externalapi.NewDomainHashFromString(block.Header.HashMerkleRoot)
+	hashMerkleRoot, err := externalapi.NewDomainHashFromString(header.HashMerkleRoot)
 	if err != nil {
 		return nil, err
 	}
-	acceptedIDMerkleRoot, err := externalapi.NewDomainHashFromString(block.Header.AcceptedIDMerkleRoot)
+	acceptedIDMerkleRoot, err := externalapi.NewDomainHashFromString(header.AcceptedIDMerkleRoot)
 	if err != nil {
 		return nil, err
 	}
-	utxoCommitment, err := externalapi.NewDomainHashFromString(block.Header.UTXOCommitment)
+	utxoCommitment, err := externalapi.NewDomainHashFromString(header.UTXOCommitment)
 	if err != nil {
 		return nil, err
 	}
-	blueWork, success := new(big.Int).SetString(block.Header.BlueWork, 16)
+	blueWork, success := new(big.Int).SetString(header.BlueWork, 16)
 	if !success {
-		return nil, errors.Errorf("failed to parse blue work: %s", block.Header.BlueWork)
+		return nil, errors.Errorf("failed to parse blue work: %s", header.BlueWork)
 	}
-	pruningPoint, err := externalapi.NewDomainHashFromString(block.Header.PruningPoint)
+	pruningPoint, err := externalapi.NewDomainHashFromString(header.PruningPoint)
 	if err != nil {
 		return nil, err
 	}
-	header := blockheader.NewImmutableBlockHeader(
-		uint16(block.Header.Version),
+	return blockheader.NewImmutableBlockHeader(
+		uint16(header.Version),
 		parents,
 		hashMerkleRoot,
 		acceptedIDMerkleRoot,
 		utxoCommitment,
-		block.Header.Timestamp,
-		block.Header.Bits,
-		block.Header.Nonce,
-		block.Header.DAAScore,
-		block.Header.BlueScore,
+		header.Timestamp,
+		header.Bits,
+		header.Nonce,
+		header.DAAScore,
+		header.BlueScore,
 		blueWork,
-		pruningPoint)
-	transactions := make([]*externalapi.DomainTransaction, len(block.Transactions))
-	for i, transaction := range block.Transactions {
-		domainTransaction, err := RPCTransactionToDomainTransaction(transaction)
+		pruningPoint), nil
+}
+
+// DomainTransactionInclusionProofToRPCTransactionInclusionProof converts an
+// externalapi.TransactionInclusionProof to an RPCTransactionInclusionProof
+func DomainTransactionInclusionProofToRPCTransactionInclusionProof(
+	proof *externalapi.TransactionInclusionProof) *RPCTransactionInclusionProof {
+
+	chainPathOtherParents := make([][]*RPCBlockHeader, len(proof.ChainPathOtherParents))
+	for i, otherParents := range proof.ChainPathOtherParents {
+		chainPathOtherParents[i] = domainBlockHeadersToRPCBlockHeaders(otherParents)
+	}
+	return &RPCTransactionInclusionProof{
+		IncludingBlockHeader:   DomainBlockHeaderToRPCBlockHeader(proof.IncludingBlockHeader),
+		HashMerkleBranch:       domainMerkleBranchToRPCMerkleBranch(proof.HashMerkleBranch),
+		MergeSetPath:           domainBlockHeadersToRPCBlockHeaders(proof.MergeSetPath),
+		AcceptingBlockHeader:   DomainBlockHeaderToRPCBlockHeader(proof.AcceptingBlockHeader),
+		AcceptedIDMerkleBranch: domainMerkleBranchToRPCMerkleBranch(proof.AcceptedIDMerkleBranch),
+		ChainPath:              domainBlockHeadersToRPCBlockHeaders(proof.ChainPath),
+		ChainPathOtherParents:  chainPathOtherParents,
+	}
+}
+
+func domainMerkleBranchToRPCMerkleBranch(branch *externalapi.MerkleBranch) *RPCMerkleBranch {
+	return &RPCMerkleBranch{
+		Index:  branch.Index,
+		Hashes: hashes.ToStrings(branch.Hashes),
+	}
+}
+
+func domainBlockHeadersToRPCBlockHeaders(headers []externalapi.BlockHeader) []*RPCBlockHeader {
+	rpcHeaders := make([]*RPCBlockHeader, len(headers))
+	for i, header := range headers {
+		rpcHeaders[i] = DomainBlockHeaderToRPCBlockHeader(header)
+	}
+	return rpcHeaders
+}
+
+// RPCTransactionInclusionProofToDomainTransactionInclusionProof converts `proof` into an
+// externalapi.TransactionInclusionProof, which can be verified with inclusionproof.Verify
+func RPCTransactionInclusionProofToDomainTransactionInclusionProof(
+	proof *RPCTransactionInclusionProof) (*externalapi.TransactionInclusionProof, error) {
+
+	if proof == nil || proof.IncludingBlockHeader == nil || proof.HashMerkleBranch == nil ||
+		proof.AcceptingBlockHeader == nil || proof.AcceptedIDMerkleBranch == nil {
+		return nil, errors.Errorf("the transaction inclusion proof is incomplete")
+	}
+	includingBlockHeader, err := RPCBlockHeaderToDomainBlockHeader(proof.IncludingBlockHeader)
+	if err != nil {
+		return nil, err
+	}
+	hashMerkleBranch, err := rpcMerkleBranchToDomainMerkleBranch(proof.HashMerkleBranch)
+	if err != nil {
+		return nil, err
+	}
+	mergeSetPath, err := rpcBlockHeadersToDomainBlockHeaders(proof.MergeSetPath)
+	if err != nil {
+		return nil, err
+	}
+	acceptingBlockHeader, err := RPCBlockHeaderToDomainBlockHeader(proof.AcceptingBlockHeader)
+	if err != nil {
+		return nil, err
+	}
+	acceptedIDMerkleBranch, err := rpcMerkleBranchToDomainMerkleBranch(proof.AcceptedIDMerkleBranch)
+	if err != nil {
+		return nil, err
+	}
+	chainPath, err := rpcBlockHeadersToDomainBlockHeaders(proof.ChainPath)
+	if err != nil {
+		return nil, err
+	}
+	chainPathOtherParents := make([][]externalapi.BlockHeader, len(proof.ChainPathOtherParents))
+	for i, otherParents := range proof.ChainPathOtherParents {
+		chainPathOtherParents[i], err = rpcBlockHeadersToDomainBlockHeaders(otherParents)
 		if err != nil {
 			return nil, err
 		}
-		transactions[i] = domainTransaction
 	}
-	return &externalapi.DomainBlock{
-		Header:       header,
-		Transactions: transactions,
-		PoWHash:      powHash,
+	return &externalapi.TransactionInclusionProof{
+		IncludingBlockHeader:   includingBlockHeader,
+		HashMerkleBranch:       hashMerkleBranch,
+		MergeSetPath:           mergeSetPath,
+		AcceptingBlockHeader:   acceptingBlockHeader,
+		AcceptedIDMerkleBranch: acceptedIDMerkleBranch,
+		ChainPath:              chainPath,
+		ChainPathOtherParents:  chainPathOtherParents,
+	}, nil
+}
+
+func rpcMerkleBranchToDomainMerkleBranch(branch *RPCMerkleBranch) (*externalapi.MerkleBranch, error) {
+	branchHashes := make([]*externalapi.DomainHash, len(branch.Hashes))
+	for i, hashString := range branch.Hashes {
+		var err error
+		branchHashes[i], err = externalapi.NewDomainHashFromString(hashString)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &externalapi.MerkleBranch{
+		Index:  branch.Index,
+		Hashes: branchHashes,
 	}, nil
 }
 
+func rpcBlockHeadersToDomainBlockHeaders(rpcHeaders []*RPCBlockHeader) ([]externalapi.BlockHeader, error) {
+	headers := make([]externalapi.BlockHeader, len(rpcHeaders))
+	for i, rpcHeader := range rpcHeaders {
+		if rpcHeader == nil {
+			return nil, errors.Errorf("the transaction inclusion proof contains a missing header")
+		}
+		var err error
+		headers[i], err = RPCBlockHeaderToDomainBlockHeader(rpcHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return headers, nil
+}
+
 // BlockWithTrustedDataToDomainBlockWithTrustedData converts *MsgBlockWithTrustedData to *externalapi.BlockWithTrustedData
 func BlockWithTrustedDataToDomainBlockWithTrustedData(block *MsgBlockWithTrustedData) *externalapi.BlockWithTrustedData {
 	daaWindow := make([]*externalapi.TrustedDataDataDAAHeader, len(block.DAAWindow))
@@ -6,6 +6,7 @@ package appmessage
 
 import (
 	"fmt"
+	"time"
 )
 
 // MessageError describes an issue with a message.
@@ -33,9 +34,31 @@ func messageError(f string, desc string) *MessageError {
 	return &MessageError{Func: f, Description: desc}
 }
 
+// RPCErrorCode classifies RPC errors that clients may want to handle programmatically
+type RPCErrorCode uint32
+
+const (
+	// RPCErrorCodeGeneric is the code of any RPC error that has no specific classification
+	RPCErrorCodeGeneric RPCErrorCode = iota
+
+	// RPCErrorCodeRateLimited indicates that the request was rejected because the client
+	// exceeded its request rate or concurrency limits
+	RPCErrorCodeRateLimited
+
+	// RPCErrorCodePermissionDenied indicates that the request was rejected because the
+	// client's permission group does not allow it
+	RPCErrorCodePermissionDenied
+)
+
 // RPCError represents an error arriving from the RPC
 type RPCError struct {
 	Message string
+
+	// Code is RPCErrorCodeGeneric unless the error was classified
+	Code RPCErrorCode
+
+	// RetryAfterMilliseconds is set for rate limited requests
+	RetryAfterMilliseconds uint64
 }
 
 func (err RPCError) Error() string {
@@ -49,3 +72,22 @@ func RPCErrorf(format string, args ...interface{}) *RPCError {
 		Message: fmt.Sprintf(format, args...),
 	}
 }
+
+// NewRateLimitedRPCError returns an RPCError signaling that a request was rate limited
+// and may be retried after the given duration
+func NewRateLimitedRPCError(retryAfter time.Duration, format string, args ...interface{}) *RPCError {
+	return &RPCError{
+		Message:                fmt.Sprintf(format, args...),
+		Code:                   RPCErrorCodeRateLimited,
+		RetryAfterMilliseconds: uint64(retryAfter.Milliseconds()),
+	}
+}
+
+// NewPermissionDeniedRPCError returns an RPCError signaling that the client is not
+// allowed to make a request
+func NewPermissionDeniedRPCError(format string, args ...interface{}) *RPCError {
+	return &RPCError{
+		Message: fmt.Sprintf(format, args...),
+		Code:    RPCErrorCodePermissionDenied,
+	}
+}
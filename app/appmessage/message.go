@@ -73,6 +73,9 @@ const (
 	CmdRequestIBDChainBlockLocator
 	CmdIBDChainBlockLocator
 	CmdRequestAnticone
+	CmdCompactBlock
+	CmdRequestBlockTransactions
+	CmdBlockTransactions
 
 	// rpc
 	CmdGetCurrentNetworkRequestMessage
@@ -165,6 +168,35 @@ const (
 	CmdGetMempoolEntriesByAddressesResponseMessage
 	CmdGetCoinSupplyRequestMessage
 	CmdGetCoinSupplyResponseMessage
+	CmdGetCoinbaseSplitRequestMessage
+	CmdGetCoinbaseSplitResponseMessage
+	CmdGetEmissionInfoRequestMessage
+	CmdGetEmissionInfoResponseMessage
+	CmdTestMempoolAcceptRequestMessage
+	CmdTestMempoolAcceptResponseMessage
+	CmdDecodeTransactionRequestMessage
+	CmdDecodeTransactionResponseMessage
+	CmdDecodeScriptRequestMessage
+	CmdDecodeScriptResponseMessage
+	CmdGetUTXOEntriesByOutpointsRequestMessage
+	CmdGetUTXOEntriesByOutpointsResponseMessage
+	CmdGetSyncStatusRequestMessage
+	CmdGetSyncStatusResponseMessage
+	CmdNotifySyncStatusChangedRequestMessage
+	CmdNotifySyncStatusChangedResponseMessage
+	CmdSyncStatusChangedNotificationMessage
+	CmdVerifyMessageRequestMessage
+	CmdVerifyMessageResponseMessage
+	CmdGetTransactionInclusionProofRequestMessage
+	CmdGetTransactionInclusionProofResponseMessage
+	CmdInvalidateBlockRequestMessage
+	CmdInvalidateBlockResponseMessage
+	CmdReconsiderBlockRequestMessage
+	CmdReconsiderBlockResponseMessage
+	CmdGetDAGSnapshotRequestMessage
+	CmdGetDAGSnapshotResponseMessage
+	CmdGetTransactionStatusRequestMessage
+	CmdGetTransactionStatusResponseMessage
 )
 
 // ProtocolMessageCommandToString maps all MessageCommands to their string representation
@@ -212,6 +244,9 @@ var ProtocolMessageCommandToString = map[MessageCommand]string{
 	CmdRequestIBDChainBlockLocator:                 "RequestIBDChainBlockLocator",
 	CmdIBDChainBlockLocator:                        "IBDChainBlockLocator",
 	CmdRequestAnticone:                             "RequestAnticone",
+	CmdCompactBlock:                                "CompactBlock",
+	CmdRequestBlockTransactions:                    "RequestBlockTransactions",
+	CmdBlockTransactions:                           "BlockTransactions",
 }
 
 // RPCMessageCommandToString maps all MessageCommands to their string representation
@@ -243,6 +278,8 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdVirtualSelectedParentChainChangedNotificationMessage:       "VirtualSelectedParentChainChangedNotification",
 	CmdGetBlockRequestMessage:                                     "GetBlockRequest",
 	CmdGetBlockResponseMessage:                                    "GetBlockResponse",
+	CmdGetBlockByTransactionIDRequestMessage:                      "GetBlockByTransactionIDRequest",
+	CmdGetBlockByTransactionIDResponseMessage:                     "GetBlockByTransactionIDResponse",
 	CmdGetSubnetworkRequestMessage:                                "GetSubnetworkRequest",
 	CmdGetSubnetworkResponseMessage:                               "GetSubnetworkResponse",
 	CmdGetVirtualSelectedParentChainFromBlockRequestMessage:       "GetVirtualSelectedParentChainFromBlockRequest",
@@ -302,6 +339,35 @@ var RPCMessageCommandToString = map[MessageCommand]string{
 	CmdGetMempoolEntriesByAddressesResponseMessage:                "GetMempoolEntriesByAddressesResponse",
 	CmdGetCoinSupplyRequestMessage:                                "GetCoinSupplyRequest",
 	CmdGetCoinSupplyResponseMessage:                               "GetCoinSupplyResponse",
+	CmdGetCoinbaseSplitRequestMessage:                             "GetCoinbaseSplitRequest",
+	CmdGetCoinbaseSplitResponseMessage:                            "GetCoinbaseSplitResponse",
+	CmdGetEmissionInfoRequestMessage:                              "GetEmissionInfoRequest",
+	CmdGetEmissionInfoResponseMessage:                             "GetEmissionInfoResponse",
+	CmdTestMempoolAcceptRequestMessage:                            "TestMempoolAcceptRequest",
+	CmdTestMempoolAcceptResponseMessage:                           "TestMempoolAcceptResponse",
+	CmdDecodeTransactionRequestMessage:                            "DecodeTransactionRequest",
+	CmdDecodeTransactionResponseMessage:                           "DecodeTransactionResponse",
+	CmdDecodeScriptRequestMessage:                                 "DecodeScriptRequest",
+	CmdDecodeScriptResponseMessage:                                "DecodeScriptResponse",
+	CmdGetUTXOEntriesByOutpointsRequestMessage:                    "GetUTXOEntriesByOutpointsRequest",
+	CmdGetUTXOEntriesByOutpointsResponseMessage:                   "GetUTXOEntriesByOutpointsResponse",
+	CmdGetSyncStatusRequestMessage:                                "GetSyncStatusRequest",
+	CmdGetSyncStatusResponseMessage:                               "GetSyncStatusResponse",
+	CmdNotifySyncStatusChangedRequestMessage:                      "NotifySyncStatusChangedRequest",
+	CmdNotifySyncStatusChangedResponseMessage:                     "NotifySyncStatusChangedResponse",
+	CmdSyncStatusChangedNotificationMessage:                       "SyncStatusChangedNotification",
+	CmdVerifyMessageRequestMessage:                                "VerifyMessageRequest",
+	CmdVerifyMessageResponseMessage:                               "VerifyMessageResponse",
+	CmdGetTransactionInclusionProofRequestMessage:                 "GetTransactionInclusionProofRequest",
+	CmdGetTransactionInclusionProofResponseMessage:                "GetTransactionInclusionProofResponse",
+	CmdInvalidateBlockRequestMessage:                              "InvalidateBlockRequest",
+	CmdInvalidateBlockResponseMessage:                             "InvalidateBlockResponse",
+	CmdReconsiderBlockRequestMessage:                              "ReconsiderBlockRequest",
+	CmdReconsiderBlockResponseMessage:                             "ReconsiderBlockResponse",
+	CmdGetDAGSnapshotRequestMessage:                               "GetDAGSnapshotRequest",
+	CmdGetDAGSnapshotResponseMessage:                              "GetDAGSnapshotResponse",
+	CmdGetTransactionStatusRequestMessage:                         "GetTransactionStatusRequest",
+	CmdGetTransactionStatusResponseMessage:                        "GetTransactionStatusResponse",
 }
 
 // Message is an interface that describes a hoosat message. A type that
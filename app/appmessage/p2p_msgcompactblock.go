@@ -0,0 +1,47 @@
+package appmessage
+
+// PrefilledTransaction is a transaction that is sent in full as part of
+// a MsgCompactBlock, along with its index in the block.
+type PrefilledTransaction struct {
+	Index       uint32
+	Transaction *MsgTx
+}
+
+// MsgCompactBlock implements the Message interface and represents a hoosat
+// CompactBlock message. It is used to relay a block to peers that support
+// compact blocks as its header and the short IDs of its transactions, so that
+// the receiving peer can reconstruct it from its own mempool.
+type MsgCompactBlock struct {
+	baseMessage
+	Header  MsgBlockHeader
+	PoWHash string
+
+	// ShortIDs are the short IDs of all the transactions that are not prefilled,
+	// in the order they appear in the block.
+	ShortIDs              []uint64
+	PrefilledTransactions []*PrefilledTransaction
+}
+
+// Command returns the protocol command string for the message. This is part
+// of the Message interface implementation.
+func (msg *MsgCompactBlock) Command() MessageCommand {
+	return CmdCompactBlock
+}
+
+// TransactionCount returns the number of transactions in the block
+func (msg *MsgCompactBlock) TransactionCount() int {
+	return len(msg.ShortIDs) + len(msg.PrefilledTransactions)
+}
+
+// NewMsgCompactBlock returns a new hoosat CompactBlock message that conforms to
+// the Message interface. See MsgCompactBlock for details.
+func NewMsgCompactBlock(header *MsgBlockHeader, powHash string, shortIDs []uint64,
+	prefilledTransactions []*PrefilledTransaction) *MsgCompactBlock {
+
+	return &MsgCompactBlock{
+		Header:                *header,
+		PoWHash:               powHash,
+		ShortIDs:              shortIDs,
+		PrefilledTransactions: prefilledTransactions,
+	}
+}
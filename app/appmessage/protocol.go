@@ -13,7 +13,7 @@ import (
 const (
 	// DefaultServices describes the default services that are supported by
 	// the server.
-	DefaultServices = SFNodeNetwork | SFNodeBloom | SFNodeCF
+	DefaultServices = SFNodeNetwork | SFNodeBloom | SFNodeCF | SFNodeCompactBlocks
 )
 
 // ServiceFlag identifies services supported by a hoosat peer.
@@ -41,16 +41,21 @@ const (
 	// SFNodeCF is a flag used to indicate a peer supports committed
 	// filters (CFs).
 	SFNodeCF
+
+	// SFNodeCompactBlocks is a flag used to indicate a peer supports
+	// receiving relay blocks as compact blocks.
+	SFNodeCompactBlocks
 )
 
 // Map of service flags back to their constant names for pretty printing.
 var sfStrings = map[ServiceFlag]string{
-	SFNodeNetwork: "SFNodeNetwork",
-	SFNodeGetUTXO: "SFNodeGetUTXO",
-	SFNodeBloom:   "SFNodeBloom",
-	SFNodeXthin:   "SFNodeXthin",
-	SFNodeBit5:    "SFNodeBit5",
-	SFNodeCF:      "SFNodeCF",
+	SFNodeNetwork:       "SFNodeNetwork",
+	SFNodeGetUTXO:       "SFNodeGetUTXO",
+	SFNodeBloom:         "SFNodeBloom",
+	SFNodeXthin:         "SFNodeXthin",
+	SFNodeBit5:          "SFNodeBit5",
+	SFNodeCF:            "SFNodeCF",
+	SFNodeCompactBlocks: "SFNodeCompactBlocks",
 }
 
 // orderedSFStrings is an ordered list of service flags from highest to
@@ -62,6 +67,7 @@ var orderedSFStrings = []ServiceFlag{
 	SFNodeXthin,
 	SFNodeBit5,
 	SFNodeCF,
+	SFNodeCompactBlocks,
 }
 
 // String returns the ServiceFlag in human-readable form.
@@ -0,0 +1,51 @@
+package appmessage
+
+// DecodeScriptRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type DecodeScriptRequestMessage struct {
+	baseMessage
+	ScriptHex string
+	Version   uint16
+}
+
+// Command returns the protocol command string for the message
+func (msg *DecodeScriptRequestMessage) Command() MessageCommand {
+	return CmdDecodeScriptRequestMessage
+}
+
+// NewDecodeScriptRequestMessage returns a instance of the message
+func NewDecodeScriptRequestMessage(scriptHex string, version uint16) *DecodeScriptRequestMessage {
+	return &DecodeScriptRequestMessage{
+		ScriptHex: scriptHex,
+		Version:   version,
+	}
+}
+
+// DecodeScriptResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type DecodeScriptResponseMessage struct {
+	baseMessage
+	Disassembly string
+	ScriptClass string
+	Addresses   []string
+	P2SHAddress string
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *DecodeScriptResponseMessage) Command() MessageCommand {
+	return CmdDecodeScriptResponseMessage
+}
+
+// NewDecodeScriptResponseMessage returns a instance of the message
+func NewDecodeScriptResponseMessage(disassembly string, scriptClass string, addresses []string,
+	p2shAddress string) *DecodeScriptResponseMessage {
+
+	return &DecodeScriptResponseMessage{
+		Disassembly: disassembly,
+		ScriptClass: scriptClass,
+		Addresses:   addresses,
+		P2SHAddress: p2shAddress,
+	}
+}
@@ -0,0 +1,56 @@
+package appmessage
+
+// TransactionEncoding is the serialization of a transaction passed to DecodeTransaction
+type TransactionEncoding uint32
+
+// TransactionEncoding constants
+const (
+	// TransactionEncodingRPC is the protobuf serialization of an RPCTransaction
+	TransactionEncodingRPC TransactionEncoding = 0
+
+	// TransactionEncodingDomain is the serialization the node stores transactions in,
+	// which is also the one htnwallet serializes transactions in
+	TransactionEncodingDomain TransactionEncoding = 1
+)
+
+// DecodeTransactionRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type DecodeTransactionRequestMessage struct {
+	baseMessage
+	TransactionHex string
+	Encoding       TransactionEncoding
+}
+
+// Command returns the protocol command string for the message
+func (msg *DecodeTransactionRequestMessage) Command() MessageCommand {
+	return CmdDecodeTransactionRequestMessage
+}
+
+// NewDecodeTransactionRequestMessage returns a instance of the message
+func NewDecodeTransactionRequestMessage(transactionHex string, encoding TransactionEncoding) *DecodeTransactionRequestMessage {
+	return &DecodeTransactionRequestMessage{
+		TransactionHex: transactionHex,
+		Encoding:       encoding,
+	}
+}
+
+// DecodeTransactionResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type DecodeTransactionResponseMessage struct {
+	baseMessage
+	Transaction *RPCTransaction
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *DecodeTransactionResponseMessage) Command() MessageCommand {
+	return CmdDecodeTransactionResponseMessage
+}
+
+// NewDecodeTransactionResponseMessage returns a instance of the message
+func NewDecodeTransactionResponseMessage(transaction *RPCTransaction) *DecodeTransactionResponseMessage {
+	return &DecodeTransactionResponseMessage{
+		Transaction: transaction,
+	}
+}
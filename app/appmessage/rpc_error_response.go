@@ -0,0 +1,123 @@
+package appmessage
+
+import (
+	"github.com/pkg/errors"
+)
+
+// NewRPCErrorResponseMessage returns the response message that matches the
+// given request command, carrying nothing but the given error. It is used to
+// reject requests before they are dispatched to their handler.
+func NewRPCErrorResponseMessage(requestCommand MessageCommand, rpcError *RPCError) (Message, error) {
+	switch requestCommand {
+	case CmdGetCurrentNetworkRequestMessage:
+		return &GetCurrentNetworkResponseMessage{Error: rpcError}, nil
+	case CmdSubmitBlockRequestMessage:
+		return &SubmitBlockResponseMessage{Error: rpcError}, nil
+	case CmdGetBlockTemplateRequestMessage:
+		return &GetBlockTemplateResponseMessage{Error: rpcError}, nil
+	case CmdNotifyBlockAddedRequestMessage:
+		return &NotifyBlockAddedResponseMessage{Error: rpcError}, nil
+	case CmdGetPeerAddressesRequestMessage:
+		return &GetPeerAddressesResponseMessage{Error: rpcError}, nil
+	case CmdGetSelectedTipHashRequestMessage:
+		return &GetSelectedTipHashResponseMessage{Error: rpcError}, nil
+	case CmdGetMempoolEntryRequestMessage:
+		return &GetMempoolEntryResponseMessage{Error: rpcError}, nil
+	case CmdGetConnectedPeerInfoRequestMessage:
+		return &GetConnectedPeerInfoResponseMessage{Error: rpcError}, nil
+	case CmdAddPeerRequestMessage:
+		return &AddPeerResponseMessage{Error: rpcError}, nil
+	case CmdSubmitTransactionRequestMessage:
+		return &SubmitTransactionResponseMessage{Error: rpcError}, nil
+	case CmdNotifyVirtualSelectedParentChainChangedRequestMessage:
+		return &NotifyVirtualSelectedParentChainChangedResponseMessage{Error: rpcError}, nil
+	case CmdGetBlockRequestMessage:
+		return &GetBlockResponseMessage{Error: rpcError}, nil
+	case CmdGetBlockByTransactionIDRequestMessage:
+		return &GetBlockByTransactionIDResponseMessage{Error: rpcError}, nil
+	case CmdGetSubnetworkRequestMessage:
+		return &GetSubnetworkResponseMessage{Error: rpcError}, nil
+	case CmdGetVirtualSelectedParentChainFromBlockRequestMessage:
+		return &GetVirtualSelectedParentChainFromBlockResponseMessage{Error: rpcError}, nil
+	case CmdGetBlocksRequestMessage:
+		return &GetBlocksResponseMessage{Error: rpcError}, nil
+	case CmdGetBlockCountRequestMessage:
+		return &GetBlockCountResponseMessage{Error: rpcError}, nil
+	case CmdGetBalanceByAddressRequestMessage:
+		return &GetBalanceByAddressResponseMessage{Error: rpcError}, nil
+	case CmdGetBlockDAGInfoRequestMessage:
+		return &GetBlockDAGInfoResponseMessage{Error: rpcError}, nil
+	case CmdResolveFinalityConflictRequestMessage:
+		return &ResolveFinalityConflictResponseMessage{Error: rpcError}, nil
+	case CmdNotifyFinalityConflictsRequestMessage:
+		return &NotifyFinalityConflictsResponseMessage{Error: rpcError}, nil
+	case CmdGetMempoolEntriesRequestMessage:
+		return &GetMempoolEntriesResponseMessage{Error: rpcError}, nil
+	case CmdShutDownRequestMessage:
+		return &ShutDownResponseMessage{Error: rpcError}, nil
+	case CmdGetHeadersRequestMessage:
+		return &GetHeadersResponseMessage{Error: rpcError}, nil
+	case CmdNotifyUTXOsChangedRequestMessage:
+		return &NotifyUTXOsChangedResponseMessage{Error: rpcError}, nil
+	case CmdStopNotifyingUTXOsChangedRequestMessage:
+		return &StopNotifyingUTXOsChangedResponseMessage{Error: rpcError}, nil
+	case CmdGetUTXOsByAddressesRequestMessage:
+		return &GetUTXOsByAddressesResponseMessage{Error: rpcError}, nil
+	case CmdGetBalancesByAddressesRequestMessage:
+		return &GetBalancesByAddressesResponseMessage{Error: rpcError}, nil
+	case CmdGetVirtualSelectedParentBlueScoreRequestMessage:
+		return &GetVirtualSelectedParentBlueScoreResponseMessage{Error: rpcError}, nil
+	case CmdNotifyVirtualSelectedParentBlueScoreChangedRequestMessage:
+		return &NotifyVirtualSelectedParentBlueScoreChangedResponseMessage{Error: rpcError}, nil
+	case CmdBanRequestMessage:
+		return &BanResponseMessage{Error: rpcError}, nil
+	case CmdUnbanRequestMessage:
+		return &UnbanResponseMessage{Error: rpcError}, nil
+	case CmdGetInfoRequestMessage:
+		return &GetInfoResponseMessage{Error: rpcError}, nil
+	case CmdNotifyPruningPointUTXOSetOverrideRequestMessage:
+		return &NotifyPruningPointUTXOSetOverrideResponseMessage{Error: rpcError}, nil
+	case CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage:
+		return &StopNotifyingPruningPointUTXOSetOverrideResponseMessage{Error: rpcError}, nil
+	case CmdEstimateNetworkHashesPerSecondRequestMessage:
+		return &EstimateNetworkHashesPerSecondResponseMessage{Error: rpcError}, nil
+	case CmdNotifyVirtualDaaScoreChangedRequestMessage:
+		return &NotifyVirtualDaaScoreChangedResponseMessage{Error: rpcError}, nil
+	case CmdNotifyNewBlockTemplateRequestMessage:
+		return &NotifyNewBlockTemplateResponseMessage{Error: rpcError}, nil
+	case CmdGetCoinSupplyRequestMessage:
+		return &GetCoinSupplyResponseMessage{Error: rpcError}, nil
+	case CmdGetMempoolEntriesByAddressesRequestMessage:
+		return &GetMempoolEntriesByAddressesResponseMessage{Error: rpcError}, nil
+	case CmdGetCoinbaseSplitRequestMessage:
+		return &GetCoinbaseSplitResponseMessage{Error: rpcError}, nil
+	case CmdGetEmissionInfoRequestMessage:
+		return &GetEmissionInfoResponseMessage{Error: rpcError}, nil
+	case CmdTestMempoolAcceptRequestMessage:
+		return &TestMempoolAcceptResponseMessage{Error: rpcError}, nil
+	case CmdDecodeTransactionRequestMessage:
+		return &DecodeTransactionResponseMessage{Error: rpcError}, nil
+	case CmdDecodeScriptRequestMessage:
+		return &DecodeScriptResponseMessage{Error: rpcError}, nil
+	case CmdGetUTXOEntriesByOutpointsRequestMessage:
+		return &GetUTXOEntriesByOutpointsResponseMessage{Error: rpcError}, nil
+	case CmdGetSyncStatusRequestMessage:
+		return &GetSyncStatusResponseMessage{Error: rpcError}, nil
+	case CmdNotifySyncStatusChangedRequestMessage:
+		return &NotifySyncStatusChangedResponseMessage{Error: rpcError}, nil
+	case CmdVerifyMessageRequestMessage:
+		return &VerifyMessageResponseMessage{Error: rpcError}, nil
+	case CmdGetTransactionInclusionProofRequestMessage:
+		return &GetTransactionInclusionProofResponseMessage{Error: rpcError}, nil
+	case CmdInvalidateBlockRequestMessage:
+		return &InvalidateBlockResponseMessage{Error: rpcError}, nil
+	case CmdReconsiderBlockRequestMessage:
+		return &ReconsiderBlockResponseMessage{Error: rpcError}, nil
+	case CmdGetDAGSnapshotRequestMessage:
+		return &GetDAGSnapshotResponseMessage{Error: rpcError}, nil
+	case CmdGetTransactionStatusRequestMessage:
+		return &GetTransactionStatusResponseMessage{Error: rpcError}, nil
+	default:
+		return nil, errors.Errorf("no response message is known for request command %s", requestCommand)
+	}
+}
@@ -0,0 +1,58 @@
+package appmessage
+
+// GetCoinbaseSplitRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetCoinbaseSplitRequestMessage struct {
+	baseMessage
+	BlockHash string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetCoinbaseSplitRequestMessage) Command() MessageCommand {
+	return CmdGetCoinbaseSplitRequestMessage
+}
+
+// NewGetCoinbaseSplitRequestMessage returns a instance of the message
+func NewGetCoinbaseSplitRequestMessage(blockHash string) *GetCoinbaseSplitRequestMessage {
+	return &GetCoinbaseSplitRequestMessage{
+		BlockHash: blockHash,
+	}
+}
+
+// CoinbaseReward is the part of a coinbase transaction that pays the reward of
+// a merged blue block, or of all the merged red blocks
+type CoinbaseReward struct {
+	// BlockHash is empty for the merged red blocks' reward
+	BlockHash    string
+	MinerAddress string
+	MinerReward  uint64
+	DevFee       uint64
+}
+
+// GetCoinbaseSplitResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetCoinbaseSplitResponseMessage struct {
+	baseMessage
+	BlockHash        string
+	BlockVersion     uint32
+	IsDevFeeActive   bool
+	DevFeeAddress    string
+	DevFeePercentage uint64
+	BlueRewards      []*CoinbaseReward
+	RedReward        *CoinbaseReward
+	RedBlockHashes   []string
+	TotalMinerReward uint64
+	TotalDevFee      uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetCoinbaseSplitResponseMessage) Command() MessageCommand {
+	return CmdGetCoinbaseSplitResponseMessage
+}
+
+// NewGetCoinbaseSplitResponseMessage returns a instance of the message
+func NewGetCoinbaseSplitResponseMessage() *GetCoinbaseSplitResponseMessage {
+	return &GetCoinbaseSplitResponseMessage{}
+}
@@ -0,0 +1,76 @@
+package appmessage
+
+// GetDAGSnapshotRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetDAGSnapshotRequestMessage struct {
+	baseMessage
+
+	LowScore    uint64
+	HighScore   uint64
+	UseDAAScore bool
+	PageCursor  string
+	PageSize    uint32
+	IncludeDOT  bool
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetDAGSnapshotRequestMessage) Command() MessageCommand {
+	return CmdGetDAGSnapshotRequestMessage
+}
+
+// NewGetDAGSnapshotRequestMessage returns a instance of the message
+func NewGetDAGSnapshotRequestMessage(lowScore uint64, highScore uint64, useDAAScore bool, pageCursor string,
+	pageSize uint32, includeDOT bool) *GetDAGSnapshotRequestMessage {
+
+	return &GetDAGSnapshotRequestMessage{
+		LowScore:    lowScore,
+		HighScore:   highScore,
+		UseDAAScore: useDAAScore,
+		PageCursor:  pageCursor,
+		PageSize:    pageSize,
+		IncludeDOT:  includeDOT,
+	}
+}
+
+// GetDAGSnapshotResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetDAGSnapshotResponseMessage struct {
+	baseMessage
+
+	Blocks         []*RPCDAGSnapshotBlock
+	NextPageCursor string
+	DOT            string
+
+	Error *RPCError
+}
+
+// RPCDAGSnapshotBlock is a DAG snapshot block representation meant to be used over RPC
+type RPCDAGSnapshotBlock struct {
+	Hash                  string
+	ParentHashes          []string
+	SelectedParentHash    string
+	MergeSetBluesHashes   []string
+	MergeSetRedsHashes    []string
+	BlueScore             uint64
+	DAAScore              uint64
+	Status                string
+	IsChainBlock          bool
+	MergingChainBlockHash string
+	IsBlue                bool
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetDAGSnapshotResponseMessage) Command() MessageCommand {
+	return CmdGetDAGSnapshotResponseMessage
+}
+
+// NewGetDAGSnapshotResponseMessage returns a instance of the message
+func NewGetDAGSnapshotResponseMessage(blocks []*RPCDAGSnapshotBlock, nextPageCursor string,
+	dot string) *GetDAGSnapshotResponseMessage {
+
+	return &GetDAGSnapshotResponseMessage{
+		Blocks:         blocks,
+		NextPageCursor: nextPageCursor,
+		DOT:            dot,
+	}
+}
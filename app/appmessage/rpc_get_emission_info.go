@@ -0,0 +1,52 @@
+package appmessage
+
+// GetEmissionInfoRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetEmissionInfoRequestMessage struct {
+	baseMessage
+	DAAScores []uint64
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetEmissionInfoRequestMessage) Command() MessageCommand {
+	return CmdGetEmissionInfoRequestMessage
+}
+
+// NewGetEmissionInfoRequestMessage returns a instance of the message
+func NewGetEmissionInfoRequestMessage(daaScores []uint64) *GetEmissionInfoRequestMessage {
+	return &GetEmissionInfoRequestMessage{
+		DAAScores: daaScores,
+	}
+}
+
+// BlockSubsidy is the subsidy of the blocks with a given DAA score
+type BlockSubsidy struct {
+	DAAScore     uint64
+	BlockVersion uint32
+	Subsidy      uint64
+	EmittedSompi uint64
+}
+
+// GetEmissionInfoResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetEmissionInfoResponseMessage struct {
+	baseMessage
+	VirtualDAAScore      uint64
+	CurrentSubsidy       *BlockSubsidy
+	NextReduction        *BlockSubsidy
+	Subsidies            []*BlockSubsidy
+	ProjectedTotalSupply uint64
+	MaxSompi             uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetEmissionInfoResponseMessage) Command() MessageCommand {
+	return CmdGetEmissionInfoResponseMessage
+}
+
+// NewGetEmissionInfoResponseMessage returns a instance of the message
+func NewGetEmissionInfoResponseMessage() *GetEmissionInfoResponseMessage {
+	return &GetEmissionInfoResponseMessage{}
+}
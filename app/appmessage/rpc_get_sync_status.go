@@ -0,0 +1,68 @@
+package appmessage
+
+// IBDPhase is a stage of the initial block download
+type IBDPhase uint32
+
+// IBDPhase constants
+const (
+	IBDPhaseNone IBDPhase = iota
+	IBDPhaseChainNegotiation
+	IBDPhaseHeadersProof
+	IBDPhaseHeaders
+	IBDPhasePruningPointUTXOSet
+	IBDPhaseBlocks
+	IBDPhaseVirtualResolution
+)
+
+// RPCSyncStatus describes whether the node is synced, and the phase
+// and the progress of the initial block download if it's running
+type RPCSyncStatus struct {
+	IsSynced                   bool
+	IsIBDRunning               bool
+	IBDPhase                   IBDPhase
+	SyncPeerID                 string
+	SyncPeerAddress            string
+	PhaseStartTimeMilliseconds uint64
+	Processed                  uint64
+	Total                      uint64
+	ProgressPercent            uint32
+	ETAMilliseconds            uint64
+	HasETA                     bool
+}
+
+// GetSyncStatusRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetSyncStatusRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetSyncStatusRequestMessage) Command() MessageCommand {
+	return CmdGetSyncStatusRequestMessage
+}
+
+// NewGetSyncStatusRequestMessage returns a instance of the message
+func NewGetSyncStatusRequestMessage() *GetSyncStatusRequestMessage {
+	return &GetSyncStatusRequestMessage{}
+}
+
+// GetSyncStatusResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetSyncStatusResponseMessage struct {
+	baseMessage
+	SyncStatus *RPCSyncStatus
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetSyncStatusResponseMessage) Command() MessageCommand {
+	return CmdGetSyncStatusResponseMessage
+}
+
+// NewGetSyncStatusResponseMessage returns a instance of the message
+func NewGetSyncStatusResponseMessage(syncStatus *RPCSyncStatus) *GetSyncStatusResponseMessage {
+	return &GetSyncStatusResponseMessage{
+		SyncStatus: syncStatus,
+	}
+}
@@ -0,0 +1,67 @@
+package appmessage
+
+// GetTransactionInclusionProofRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionInclusionProofRequestMessage struct {
+	baseMessage
+	TransactionID   string
+	BlockHash       string
+	TargetBlockHash string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionInclusionProofRequestMessage) Command() MessageCommand {
+	return CmdGetTransactionInclusionProofRequestMessage
+}
+
+// NewGetTransactionInclusionProofRequestMessage returns a instance of the message
+func NewGetTransactionInclusionProofRequestMessage(transactionID string, blockHash string,
+	targetBlockHash string) *GetTransactionInclusionProofRequestMessage {
+
+	return &GetTransactionInclusionProofRequestMessage{
+		TransactionID:   transactionID,
+		BlockHash:       blockHash,
+		TargetBlockHash: targetBlockHash,
+	}
+}
+
+// GetTransactionInclusionProofResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionInclusionProofResponseMessage struct {
+	baseMessage
+	Proof *RPCTransactionInclusionProof
+
+	Error *RPCError
+}
+
+// RPCMerkleBranch is a merkle branch representation meant to be used over RPC
+type RPCMerkleBranch struct {
+	Index  uint64
+	Hashes []string
+}
+
+// RPCTransactionInclusionProof is a transaction inclusion proof representation
+// meant to be used over RPC
+type RPCTransactionInclusionProof struct {
+	IncludingBlockHeader   *RPCBlockHeader
+	HashMerkleBranch       *RPCMerkleBranch
+	MergeSetPath           []*RPCBlockHeader
+	AcceptingBlockHeader   *RPCBlockHeader
+	AcceptedIDMerkleBranch *RPCMerkleBranch
+	ChainPath              []*RPCBlockHeader
+	ChainPathOtherParents  [][]*RPCBlockHeader
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionInclusionProofResponseMessage) Command() MessageCommand {
+	return CmdGetTransactionInclusionProofResponseMessage
+}
+
+// NewGetTransactionInclusionProofResponseMessage returns a instance of the message
+func NewGetTransactionInclusionProofResponseMessage(
+	proof *RPCTransactionInclusionProof) *GetTransactionInclusionProofResponseMessage {
+
+	return &GetTransactionInclusionProofResponseMessage{
+		Proof: proof,
+	}
+}
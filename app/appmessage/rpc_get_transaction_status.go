@@ -0,0 +1,66 @@
+package appmessage
+
+// TransactionStatus describes whether a transaction is in the mempool, or was included
+// in a block and accepted by the selected chain
+type TransactionStatus uint32
+
+// TransactionStatus constants
+const (
+	TransactionStatusUnknown TransactionStatus = iota
+	TransactionStatusInMempool
+	TransactionStatusOrphan
+	TransactionStatusIncludedNotAccepted
+	TransactionStatusAccepted
+)
+
+// GetTransactionStatusRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionStatusRequestMessage struct {
+	baseMessage
+	TransactionID      string
+	IncludingBlockHash string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionStatusRequestMessage) Command() MessageCommand {
+	return CmdGetTransactionStatusRequestMessage
+}
+
+// NewGetTransactionStatusRequestMessage returns a instance of the message
+func NewGetTransactionStatusRequestMessage(transactionID string, includingBlockHash string) *GetTransactionStatusRequestMessage {
+	return &GetTransactionStatusRequestMessage{
+		TransactionID:      transactionID,
+		IncludingBlockHash: includingBlockHash,
+	}
+}
+
+// GetTransactionStatusResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetTransactionStatusResponseMessage struct {
+	baseMessage
+	Status                 TransactionStatus
+	IncludingBlockHash     string
+	AcceptingBlockHash     string
+	AcceptingBlockDAAScore uint64
+	Confirmations          uint64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetTransactionStatusResponseMessage) Command() MessageCommand {
+	return CmdGetTransactionStatusResponseMessage
+}
+
+// NewGetTransactionStatusResponseMessage returns a instance of the message
+func NewGetTransactionStatusResponseMessage(status TransactionStatus, includingBlockHash string,
+	acceptingBlockHash string, acceptingBlockDAAScore uint64, confirmations uint64) *GetTransactionStatusResponseMessage {
+
+	return &GetTransactionStatusResponseMessage{
+		Status:                 status,
+		IncludingBlockHash:     includingBlockHash,
+		AcceptingBlockHash:     acceptingBlockHash,
+		AcceptingBlockDAAScore: acceptingBlockDAAScore,
+		Confirmations:          confirmations,
+	}
+}
@@ -0,0 +1,54 @@
+package appmessage
+
+// GetUTXOEntriesByOutpointsRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type GetUTXOEntriesByOutpointsRequestMessage struct {
+	baseMessage
+	Outpoints            []*RPCOutpoint
+	IncludeMempoolSpends bool
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetUTXOEntriesByOutpointsRequestMessage) Command() MessageCommand {
+	return CmdGetUTXOEntriesByOutpointsRequestMessage
+}
+
+// NewGetUTXOEntriesByOutpointsRequestMessage returns a instance of the message
+func NewGetUTXOEntriesByOutpointsRequestMessage(outpoints []*RPCOutpoint,
+	includeMempoolSpends bool) *GetUTXOEntriesByOutpointsRequestMessage {
+
+	return &GetUTXOEntriesByOutpointsRequestMessage{
+		Outpoints:            outpoints,
+		IncludeMempoolSpends: includeMempoolSpends,
+	}
+}
+
+// GetUTXOEntriesByOutpointsResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type GetUTXOEntriesByOutpointsResponseMessage struct {
+	baseMessage
+	Entries []*UTXOEntryByOutpoint
+
+	Error *RPCError
+}
+
+// UTXOEntryByOutpoint represents the virtual UTXO entry of an outpoint.
+// UTXOEntry is nil if the outpoint isn't in the virtual UTXO set
+type UTXOEntryByOutpoint struct {
+	Outpoint                     *RPCOutpoint
+	UTXOEntry                    *RPCUTXOEntry
+	IsSpentInMempool             bool
+	MempoolSpendingTransactionID string
+}
+
+// Command returns the protocol command string for the message
+func (msg *GetUTXOEntriesByOutpointsResponseMessage) Command() MessageCommand {
+	return CmdGetUTXOEntriesByOutpointsResponseMessage
+}
+
+// NewGetUTXOEntriesByOutpointsResponseMessage returns a instance of the message
+func NewGetUTXOEntriesByOutpointsResponseMessage(entries []*UTXOEntryByOutpoint) *GetUTXOEntriesByOutpointsResponseMessage {
+	return &GetUTXOEntriesByOutpointsResponseMessage{
+		Entries: entries,
+	}
+}
@@ -0,0 +1,39 @@
+package appmessage
+
+// InvalidateBlockRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type InvalidateBlockRequestMessage struct {
+	baseMessage
+
+	BlockHash string
+}
+
+// Command returns the protocol command string for the message
+func (msg *InvalidateBlockRequestMessage) Command() MessageCommand {
+	return CmdInvalidateBlockRequestMessage
+}
+
+// NewInvalidateBlockRequestMessage returns an instance of the message
+func NewInvalidateBlockRequestMessage(blockHash string) *InvalidateBlockRequestMessage {
+	return &InvalidateBlockRequestMessage{
+		BlockHash: blockHash,
+	}
+}
+
+// InvalidateBlockResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type InvalidateBlockResponseMessage struct {
+	baseMessage
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *InvalidateBlockResponseMessage) Command() MessageCommand {
+	return CmdInvalidateBlockResponseMessage
+}
+
+// NewInvalidateBlockResponseMessage returns an instance of the message
+func NewInvalidateBlockResponseMessage() *InvalidateBlockResponseMessage {
+	return &InvalidateBlockResponseMessage{}
+}
@@ -0,0 +1,53 @@
+package appmessage
+
+// NotifySyncStatusChangedRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifySyncStatusChangedRequestMessage struct {
+	baseMessage
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifySyncStatusChangedRequestMessage) Command() MessageCommand {
+	return CmdNotifySyncStatusChangedRequestMessage
+}
+
+// NewNotifySyncStatusChangedRequestMessage returns a instance of the message
+func NewNotifySyncStatusChangedRequestMessage() *NotifySyncStatusChangedRequestMessage {
+	return &NotifySyncStatusChangedRequestMessage{}
+}
+
+// NotifySyncStatusChangedResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type NotifySyncStatusChangedResponseMessage struct {
+	baseMessage
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *NotifySyncStatusChangedResponseMessage) Command() MessageCommand {
+	return CmdNotifySyncStatusChangedResponseMessage
+}
+
+// NewNotifySyncStatusChangedResponseMessage returns a instance of the message
+func NewNotifySyncStatusChangedResponseMessage() *NotifySyncStatusChangedResponseMessage {
+	return &NotifySyncStatusChangedResponseMessage{}
+}
+
+// SyncStatusChangedNotificationMessage is an appmessage corresponding to
+// its respective RPC message
+type SyncStatusChangedNotificationMessage struct {
+	baseMessage
+	SyncStatus *RPCSyncStatus
+}
+
+// Command returns the protocol command string for the message
+func (msg *SyncStatusChangedNotificationMessage) Command() MessageCommand {
+	return CmdSyncStatusChangedNotificationMessage
+}
+
+// NewSyncStatusChangedNotificationMessage returns a instance of the message
+func NewSyncStatusChangedNotificationMessage(syncStatus *RPCSyncStatus) *SyncStatusChangedNotificationMessage {
+	return &SyncStatusChangedNotificationMessage{
+		SyncStatus: syncStatus,
+	}
+}
@@ -0,0 +1,39 @@
+package appmessage
+
+// ReconsiderBlockRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type ReconsiderBlockRequestMessage struct {
+	baseMessage
+
+	BlockHash string
+}
+
+// Command returns the protocol command string for the message
+func (msg *ReconsiderBlockRequestMessage) Command() MessageCommand {
+	return CmdReconsiderBlockRequestMessage
+}
+
+// NewReconsiderBlockRequestMessage returns an instance of the message
+func NewReconsiderBlockRequestMessage(blockHash string) *ReconsiderBlockRequestMessage {
+	return &ReconsiderBlockRequestMessage{
+		BlockHash: blockHash,
+	}
+}
+
+// ReconsiderBlockResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type ReconsiderBlockResponseMessage struct {
+	baseMessage
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *ReconsiderBlockResponseMessage) Command() MessageCommand {
+	return CmdReconsiderBlockResponseMessage
+}
+
+// NewReconsiderBlockResponseMessage returns an instance of the message
+func NewReconsiderBlockResponseMessage() *ReconsiderBlockResponseMessage {
+	return &ReconsiderBlockResponseMessage{}
+}
@@ -0,0 +1,53 @@
+package appmessage
+
+// TestMempoolAcceptRequestMessage is an appmessage corresponding to
+// its respective RPC message
+type TestMempoolAcceptRequestMessage struct {
+	baseMessage
+	Transaction *RPCTransaction
+}
+
+// Command returns the protocol command string for the message
+func (msg *TestMempoolAcceptRequestMessage) Command() MessageCommand {
+	return CmdTestMempoolAcceptRequestMessage
+}
+
+// NewTestMempoolAcceptRequestMessage returns a instance of the message
+func NewTestMempoolAcceptRequestMessage(transaction *RPCTransaction) *TestMempoolAcceptRequestMessage {
+	return &TestMempoolAcceptRequestMessage{
+		Transaction: transaction,
+	}
+}
+
+// TestMempoolAcceptResponseMessage is an appmessage corresponding to
+// its respective RPC message
+type TestMempoolAcceptResponseMessage struct {
+	baseMessage
+	TransactionID string
+	IsAccepted    bool
+	RejectReason  string
+	Mass          uint64
+	Fee           uint64
+	FeeRate       float64
+
+	Error *RPCError
+}
+
+// Command returns the protocol command string for the message
+func (msg *TestMempoolAcceptResponseMessage) Command() MessageCommand {
+	return CmdTestMempoolAcceptResponseMessage
+}
+
+// NewTestMempoolAcceptResponseMessage returns a instance of the message
+func NewTestMempoolAcceptResponseMessage(transactionID string, isAccepted bool, rejectReason string,
+	mass uint64, fee uint64, feeRate float64) *TestMempoolAcceptResponseMessage {
+
+	return &TestMempoolAcceptResponseMessage{
+		TransactionID: transactionID,
+		IsAccepted:    isAccepted,
+		RejectReason:  rejectReason,
+		Mass:          mass,
+		Fee:           fee,
+		FeeRate:       feeRate,
+	}
+}
@@ -83,6 +83,11 @@ func NewComponentManager(cfg *config.Config, db infrastructuredatabase.Database,
 		DeletionDepth:                   cfg.DeletionDepth,
 		EnableSanityCheckPruningUTXOSet: cfg.EnableSanityCheckPruningUTXOSet,
 	}
+	consensusConfig.AssumeValidBlockHash = cfg.AssumeValidBlockHash
+	if consensusConfig.AssumeValidBlockHash != nil {
+		log.Infof("Assuming that the transaction scripts in the past of block %s are valid",
+			consensusConfig.AssumeValidBlockHash)
+	}
 	mempoolConfig := mempool.DefaultConfig(&consensusConfig.Params)
 	mempoolConfig.MaximumOrphanTransactionCount = cfg.MaxOrphanTxs
 	mempoolConfig.MinimumRelayTransactionFee = cfg.MinRelayTxFee
@@ -176,6 +181,7 @@ func setupRPC(
 	)
 	protocolManager.SetOnNewBlockTemplateHandler(rpcManager.NotifyNewBlockTemplate)
 	protocolManager.SetOnPruningPointUTXOSetOverrideHandler(rpcManager.NotifyPruningPointUTXOSetOverride)
+	protocolManager.SetOnSyncStatusChangedHandler(rpcManager.NotifySyncStatusChanged)
 
 	return rpcManager
 }
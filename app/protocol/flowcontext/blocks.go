@@ -142,26 +142,33 @@ func (f *FlowContext) IsIBDRunning() bool {
 // if it is already set
 func (f *FlowContext) TrySetIBDRunning(ibdPeer *peerpkg.Peer) bool {
 	f.ibdPeerMutex.Lock()
-	defer f.ibdPeerMutex.Unlock()
 
 	if f.ibdPeer != nil {
+		f.ibdPeerMutex.Unlock()
 		return false
 	}
 	f.ibdPeer = ibdPeer
+	f.syncStatus = SyncStatus{Phase: IBDPhaseChainNegotiation, PhaseStartTime: time.Now()}
+	f.ibdPeerMutex.Unlock()
 
+	f.notifySyncStatusChanged()
 	return true
 }
 
 // UnsetIBDRunning unsets isInIBD
 func (f *FlowContext) UnsetIBDRunning() {
 	f.ibdPeerMutex.Lock()
-	defer f.ibdPeerMutex.Unlock()
 
 	if f.ibdPeer == nil {
+		f.ibdPeerMutex.Unlock()
 		panic("attempted to unset isInIBD when it was not set to begin with")
 	}
 
 	f.ibdPeer = nil
+	f.syncStatus = SyncStatus{}
+	f.ibdPeerMutex.Unlock()
+
+	f.notifySyncStatusChanged()
 }
 
 // IBDPeer returns the current IBD peer or null if the node is not
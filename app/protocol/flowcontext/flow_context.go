@@ -43,6 +43,7 @@ type FlowContext struct {
 	onNewBlockTemplateHandler            OnNewBlockTemplateHandler
 	onPruningPointUTXOSetOverrideHandler OnPruningPointUTXOSetOverrideHandler
 	onTransactionAddedToMempoolHandler   OnTransactionAddedToMempoolHandler
+	onSyncStatusChangedHandler           OnSyncStatusChangedHandler
 
 	lastRebroadcastTime         time.Time
 	sharedRequestedTransactions *SharedRequestedTransactions
@@ -50,6 +51,7 @@ type FlowContext struct {
 	sharedRequestedBlocks *SharedRequestedBlocks
 
 	ibdPeer      *peerpkg.Peer
+	syncStatus   SyncStatus
 	ibdPeerMutex sync.RWMutex
 
 	peers      map[id.ID]*peerpkg.Peer
@@ -116,3 +118,8 @@ func (f *FlowContext) SetOnPruningPointUTXOSetOverrideHandler(onPruningPointUTXO
 func (f *FlowContext) SetOnTransactionAddedToMempoolHandler(onTransactionAddedToMempoolHandler OnTransactionAddedToMempoolHandler) {
 	f.onTransactionAddedToMempoolHandler = onTransactionAddedToMempoolHandler
 }
+
+// SetOnSyncStatusChangedHandler sets the onSyncStatusChanged handler
+func (f *FlowContext) SetOnSyncStatusChangedHandler(onSyncStatusChangedHandler OnSyncStatusChangedHandler) {
+	f.onSyncStatusChangedHandler = onSyncStatusChangedHandler
+}
@@ -0,0 +1,123 @@
+package flowcontext
+
+import (
+	"time"
+
+	peerpkg "github.com/Hoosat-Oy/HTND/app/protocol/peer"
+)
+
+// IBDPhase is a stage of the initial block download
+type IBDPhase uint32
+
+const (
+	// IBDPhaseNone means that IBD isn't running
+	IBDPhaseNone IBDPhase = iota
+
+	// IBDPhaseChainNegotiation is the search for the highest chain block shared with the syncer
+	IBDPhaseChainNegotiation
+
+	// IBDPhaseHeadersProof is the download and validation of the pruning point proof,
+	// the past pruning points and the pruning point anticone
+	IBDPhaseHeadersProof
+
+	// IBDPhaseHeaders is the download of the headers in the future of the pruning point
+	IBDPhaseHeaders
+
+	// IBDPhasePruningPointUTXOSet is the download of the pruning point UTXO set
+	IBDPhasePruningPointUTXOSet
+
+	// IBDPhaseBlocks is the download of the missing block bodies
+	IBDPhaseBlocks
+
+	// IBDPhaseVirtualResolution is the resolution of the virtual after the blocks were downloaded
+	IBDPhaseVirtualResolution
+)
+
+var ibdPhaseStrings = map[IBDPhase]string{
+	IBDPhaseNone:                "None",
+	IBDPhaseChainNegotiation:    "ChainNegotiation",
+	IBDPhaseHeadersProof:        "HeadersProof",
+	IBDPhaseHeaders:             "Headers",
+	IBDPhasePruningPointUTXOSet: "PruningPointUTXOSet",
+	IBDPhaseBlocks:              "Blocks",
+	IBDPhaseVirtualResolution:   "VirtualResolution",
+}
+
+func (phase IBDPhase) String() string {
+	if phaseString, ok := ibdPhaseStrings[phase]; ok {
+		return phaseString
+	}
+	return "Unknown"
+}
+
+// OnSyncStatusChangedHandler is a handler function that's triggered whenever the IBD phase
+// or its progress changes
+type OnSyncStatusChangedHandler func() error
+
+// SyncStatus is a snapshot of the progress of the running IBD
+type SyncStatus struct {
+	Phase          IBDPhase
+	SyncPeer       *peerpkg.Peer
+	PhaseStartTime time.Time
+
+	// Processed is the amount of objects processed so far in the current phase.
+	// Total is the amount of objects expected in the current phase, or 0 if it isn't known in advance
+	Processed uint64
+	Total     uint64
+
+	ProgressPercent int
+}
+
+// ETA estimates the time left until the current phase is done by extrapolating the time it
+// took to reach ProgressPercent. It returns false when there's no progress to extrapolate from
+func (s *SyncStatus) ETA(now time.Time) (time.Duration, bool) {
+	if s.Phase == IBDPhaseNone || s.ProgressPercent <= 0 {
+		return 0, false
+	}
+	if s.ProgressPercent >= 100 {
+		return 0, true
+	}
+	elapsed := now.Sub(s.PhaseStartTime)
+	return elapsed * time.Duration(100-s.ProgressPercent) / time.Duration(s.ProgressPercent), true
+}
+
+// SyncStatus returns a snapshot of the progress of the running IBD
+func (f *FlowContext) SyncStatus() *SyncStatus {
+	f.ibdPeerMutex.RLock()
+	defer f.ibdPeerMutex.RUnlock()
+
+	syncStatus := f.syncStatus
+	syncStatus.SyncPeer = f.ibdPeer
+	return &syncStatus
+}
+
+// SetIBDPhase marks the beginning of the given IBD phase
+func (f *FlowContext) SetIBDPhase(phase IBDPhase) {
+	f.ibdPeerMutex.Lock()
+	f.syncStatus = SyncStatus{Phase: phase, PhaseStartTime: time.Now()}
+	f.ibdPeerMutex.Unlock()
+
+	log.Debugf("IBD phase: %s", phase)
+	f.notifySyncStatusChanged()
+}
+
+// ReportIBDProgress updates the progress of the current IBD phase
+func (f *FlowContext) ReportIBDProgress(processed uint64, total uint64, progressPercent int) {
+	f.ibdPeerMutex.Lock()
+	f.syncStatus.Processed = processed
+	f.syncStatus.Total = total
+	f.syncStatus.ProgressPercent = progressPercent
+	f.ibdPeerMutex.Unlock()
+
+	f.notifySyncStatusChanged()
+}
+
+func (f *FlowContext) notifySyncStatusChanged() {
+	if f.onSyncStatusChangedHandler == nil {
+		return
+	}
+	err := f.onSyncStatusChangedHandler()
+	if err != nil {
+		log.Warnf("Failed to notify about the sync status: %s", err)
+	}
+}
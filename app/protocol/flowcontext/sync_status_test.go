@@ -0,0 +1,51 @@
+package flowcontext
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSyncStatusETA(t *testing.T) {
+	now := time.Now()
+	phaseStartTime := now.Add(-time.Minute)
+
+	tests := []struct {
+		name        string
+		syncStatus  SyncStatus
+		expectedETA time.Duration
+		expectedOK  bool
+	}{
+		{
+			name:       "IBD isn't running",
+			syncStatus: SyncStatus{Phase: IBDPhaseNone, PhaseStartTime: phaseStartTime, ProgressPercent: 50},
+			expectedOK: false,
+		},
+		{
+			name:       "no progress yet",
+			syncStatus: SyncStatus{Phase: IBDPhaseBlocks, PhaseStartTime: phaseStartTime},
+			expectedOK: false,
+		},
+		{
+			name:        "quarter done",
+			syncStatus:  SyncStatus{Phase: IBDPhaseBlocks, PhaseStartTime: phaseStartTime, ProgressPercent: 25},
+			expectedETA: 3 * time.Minute,
+			expectedOK:  true,
+		},
+		{
+			name:        "done",
+			syncStatus:  SyncStatus{Phase: IBDPhaseHeaders, PhaseStartTime: phaseStartTime, ProgressPercent: 100},
+			expectedETA: 0,
+			expectedOK:  true,
+		},
+	}
+
+	for _, test := range tests {
+		eta, ok := test.syncStatus.ETA(now)
+		if ok != test.expectedOK {
+			t.Fatalf("%s: expected ok %t, got %t", test.name, test.expectedOK, ok)
+		}
+		if eta != test.expectedETA {
+			t.Fatalf("%s: expected ETA %s, got %s", test.name, test.expectedETA, eta)
+		}
+	}
+}
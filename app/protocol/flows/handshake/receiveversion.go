@@ -68,6 +68,14 @@ func (flow *receiveVersionFlow) start() (*appmessage.NetAddress, error) {
 		return nil, protocolerrors.New(false, "connected to self")
 	}
 
+	// Disconnect and ban peers that claim an ID other than the one bound to the
+	// node key they authenticated with in the encrypted transport
+	peerPublicKey := flow.peer.Connection().PeerPublicKey()
+	if peerPublicKey != nil && !peerPublicKey.ID().IsEqual(msgVersion.ID) {
+		return nil, protocolerrors.Errorf(true, "peer ID %s doesn't match its node public key %s",
+			msgVersion.ID, peerPublicKey)
+	}
+
 	// Disconnect and ban peers from a different network
 	if msgVersion.Network != flow.Config().ActiveNetParams.Name {
 		return nil, protocolerrors.Errorf(true, "wrong network")
@@ -0,0 +1,116 @@
+package blockrelay
+
+import (
+	"encoding/binary"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/protocol/protocolerrors"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionhelper"
+	"lukechampine.com/blake3"
+)
+
+// shortIDSize is the size in bytes of the short transaction IDs in compact blocks
+const shortIDSize = 8
+
+// shortIDHasher calculates the short IDs of the transactions of a compact block. The short
+// IDs are keyed by the block hash, so that colliding transactions can't be crafted in advance.
+type shortIDHasher struct {
+	hasher *blake3.Hasher
+}
+
+func newShortIDHasher(blockHash *externalapi.DomainHash) *shortIDHasher {
+	return &shortIDHasher{hasher: blake3.New(shortIDSize, blockHash.ByteSlice())}
+}
+
+func (h *shortIDHasher) shortID(transactionID *externalapi.DomainTransactionID) uint64 {
+	h.hasher.Reset()
+	_, _ = h.hasher.Write(transactionID.ByteSlice())
+	return binary.LittleEndian.Uint64(h.hasher.Sum(nil))
+}
+
+// domainBlockToMsgCompactBlock converts the given block to a compact block. Only the coinbase
+// transaction is prefilled, since it's the only one that can't be in the receiving peer's mempool.
+func domainBlockToMsgCompactBlock(block *externalapi.DomainBlock) *appmessage.MsgCompactBlock {
+	hasher := newShortIDHasher(consensushashing.BlockHash(block))
+
+	shortIDs := make([]uint64, 0, len(block.Transactions))
+	var prefilledTransactions []*appmessage.PrefilledTransaction
+	for i, transaction := range block.Transactions {
+		if i == transactionhelper.CoinbaseTransactionIndex {
+			prefilledTransactions = append(prefilledTransactions, &appmessage.PrefilledTransaction{
+				Index:       uint32(i),
+				Transaction: appmessage.DomainTransactionToMsgTx(transaction),
+			})
+			continue
+		}
+		shortIDs = append(shortIDs, hasher.shortID(consensushashing.TransactionID(transaction)))
+	}
+
+	return appmessage.NewMsgCompactBlock(appmessage.DomainBlockHeaderToBlockHeader(block.Header), block.PoWHash,
+		shortIDs, prefilledTransactions)
+}
+
+// compactBlockTransactions returns the transactions of the given compact block, taking the ones that
+// are not prefilled from the mempool using getMempoolTransactions. The transactions that were not
+// found, or whose short IDs are shared by several mempool transactions, are left nil, and their
+// indexes are returned as missingIndexes.
+func compactBlockTransactions(compactBlock *appmessage.MsgCompactBlock, blockHash *externalapi.DomainHash,
+	getMempoolTransactions func(filter func(transactionID *externalapi.DomainTransactionID) bool) []*externalapi.DomainTransaction) (
+	transactions []*externalapi.DomainTransaction, missingIndexes []uint32, err error) {
+
+	transactions = make([]*externalapi.DomainTransaction, compactBlock.TransactionCount())
+	for _, prefilledTransaction := range compactBlock.PrefilledTransactions {
+		index := prefilledTransaction.Index
+		if int(index) >= len(transactions) || transactions[index] != nil {
+			return nil, nil, protocolerrors.Errorf(true, "compact block %s has an invalid prefilled "+
+				"transaction index %d", blockHash, index)
+		}
+		transactions[index] = appmessage.MsgTxToDomainTransaction(prefilledTransaction.Transaction)
+	}
+
+	// Transactions whose short IDs are shared with other block transactions or with several
+	// mempool transactions can't be told apart, and are requested from the peer instead
+	isAmbiguous := make(map[uint32]bool)
+	indexesByShortID := make(map[uint64]uint32, len(compactBlock.ShortIDs))
+	nextShortID := 0
+	for i, transaction := range transactions {
+		if transaction != nil {
+			continue
+		}
+		shortID := compactBlock.ShortIDs[nextShortID]
+		nextShortID++
+		if index, ok := indexesByShortID[shortID]; ok {
+			isAmbiguous[index] = true
+			isAmbiguous[uint32(i)] = true
+			continue
+		}
+		indexesByShortID[shortID] = uint32(i)
+	}
+
+	hasher := newShortIDHasher(blockHash)
+	mempoolTransactions := getMempoolTransactions(func(transactionID *externalapi.DomainTransactionID) bool {
+		_, ok := indexesByShortID[hasher.shortID(transactionID)]
+		return ok
+	})
+	for _, transaction := range mempoolTransactions {
+		index := indexesByShortID[hasher.shortID(consensushashing.TransactionID(transaction))]
+		if transactions[index] != nil {
+			isAmbiguous[index] = true
+			continue
+		}
+		// Mempool transactions carry populated UTXO entries and fees, so only what the peer
+		// would have sent over the wire is taken from them
+		transactions[index] = appmessage.MsgTxToDomainTransaction(appmessage.DomainTransactionToMsgTx(transaction))
+	}
+
+	for i := range transactions {
+		if transactions[i] == nil || isAmbiguous[uint32(i)] {
+			transactions[i] = nil
+			missingIndexes = append(missingIndexes, uint32(i))
+		}
+	}
+
+	return transactions, missingIndexes, nil
+}
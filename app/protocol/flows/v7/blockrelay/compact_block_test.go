@@ -0,0 +1,117 @@
+package blockrelay
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/blockheader"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/merkle"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/subnetworks"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/utxo"
+)
+
+func testCompactBlockTransaction(lockTime uint64, subnetworkID externalapi.DomainSubnetworkID) *externalapi.DomainTransaction {
+	return &externalapi.DomainTransaction{
+		Version: 0,
+		Inputs: []*externalapi.DomainTransactionInput{{
+			PreviousOutpoint: externalapi.DomainOutpoint{TransactionID: externalapi.DomainTransactionID{}, Index: uint32(lockTime)},
+			SignatureScript:  []byte{},
+		}},
+		Outputs:      []*externalapi.DomainTransactionOutput{},
+		LockTime:     lockTime,
+		SubnetworkID: subnetworkID,
+		Payload:      []byte{},
+	}
+}
+
+func TestCompactBlockTransactions(t *testing.T) {
+	transactions := []*externalapi.DomainTransaction{testCompactBlockTransaction(0, subnetworks.SubnetworkIDCoinbase)}
+	for i := uint64(1); i <= 5; i++ {
+		transactions = append(transactions, testCompactBlockTransaction(i, subnetworks.SubnetworkIDNative))
+	}
+	block := &externalapi.DomainBlock{
+		Header: blockheader.NewImmutableBlockHeader(1, nil, merkle.CalculateHashMerkleRoot(transactions),
+			&externalapi.DomainHash{}, &externalapi.DomainHash{}, 0, 0, 0, 0, 0, big.NewInt(0), &externalapi.DomainHash{}),
+		Transactions: transactions,
+		PoWHash:      "powhash",
+	}
+	blockHash := consensushashing.BlockHash(block)
+
+	compactBlock := domainBlockToMsgCompactBlock(block)
+	if len(compactBlock.PrefilledTransactions) != 1 || compactBlock.PrefilledTransactions[0].Index != 0 {
+		t.Fatalf("expected only the coinbase transaction to be prefilled")
+	}
+	if compactBlock.TransactionCount() != len(transactions) {
+		t.Fatalf("expected %d transactions in the compact block, got %d", len(transactions), compactBlock.TransactionCount())
+	}
+
+	getMempoolTransactions := func(mempool []*externalapi.DomainTransaction) func(
+		filter func(transactionID *externalapi.DomainTransactionID) bool) []*externalapi.DomainTransaction {
+
+		return func(filter func(transactionID *externalapi.DomainTransactionID) bool) []*externalapi.DomainTransaction {
+			var filtered []*externalapi.DomainTransaction
+			for _, transaction := range mempool {
+				if filter(consensushashing.TransactionID(transaction)) {
+					filtered = append(filtered, transaction)
+				}
+			}
+			return filtered
+		}
+	}
+
+	// All the block transactions are in the mempool, with their UTXO entries populated, along with an unrelated one
+	mempool := []*externalapi.DomainTransaction{testCompactBlockTransaction(100, subnetworks.SubnetworkIDNative)}
+	for _, transaction := range transactions[1:] {
+		mempoolTransaction := transaction.Clone()
+		mempoolTransaction.Inputs[0].UTXOEntry = utxo.NewUTXOEntry(1, &externalapi.ScriptPublicKey{Script: []byte{}}, false, 0)
+		mempoolTransaction.Fee = 1
+		mempool = append(mempool, mempoolTransaction)
+	}
+	reconstructed, missingIndexes, err := compactBlockTransactions(compactBlock, blockHash, getMempoolTransactions(mempool))
+	if err != nil {
+		t.Fatalf("compactBlockTransactions: %+v", err)
+	}
+	if len(missingIndexes) != 0 {
+		t.Fatalf("expected no missing transactions, got %v", missingIndexes)
+	}
+	if !merkle.CalculateHashMerkleRoot(reconstructed).Equal(block.Header.HashMerkleRoot()) {
+		t.Fatalf("the reconstructed transactions don't match the block")
+	}
+	for i, transaction := range reconstructed[1:] {
+		if transaction.Inputs[0].UTXOEntry != nil || transaction.Fee != 0 {
+			t.Fatalf("reconstructed transaction #%d has mempool data", i+1)
+		}
+	}
+
+	// Transactions that are not in the mempool are missing, in block order
+	mempool = []*externalapi.DomainTransaction{transactions[1], transactions[3], transactions[5]}
+	reconstructed, missingIndexes, err = compactBlockTransactions(compactBlock, blockHash, getMempoolTransactions(mempool))
+	if err != nil {
+		t.Fatalf("compactBlockTransactions: %+v", err)
+	}
+	if len(missingIndexes) != 2 || missingIndexes[0] != 2 || missingIndexes[1] != 4 {
+		t.Fatalf("expected missing indexes [2 4], got %v", missingIndexes)
+	}
+	if reconstructed[2] != nil || reconstructed[4] != nil || reconstructed[3] == nil {
+		t.Fatalf("unexpected reconstructed transactions")
+	}
+
+	// Transactions with the same short ID can't be told apart, and are requested from the peer
+	compactBlock.ShortIDs[1] = compactBlock.ShortIDs[0]
+	_, missingIndexes, err = compactBlockTransactions(compactBlock, blockHash, getMempoolTransactions(transactions[1:]))
+	if err != nil {
+		t.Fatalf("compactBlockTransactions: %+v", err)
+	}
+	if len(missingIndexes) != 2 || missingIndexes[0] != 1 || missingIndexes[1] != 2 {
+		t.Fatalf("expected missing indexes [1 2], got %v", missingIndexes)
+	}
+
+	// A prefilled transaction index must be within the block
+	compactBlock.PrefilledTransactions[0].Index = uint32(len(transactions))
+	_, _, err = compactBlockTransactions(compactBlock, blockHash, getMempoolTransactions(nil))
+	if err == nil {
+		t.Fatalf("expected an error for an out of range prefilled transaction index")
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Hoosat-Oy/HTND/app/appmessage"
 	peerpkg "github.com/Hoosat-Oy/HTND/app/protocol/peer"
+	"github.com/Hoosat-Oy/HTND/app/protocol/protocolerrors"
 	"github.com/Hoosat-Oy/HTND/domain"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
@@ -20,7 +21,9 @@ type RelayBlockRequestsContext interface {
 const getBlockRetryInterval = 10 * time.Millisecond
 
 // HandleRelayBlockRequests listens to appmessage.MsgRequestRelayBlocks messages and sends
-// their corresponding blocks to the requesting peer.
+// their corresponding blocks to the requesting peer. Peers that support compact blocks receive
+// them as appmessage.MsgCompactBlock, and may then request the transactions they are missing
+// with appmessage.MsgRequestBlockTransactions.
 func HandleRelayBlockRequests(context RelayBlockRequestsContext, incomingRoute *router.Route,
 	outgoingRoute *router.Route, peer *peerpkg.Peer) error {
 	for {
@@ -28,6 +31,13 @@ func HandleRelayBlockRequests(context RelayBlockRequestsContext, incomingRoute *
 		if err != nil {
 			return err
 		}
+		if requestBlockTransactionsMessage, ok := message.(*appmessage.MsgRequestBlockTransactions); ok {
+			err := sendBlockTransactions(context, outgoingRoute, requestBlockTransactionsMessage)
+			if err != nil {
+				return err
+			}
+			continue
+		}
 		getRelayBlocksMessage := message.(*appmessage.MsgRequestRelayBlocks)
 		hashesLen := len(getRelayBlocksMessage.Hashes)
 		for i := 0; i < hashesLen; i++ {
@@ -65,8 +75,15 @@ func HandleRelayBlockRequests(context RelayBlockRequestsContext, incomingRoute *
 						block.PoWHash = powHash.String()
 					}
 				}
-				log.Debugf("Relaying block %s to peer %s", hash, peer.Address())
-				err = outgoingRoute.Enqueue(appmessage.DomainBlockToMsgBlock(block))
+				var blockMessage appmessage.Message
+				if peer.HasService(appmessage.SFNodeCompactBlocks) {
+					log.Debugf("Relaying compact block %s to peer %s", hash, peer.Address())
+					blockMessage = domainBlockToMsgCompactBlock(block)
+				} else {
+					log.Debugf("Relaying block %s to peer %s", hash, peer.Address())
+					blockMessage = appmessage.DomainBlockToMsgBlock(block)
+				}
+				err = outgoingRoute.Enqueue(blockMessage)
 				if err != nil {
 					log.Warnf("failed to enqueue block %s: %s", hash, err)
 					return
@@ -75,3 +92,27 @@ func HandleRelayBlockRequests(context RelayBlockRequestsContext, incomingRoute *
 		}
 	}
 }
+
+func sendBlockTransactions(context RelayBlockRequestsContext, outgoingRoute *router.Route,
+	message *appmessage.MsgRequestBlockTransactions) error {
+
+	block, found, err := context.Domain().Consensus().GetBlock(message.BlockHash)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return protocolerrors.Errorf(false, "requested transactions of block %s which is not found", message.BlockHash)
+	}
+
+	transactions := make([]*appmessage.MsgTx, len(message.Indexes))
+	for i, index := range message.Indexes {
+		if int(index) >= len(block.Transactions) {
+			return protocolerrors.Errorf(true, "requested transaction #%d of block %s which has only %d transactions",
+				index, message.BlockHash, len(block.Transactions))
+		}
+		transactions[i] = appmessage.DomainTransactionToMsgTx(block.Transactions[index])
+	}
+
+	log.Debugf("Sending %d transactions of block %s", len(transactions), message.BlockHash)
+	return outgoingRoute.Enqueue(appmessage.NewMsgBlockTransactions(message.BlockHash, transactions))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/hashset"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/merkle"
 	"github.com/Hoosat-Oy/HTND/infrastructure/config"
 	"github.com/Hoosat-Oy/HTND/infrastructure/db/database"
 	"github.com/Hoosat-Oy/HTND/infrastructure/network/connmanager"
@@ -388,11 +389,23 @@ func (flow *handleRelayInvsFlow) requestBlock(requestHash *externalapi.DomainHas
 		return nil, false, err
 	}
 
-	msgBlock, err := flow.readMsgBlock()
+	message, err := flow.readMessage()
 	if err != nil {
 		return nil, false, err
 	}
-	block := appmessage.MsgBlockToDomainBlock(msgBlock)
+
+	var block *externalapi.DomainBlock
+	switch message := message.(type) {
+	case *appmessage.MsgBlock:
+		block = appmessage.MsgBlockToDomainBlock(message)
+	case *appmessage.MsgCompactBlock:
+		block, err = flow.reconstructCompactBlock(message, requestHash)
+		if err != nil {
+			return nil, false, err
+		}
+	default:
+		return nil, false, errors.Errorf("unexpected message %s", message.Command())
+	}
 	blockHash := consensushashing.BlockHash(block)
 	if !blockHash.Equal(requestHash) {
 		return nil, false, protocolerrors.Errorf(true, "got unrequested block %s", blockHash)
@@ -401,24 +414,111 @@ func (flow *handleRelayInvsFlow) requestBlock(requestHash *externalapi.DomainHas
 	return block, false, nil
 }
 
-// readMsgBlock returns the next msgBlock in msgChan, and populates invsQueue with any inv messages that meanwhile arrive.
-//
-// Note: this function assumes msgChan can contain only appmessage.MsgInvRelayBlock and appmessage.MsgBlock messages.
-func (flow *handleRelayInvsFlow) readMsgBlock() (msgBlock *appmessage.MsgBlock, err error) {
+// reconstructCompactBlock rebuilds the block of the given compact block from the prefilled transactions and
+// the mempool, and requests the transactions that are still missing from the peer.
+func (flow *handleRelayInvsFlow) reconstructCompactBlock(compactBlock *appmessage.MsgCompactBlock,
+	requestHash *externalapi.DomainHash) (*externalapi.DomainBlock, error) {
+
+	header := appmessage.BlockHeaderToDomainBlockHeader(&compactBlock.Header)
+	blockHash := consensushashing.HeaderHash(header)
+	if !blockHash.Equal(requestHash) {
+		return nil, protocolerrors.Errorf(true, "got unrequested compact block %s", blockHash)
+	}
+
+	transactions, missingIndexes, err := compactBlockTransactions(compactBlock, blockHash,
+		func(filter func(transactionID *externalapi.DomainTransactionID) bool) []*externalapi.DomainTransaction {
+			transactionPoolTransactions, orphanPoolTransactions := flow.Domain().MiningManager().FilterTransactions(filter, true, true)
+			return append(transactionPoolTransactions, orphanPoolTransactions...)
+		})
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("Reconstructing compact block %s: %d of its %d transactions are missing from the mempool",
+		blockHash, len(missingIndexes), len(transactions))
+
+	if len(missingIndexes) > 0 {
+		err := flow.requestBlockTransactions(blockHash, transactions, missingIndexes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A mempool transaction may have the same ID as a block transaction and still differ from it, for
+	// example by its signature scripts. In that case, all the transactions taken from the mempool are
+	// requested again from the peer.
+	if !merkle.CalculateHashMerkleRoot(transactions).Equal(header.HashMerkleRoot()) {
+		log.Debugf("Compact block %s doesn't match its hash merkle root, requesting all of its transactions", blockHash)
+
+		isPrefilled := make(map[uint32]bool, len(compactBlock.PrefilledTransactions))
+		for _, prefilledTransaction := range compactBlock.PrefilledTransactions {
+			isPrefilled[prefilledTransaction.Index] = true
+		}
+		nonPrefilledIndexes := make([]uint32, 0, len(compactBlock.ShortIDs))
+		for i := range transactions {
+			if !isPrefilled[uint32(i)] {
+				nonPrefilledIndexes = append(nonPrefilledIndexes, uint32(i))
+			}
+		}
+		err := flow.requestBlockTransactions(blockHash, transactions, nonPrefilledIndexes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &externalapi.DomainBlock{
+		Header:       header,
+		Transactions: transactions,
+		PoWHash:      compactBlock.PoWHash,
+	}, nil
+}
+
+// requestBlockTransactions requests the transactions at the given indexes of the given block from the
+// peer, and sets them in transactions
+func (flow *handleRelayInvsFlow) requestBlockTransactions(blockHash *externalapi.DomainHash,
+	transactions []*externalapi.DomainTransaction, indexes []uint32) error {
+
+	err := flow.outgoingRoute.Enqueue(appmessage.NewMsgRequestBlockTransactions(blockHash, indexes))
+	if err != nil {
+		return err
+	}
+
+	message, err := flow.readMessage()
+	if err != nil {
+		return err
+	}
+	msgBlockTransactions, ok := message.(*appmessage.MsgBlockTransactions)
+	if !ok {
+		return errors.Errorf("unexpected message %s", message.Command())
+	}
+	if !msgBlockTransactions.BlockHash.Equal(blockHash) {
+		return protocolerrors.Errorf(true, "got transactions of block %s while expecting the ones of block %s",
+			msgBlockTransactions.BlockHash, blockHash)
+	}
+	if len(msgBlockTransactions.Transactions) != len(indexes) {
+		return protocolerrors.Errorf(true, "got %d transactions of block %s while expecting %d",
+			len(msgBlockTransactions.Transactions), blockHash, len(indexes))
+	}
+
+	for i, index := range indexes {
+		transactions[index] = appmessage.MsgTxToDomainTransaction(msgBlockTransactions.Transactions[i])
+	}
+	return nil
+}
+
+// readMessage returns the next message in msgChan which is not an inv, and populates invsQueue with
+// any inv messages that meanwhile arrive.
+func (flow *handleRelayInvsFlow) readMessage() (appmessage.Message, error) {
 	for {
 		message, err := flow.incomingRoute.DequeueWithTimeout(common.DefaultTimeout)
 		if err != nil {
 			return nil, err
 		}
 
-		switch message := message.(type) {
-		case *appmessage.MsgInvRelayBlock:
-			flow.invsQueue = append(flow.invsQueue, invRelayBlock{Hash: message.Hash, IsOrphanRoot: false})
-		case *appmessage.MsgBlock:
+		msgInv, ok := message.(*appmessage.MsgInvRelayBlock)
+		if !ok {
 			return message, nil
-		default:
-			return nil, errors.Errorf("unexpected message %s", message.Command())
 		}
+		flow.invsQueue = append(flow.invsQueue, invRelayBlock{Hash: msgInv.Hash, IsOrphanRoot: false})
 	}
 }
 
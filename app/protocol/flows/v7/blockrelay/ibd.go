@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/protocol/flowcontext"
 	peerpkg "github.com/Hoosat-Oy/HTND/app/protocol/peer"
 	"github.com/Hoosat-Oy/HTND/app/protocol/protocolerrors"
 	"github.com/Hoosat-Oy/HTND/domain"
@@ -30,6 +31,8 @@ type IBDContext interface {
 	IsIBDRunning() bool
 	TrySetIBDRunning(ibdPeer *peerpkg.Peer) bool
 	UnsetIBDRunning()
+	SetIBDPhase(phase flowcontext.IBDPhase)
+	ReportIBDProgress(processed uint64, total uint64, progressPercent int)
 	IsRecoverableError(err error) bool
 }
 
@@ -365,6 +368,7 @@ func (flow *handleIBDFlow) syncPruningPointFutureHeaders(
 ) error {
 
 	log.Infof("Downloading headers from %s", flow.peer)
+	flow.SetIBDPhase(flowcontext.IBDPhaseHeaders)
 
 	if highestKnownSyncerChainHash.Equal(syncerHeaderSelectedTipHash) {
 		// No need to get syncer selected tip headers → sync relay past and return
@@ -381,7 +385,7 @@ func (flow *handleIBDFlow) syncPruningPointFutureHeaders(
 		return err
 	}
 
-	progressReporter := newIBDProgressReporter(highestSharedBlockHeader.DAAScore(), highBlockDAAScoreHint, "block headers")
+	progressReporter := newIBDProgressReporter(flow, highestSharedBlockHeader.DAAScore(), highBlockDAAScoreHint, "block headers", 0)
 
 	for {
 		// Receive next batch of headers (this call blocks)
@@ -602,6 +606,8 @@ func (flow *handleIBDFlow) receiveAndInsertPruningPointUTXOSet(
 			if receivedChunkCount%getIBDBatchSize() == 0 {
 				log.Infof("Received %d UTXO set chunks so far, totaling in %d UTXOs",
 					receivedChunkCount, receivedUTXOCount)
+				// The size of the UTXO set isn't known in advance
+				flow.ReportIBDProgress(uint64(receivedUTXOCount), 0, 0)
 
 				requestNextPruningPointUTXOSetChunkMessage := appmessage.NewMsgRequestNextPruningPointUTXOSetChunk()
 				err := flow.outgoingRoute.Enqueue(requestNextPruningPointUTXOSetChunkMessage)
@@ -629,6 +635,7 @@ func (flow *handleIBDFlow) receiveAndInsertPruningPointUTXOSet(
 }
 
 func (flow *handleIBDFlow) syncMissingBlockBodies(highHash *externalapi.DomainHash) error {
+	flow.SetIBDPhase(flowcontext.IBDPhaseBlocks)
 	hashes, err := flow.Domain().Consensus().GetMissingBlockBodyHashes(highHash)
 	log.Infof("Found %d missing block bodies to sync.", len(hashes))
 	if err != nil {
@@ -647,7 +654,7 @@ func (flow *handleIBDFlow) syncMissingBlockBodies(highHash *externalapi.DomainHa
 	if err != nil {
 		return err
 	}
-	progressReporter := newIBDProgressReporter(lowBlockHeader.DAAScore(), highBlockHeader.DAAScore(), "blocks")
+	progressReporter := newIBDProgressReporter(flow, lowBlockHeader.DAAScore(), highBlockHeader.DAAScore(), "blocks", len(hashes))
 	highestProcessedDAAScore := lowBlockHeader.DAAScore()
 	updateVirtual, err := flow.Domain().Consensus().IsNearlySynced()
 	if err != nil {
@@ -728,6 +735,7 @@ func (flow *handleIBDFlow) syncMissingBlockBodies(highHash *externalapi.DomainHa
 }
 
 func (flow *handleIBDFlow) resolveVirtual(estimatedVirtualDAAScoreTarget uint64) error {
+	flow.SetIBDPhase(flowcontext.IBDPhaseVirtualResolution)
 	err := flow.Domain().Consensus().ResolveVirtual(func(virtualDAAScoreStart uint64, virtualDAAScore uint64) {
 		var percents int
 		if estimatedVirtualDAAScoreTarget-virtualDAAScoreStart <= 0 {
@@ -741,6 +749,15 @@ func (flow *handleIBDFlow) resolveVirtual(estimatedVirtualDAAScoreTarget uint64)
 			percents = 100
 		}
 		log.Infof("Resolving virtual. Estimated progress: %d%%", percents)
+
+		var processed, total uint64
+		if virtualDAAScore > virtualDAAScoreStart {
+			processed = virtualDAAScore - virtualDAAScoreStart
+		}
+		if estimatedVirtualDAAScoreTarget > virtualDAAScoreStart {
+			total = estimatedVirtualDAAScoreTarget - virtualDAAScoreStart
+		}
+		flow.ReportIBDProgress(processed, total, percents)
 	})
 	if err != nil {
 		if database.IsNotFoundError(err) {
@@ -1,23 +1,31 @@
 package blockrelay
 
 type ibdProgressReporter struct {
+	context                     IBDContext
 	lowDAAScore                 uint64
 	highDAAScore                uint64
 	objectName                  string
+	totalObjects                int
 	totalDAAScoreDifference     uint64
 	lastReportedProgressPercent int
 	processed                   int
 }
 
-func newIBDProgressReporter(lowDAAScore uint64, highDAAScore uint64, objectName string) *ibdProgressReporter {
+// newIBDProgressReporter creates a reporter for the progress of the current IBD phase.
+// totalObjects is the amount of objects expected to be processed, or 0 if it isn't known in advance
+func newIBDProgressReporter(context IBDContext, lowDAAScore uint64, highDAAScore uint64, objectName string,
+	totalObjects int) *ibdProgressReporter {
+
 	if highDAAScore <= lowDAAScore {
 		// Avoid a zero or negative diff
 		highDAAScore = lowDAAScore + 1
 	}
 	return &ibdProgressReporter{
+		context:                     context,
 		lowDAAScore:                 lowDAAScore,
 		highDAAScore:                highDAAScore,
 		objectName:                  objectName,
+		totalObjects:                totalObjects,
 		totalDAAScoreDifference:     highDAAScore - lowDAAScore,
 		lastReportedProgressPercent: 0,
 		processed:                   0,
@@ -41,5 +49,6 @@ func (ipr *ibdProgressReporter) reportProgress(processedDelta int, highestProces
 	if progressPercent > ipr.lastReportedProgressPercent {
 		log.Infof("IBD: Processed %d %s (%d%%)", ipr.processed, ipr.objectName, progressPercent)
 		ipr.lastReportedProgressPercent = progressPercent
+		ipr.context.ReportIBDProgress(uint64(ipr.processed), uint64(ipr.totalObjects), progressPercent)
 	}
 }
@@ -65,6 +65,7 @@ func registerBlockRelayFlows(m protocolManager, netConnection *netadapter.NetCon
 
 		m.RegisterFlow("HandleRelayInvs", router, []appmessage.MessageCommand{
 			appmessage.CmdInvRelayBlock, appmessage.CmdBlock, appmessage.CmdBlockLocator,
+			appmessage.CmdCompactBlock, appmessage.CmdBlockTransactions,
 		},
 			isStopping, errChan, func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
 				return blockrelay.HandleRelayInvs(m.Context(), m.Context().ConnectionManager(), netConnection, incomingRoute,
@@ -87,7 +88,8 @@ func registerBlockRelayFlows(m protocolManager, netConnection *netadapter.NetCon
 			},
 		),
 
-		m.RegisterFlow("HandleRelayBlockRequests", router, []appmessage.MessageCommand{appmessage.CmdRequestRelayBlocks}, isStopping, errChan,
+		m.RegisterFlow("HandleRelayBlockRequests", router, []appmessage.MessageCommand{
+			appmessage.CmdRequestRelayBlocks, appmessage.CmdRequestBlockTransactions}, isStopping, errChan,
 			func(incomingRoute *routerpkg.Route, peer *peerpkg.Peer) error {
 				return blockrelay.HandleRelayBlockRequests(m.Context(), incomingRoute, outgoingRoute, peer)
 			},
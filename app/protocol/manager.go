@@ -106,6 +106,11 @@ func (m *Manager) SetOnTransactionAddedToMempoolHandler(onTransactionAddedToMemp
 	m.context.SetOnTransactionAddedToMempoolHandler(onTransactionAddedToMempoolHandler)
 }
 
+// SetOnSyncStatusChangedHandler sets the onSyncStatusChanged handler
+func (m *Manager) SetOnSyncStatusChangedHandler(onSyncStatusChangedHandler flowcontext.OnSyncStatusChangedHandler) {
+	m.context.SetOnSyncStatusChangedHandler(onSyncStatusChangedHandler)
+}
+
 // IsIBDRunning returns true if IBD is currently marked as running
 func (m *Manager) IsIBDRunning() bool {
 	return m.context.IsIBDRunning()
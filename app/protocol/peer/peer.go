@@ -90,6 +90,11 @@ func (p *Peer) IsOutbound() bool {
 	return p.connection.IsOutbound()
 }
 
+// HasService returns whether the peer advertised the given service in its version message.
+func (p *Peer) HasService(service appmessage.ServiceFlag) bool {
+	return p.services&service == service
+}
+
 // UpdateFieldsFromMsgVersion updates the peer with the data from the version message.
 func (p *Peer) UpdateFieldsFromMsgVersion(msg *appmessage.MsgVersion, maxProtocolVersion uint32) {
 	// Negotiate the protocol version.
@@ -1,6 +1,8 @@
 package rpc
 
 import (
+	"time"
+
 	"github.com/Hoosat-Oy/HTND/app/appmessage"
 	"github.com/Hoosat-Oy/HTND/app/protocol"
 	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
@@ -17,7 +19,8 @@ import (
 
 // Manager is an RPC manager
 type Manager struct {
-	context *rpccontext.Context
+	context     *rpccontext.Context
+	rateLimiter *rateLimiter
 }
 
 // NewManager creates a new RPC Manager
@@ -43,6 +46,8 @@ func NewManager(
 			utxoIndex,
 			shutDownChan,
 		),
+		rateLimiter: newRateLimiter(cfg.RPCRateLimit, cfg.RPCRateBurst,
+			cfg.RPCMaxConcurrentReqs, cfg.RPCMaxClientConcurrentReqs),
 	}
 	netAdapter.SetRPCRouterInitializer(manager.routerInitializer)
 
@@ -76,6 +81,46 @@ func (m *Manager) initConsensusEventsHandler(consensusEventsChan chan externalap
 	})
 }
 
+// authorizeRequest checks the given request against the client's permission group,
+// the safe RPC mode and the rate limits before it is dispatched to its handler.
+// If the request is rejected, a response carrying the reason is returned instead.
+// Otherwise, the returned release function must be called once the request is handled.
+func (m *Manager) authorizeRequest(client *rpcClient, request appmessage.Message) (
+	release func(), rejectionResponse appmessage.Message, err error) {
+
+	command := request.Command()
+	var rpcError *appmessage.RPCError
+	switch {
+	case client.hasInvalidCredential:
+		rpcError = appmessage.NewPermissionDeniedRPCError("invalid RPC authentication token")
+	case !isCommandAllowed(client.group, command):
+		rpcError = appmessage.NewPermissionDeniedRPCError("%s is not allowed for RPC permission group %s",
+			command, client.group)
+	case m.context.Config.SafeRPC && isSafeRPCDisabled(command):
+		log.Warnf("%s called while node in safe RPC mode -- ignoring.", command)
+		rpcError = appmessage.NewPermissionDeniedRPCError("%s called while node in safe RPC mode", command)
+	default:
+		release, rpcError = m.rateLimiter.acquire(client.key, time.Now())
+		if rpcError != nil {
+			log.Debugf("Rate limited %s from RPC client %s: %s", command, client.key, rpcError.Message)
+		}
+	}
+	if rpcError == nil {
+		return release, nil, nil
+	}
+
+	rejectionResponse, err = appmessage.NewRPCErrorResponseMessage(command, rpcError)
+	if err != nil {
+		return nil, nil, err
+	}
+	return nil, rejectionResponse, nil
+}
+
+func isSafeRPCDisabled(command appmessage.MessageCommand) bool {
+	_, ok := safeRPCDisabledCommands[command]
+	return ok
+}
+
 // notifyBlockAddedToDAG notifies the manager that a block has been added to the DAG
 func (m *Manager) notifyBlockAddedToDAG(block *externalapi.DomainBlock) error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.notifyBlockAddedToDAG")
@@ -161,6 +206,25 @@ func (m *Manager) NotifyPruningPointUTXOSetOverride() error {
 	return nil
 }
 
+// NotifySyncStatusChanged notifies the manager that the phase or the progress of IBD has changed
+func (m *Manager) NotifySyncStatusChanged() error {
+	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifySyncStatusChanged")
+	defer onEnd()
+
+	// Building the sync status requires the consensus lock, so it's only
+	// done if any listeners are interested
+	if !m.context.NotificationManager.HasSyncStatusChangedListeners() {
+		return nil
+	}
+
+	syncStatus, err := m.context.SyncStatus()
+	if err != nil {
+		return err
+	}
+	notification := appmessage.NewSyncStatusChangedNotificationMessage(syncStatus)
+	return m.context.NotificationManager.NotifySyncStatusChanged(notification)
+}
+
 // NotifyFinalityConflict notifies the manager that there's a finality conflict in the DAG
 func (m *Manager) NotifyFinalityConflict(violatingBlockHash string) error {
 	onEnd := logger.LogAndMeasureExecutionTime(log, "RPCManager.NotifyFinalityConflict")
@@ -0,0 +1,187 @@
+package rpc
+
+import (
+	"net"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/infrastructure/config"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter"
+)
+
+// readOnlyCommands are the commands that query the node without affecting it.
+// They are allowed for every permission group.
+var readOnlyCommands = []appmessage.MessageCommand{
+	appmessage.CmdGetCurrentNetworkRequestMessage,
+	appmessage.CmdGetSelectedTipHashRequestMessage,
+	appmessage.CmdGetMempoolEntryRequestMessage,
+	appmessage.CmdGetMempoolEntriesRequestMessage,
+	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage,
+	appmessage.CmdGetBlockRequestMessage,
+	appmessage.CmdGetBlocksRequestMessage,
+	appmessage.CmdGetBlockByTransactionIDRequestMessage,
+	appmessage.CmdGetBlockCountRequestMessage,
+	appmessage.CmdGetBlockDAGInfoRequestMessage,
+	appmessage.CmdGetHeadersRequestMessage,
+	appmessage.CmdGetSubnetworkRequestMessage,
+	appmessage.CmdGetVirtualSelectedParentChainFromBlockRequestMessage,
+	appmessage.CmdGetVirtualSelectedParentBlueScoreRequestMessage,
+	appmessage.CmdGetUTXOsByAddressesRequestMessage,
+	appmessage.CmdGetBalanceByAddressRequestMessage,
+	appmessage.CmdGetBalancesByAddressesRequestMessage,
+	appmessage.CmdGetInfoRequestMessage,
+	appmessage.CmdGetCoinSupplyRequestMessage,
+	appmessage.CmdGetCoinbaseSplitRequestMessage,
+	appmessage.CmdGetEmissionInfoRequestMessage,
+	appmessage.CmdDecodeTransactionRequestMessage,
+	appmessage.CmdDecodeScriptRequestMessage,
+	appmessage.CmdGetUTXOEntriesByOutpointsRequestMessage,
+	appmessage.CmdGetSyncStatusRequestMessage,
+	appmessage.CmdVerifyMessageRequestMessage,
+	appmessage.CmdGetTransactionInclusionProofRequestMessage,
+	appmessage.CmdGetDAGSnapshotRequestMessage,
+	appmessage.CmdGetTransactionStatusRequestMessage,
+	appmessage.CmdEstimateNetworkHashesPerSecondRequestMessage,
+	appmessage.CmdNotifyBlockAddedRequestMessage,
+	appmessage.CmdNotifyVirtualSelectedParentChainChangedRequestMessage,
+	appmessage.CmdNotifyFinalityConflictsRequestMessage,
+	appmessage.CmdNotifyUTXOsChangedRequestMessage,
+	appmessage.CmdStopNotifyingUTXOsChangedRequestMessage,
+	appmessage.CmdNotifyVirtualSelectedParentBlueScoreChangedRequestMessage,
+	appmessage.CmdNotifyPruningPointUTXOSetOverrideRequestMessage,
+	appmessage.CmdStopNotifyingPruningPointUTXOSetOverrideRequestMessage,
+	appmessage.CmdNotifyVirtualDaaScoreChangedRequestMessage,
+	appmessage.CmdNotifySyncStatusChangedRequestMessage,
+	appmessage.CmdNotifyNewBlockTemplateRequestMessage,
+}
+
+// miningCommands are the commands allowed for the mining group on top of readOnlyCommands
+var miningCommands = []appmessage.MessageCommand{
+	appmessage.CmdGetBlockTemplateRequestMessage,
+	appmessage.CmdSubmitBlockRequestMessage,
+}
+
+// walletCommands are the commands allowed for the wallet group on top of readOnlyCommands
+var walletCommands = []appmessage.MessageCommand{
+	appmessage.CmdSubmitTransactionRequestMessage,
+	appmessage.CmdTestMempoolAcceptRequestMessage,
+}
+
+// adminCommands are the commands that are allowed only for the admin group.
+// Commands that are not classified at all are treated the same way.
+var adminCommands = []appmessage.MessageCommand{
+	appmessage.CmdGetPeerAddressesRequestMessage,
+	appmessage.CmdGetConnectedPeerInfoRequestMessage,
+	appmessage.CmdAddPeerRequestMessage,
+	appmessage.CmdBanRequestMessage,
+	appmessage.CmdUnbanRequestMessage,
+	appmessage.CmdResolveFinalityConflictRequestMessage,
+	appmessage.CmdInvalidateBlockRequestMessage,
+	appmessage.CmdReconsiderBlockRequestMessage,
+	appmessage.CmdShutDownRequestMessage,
+}
+
+// safeRPCDisabledCommands are the commands that are disabled for every client
+// when the node runs with --saferpc
+var safeRPCDisabledCommands = map[appmessage.MessageCommand]struct{}{
+	appmessage.CmdGetBlockByTransactionIDRequestMessage: {},
+	appmessage.CmdAddPeerRequestMessage:                 {},
+	appmessage.CmdBanRequestMessage:                     {},
+	appmessage.CmdUnbanRequestMessage:                   {},
+	appmessage.CmdResolveFinalityConflictRequestMessage: {},
+	appmessage.CmdInvalidateBlockRequestMessage:         {},
+	appmessage.CmdReconsiderBlockRequestMessage:         {},
+	appmessage.CmdShutDownRequestMessage:                {},
+}
+
+var permissionGroupCommands = buildPermissionGroupCommands()
+
+func buildPermissionGroupCommands() map[config.RPCPermissionGroup]map[appmessage.MessageCommand]struct{} {
+	toSet := func(commandLists ...[]appmessage.MessageCommand) map[appmessage.MessageCommand]struct{} {
+		set := make(map[appmessage.MessageCommand]struct{})
+		for _, commands := range commandLists {
+			for _, command := range commands {
+				set[command] = struct{}{}
+			}
+		}
+		return set
+	}
+	return map[config.RPCPermissionGroup]map[appmessage.MessageCommand]struct{}{
+		config.RPCPermissionGroupReadOnly: toSet(readOnlyCommands),
+		config.RPCPermissionGroupMining:   toSet(readOnlyCommands, miningCommands),
+		config.RPCPermissionGroupWallet:   toSet(readOnlyCommands, walletCommands),
+	}
+}
+
+// isCommandAllowed returns whether the given permission group may call the given command
+func isCommandAllowed(group config.RPCPermissionGroup, command appmessage.MessageCommand) bool {
+	if group == config.RPCPermissionGroupAdmin {
+		return true
+	}
+	_, ok := permissionGroupCommands[group][command]
+	return ok
+}
+
+// rpcClient describes the party on the other side of an RPC connection
+type rpcClient struct {
+	// key identifies the client for the purpose of rate limiting. Clients
+	// that present a credential are identified by it, other clients by their IP.
+	key string
+
+	group config.RPCPermissionGroup
+
+	// hasInvalidCredential is set if the client presented a token that
+	// matches none of the configured credentials
+	hasInvalidCredential bool
+}
+
+// newRPCClient resolves the identity and permission group of the client
+// connected through the given connection
+func newRPCClient(cfg *config.Config, netConnection *netadapter.NetConnection) *rpcClient {
+	authToken := netConnection.AuthToken()
+	if authToken != "" {
+		group, ok := cfg.RPCCredentials[authToken]
+		return &rpcClient{
+			key:                  "token:" + authToken,
+			group:                group,
+			hasInvalidCredential: !ok,
+		}
+	}
+
+	key := netConnection.Address()
+	host, _, err := net.SplitHostPort(key)
+	if err == nil {
+		key = host
+	}
+	return &rpcClient{
+		key:   key,
+		group: listenerPermissionGroup(cfg, netConnection.LocalAddress()),
+	}
+}
+
+// listenerPermissionGroup returns the permission group assigned to the RPC listener
+// that accepted a connection on localAddress, falling back to --rpcdefaultgroup.
+// A listener assigned to a specific interface takes precedence over a wildcard one.
+func listenerPermissionGroup(cfg *config.Config, localAddress string) config.RPCPermissionGroup {
+	defaultGroup := config.RPCPermissionGroup(cfg.RPCDefaultGroup)
+	if localAddress == "" || len(cfg.RPCListenerGroups) == 0 {
+		return defaultGroup
+	}
+	if group, ok := cfg.RPCListenerGroups[localAddress]; ok {
+		return group
+	}
+
+	_, localPort, err := net.SplitHostPort(localAddress)
+	if err != nil {
+		return defaultGroup
+	}
+	for listener, group := range cfg.RPCListenerGroups {
+		host, port, err := net.SplitHostPort(listener)
+		if err != nil || port != localPort {
+			continue
+		}
+		if host == "" || net.ParseIP(host).IsUnspecified() {
+			return group
+		}
+	}
+	return defaultGroup
+}
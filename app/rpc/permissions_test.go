@@ -0,0 +1,80 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/infrastructure/config"
+)
+
+func TestAllHandledCommandsAreClassified(t *testing.T) {
+	classified := make(map[appmessage.MessageCommand]struct{})
+	for _, commands := range [][]appmessage.MessageCommand{readOnlyCommands, miningCommands, walletCommands, adminCommands} {
+		for _, command := range commands {
+			if _, ok := classified[command]; ok {
+				t.Errorf("%s is classified more than once", command)
+			}
+			classified[command] = struct{}{}
+		}
+	}
+
+	for command := range handlers {
+		if _, ok := classified[command]; !ok {
+			t.Errorf("%s is not classified into any RPC permission group", command)
+		}
+		_, err := appmessage.NewRPCErrorResponseMessage(command, appmessage.RPCErrorf("error"))
+		if err != nil {
+			t.Errorf("%s has no error response: %s", command, err)
+		}
+	}
+}
+
+func TestIsCommandAllowed(t *testing.T) {
+	tests := []struct {
+		group    config.RPCPermissionGroup
+		command  appmessage.MessageCommand
+		expected bool
+	}{
+		{config.RPCPermissionGroupReadOnly, appmessage.CmdGetInfoRequestMessage, true},
+		{config.RPCPermissionGroupReadOnly, appmessage.CmdSubmitTransactionRequestMessage, false},
+		{config.RPCPermissionGroupReadOnly, appmessage.CmdSubmitBlockRequestMessage, false},
+		{config.RPCPermissionGroupMining, appmessage.CmdSubmitBlockRequestMessage, true},
+		{config.RPCPermissionGroupMining, appmessage.CmdSubmitTransactionRequestMessage, false},
+		{config.RPCPermissionGroupWallet, appmessage.CmdSubmitTransactionRequestMessage, true},
+		{config.RPCPermissionGroupWallet, appmessage.CmdGetBlockTemplateRequestMessage, false},
+		{config.RPCPermissionGroupWallet, appmessage.CmdBanRequestMessage, false},
+		{config.RPCPermissionGroupAdmin, appmessage.CmdShutDownRequestMessage, true},
+		{"", appmessage.CmdGetInfoRequestMessage, false},
+	}
+	for _, test := range tests {
+		allowed := isCommandAllowed(test.group, test.command)
+		if allowed != test.expected {
+			t.Errorf("isCommandAllowed(%s, %s): expected %t but got %t", test.group, test.command, test.expected, allowed)
+		}
+	}
+}
+
+func TestListenerPermissionGroup(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.RPCDefaultGroup = string(config.RPCPermissionGroupAdmin)
+	cfg.RPCListenerGroups = map[string]config.RPCPermissionGroup{
+		"0.0.0.0:42420":   config.RPCPermissionGroupReadOnly,
+		"127.0.0.1:42420": config.RPCPermissionGroupMining,
+	}
+
+	tests := []struct {
+		localAddress string
+		expected     config.RPCPermissionGroup
+	}{
+		{"127.0.0.1:42420", config.RPCPermissionGroupMining},
+		{"10.0.0.1:42420", config.RPCPermissionGroupReadOnly},
+		{"10.0.0.1:42421", config.RPCPermissionGroupAdmin},
+		{"", config.RPCPermissionGroupAdmin},
+	}
+	for _, test := range tests {
+		group := listenerPermissionGroup(cfg, test.localAddress)
+		if group != test.expected {
+			t.Errorf("listenerPermissionGroup(%s): expected %s but got %s", test.localAddress, test.expected, group)
+		}
+	}
+}
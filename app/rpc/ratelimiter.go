@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+)
+
+// idleBucketPruneInterval is how often the rate limiter drops the token
+// buckets of clients that have been idle long enough for them to refill
+const idleBucketPruneInterval = time.Minute
+
+// tokenBucket holds the request allowance of a single client
+type tokenBucket struct {
+	tokens     float64
+	lastRefill time.Time
+}
+
+// rateLimiter enforces per-client token-bucket rate limits as well as
+// per-client and global caps on the number of concurrently processed requests
+type rateLimiter struct {
+	rate                   float64
+	burst                  float64
+	maxConcurrentRequests  int
+	maxConcurrentPerClient int
+
+	lock              sync.Mutex
+	buckets           map[string]*tokenBucket
+	clientConcurrency map[string]int
+	totalConcurrency  int
+	lastPrune         time.Time
+}
+
+// newRateLimiter creates a rateLimiter. A rate of 0 disables rate limiting,
+// and a concurrency cap of 0 disables that cap.
+func newRateLimiter(rate float64, burst int, maxConcurrentRequests int, maxConcurrentPerClient int) *rateLimiter {
+	return &rateLimiter{
+		rate:                   rate,
+		burst:                  float64(burst),
+		maxConcurrentRequests:  maxConcurrentRequests,
+		maxConcurrentPerClient: maxConcurrentPerClient,
+		buckets:                make(map[string]*tokenBucket),
+		clientConcurrency:      make(map[string]int),
+	}
+}
+
+// acquire admits a single request of the given client at time `now`. If the request
+// is admitted, the returned release function must be called once it is processed.
+// Otherwise, the returned RPCError describes why the request was rejected.
+func (rl *rateLimiter) acquire(clientKey string, now time.Time) (release func(), rpcError *appmessage.RPCError) {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	if rl.maxConcurrentRequests > 0 && rl.totalConcurrency >= rl.maxConcurrentRequests {
+		return nil, appmessage.NewRateLimitedRPCError(0,
+			"the node is already processing the maximum of %d concurrent RPC requests", rl.maxConcurrentRequests)
+	}
+	if rl.maxConcurrentPerClient > 0 && rl.clientConcurrency[clientKey] >= rl.maxConcurrentPerClient {
+		return nil, appmessage.NewRateLimitedRPCError(0,
+			"the client already has the maximum of %d concurrent RPC requests in progress", rl.maxConcurrentPerClient)
+	}
+
+	if rl.rate > 0 {
+		rl.pruneIdleBuckets(now)
+
+		bucket, ok := rl.buckets[clientKey]
+		if !ok {
+			bucket = &tokenBucket{tokens: rl.burst, lastRefill: now}
+			rl.buckets[clientKey] = bucket
+		}
+		rl.refill(bucket, now)
+		if bucket.tokens < 1 {
+			retryAfter := time.Duration(math.Ceil((1-bucket.tokens)/rl.rate*1000)) * time.Millisecond
+			return nil, appmessage.NewRateLimitedRPCError(retryAfter,
+				"the client exceeded the rate limit of %g RPC requests per second", rl.rate)
+		}
+		bucket.tokens--
+	}
+
+	rl.totalConcurrency++
+	rl.clientConcurrency[clientKey]++
+
+	var once sync.Once
+	return func() { once.Do(func() { rl.release(clientKey) }) }, nil
+}
+
+func (rl *rateLimiter) release(clientKey string) {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	rl.totalConcurrency--
+	rl.clientConcurrency[clientKey]--
+	if rl.clientConcurrency[clientKey] <= 0 {
+		delete(rl.clientConcurrency, clientKey)
+	}
+}
+
+func (rl *rateLimiter) refill(bucket *tokenBucket, now time.Time) {
+	elapsed := now.Sub(bucket.lastRefill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	bucket.tokens = math.Min(rl.burst, bucket.tokens+elapsed*rl.rate)
+	bucket.lastRefill = now
+}
+
+// pruneIdleBuckets drops buckets that are full by now. A full bucket is
+// indistinguishable from a new one, so dropping it does not affect the limits.
+// Must be called with the lock held.
+func (rl *rateLimiter) pruneIdleBuckets(now time.Time) {
+	if now.Sub(rl.lastPrune) < idleBucketPruneInterval {
+		return
+	}
+	rl.lastPrune = now
+
+	for clientKey, bucket := range rl.buckets {
+		rl.refill(bucket, now)
+		if bucket.tokens >= rl.burst {
+			delete(rl.buckets, clientKey)
+		}
+	}
+}
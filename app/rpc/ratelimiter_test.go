@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+)
+
+func TestRateLimiterTokenBucket(t *testing.T) {
+	limiter := newRateLimiter(2, 3, 0, 0)
+	now := time.Unix(1000, 0)
+
+	for i := 0; i < 3; i++ {
+		release, rpcError := limiter.acquire("client", now)
+		if rpcError != nil {
+			t.Fatalf("request %d: unexpected rejection: %s", i, rpcError)
+		}
+		release()
+	}
+
+	_, rpcError := limiter.acquire("client", now)
+	if rpcError == nil {
+		t.Fatalf("expected the request after the burst to be rate limited")
+	}
+	if rpcError.Code != appmessage.RPCErrorCodeRateLimited {
+		t.Fatalf("expected code %d but got %d", appmessage.RPCErrorCodeRateLimited, rpcError.Code)
+	}
+	if rpcError.RetryAfterMilliseconds != 500 {
+		t.Fatalf("expected to retry after 500ms but got %dms", rpcError.RetryAfterMilliseconds)
+	}
+
+	_, rpcError = limiter.acquire("other client", now)
+	if rpcError != nil {
+		t.Fatalf("another client must not be affected: %s", rpcError)
+	}
+
+	release, rpcError := limiter.acquire("client", now.Add(500*time.Millisecond))
+	if rpcError != nil {
+		t.Fatalf("expected a token to be refilled: %s", rpcError)
+	}
+	release()
+}
+
+func TestRateLimiterConcurrency(t *testing.T) {
+	limiter := newRateLimiter(0, 0, 3, 2)
+	now := time.Unix(1000, 0)
+
+	releaseA1, rpcError := limiter.acquire("a", now)
+	if rpcError != nil {
+		t.Fatalf("unexpected rejection: %s", rpcError)
+	}
+	_, rpcError = limiter.acquire("a", now)
+	if rpcError != nil {
+		t.Fatalf("unexpected rejection: %s", rpcError)
+	}
+	_, rpcError = limiter.acquire("a", now)
+	if rpcError == nil {
+		t.Fatalf("expected the per-client concurrency cap to be enforced")
+	}
+
+	_, rpcError = limiter.acquire("b", now)
+	if rpcError != nil {
+		t.Fatalf("unexpected rejection: %s", rpcError)
+	}
+	_, rpcError = limiter.acquire("c", now)
+	if rpcError == nil {
+		t.Fatalf("expected the global concurrency cap to be enforced")
+	}
+
+	releaseA1()
+	releaseA1()
+	_, rpcError = limiter.acquire("c", now)
+	if rpcError != nil {
+		t.Fatalf("expected a released slot to be reusable: %s", rpcError)
+	}
+	_, rpcError = limiter.acquire("c", now)
+	if rpcError == nil {
+		t.Fatalf("a release function called twice must release only once")
+	}
+}
@@ -52,6 +52,20 @@ var handlers = map[appmessage.MessageCommand]handler{
 	appmessage.CmdNotifyNewBlockTemplateRequestMessage:                      rpchandlers.HandleNotifyNewBlockTemplate,
 	appmessage.CmdGetCoinSupplyRequestMessage:                               rpchandlers.HandleGetCoinSupply,
 	appmessage.CmdGetMempoolEntriesByAddressesRequestMessage:                rpchandlers.HandleGetMempoolEntriesByAddresses,
+	appmessage.CmdGetCoinbaseSplitRequestMessage:                            rpchandlers.HandleGetCoinbaseSplit,
+	appmessage.CmdGetEmissionInfoRequestMessage:                             rpchandlers.HandleGetEmissionInfo,
+	appmessage.CmdTestMempoolAcceptRequestMessage:                           rpchandlers.HandleTestMempoolAccept,
+	appmessage.CmdDecodeTransactionRequestMessage:                           rpchandlers.HandleDecodeTransaction,
+	appmessage.CmdDecodeScriptRequestMessage:                                rpchandlers.HandleDecodeScript,
+	appmessage.CmdGetUTXOEntriesByOutpointsRequestMessage:                   rpchandlers.HandleGetUTXOEntriesByOutpoints,
+	appmessage.CmdGetSyncStatusRequestMessage:                               rpchandlers.HandleGetSyncStatus,
+	appmessage.CmdNotifySyncStatusChangedRequestMessage:                     rpchandlers.HandleNotifySyncStatusChanged,
+	appmessage.CmdVerifyMessageRequestMessage:                               rpchandlers.HandleVerifyMessage,
+	appmessage.CmdGetTransactionInclusionProofRequestMessage:                rpchandlers.HandleGetTransactionInclusionProof,
+	appmessage.CmdInvalidateBlockRequestMessage:                             rpchandlers.HandleInvalidateBlock,
+	appmessage.CmdReconsiderBlockRequestMessage:                             rpchandlers.HandleReconsiderBlock,
+	appmessage.CmdGetDAGSnapshotRequestMessage:                              rpchandlers.HandleGetDAGSnapshot,
+	appmessage.CmdGetTransactionStatusRequestMessage:                        rpchandlers.HandleGetTransactionStatus,
 }
 
 func (m *Manager) routerInitializer(router *router.Router, netConnection *netadapter.NetConnection) {
@@ -64,16 +78,17 @@ func (m *Manager) routerInitializer(router *router.Router, netConnection *netada
 		panic(err)
 	}
 	m.context.NotificationManager.AddListener(router)
+	client := newRPCClient(m.context.Config, netConnection)
 
 	spawn("routerInitializer-handleIncomingMessages", func() {
 		defer m.context.NotificationManager.RemoveListener(router)
 
-		err := m.handleIncomingMessages(router, incomingRoute)
+		err := m.handleIncomingMessages(router, incomingRoute, client)
 		m.handleError(err, netConnection)
 	})
 }
 
-func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route) error {
+func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *router.Route, client *rpcClient) error {
 	outgoingRoute := router.OutgoingRoute()
 	for {
 		request, err := incomingRoute.Dequeue()
@@ -84,10 +99,17 @@ func (m *Manager) handleIncomingMessages(router *router.Router, incomingRoute *r
 		if !ok {
 			return err
 		}
-		response, err := handler(m.context, router, request)
+		release, response, err := m.authorizeRequest(client, request)
 		if err != nil {
 			return err
 		}
+		if response == nil {
+			response, err = handler(m.context, router, request)
+			release()
+			if err != nil {
+				return err
+			}
+		}
 		err = outgoingRoute.Enqueue(response)
 		if err != nil {
 			return err
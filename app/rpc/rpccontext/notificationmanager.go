@@ -41,6 +41,7 @@ type NotificationListener struct {
 	propagateVirtualDaaScoreChangedNotifications                bool
 	propagatePruningPointUTXOSetOverrideNotifications           bool
 	propagateNewBlockTemplateNotifications                      bool
+	propagateSyncStatusChangedNotifications                     bool
 
 	propagateUTXOsChangedNotificationAddresses                                    map[utxoindex.ScriptPublicKeyString]*UTXOsChangedNotificationAddress
 	includeAcceptedTransactionIDsInVirtualSelectedParentChainChangedNotifications bool
@@ -301,6 +302,38 @@ func (nm *NotificationManager) NotifyPruningPointUTXOSetOverride() error {
 	return nil
 }
 
+// HasSyncStatusChangedListeners indicates if the notification manager has any listeners for `SyncStatusChanged` events
+func (nm *NotificationManager) HasSyncStatusChangedListeners() bool {
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for _, listener := range nm.listeners {
+		if listener.propagateSyncStatusChangedNotifications {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifySyncStatusChanged notifies the notification manager that the phase or
+// the progress of IBD has changed
+func (nm *NotificationManager) NotifySyncStatusChanged(
+	notification *appmessage.SyncStatusChangedNotificationMessage) error {
+
+	nm.RLock()
+	defer nm.RUnlock()
+
+	for router, listener := range nm.listeners {
+		if listener.propagateSyncStatusChangedNotifications {
+			err := router.OutgoingRoute().MaybeEnqueue(notification)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func newNotificationListener(params *dagconfig.Params) *NotificationListener {
 	return &NotificationListener{
 		params: params,
@@ -313,6 +346,7 @@ func newNotificationListener(params *dagconfig.Params) *NotificationListener {
 		propagateVirtualSelectedParentBlueScoreChangedNotifications: false,
 		propagateNewBlockTemplateNotifications:                      false,
 		propagatePruningPointUTXOSetOverrideNotifications:           false,
+		propagateSyncStatusChangedNotifications:                     false,
 	}
 }
 
@@ -452,7 +486,7 @@ func (nl *NotificationListener) scriptPubKeyStringToAddressString(scriptPublicKe
 	}
 
 	var addressString string
-	if scriptType == txscript.NonStandardTy {
+	if scriptType == txscript.NonStandardTy || address == nil {
 		addressString = ""
 	} else {
 		addressString = address.String()
@@ -489,3 +523,9 @@ func (nl *NotificationListener) PropagatePruningPointUTXOSetOverrideNotification
 func (nl *NotificationListener) StopPropagatingPruningPointUTXOSetOverrideNotifications() {
 	nl.propagatePruningPointUTXOSetOverrideNotifications = false
 }
+
+// PropagateSyncStatusChangedNotifications instructs the listener to send
+// sync status notifications to the remote listener
+func (nl *NotificationListener) PropagateSyncStatusChangedNotifications() {
+	nl.propagateSyncStatusChangedNotifications = true
+}
@@ -0,0 +1,52 @@
+package rpccontext
+
+import (
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/protocol/flowcontext"
+)
+
+var ibdPhasesToRPC = map[flowcontext.IBDPhase]appmessage.IBDPhase{
+	flowcontext.IBDPhaseNone:                appmessage.IBDPhaseNone,
+	flowcontext.IBDPhaseChainNegotiation:    appmessage.IBDPhaseChainNegotiation,
+	flowcontext.IBDPhaseHeadersProof:        appmessage.IBDPhaseHeadersProof,
+	flowcontext.IBDPhaseHeaders:             appmessage.IBDPhaseHeaders,
+	flowcontext.IBDPhasePruningPointUTXOSet: appmessage.IBDPhasePruningPointUTXOSet,
+	flowcontext.IBDPhaseBlocks:              appmessage.IBDPhaseBlocks,
+	flowcontext.IBDPhaseVirtualResolution:   appmessage.IBDPhaseVirtualResolution,
+}
+
+// SyncStatus returns whether this node is synced, along with the phase
+// and the progress of IBD if it's running
+func (ctx *Context) SyncStatus() (*appmessage.RPCSyncStatus, error) {
+	isNearlySynced, err := ctx.Domain.Consensus().IsNearlySynced()
+	if err != nil {
+		return nil, err
+	}
+
+	syncStatus := ctx.ProtocolManager.Context().SyncStatus()
+	rpcSyncStatus := &appmessage.RPCSyncStatus{
+		IsSynced:     ctx.ProtocolManager.Context().HasPeers() && isNearlySynced,
+		IsIBDRunning: syncStatus.SyncPeer != nil,
+		IBDPhase:     ibdPhasesToRPC[syncStatus.Phase],
+	}
+	if syncStatus.SyncPeer == nil {
+		return rpcSyncStatus, nil
+	}
+
+	if syncStatus.SyncPeer.ID() != nil {
+		rpcSyncStatus.SyncPeerID = syncStatus.SyncPeer.ID().String()
+	}
+	rpcSyncStatus.SyncPeerAddress = syncStatus.SyncPeer.Address()
+	rpcSyncStatus.PhaseStartTimeMilliseconds = uint64(syncStatus.PhaseStartTime.UnixMilli())
+	rpcSyncStatus.Processed = syncStatus.Processed
+	rpcSyncStatus.Total = syncStatus.Total
+	rpcSyncStatus.ProgressPercent = uint32(syncStatus.ProgressPercent)
+	eta, hasETA := syncStatus.ETA(time.Now())
+	if hasETA {
+		rpcSyncStatus.ETAMilliseconds = uint64(eta.Milliseconds())
+		rpcSyncStatus.HasETA = true
+	}
+	return rpcSyncStatus, nil
+}
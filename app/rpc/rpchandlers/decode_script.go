@@ -0,0 +1,55 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+	"github.com/Hoosat-Oy/HTND/util"
+)
+
+// HandleDecodeScript handles the respectively named RPC command
+func HandleDecodeScript(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	decodeScriptRequest := request.(*appmessage.DecodeScriptRequestMessage)
+
+	if decodeScriptRequest.Version > constants.MaxScriptPublicKeyVersion {
+		errorMessage := &appmessage.DecodeScriptResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Unknown script version %d", decodeScriptRequest.Version)
+		return errorMessage, nil
+	}
+	script, err := hex.DecodeString(decodeScriptRequest.ScriptHex)
+	if err != nil {
+		errorMessage := &appmessage.DecodeScriptResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode script hex: %s", err)
+		return errorMessage, nil
+	}
+
+	// Ignore the error here since a script that fails to parse is disassembled
+	// up to the failure, which is marked with [error]
+	disassembly, _ := txscript.DisasmString(decodeScriptRequest.Version, script)
+
+	// Ignore the error here as well, since it only means the script couldn't
+	// be parsed, and as such is non-standard
+	scriptPublicKey := &externalapi.ScriptPublicKey{Script: script, Version: decodeScriptRequest.Version}
+	scriptClass, address, _ := txscript.ExtractScriptPubKeyAddress(scriptPublicKey, context.Config.ActiveNetParams)
+	addresses := []string{}
+	if address != nil {
+		addresses = append(addresses, address.EncodeAddress())
+	}
+
+	// A pay-to-script-hash script can't be redeemed through another pay-to-script-hash
+	p2shAddress := ""
+	if scriptClass != txscript.ScriptHashTy {
+		scriptHashAddress, err := util.NewAddressScriptHash(script, context.Config.ActiveNetParams.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		p2shAddress = scriptHashAddress.EncodeAddress()
+	}
+
+	return appmessage.NewDecodeScriptResponseMessage(disassembly, scriptClass.String(), addresses, p2shAddress), nil
+}
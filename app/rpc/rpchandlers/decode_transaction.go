@@ -0,0 +1,65 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/database/serialization"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// HandleDecodeTransaction handles the respectively named RPC command
+func HandleDecodeTransaction(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	decodeTransactionRequest := request.(*appmessage.DecodeTransactionRequestMessage)
+
+	serializedTransaction, err := hex.DecodeString(decodeTransactionRequest.TransactionHex)
+	if err != nil {
+		errorMessage := &appmessage.DecodeTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode transaction hex: %s", err)
+		return errorMessage, nil
+	}
+
+	transaction, err := deserializeTransaction(serializedTransaction, decodeTransactionRequest.Encoding)
+	if err != nil {
+		errorMessage := &appmessage.DecodeTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not deserialize transaction: %s", err)
+		return errorMessage, nil
+	}
+
+	// The verbose data is computed from the transaction itself, so any verbose
+	// data that came with a serialized RPCTransaction is overwritten
+	err = context.PopulateTransactionWithVerboseData(transaction, nil)
+	if err != nil {
+		errorMessage := &appmessage.DecodeTransactionResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction: %s", err)
+		return errorMessage, nil
+	}
+
+	return appmessage.NewDecodeTransactionResponseMessage(transaction), nil
+}
+
+func deserializeTransaction(serializedTransaction []byte, encoding appmessage.TransactionEncoding) (
+	*appmessage.RPCTransaction, error) {
+
+	switch encoding {
+	case appmessage.TransactionEncodingRPC:
+		return protowire.DeserializeRPCTransaction(serializedTransaction)
+	case appmessage.TransactionEncodingDomain:
+		dbTransaction := &serialization.DbTransaction{}
+		err := proto.Unmarshal(serializedTransaction, dbTransaction)
+		if err != nil {
+			return nil, err
+		}
+		domainTransaction, err := serialization.DbTransactionToDomainTransaction(dbTransaction)
+		if err != nil {
+			return nil, err
+		}
+		return appmessage.DomainTransactionToRPCTransaction(domainTransaction), nil
+	default:
+		return nil, errors.Errorf("unknown transaction encoding %d", encoding)
+	}
+}
@@ -0,0 +1,69 @@
+package rpchandlers
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/database/serialization"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDeserializeTransaction(t *testing.T) {
+	genesisTx := dagconfig.MainnetParams.GenesisBlock.Transactions[0]
+	genesisTxID := consensushashing.TransactionID(genesisTx)
+
+	serializedRPCTransaction, err := protowire.SerializeRPCTransaction(appmessage.DomainTransactionToRPCTransaction(genesisTx))
+	if err != nil {
+		t.Fatalf("SerializeRPCTransaction: %+v", err)
+	}
+	serializedDomainTransaction, err := proto.Marshal(serialization.DomainTransactionToDbTransaction(genesisTx))
+	if err != nil {
+		t.Fatalf("Marshal: %+v", err)
+	}
+
+	tests := []struct {
+		name                  string
+		serializedTransaction []byte
+		encoding              appmessage.TransactionEncoding
+	}{
+		{name: "rpc", serializedTransaction: serializedRPCTransaction, encoding: appmessage.TransactionEncodingRPC},
+		{name: "domain", serializedTransaction: serializedDomainTransaction, encoding: appmessage.TransactionEncodingDomain},
+	}
+	for _, test := range tests {
+		transaction, err := deserializeTransaction(test.serializedTransaction, test.encoding)
+		if err != nil {
+			t.Fatalf("%s: deserializeTransaction: %+v", test.name, err)
+		}
+		domainTransaction, err := appmessage.RPCTransactionToDomainTransaction(transaction)
+		if err != nil {
+			t.Fatalf("%s: RPCTransactionToDomainTransaction: %+v", test.name, err)
+		}
+		transactionID := consensushashing.TransactionID(domainTransaction)
+		if !transactionID.Equal(genesisTxID) {
+			t.Fatalf("%s: expected transaction ID %s, got %s", test.name, genesisTxID, transactionID)
+		}
+	}
+
+	// A domain transaction with an input that has no previous outpoint must be rejected
+	// instead of crashing the node
+	malformedTransaction := &serialization.DbTransaction{
+		SubnetworkID: &serialization.DbSubnetworkId{SubnetworkId: genesisTx.SubnetworkID[:]},
+		Inputs:       []*serialization.DbTransactionInput{{}},
+	}
+	serializedMalformedTransaction, err := proto.Marshal(malformedTransaction)
+	if err != nil {
+		t.Fatalf("Marshal: %+v", err)
+	}
+	_, err = deserializeTransaction(serializedMalformedTransaction, appmessage.TransactionEncodingDomain)
+	if err == nil {
+		t.Fatalf("deserializeTransaction unexpectedly succeeded for a malformed transaction")
+	}
+
+	_, err = deserializeTransaction(serializedRPCTransaction, appmessage.TransactionEncoding(2))
+	if err == nil {
+		t.Fatalf("deserializeTransaction unexpectedly succeeded for an unknown encoding")
+	}
+}
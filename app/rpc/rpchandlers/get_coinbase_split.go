@@ -0,0 +1,71 @@
+package rpchandlers
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+)
+
+// HandleGetCoinbaseSplit handles the respectively named RPC command
+func HandleGetCoinbaseSplit(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getCoinbaseSplitRequest := request.(*appmessage.GetCoinbaseSplitRequestMessage)
+
+	blockHash, err := externalapi.NewDomainHashFromString(getCoinbaseSplitRequest.BlockHash)
+	if err != nil {
+		errorMessage := &appmessage.GetCoinbaseSplitResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Hash could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	split, err := context.Domain.Consensus().GetCoinbaseSplit(blockHash)
+	if err != nil {
+		errorMessage := &appmessage.GetCoinbaseSplitResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not get the coinbase split of block %s: %s", blockHash, err)
+		return errorMessage, nil
+	}
+
+	params := context.Config.ActiveNetParams
+	response := appmessage.NewGetCoinbaseSplitResponseMessage()
+	response.BlockHash = blockHash.String()
+	response.BlockVersion = uint32(split.BlockVersion)
+	response.IsDevFeeActive = split.IsDevFeeActive
+	response.DevFeeAddress = params.DevFeeAddress
+	response.DevFeePercentage = params.DevFeePercentage
+
+	response.BlueRewards = make([]*appmessage.CoinbaseReward, len(split.BlueRewards))
+	for i, blueReward := range split.BlueRewards {
+		response.BlueRewards[i] = coinbaseRewardToRPC(context, blueReward)
+		response.TotalMinerReward += blueReward.MinerReward
+		response.TotalDevFee += blueReward.DevFee
+	}
+	if split.RedReward != nil {
+		response.RedReward = coinbaseRewardToRPC(context, split.RedReward)
+		response.TotalMinerReward += split.RedReward.MinerReward
+		response.TotalDevFee += split.RedReward.DevFee
+	}
+
+	response.RedBlockHashes = make([]string, len(split.RedBlockHashes))
+	for i, redBlockHash := range split.RedBlockHashes {
+		response.RedBlockHashes[i] = redBlockHash.String()
+	}
+
+	return response, nil
+}
+
+func coinbaseRewardToRPC(context *rpccontext.Context, reward *externalapi.CoinbaseReward) *appmessage.CoinbaseReward {
+	rpcReward := &appmessage.CoinbaseReward{
+		MinerReward: reward.MinerReward,
+		DevFee:      reward.DevFee,
+	}
+	if reward.BlockHash != nil {
+		rpcReward.BlockHash = reward.BlockHash.String()
+	}
+	// Miners may pay their reward to a non-standard script, in which case there's no address to report
+	_, address, err := txscript.ExtractScriptPubKeyAddress(reward.ScriptPublicKey, context.Config.ActiveNetParams)
+	if err == nil && address != nil {
+		rpcReward.MinerAddress = address.String()
+	}
+	return rpcReward
+}
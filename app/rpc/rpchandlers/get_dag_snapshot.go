@@ -0,0 +1,119 @@
+package rpchandlers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/hashes"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+)
+
+const (
+	// defaultDAGSnapshotPageSize is the page size of GetDAGSnapshot when the request doesn't specify one
+	defaultDAGSnapshotPageSize = 1000
+
+	// maxDAGSnapshotPageSize is the maximum page size that may be requested from GetDAGSnapshot
+	maxDAGSnapshotPageSize = 10_000
+)
+
+// HandleGetDAGSnapshot handles the respectively named RPC command
+func HandleGetDAGSnapshot(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getDAGSnapshotRequest := request.(*appmessage.GetDAGSnapshotRequestMessage)
+
+	pageSize := getDAGSnapshotRequest.PageSize
+	if pageSize == 0 {
+		pageSize = defaultDAGSnapshotPageSize
+	}
+	if pageSize > maxDAGSnapshotPageSize {
+		errorMessage := &appmessage.GetDAGSnapshotResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Page size %d is greater than the maximum of %d",
+			pageSize, maxDAGSnapshotPageSize)
+		return errorMessage, nil
+	}
+
+	var startChainBlockHash *externalapi.DomainHash
+	if getDAGSnapshotRequest.PageCursor != "" {
+		var err error
+		startChainBlockHash, err = externalapi.NewDomainHashFromString(getDAGSnapshotRequest.PageCursor)
+		if err != nil {
+			errorMessage := &appmessage.GetDAGSnapshotResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Page cursor could not be parsed: %s", err)
+			return errorMessage, nil
+		}
+	}
+
+	snapshot, err := context.Domain.Consensus().GetDAGSnapshot(getDAGSnapshotRequest.LowScore,
+		getDAGSnapshotRequest.HighScore, getDAGSnapshotRequest.UseDAAScore, startChainBlockHash, int(pageSize))
+	if err != nil {
+		errorMessage := &appmessage.GetDAGSnapshotResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not build a DAG snapshot: %s", err)
+		return errorMessage, nil
+	}
+
+	blocks := make([]*appmessage.RPCDAGSnapshotBlock, len(snapshot.Blocks))
+	for i, block := range snapshot.Blocks {
+		blocks[i] = &appmessage.RPCDAGSnapshotBlock{
+			Hash:                block.Hash.String(),
+			ParentHashes:        hashes.ToStrings(block.ParentHashes),
+			SelectedParentHash:  block.SelectedParent.String(),
+			MergeSetBluesHashes: hashes.ToStrings(block.MergeSetBlues),
+			MergeSetRedsHashes:  hashes.ToStrings(block.MergeSetReds),
+			BlueScore:           block.BlueScore,
+			DAAScore:            block.DAAScore,
+			Status:              block.BlockStatus.String(),
+			IsChainBlock:        block.IsChainBlock,
+			IsBlue:              block.IsBlue,
+		}
+		if block.MergingChainBlockHash != nil {
+			blocks[i].MergingChainBlockHash = block.MergingChainBlockHash.String()
+		}
+	}
+	nextPageCursor := ""
+	if snapshot.NextChainBlockHash != nil {
+		nextPageCursor = snapshot.NextChainBlockHash.String()
+	}
+	dot := ""
+	if getDAGSnapshotRequest.IncludeDOT {
+		dot = dagSnapshotToDOT(blocks)
+	}
+
+	return appmessage.NewGetDAGSnapshotResponseMessage(blocks, nextPageCursor, dot), nil
+}
+
+// dagSnapshotToDOT renders the given blocks as a GraphViz DOT script. Chain blocks are drawn with a
+// bold border, blue and red blocks are filled accordingly, and the edge to the selected parent is bold
+func dagSnapshotToDOT(blocks []*appmessage.RPCDAGSnapshotBlock) string {
+	var dotScriptBuilder strings.Builder
+	dotScriptBuilder.WriteString("digraph {\n\trankdir = RL;\n\tnode [shape = box, style = filled];\n")
+
+	edges := []string{}
+	for _, block := range blocks {
+		fillColor := "lightcoral"
+		if block.IsBlue {
+			fillColor = "lightblue"
+		}
+		penWidth := 1
+		if block.IsChainBlock {
+			penWidth = 3
+		}
+		dotScriptBuilder.WriteString(fmt.Sprintf("\t\"%s\" [label = \"%.8s\\n%d\", fillcolor = %s, penwidth = %d];\n",
+			block.Hash, block.Hash, block.BlueScore, fillColor, penWidth))
+
+		for _, parentHash := range block.ParentHashes {
+			if parentHash == block.SelectedParentHash {
+				edges = append(edges, fmt.Sprintf("\t\"%s\" -> \"%s\" [style = bold];", block.Hash, parentHash))
+				continue
+			}
+			edges = append(edges, fmt.Sprintf("\t\"%s\" -> \"%s\";", block.Hash, parentHash))
+		}
+	}
+
+	dotScriptBuilder.WriteString("\n")
+	dotScriptBuilder.WriteString(strings.Join(edges, "\n"))
+	dotScriptBuilder.WriteString("\n}")
+
+	return dotScriptBuilder.String()
+}
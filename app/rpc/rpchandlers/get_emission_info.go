@@ -0,0 +1,54 @@
+package rpchandlers
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+)
+
+// maxEmissionInfoDAAScores is the maximum number of DAA scores a single GetEmissionInfo request may ask about
+const maxEmissionInfoDAAScores = 1000
+
+// HandleGetEmissionInfo handles the respectively named RPC command
+func HandleGetEmissionInfo(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getEmissionInfoRequest := request.(*appmessage.GetEmissionInfoRequestMessage)
+
+	if len(getEmissionInfoRequest.DAAScores) > maxEmissionInfoDAAScores {
+		errorMessage := appmessage.NewGetEmissionInfoResponseMessage()
+		errorMessage.Error = appmessage.RPCErrorf("At most %d DAA scores may be requested, got %d",
+			maxEmissionInfoDAAScores, len(getEmissionInfoRequest.DAAScores))
+		return errorMessage, nil
+	}
+
+	emissionInfo, err := context.Domain.Consensus().GetEmissionInfo(getEmissionInfoRequest.DAAScores)
+	if err != nil {
+		return nil, err
+	}
+
+	response := appmessage.NewGetEmissionInfoResponseMessage()
+	response.VirtualDAAScore = emissionInfo.VirtualDAAScore
+	response.CurrentSubsidy = blockSubsidyToRPC(emissionInfo.CurrentSubsidy)
+	response.NextReduction = blockSubsidyToRPC(emissionInfo.NextReduction)
+	response.Subsidies = make([]*appmessage.BlockSubsidy, len(emissionInfo.Subsidies))
+	for i, subsidy := range emissionInfo.Subsidies {
+		response.Subsidies[i] = blockSubsidyToRPC(subsidy)
+	}
+	response.ProjectedTotalSupply = emissionInfo.ProjectedTotalSupply
+	response.MaxSompi = constants.MaxSompi
+
+	return response, nil
+}
+
+func blockSubsidyToRPC(subsidy *externalapi.BlockSubsidy) *appmessage.BlockSubsidy {
+	if subsidy == nil {
+		return nil
+	}
+	return &appmessage.BlockSubsidy{
+		DAAScore:     subsidy.DAAScore,
+		BlockVersion: uint32(subsidy.BlockVersion),
+		Subsidy:      subsidy.Subsidy,
+		EmittedSompi: subsidy.EmittedSompi,
+	}
+}
@@ -0,0 +1,16 @@
+package rpchandlers
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+)
+
+// HandleGetSyncStatus handles the respectively named RPC command
+func HandleGetSyncStatus(context *rpccontext.Context, _ *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	syncStatus, err := context.SyncStatus()
+	if err != nil {
+		return nil, err
+	}
+	return appmessage.NewGetSyncStatusResponseMessage(syncStatus), nil
+}
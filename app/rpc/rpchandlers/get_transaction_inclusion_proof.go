@@ -0,0 +1,65 @@
+package rpchandlers
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionid"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+)
+
+// maxInclusionProofChainPathLength is the maximum number of chain block headers
+// that a transaction inclusion proof may contain in order to reach its target block
+const maxInclusionProofChainPathLength = 10_000
+
+// HandleGetTransactionInclusionProof handles the respectively named RPC command
+func HandleGetTransactionInclusionProof(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getTransactionInclusionProofRequest := request.(*appmessage.GetTransactionInclusionProofRequestMessage)
+
+	transactionID, err := transactionid.FromString(getTransactionInclusionProofRequest.TransactionID)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionInclusionProofResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction ID could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	var blockHash *externalapi.DomainHash
+	if getTransactionInclusionProofRequest.BlockHash != "" {
+		blockHash, err = externalapi.NewDomainHashFromString(getTransactionInclusionProofRequest.BlockHash)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionInclusionProofResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Block hash could not be parsed: %s", err)
+			return errorMessage, nil
+		}
+	} else {
+		block, err := context.Domain.Consensus().GetBlockByTransactionID(transactionID)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionInclusionProofResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Transaction %s not found in any block", transactionID)
+			return errorMessage, nil
+		}
+		blockHash = consensushashing.BlockHash(block)
+	}
+
+	var targetBlockHash *externalapi.DomainHash
+	if getTransactionInclusionProofRequest.TargetBlockHash != "" {
+		targetBlockHash, err = externalapi.NewDomainHashFromString(getTransactionInclusionProofRequest.TargetBlockHash)
+		if err != nil {
+			errorMessage := &appmessage.GetTransactionInclusionProofResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Target block hash could not be parsed: %s", err)
+			return errorMessage, nil
+		}
+	}
+
+	proof, err := context.Domain.Consensus().GetTransactionInclusionProof(transactionID, blockHash, targetBlockHash,
+		maxInclusionProofChainPathLength)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionInclusionProofResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not build an inclusion proof for transaction %s: %s", transactionID, err)
+		return errorMessage, nil
+	}
+
+	return appmessage.NewGetTransactionInclusionProofResponseMessage(
+		appmessage.DomainTransactionInclusionProofToRPCTransactionInclusionProof(proof)), nil
+}
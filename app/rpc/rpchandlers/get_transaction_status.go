@@ -0,0 +1,173 @@
+package rpchandlers
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionid"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+)
+
+// HandleGetTransactionStatus handles the respectively named RPC command
+func HandleGetTransactionStatus(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getTransactionStatusRequest := request.(*appmessage.GetTransactionStatusRequestMessage)
+
+	transactionID, err := transactionid.FromString(getTransactionStatusRequest.TransactionID)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionStatusResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction ID could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	// A transaction is removed from the mempool once it's included in a block
+	_, isOrphan, found := context.Domain.MiningManager().GetTransaction(transactionID, true, true)
+	if found {
+		if isOrphan {
+			return appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusOrphan, "", "", 0, 0), nil
+		}
+		return appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusInMempool, "", "", 0, 0), nil
+	}
+
+	// Blocks aren't indexed by the transactions they include, and scanning all of them is too
+	// expensive for an RPC call, so the including block must be given by the caller
+	if getTransactionStatusRequest.IncludingBlockHash == "" {
+		errorMessage := &appmessage.GetTransactionStatusResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s is not in the mempool, and "+
+			"an including block hash is required to look it up in the DAG", transactionID)
+		return errorMessage, nil
+	}
+	includingBlockHash, err := externalapi.NewDomainHashFromString(getTransactionStatusRequest.IncludingBlockHash)
+	if err != nil {
+		errorMessage := &appmessage.GetTransactionStatusResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Including block hash could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+	consensus := context.Domain.Consensus()
+	block, found, err := consensus.GetBlock(includingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		errorMessage := &appmessage.GetTransactionStatusResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Block %s not found", includingBlockHash)
+		return errorMessage, nil
+	}
+	if !blockContainsTransaction(block, transactionID) {
+		errorMessage := &appmessage.GetTransactionStatusResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Transaction %s is not in block %s", transactionID, includingBlockHash)
+		return errorMessage, nil
+	}
+
+	mergingBlockHash, err := mergingChainBlock(consensus, includingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	if mergingBlockHash == nil {
+		return appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusIncludedNotAccepted,
+			includingBlockHash.String(), "", 0, 0), nil
+	}
+
+	acceptanceData, err := consensus.GetBlockAcceptanceData(mergingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	// Other blocks in the same merge set may include the transaction as well, in which case
+	// only one of them is accepted, and that's the one that's reported
+	acceptingIncludingBlockHash := acceptedFromBlock(acceptanceData, transactionID)
+	if acceptingIncludingBlockHash == nil {
+		return appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusIncludedNotAccepted,
+			includingBlockHash.String(), "", 0, 0), nil
+	}
+
+	mergingBlockHeader, err := consensus.GetBlockHeader(mergingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	virtualDAAScore, err := consensus.GetVirtualDAAScore()
+	if err != nil {
+		return nil, err
+	}
+	confirmations := uint64(0)
+	if virtualDAAScore > mergingBlockHeader.DAAScore() {
+		confirmations = virtualDAAScore - mergingBlockHeader.DAAScore()
+	}
+
+	return appmessage.NewGetTransactionStatusResponseMessage(appmessage.TransactionStatusAccepted,
+		acceptingIncludingBlockHash.String(), mergingBlockHash.String(), mergingBlockHeader.DAAScore(), confirmations), nil
+}
+
+func blockContainsTransaction(block *externalapi.DomainBlock, transactionID *externalapi.DomainTransactionID) bool {
+	for _, transaction := range block.Transactions {
+		if consensushashing.TransactionID(transaction).Equal(transactionID) {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptedFromBlock returns the block of the given acceptance data from which the given transaction
+// was accepted, or nil if none of the blocks that include it had it accepted
+func acceptedFromBlock(acceptanceData externalapi.AcceptanceData,
+	transactionID *externalapi.DomainTransactionID) *externalapi.DomainHash {
+
+	for _, blockAcceptanceData := range acceptanceData {
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			if transactionAcceptanceData.IsAccepted &&
+				consensushashing.TransactionID(transactionAcceptanceData.Transaction).Equal(transactionID) {
+
+				return blockAcceptanceData.BlockHash
+			}
+		}
+	}
+	return nil
+}
+
+// mergingChainBlock returns the selected chain block whose merge set contains the given block, or nil if no
+// selected chain block merged it yet. That's the chain block with the lowest blue score in the future of the
+// given block, and it's reachable from the given block through blocks that aren't in the selected chain
+func mergingChainBlock(consensus externalapi.Consensus, blockHash *externalapi.DomainHash) (*externalapi.DomainHash, error) {
+	var mergingBlockHash *externalapi.DomainHash
+	var mergingBlockBlueScore uint64
+
+	visited := map[externalapi.DomainHash]struct{}{*blockHash: {}}
+	queue := []*externalapi.DomainHash{blockHash}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		_, children, err := consensus.GetBlockRelations(current)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			if _, ok := visited[*child]; ok || child.Equal(model.VirtualBlockHash) {
+				continue
+			}
+			visited[*child] = struct{}{}
+
+			// Blue scores grow along the children, so neither this block nor its future may contain a
+			// chain block with a lower blue score than the one that was already found
+			blockInfo, err := consensus.GetBlockInfo(child)
+			if err != nil {
+				return nil, err
+			}
+			if !blockInfo.HasHeader() || mergingBlockHash != nil && blockInfo.BlueScore >= mergingBlockBlueScore {
+				continue
+			}
+			isChainBlock, err := consensus.IsChainBlock(child)
+			if err != nil {
+				return nil, err
+			}
+			if !isChainBlock {
+				queue = append(queue, child)
+				continue
+			}
+			mergingBlockHash = child
+			mergingBlockBlueScore = blockInfo.BlueScore
+		}
+	}
+
+	return mergingBlockHash, nil
+}
@@ -0,0 +1,104 @@
+package rpchandlers
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+)
+
+func TestMergingChainBlock(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestMergingChainBlock")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		addBlock := func(parentHashes []*externalapi.DomainHash,
+			transactions []*externalapi.DomainTransaction) *externalapi.DomainHash {
+
+			blockHash, _, err := tc.AddBlock(parentHashes, nil, transactions)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			return blockHash
+		}
+
+		// Build the following DAG, in which A5 is the virtual selected parent and X is merged only by the virtual.
+		// B1 and C1 include two transactions that spend the same output, so A5 accepts only one of them:
+		// genesis <- A1 <- A2 <- A3 <- A4 <- A5
+		//                  A2 <- B1 <------- A5
+		//                  A2 <- C1 <------- A5
+		//                        A3 <- X
+		genesisHash := consensusConfig.GenesisHash
+		a1Hash := addBlock([]*externalapi.DomainHash{genesisHash}, nil)
+		a2Hash := addBlock([]*externalapi.DomainHash{a1Hash}, nil)
+		a2Block, _, err := tc.GetBlock(a2Hash)
+		if err != nil {
+			t.Fatalf("GetBlock: %+v", err)
+		}
+		transaction1, err := testutils.CreateTransaction(a2Block.Transactions[0], 1)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %+v", err)
+		}
+		transaction2, err := testutils.CreateTransaction(a2Block.Transactions[0], 2)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %+v", err)
+		}
+		a3Hash := addBlock([]*externalapi.DomainHash{a2Hash}, nil)
+		a4Hash := addBlock([]*externalapi.DomainHash{a3Hash}, nil)
+		b1Hash := addBlock([]*externalapi.DomainHash{a2Hash}, []*externalapi.DomainTransaction{transaction1})
+		c1Hash := addBlock([]*externalapi.DomainHash{a2Hash}, []*externalapi.DomainTransaction{transaction2})
+		a5Hash := addBlock([]*externalapi.DomainHash{a4Hash, b1Hash, c1Hash}, nil)
+		xHash := addBlock([]*externalapi.DomainHash{a3Hash}, nil)
+
+		expectedMergingChainBlocks := map[externalapi.DomainHash]*externalapi.DomainHash{
+			*genesisHash: a1Hash,
+			*a2Hash:      a3Hash,
+			*a4Hash:      a5Hash,
+			*b1Hash:      a5Hash,
+			*c1Hash:      a5Hash,
+			*a5Hash:      nil,
+			*xHash:       nil,
+		}
+		for blockHash, expectedMergingChainBlock := range expectedMergingChainBlocks {
+			blockHash := blockHash
+			mergingBlockHash, err := mergingChainBlock(tc, &blockHash)
+			if err != nil {
+				t.Fatalf("mergingChainBlock: %+v", err)
+			}
+			if expectedMergingChainBlock == nil && mergingBlockHash != nil ||
+				expectedMergingChainBlock != nil && !expectedMergingChainBlock.Equal(mergingBlockHash) {
+
+				t.Fatalf("expected block %s to be merged by %s, got %s", blockHash, expectedMergingChainBlock, mergingBlockHash)
+			}
+		}
+
+		acceptanceData, err := tc.GetBlockAcceptanceData(a5Hash)
+		if err != nil {
+			t.Fatalf("GetBlockAcceptanceData: %+v", err)
+		}
+		transaction1ID := consensushashing.TransactionID(transaction1)
+		transaction2ID := consensushashing.TransactionID(transaction2)
+		transaction1AcceptedFrom := acceptedFromBlock(acceptanceData, transaction1ID)
+		transaction2AcceptedFrom := acceptedFromBlock(acceptanceData, transaction2ID)
+		if (transaction1AcceptedFrom == nil) == (transaction2AcceptedFrom == nil) {
+			t.Fatalf("expected exactly one of the conflicting transactions to be accepted, got %s and %s",
+				transaction1AcceptedFrom, transaction2AcceptedFrom)
+		}
+		if transaction1AcceptedFrom != nil && !transaction1AcceptedFrom.Equal(b1Hash) {
+			t.Fatalf("expected transaction %s to be accepted from block %s, got %s",
+				transaction1ID, b1Hash, transaction1AcceptedFrom)
+		}
+		if transaction2AcceptedFrom != nil && !transaction2AcceptedFrom.Equal(c1Hash) {
+			t.Fatalf("expected transaction %s to be accepted from block %s, got %s",
+				transaction2ID, c1Hash, transaction2AcceptedFrom)
+		}
+	})
+}
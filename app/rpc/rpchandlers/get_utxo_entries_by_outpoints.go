@@ -0,0 +1,64 @@
+package rpchandlers
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+)
+
+// maxUTXOEntriesByOutpointsOutpoints is the maximum number of outpoints a single
+// GetUTXOEntriesByOutpoints request may ask about
+const maxUTXOEntriesByOutpointsOutpoints = 10_000
+
+// HandleGetUTXOEntriesByOutpoints handles the respectively named RPC command
+func HandleGetUTXOEntriesByOutpoints(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	getUTXOEntriesByOutpointsRequest := request.(*appmessage.GetUTXOEntriesByOutpointsRequestMessage)
+
+	if len(getUTXOEntriesByOutpointsRequest.Outpoints) > maxUTXOEntriesByOutpointsOutpoints {
+		errorMessage := &appmessage.GetUTXOEntriesByOutpointsResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("At most %d outpoints may be requested, got %d",
+			maxUTXOEntriesByOutpointsOutpoints, len(getUTXOEntriesByOutpointsRequest.Outpoints))
+		return errorMessage, nil
+	}
+
+	outpoints := make([]*externalapi.DomainOutpoint, len(getUTXOEntriesByOutpointsRequest.Outpoints))
+	for i, rpcOutpoint := range getUTXOEntriesByOutpointsRequest.Outpoints {
+		outpoint, err := appmessage.RPCOutpointToDomainOutpoint(rpcOutpoint)
+		if err != nil {
+			errorMessage := &appmessage.GetUTXOEntriesByOutpointsResponseMessage{}
+			errorMessage.Error = appmessage.RPCErrorf("Could not parse outpoint %s:%d: %s",
+				rpcOutpoint.TransactionID, rpcOutpoint.Index, err)
+			return errorMessage, nil
+		}
+		outpoints[i] = outpoint
+	}
+
+	utxoEntries, err := context.Domain.Consensus().GetVirtualUTXOEntries(outpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*appmessage.UTXOEntryByOutpoint, len(outpoints))
+	for i, outpoint := range outpoints {
+		entry := &appmessage.UTXOEntryByOutpoint{
+			Outpoint: &appmessage.RPCOutpoint{
+				TransactionID: outpoint.TransactionID.String(),
+				Index:         outpoint.Index,
+			},
+		}
+		if utxoEntries[i] != nil {
+			entry.UTXOEntry = appmessage.UTXOEntryToRPCUTXOEntry(utxoEntries[i])
+		}
+		if getUTXOEntriesByOutpointsRequest.IncludeMempoolSpends {
+			spendingTransactionID, found := context.Domain.MiningManager().GetTransactionSpendingOutpoint(outpoint)
+			if found {
+				entry.IsSpentInMempool = true
+				entry.MempoolSpendingTransactionID = spendingTransactionID.String()
+			}
+		}
+		entries[i] = entry
+	}
+
+	return appmessage.NewGetUTXOEntriesByOutpointsResponseMessage(entries), nil
+}
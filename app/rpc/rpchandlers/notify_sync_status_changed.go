@@ -0,0 +1,19 @@
+package rpchandlers
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+)
+
+// HandleNotifySyncStatusChanged handles the respectively named RPC command
+func HandleNotifySyncStatusChanged(context *rpccontext.Context, router *router.Router, _ appmessage.Message) (appmessage.Message, error) {
+	listener, err := context.NotificationManager.Listener(router)
+	if err != nil {
+		return nil, err
+	}
+	listener.PropagateSyncStatusChangedNotifications()
+
+	response := appmessage.NewNotifySyncStatusChangedResponseMessage()
+	return response, nil
+}
@@ -0,0 +1,35 @@
+package rpchandlers
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+)
+
+// HandleReconsiderBlock handles the respectively named RPC command
+func HandleReconsiderBlock(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	reconsiderBlockRequest := request.(*appmessage.ReconsiderBlockRequestMessage)
+
+	blockHash, err := externalapi.NewDomainHashFromString(reconsiderBlockRequest.BlockHash)
+	if err != nil {
+		errorMessage := &appmessage.ReconsiderBlockResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Block hash could not be parsed: %s", err)
+		return errorMessage, nil
+	}
+
+	err = context.Domain.Consensus().ReconsiderBlock(blockHash)
+	if err != nil {
+		errorMessage := &appmessage.ReconsiderBlockResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not reconsider block %s: %s", blockHash, err)
+		return errorMessage, nil
+	}
+
+	// The virtual has moved, so the cached block template is stale
+	err = context.ProtocolManager.Context().OnNewBlockTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	return appmessage.NewReconsiderBlockResponseMessage(), nil
+}
@@ -0,0 +1,39 @@
+package rpchandlers
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/miningmanager/mempool"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// HandleTestMempoolAccept handles the respectively named RPC command
+func HandleTestMempoolAccept(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	testMempoolAcceptRequest := request.(*appmessage.TestMempoolAcceptRequestMessage)
+
+	domainTransaction, err := appmessage.RPCTransactionToDomainTransaction(testMempoolAcceptRequest.Transaction)
+	if err != nil {
+		errorMessage := &appmessage.TestMempoolAcceptResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not parse transaction: %s", err)
+		return errorMessage, nil
+	}
+
+	transactionID := consensushashing.TransactionID(domainTransaction)
+	validatedTransaction, err := context.Domain.MiningManager().TestTransactionAccept(domainTransaction)
+	rejectReason := ""
+	if err != nil {
+		if !errors.As(err, &mempool.RuleError{}) {
+			return nil, err
+		}
+		rejectReason = err.Error()
+	}
+
+	feeRate := 0.0
+	if validatedTransaction.Mass > 0 {
+		feeRate = float64(validatedTransaction.Fee) / float64(validatedTransaction.Mass)
+	}
+	return appmessage.NewTestMempoolAcceptResponseMessage(transactionID.String(), err == nil, rejectReason,
+		validatedTransaction.Mass, validatedTransaction.Fee, feeRate), nil
+}
@@ -0,0 +1,38 @@
+package rpchandlers
+
+import (
+	"encoding/hex"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/app/rpc/rpccontext"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/Hoosat-Oy/HTND/util/signedmessage"
+)
+
+// HandleVerifyMessage handles the respectively named RPC command
+func HandleVerifyMessage(context *rpccontext.Context, _ *router.Router, request appmessage.Message) (appmessage.Message, error) {
+	verifyMessageRequest := request.(*appmessage.VerifyMessageRequestMessage)
+
+	address, err := util.DecodeAddress(verifyMessageRequest.Address, context.Config.ActiveNetParams.Prefix)
+	if err != nil {
+		errorMessage := &appmessage.VerifyMessageResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode address: %s", err)
+		return errorMessage, nil
+	}
+	signature, err := hex.DecodeString(verifyMessageRequest.SignatureHex)
+	if err != nil {
+		errorMessage := &appmessage.VerifyMessageResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not decode signature hex: %s", err)
+		return errorMessage, nil
+	}
+
+	isValid, err := signedmessage.Verify(address, []byte(verifyMessageRequest.Message), signature)
+	if err != nil {
+		errorMessage := &appmessage.VerifyMessageResponseMessage{}
+		errorMessage.Error = appmessage.RPCErrorf("Could not verify the signature: %s", err)
+		return errorMessage, nil
+	}
+
+	return appmessage.NewVerifyMessageResponseMessage(isValid), nil
+}
@@ -1,12 +1,14 @@
 package main
 
 import (
+	"encoding/json"
 	"reflect"
 	"strconv"
 	"strings"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/pkg/errors"
@@ -15,19 +17,13 @@ import (
 func parseCommand(args []string, commandDescs []*commandDescription) (*protowire.HoosatdMessage, error) {
 	commandName, parameterStrings := args[0], args[1:]
 
-	var commandDesc *commandDescription
-	for _, cd := range commandDescs {
-		if cd.name == commandName {
-			commandDesc = cd
-			break
-		}
-	}
+	commandDesc := findCommandDescription(commandName, commandDescs)
 	if commandDesc == nil {
 		return nil, errors.Errorf("unknown command: %s. Use --list-commands to list all commands", commandName)
 	}
-	if len(parameterStrings) != len(commandDesc.parameters) {
-		return nil, errors.Errorf("command '%s' expects %d parameters but got %d",
-			commandName, len(commandDesc.parameters), len(parameterStrings))
+	parameterStrings, err := orderParameters(commandDesc, parameterStrings)
+	if err != nil {
+		return nil, err
 	}
 
 	commandValue := reflect.New(unwrapCommandType(commandDesc.typeof))
@@ -42,6 +38,56 @@ func parseCommand(args []string, commandDescs []*commandDescription) (*protowire
 	return generateHoosatdMessage(commandValue, commandDesc)
 }
 
+// orderParameters returns the parameter strings in the order of the command's parameters.
+// Parameters are either all given in order, or all given by name in the form <name>=<value>,
+// in which case parameters that are not given are left unset.
+func orderParameters(commandDesc *commandDescription, parameterStrings []string) ([]string, error) {
+	if len(parameterStrings) == 0 || namedParameterIndex(commandDesc, parameterStrings[0]) == -1 {
+		if len(parameterStrings) != len(commandDesc.parameters) {
+			return nil, errors.Errorf("command '%s' expects %d parameters but got %d",
+				commandDesc.name, len(commandDesc.parameters), len(parameterStrings))
+		}
+		return parameterStrings, nil
+	}
+
+	orderedParameterStrings := make([]string, len(commandDesc.parameters))
+	isSet := make([]bool, len(commandDesc.parameters))
+	for _, parameterString := range parameterStrings {
+		index := namedParameterIndex(commandDesc, parameterString)
+		if index == -1 {
+			return nil, errors.Errorf("parameter '%s' of command '%s' must be in the form <name>=<value> "+
+				"since other parameters are given by name", parameterString, commandDesc.name)
+		}
+		if isSet[index] {
+			return nil, errors.Errorf("parameter '%s' of command '%s' is given more than once",
+				commandDesc.parameters[index].name, commandDesc.name)
+		}
+		orderedParameterStrings[index] = parameterString[len(commandDesc.parameters[index].name)+1:]
+		isSet[index] = true
+	}
+	for i := range orderedParameterStrings {
+		if !isSet[i] {
+			orderedParameterStrings[i] = "-"
+		}
+	}
+	return orderedParameterStrings, nil
+}
+
+// namedParameterIndex returns the index of the parameter named by the given
+// <name>=<value> parameter string, or -1 if it does not name any parameter
+func namedParameterIndex(commandDesc *commandDescription, parameterString string) int {
+	name, _, found := strings.Cut(parameterString, "=")
+	if !found {
+		return -1
+	}
+	for i, parameterDesc := range commandDesc.parameters {
+		if parameterDesc.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
 func setField(commandValue reflect.Value, parameterValue reflect.Value, parameterDesc *parameterDescription) {
 	parameterField := commandValue.Elem().FieldByName(parameterDesc.name)
 
@@ -76,6 +122,9 @@ func stringToValue(parameterDesc *parameterDescription, valueStr string) (reflec
 		}
 		value = int16(valueInt64)
 	case reflect.Int32:
+		if enum, ok := reflect.Zero(parameterDesc.typeof).Interface().(protoreflect.Enum); ok {
+			return stringToEnumValue(parameterDesc, enum, valueStr)
+		}
 		var valueInt64 int64
 		valueInt64, err = strconv.ParseInt(valueStr, 10, 32)
 		if err != nil {
@@ -152,17 +201,56 @@ func stringToValue(parameterDesc *parameterDescription, valueStr string) (reflec
 
 	case reflect.Slice:
 		sliceType := parameterDesc.typeof.Elem()
-		if sliceType.Kind() != reflect.String {
+		switch sliceType.Kind() {
+		case reflect.String:
+			if valueStr == "" {
+				value = []string{}
+			} else {
+				value = strings.Split(valueStr, ",")
+			}
+		case reflect.Uint64:
+			uint64Values := []uint64{}
+			if valueStr != "" {
+				for _, elementStr := range strings.Split(valueStr, ",") {
+					element, err := strconv.ParseUint(elementStr, 10, 64)
+					if err != nil {
+						return reflect.Value{}, errors.WithStack(err)
+					}
+					uint64Values = append(uint64Values, element)
+				}
+			}
+			value = uint64Values
+		case reflect.Ptr:
+			// Slices of messages are given as a JSON array
+			var rawElements []json.RawMessage
+			if valueStr != "" {
+				err := json.Unmarshal([]byte(valueStr), &rawElements)
+				if err != nil {
+					return reflect.Value{}, errors.WithStack(err)
+				}
+			}
+			messages := reflect.MakeSlice(parameterDesc.typeof, 0, len(rawElements))
+			for _, rawElement := range rawElements {
+				element, ok := reflect.New(sliceType.Elem()).Interface().(proto.Message)
+				if !ok {
+					return reflect.Value{},
+						errors.Errorf("Unsupported slice type '%s' for parameter '%s'",
+							sliceType,
+							parameterDesc.name)
+				}
+				err := protojson.Unmarshal(rawElement, element)
+				if err != nil {
+					return reflect.Value{}, errors.WithStack(err)
+				}
+				messages = reflect.Append(messages, reflect.ValueOf(element))
+			}
+			value = messages.Interface()
+		default:
 			return reflect.Value{},
 				errors.Errorf("Unsupported slice type '%s' for parameter '%s'",
 					sliceType,
 					parameterDesc.name)
 		}
-		if valueStr == "" {
-			value = []string{}
-		} else {
-			value = strings.Split(valueStr, ",")
-		}
 	// Int and uint are not supported because their size is platform-dependant
 	case reflect.Int,
 		reflect.Uint,
@@ -186,3 +274,20 @@ func stringToValue(parameterDesc *parameterDescription, valueStr string) (reflec
 
 	return reflect.ValueOf(value), nil
 }
+
+// stringToEnumValue parses either the name or the number of a value of the given enum
+func stringToEnumValue(parameterDesc *parameterDescription, enum protoreflect.Enum, valueStr string) (reflect.Value, error) {
+	enumValues := enum.Descriptor().Values()
+	enumValue := enumValues.ByName(protoreflect.Name(valueStr))
+	if enumValue == nil {
+		number, err := strconv.ParseInt(valueStr, 10, 32)
+		if err == nil {
+			enumValue = enumValues.ByNumber(protoreflect.EnumNumber(number))
+		}
+	}
+	if enumValue == nil {
+		return reflect.Value{},
+			errors.Errorf("Invalid value '%s' for parameter '%s'", valueStr, parameterDesc.name)
+	}
+	return reflect.ValueOf(int32(enumValue.Number())).Convert(parameterDesc.typeof), nil
+}
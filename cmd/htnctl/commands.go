@@ -14,6 +14,7 @@ var commandTypes = []reflect.Type{
 	reflect.TypeOf(protowire.HoosatdMessage_GetPeerAddressesRequest{}),
 	reflect.TypeOf(protowire.HoosatdMessage_GetCurrentNetworkRequest{}),
 	reflect.TypeOf(protowire.HoosatdMessage_GetInfoRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_GetSyncStatusRequest{}),
 
 	reflect.TypeOf(protowire.HoosatdMessage_GetBlockRequest{}),
 	reflect.TypeOf(protowire.HoosatdMessage_GetBlockByTransactionIdRequest{}),
@@ -35,13 +36,38 @@ var commandTypes = []reflect.Type{
 	reflect.TypeOf(protowire.HoosatdMessage_GetMempoolEntriesByAddressesRequest{}),
 
 	reflect.TypeOf(protowire.HoosatdMessage_SubmitTransactionRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_TestMempoolAcceptRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_DecodeTransactionRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_DecodeScriptRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_GetUtxoEntriesByOutpointsRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_VerifyMessageRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_GetTransactionInclusionProofRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_GetDagSnapshotRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_GetTransactionStatusRequest{}),
 
 	reflect.TypeOf(protowire.HoosatdMessage_GetUtxosByAddressesRequest{}),
 	reflect.TypeOf(protowire.HoosatdMessage_GetBalanceByAddressRequest{}),
 	reflect.TypeOf(protowire.HoosatdMessage_GetCoinSupplyRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_GetCoinbaseSplitRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_GetEmissionInfoRequest{}),
 
 	reflect.TypeOf(protowire.HoosatdMessage_BanRequest{}),
 	reflect.TypeOf(protowire.HoosatdMessage_UnbanRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_InvalidateBlockRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_ReconsiderBlockRequest{}),
+}
+
+// subscriptionTypes are the notifications that can be subscribed to with `htnctl subscribe`
+var subscriptionTypes = []reflect.Type{
+	reflect.TypeOf(protowire.HoosatdMessage_NotifyBlockAddedRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_NotifyVirtualSelectedParentChainChangedRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_NotifyFinalityConflictsRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_NotifyUtxosChangedRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_NotifyVirtualSelectedParentBlueScoreChangedRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_NotifyVirtualDaaScoreChangedRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_NotifyPruningPointUTXOSetOverrideRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_NotifyNewBlockTemplateRequest{}),
+	reflect.TypeOf(protowire.HoosatdMessage_NotifySyncStatusChangedRequest{}),
 }
 
 type commandDescription struct {
@@ -56,12 +82,22 @@ type parameterDescription struct {
 }
 
 func commandDescriptions() []*commandDescription {
-	commandDescriptions := make([]*commandDescription, len(commandTypes))
+	return describeCommandTypes(commandTypes, "")
+}
+
+// subscriptionDescriptions describes the subscribable notifications. They are
+// named after their Notify request, without the `Notify` prefix.
+func subscriptionDescriptions() []*commandDescription {
+	return describeCommandTypes(subscriptionTypes, "Notify")
+}
 
-	for i, commandTypeWrapped := range commandTypes {
+func describeCommandTypes(types []reflect.Type, namePrefix string) []*commandDescription {
+	commandDescriptions := make([]*commandDescription, len(types))
+
+	for i, commandTypeWrapped := range types {
 		commandType := unwrapCommandType(commandTypeWrapped)
 
-		name := strings.TrimSuffix(commandType.Name(), "RequestMessage")
+		name := strings.TrimPrefix(strings.TrimSuffix(commandType.Name(), "RequestMessage"), namePrefix)
 		numFields := commandType.NumField()
 
 		var parameters []*parameterDescription
@@ -95,3 +131,13 @@ func (cd *commandDescription) help() string {
 	}
 	return sb.String()
 }
+
+// findCommandDescription returns the description of the command with the given name, or nil if there is none
+func findCommandDescription(commandName string, commandDescs []*commandDescription) *commandDescription {
+	for _, commandDesc := range commandDescs {
+		if commandDesc.name == commandName {
+			return commandDesc
+		}
+	}
+	return nil
+}
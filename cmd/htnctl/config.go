@@ -1,21 +1,29 @@
 package main
 
 import (
+	"path/filepath"
+
 	"github.com/Hoosat-Oy/HTND/infrastructure/config"
+	"github.com/Hoosat-Oy/HTND/util"
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 )
 
 var (
-	defaultRPCServer        = "localhost"
-	defaultTimeout   uint64 = 30
+	defaultRPCServer          = "localhost"
+	defaultTimeout     uint64 = 30
+	defaultHistoryFile        = filepath.Join(util.AppDir("htnctl", false), "history")
 )
 
 type configFlags struct {
 	RPCServer                          string `short:"s" long:"rpcserver" description:"RPC server to connect to"`
+	RPCToken                           string `long:"rpctoken" description:"Authentication token to present to the RPC server"`
 	Timeout                            uint64 `short:"t" long:"timeout" description:"Timeout for the request (in seconds)"`
 	RequestJSON                        string `short:"j" long:"json" description:"The request in JSON format"`
 	ListCommands                       bool   `short:"l" long:"list-commands" description:"List all commands and exit"`
+	Interactive                        bool   `short:"i" long:"interactive" description:"Start an interactive shell with tab-completion and command history"`
+	BatchFile                          string `short:"b" long:"batch" description:"Execute the commands in the given file, one per line, stopping at the first error. Use - to read them from stdin"`
+	HistoryFile                        string `long:"historyfile" description:"File to keep the interactive shell's command history in. Set to an empty string to not keep the history"`
 	AllowConnectionToDifferentVersions bool   `short:"a" long:"allow-connection-to-different-versions" description:"Allow connections to versions different than htnctl's version'"`
 	CommandAndParameters               []string
 	config.NetworkFlags
@@ -23,13 +31,16 @@ type configFlags struct {
 
 func parseConfig() (*configFlags, error) {
 	cfg := &configFlags{
-		RPCServer: defaultRPCServer,
-		Timeout:   defaultTimeout,
+		RPCServer:   defaultRPCServer,
+		Timeout:     defaultTimeout,
+		HistoryFile: defaultHistoryFile,
 	}
 	parser := flags.NewParser(cfg, flags.HelpFlag)
 	parser.Usage = "htnctl [OPTIONS] [COMMAND] [COMMAND PARAMETERS].\n\nCommand can be supplied only if --json is not used." +
 		"\n\nUse `htnctl --list-commands` to get a list of all commands and their parameters." +
-		"\nFor optional parameters- use '-' without quotes to not pass the parameter.\n"
+		"\nFor optional parameters- use '-' without quotes to not pass the parameter." +
+		"\nParameters may also be given by name in the form <name>=<value>, leaving out the ones that are not needed." +
+		"\n\nUse `htnctl subscribe <NOTIFICATION> [PARAMETERS]` to print notifications as JSON lines until interrupted.\n"
 	remainingArgs, err := parser.Parse()
 	if err != nil {
 		return nil, err
@@ -45,10 +56,14 @@ func parseConfig() (*configFlags, error) {
 	}
 
 	cfg.CommandAndParameters = remainingArgs
-	if len(cfg.CommandAndParameters) == 0 && cfg.RequestJSON == "" ||
-		len(cfg.CommandAndParameters) > 0 && cfg.RequestJSON != "" {
-
-		return nil, errors.New("Exactly one of --json or a command must be specified")
+	numberOfModes := 0
+	for _, isModeUsed := range []bool{len(cfg.CommandAndParameters) > 0, cfg.RequestJSON != "", cfg.Interactive, cfg.BatchFile != ""} {
+		if isModeUsed {
+			numberOfModes++
+		}
+	}
+	if numberOfModes != 1 {
+		return nil, errors.New("Exactly one of --json, --interactive, --batch or a command must be specified")
 	}
 
 	return cfg, nil
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxHistoryEntries is the number of most recent lines kept in the shell's history
+const maxHistoryEntries = 1000
+
+// fileHistory is the history of the interactive shell. It implements
+// term.History, and persists the history to a file so that it survives
+// between sessions.
+type fileHistory struct {
+	entries []string
+	file    *os.File
+}
+
+// openHistory loads the history from the file at the given path, creating it if
+// needed. An empty path results in a history that is not persisted.
+func openHistory(path string) (*fileHistory, error) {
+	history := &fileHistory{}
+	if path == "" {
+		return history, nil
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating the directory of the history file")
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "error reading the history file")
+	}
+	scanner := bufio.NewScanner(strings.NewReader(string(content)))
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxBatchLineSize)
+	for scanner.Scan() {
+		if scanner.Text() != "" {
+			history.entries = append(history.entries, scanner.Text())
+		}
+	}
+
+	// Compact the file so that it doesn't grow indefinitely
+	if len(history.entries) > maxHistoryEntries {
+		history.entries = history.entries[len(history.entries)-maxHistoryEntries:]
+		err := os.WriteFile(path, []byte(strings.Join(history.entries, "\n")+"\n"), 0600)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error writing the history file")
+		}
+	}
+
+	history.file, err = os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening the history file")
+	}
+	return history, nil
+}
+
+// Add adds the given line to the history, unless it is empty or repeats the previous line
+func (h *fileHistory) Add(entry string) {
+	if strings.TrimSpace(entry) == "" || (len(h.entries) > 0 && h.entries[len(h.entries)-1] == entry) {
+		return
+	}
+	h.entries = append(h.entries, entry)
+	if len(h.entries) > maxHistoryEntries {
+		h.entries = h.entries[1:]
+	}
+	if h.file != nil {
+		_, _ = h.file.WriteString(entry + "\n")
+	}
+}
+
+// Len returns the number of lines in the history
+func (h *fileHistory) Len() int {
+	return len(h.entries)
+}
+
+// At returns the line at the given index, where index 0 is the most recent line
+func (h *fileHistory) At(index int) string {
+	return h.entries[len(h.entries)-1-index]
+}
+
+func (h *fileHistory) close() {
+	if h.file != nil {
+		_ = h.file.Close()
+	}
+}
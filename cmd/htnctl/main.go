@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -21,7 +22,7 @@ func main() {
 		printErrorAndExit(fmt.Sprintf("error parsing command-line arguments: %s", err))
 	}
 	if cfg.ListCommands {
-		printAllCommands()
+		writeAllCommands(os.Stdout)
 		return
 	}
 
@@ -29,7 +30,7 @@ func main() {
 	if err != nil {
 		printErrorAndExit(fmt.Sprintf("error parsing RPC server address: %s", err))
 	}
-	client, err := grpcclient.Connect(rpcAddress)
+	client, err := grpcclient.ConnectWithAuthToken(rpcAddress, cfg.RPCToken)
 	if err != nil {
 		printErrorAndExit(fmt.Sprintf("error connecting to the RPC server: %s", err))
 	}
@@ -49,68 +50,105 @@ func main() {
 		}
 	}
 
-	responseChan := make(chan string)
-
-	if cfg.RequestJSON != "" {
-		go postJSON(cfg, client, responseChan)
-	} else {
-		go postCommand(cfg, client, responseChan)
+	switch {
+	case cfg.Interactive:
+		err = runInteractive(cfg, client)
+	case cfg.BatchFile != "":
+		err = executeBatchFile(cfg, client)
+	case len(cfg.CommandAndParameters) > 0 && cfg.CommandAndParameters[0] == subscribeCommandName:
+		err = subscribe(client, cfg.CommandAndParameters[1:])
+	default:
+		err = executeSingleRequest(cfg, client)
 	}
-
-	timeout := time.Duration(cfg.Timeout) * time.Second
-	select {
-	case responseString := <-responseChan:
-		prettyResponseString := prettifyResponse(responseString)
-		fmt.Println(prettyResponseString)
-	case <-time.After(timeout):
-		printErrorAndExit(fmt.Sprintf("timeout of %s has been exceeded", timeout))
+	if err != nil {
+		printErrorAndExit(err.Error())
 	}
 }
 
-func printAllCommands() {
+func writeAllCommands(writer io.Writer) {
 	requestDescs := commandDescriptions()
 	for _, requestDesc := range requestDescs {
-		fmt.Printf("\t%s\n", requestDesc.help())
+		_, _ = fmt.Fprintf(writer, "\t%s\n", requestDesc.help())
+	}
+
+	_, _ = fmt.Fprintf(writer, "\nNotifications for `htnctl %s`:\n", subscribeCommandName)
+	for _, subscriptionDesc := range subscriptionDescriptions() {
+		_, _ = fmt.Fprintf(writer, "\t%s\n", subscriptionDesc.help())
 	}
 }
 
-func postCommand(cfg *configFlags, client *grpcclient.GRPCClient, responseChan chan string) {
-	message, err := parseCommand(cfg.CommandAndParameters, commandDescriptions())
+func executeSingleRequest(cfg *configFlags, client *grpcclient.GRPCClient) error {
+	request, err := parseRequest(cfg.CommandAndParameters, cfg.RequestJSON)
 	if err != nil {
-		printErrorAndExit(fmt.Sprintf("error parsing command: %s", err))
+		return err
 	}
-
-	response, err := client.Post(message)
+	response, err := postRequest(cfg, client, request)
 	if err != nil {
-		printErrorAndExit(fmt.Sprintf("error posting the request to the RPC server: %s", err))
+		return err
 	}
-	responseBytes, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(response)
+	fmt.Println(prettifyResponse(response))
+	return nil
+}
+
+func executeBatchFile(cfg *configFlags, client *grpcclient.GRPCClient) error {
+	batchFile, err := openBatchFile(cfg.BatchFile)
 	if err != nil {
-		printErrorAndExit(errors.Wrapf(err, "error parsing the response from the RPC server").Error())
+		return err
 	}
+	defer func() { _ = batchFile.Close() }()
 
-	responseChan <- string(responseBytes)
+	return runBatch(cfg, client, batchFile)
 }
 
-func postJSON(cfg *configFlags, client *grpcclient.GRPCClient, doneChan chan string) {
-	responseString, err := client.PostJSON(cfg.RequestJSON)
+// parseRequest parses a request given either as a command and its parameters, or in JSON format
+func parseRequest(commandAndParameters []string, requestJSON string) (*protowire.HoosatdMessage, error) {
+	if requestJSON == "" {
+		message, err := parseCommand(commandAndParameters, commandDescriptions())
+		if err != nil {
+			return nil, errors.Wrapf(err, "error parsing command")
+		}
+		return message, nil
+	}
+
+	message := &protowire.HoosatdMessage{}
+	err := protojson.Unmarshal([]byte(requestJSON), message)
 	if err != nil {
-		printErrorAndExit(fmt.Sprintf("error posting the request to the RPC server: %s", err))
+		return nil, errors.Wrapf(err, "error parsing the request")
 	}
-	doneChan <- responseString
+	return message, nil
 }
 
-func prettifyResponse(response string) string {
-	htndMessage := &protowire.HoosatdMessage{}
-	err := protojson.Unmarshal([]byte(response), htndMessage)
-	if err != nil {
-		printErrorAndExit(fmt.Sprintf("error parsing the response from the RPC server: %s", err))
+// postRequest posts the given request to the RPC server and returns its response.
+// Once the timeout is exceeded, a late response may still arrive, so the client should not
+// be used anymore.
+func postRequest(cfg *configFlags, client *grpcclient.GRPCClient, request *protowire.HoosatdMessage) (*protowire.HoosatdMessage, error) {
+	responseChan := make(chan *protowire.HoosatdMessage, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		response, err := client.Post(request)
+		if err != nil {
+			errChan <- errors.Wrapf(err, "error posting the request to the RPC server")
+			return
+		}
+		responseChan <- response
+	}()
+
+	timeout := time.Duration(cfg.Timeout) * time.Second
+	select {
+	case response := <-responseChan:
+		return response, nil
+	case err := <-errChan:
+		return nil, err
+	case <-time.After(timeout):
+		return nil, errors.Errorf("timeout of %s has been exceeded", timeout)
 	}
+}
 
+func prettifyResponse(response *protowire.HoosatdMessage) string {
 	marshalOptions := &protojson.MarshalOptions{}
 	marshalOptions.Indent = "    "
 	marshalOptions.EmitUnpopulated = true
-	return marshalOptions.Format(htndMessage)
+	return marshalOptions.Format(response)
 }
 
 func printErrorAndExit(message string) {
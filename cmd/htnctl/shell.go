@@ -0,0 +1,298 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/rpcclient/grpcclient"
+	"github.com/pkg/errors"
+	"golang.org/x/term"
+)
+
+const (
+	shellPrompt = "htnctl> "
+
+	// maxBatchLineSize is the maximum length of a single line in a batch file
+	maxBatchLineSize = 1024 * 1024
+)
+
+var (
+	helpCommandNames = []string{"help"}
+	exitCommandNames = []string{"exit", "quit"}
+)
+
+// errExit is returned by executeLine when the user asks to leave the shell
+var errExit = errors.New("exit")
+
+// shell executes requests given as lines of text, either typed into the
+// interactive shell or read from a batch file. A line holds either a command
+// and its parameters, quoted as in a POSIX shell, or a request in JSON format.
+type shell struct {
+	cfg    *configFlags
+	client *grpcclient.GRPCClient
+	output io.Writer
+}
+
+// runInteractive runs the interactive shell until the user exits it. If stdin
+// is not a terminal, the lines are read from stdin as if it were a batch file.
+func runInteractive(cfg *configFlags, client *grpcclient.GRPCClient) error {
+	stdinFd := int(os.Stdin.Fd())
+	if !term.IsTerminal(stdinFd) {
+		return runBatch(cfg, client, os.Stdin)
+	}
+
+	history, err := openHistory(cfg.HistoryFile)
+	if err != nil {
+		return err
+	}
+	defer history.close()
+
+	oldState, err := term.MakeRaw(stdinFd)
+	if err != nil {
+		return errors.Wrapf(err, "error switching the terminal to raw mode")
+	}
+	defer func() { _ = term.Restore(stdinFd, oldState) }()
+
+	terminal := term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{os.Stdin, os.Stdout}, shellPrompt)
+	terminal.History = history
+	if width, height, err := term.GetSize(stdinFd); err == nil {
+		_ = terminal.SetSize(width, height)
+	}
+
+	s := &shell{cfg: cfg, client: client, output: terminal}
+	terminal.AutoCompleteCallback = s.complete
+
+	_, _ = fmt.Fprintln(terminal, "Type `help` to list all commands, and `exit` or Ctrl-D to quit")
+	for {
+		line, err := terminal.ReadLine()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "error reading from the terminal")
+		}
+
+		lineErr, err := s.executeLine(line)
+		if errors.Is(err, errExit) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if lineErr != nil {
+			_, _ = fmt.Fprintf(terminal, "Error: %s\n", lineErr)
+		}
+	}
+}
+
+// runBatch executes the lines read from the given reader one by one, and stops at the first error
+func runBatch(cfg *configFlags, client *grpcclient.GRPCClient, reader io.Reader) error {
+	s := &shell{cfg: cfg, client: client, output: os.Stdout}
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxBatchLineSize)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		lineErr, err := s.executeLine(scanner.Text())
+		if errors.Is(err, errExit) {
+			return nil
+		}
+		if err == nil {
+			err = lineErr
+		}
+		if err != nil {
+			return errors.Wrapf(err, "line %d", lineNumber)
+		}
+	}
+	return errors.Wrapf(scanner.Err(), "error reading the batch")
+}
+
+// openBatchFile opens the batch file at the given path, where `-` stands for stdin
+func openBatchFile(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening the batch file")
+	}
+	return file, nil
+}
+
+// executeLine executes a single line and writes its result to the shell's output.
+// lineErr is set if the line could not be executed as given or the RPC server
+// responded with an error, and err is set if the connection to the RPC server
+// can no longer be used.
+func (s *shell) executeLine(line string) (lineErr error, err error) {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil, nil
+	}
+
+	var args []string
+	requestJSON := ""
+	if strings.HasPrefix(line, "{") {
+		requestJSON = line
+	} else {
+		args, lineErr = splitCommandLine(line)
+		if lineErr != nil {
+			return lineErr, nil
+		}
+		switch {
+		case contains(exitCommandNames, args[0]):
+			return nil, errExit
+		case contains(helpCommandNames, args[0]):
+			writeAllCommands(s.output)
+			return nil, nil
+		case args[0] == subscribeCommandName:
+			return errors.Errorf("%s is only available as a command-line mode: htnctl %s <notification>",
+				subscribeCommandName, subscribeCommandName), nil
+		}
+	}
+
+	request, lineErr := parseRequest(args, requestJSON)
+	if lineErr != nil {
+		return lineErr, nil
+	}
+	response, err := postRequest(s.cfg, s.client, request)
+	if err != nil {
+		return nil, err
+	}
+	_, _ = fmt.Fprintln(s.output, prettifyResponse(response))
+	if rpcError := responseError(response); rpcError != nil {
+		return errors.Errorf("the RPC server responded with an error: %s", rpcError.Message), nil
+	}
+	return nil, nil
+}
+
+// complete is the terminal's AutoCompleteCallback. On tab, it completes the word
+// under the cursor to a command name, or to a parameter name of the command
+// being typed.
+func (s *shell) complete(line string, pos int, key rune) (newLine string, newPos int, ok bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+
+	prefix := line[:pos]
+	words := strings.Fields(prefix)
+	currentWord := ""
+	if len(words) > 0 && !strings.HasSuffix(prefix, " ") {
+		currentWord = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+
+	var candidates []string
+	if len(words) == 0 {
+		candidates = append(candidates, helpCommandNames...)
+		candidates = append(candidates, exitCommandNames...)
+		for _, commandDesc := range commandDescriptions() {
+			candidates = append(candidates, commandDesc.name)
+		}
+	} else {
+		commandDesc := findCommandDescription(words[0], commandDescriptions())
+		if commandDesc == nil {
+			return line, pos, true
+		}
+		for _, parameterDesc := range commandDesc.parameters {
+			candidates = append(candidates, parameterDesc.name+"=")
+		}
+	}
+
+	var matches []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, currentWord) {
+			matches = append(matches, candidate)
+		}
+	}
+	if len(matches) == 0 {
+		return line, pos, true
+	}
+	sort.Strings(matches)
+
+	completion := longestCommonPrefix(matches)
+	if len(matches) == 1 && !strings.HasSuffix(completion, "=") {
+		completion += " "
+	}
+	if completion == currentWord {
+		_, _ = fmt.Fprintln(s.output, strings.Join(matches, "  "))
+	}
+
+	newPrefix := prefix[:len(prefix)-len(currentWord)] + completion
+	return newPrefix + line[pos:], len(newPrefix), true
+}
+
+// splitCommandLine splits the given line into arguments, honoring single quotes,
+// double quotes and backslash escapes the same way a POSIX shell does
+func splitCommandLine(line string) ([]string, error) {
+	var args []string
+	current := &strings.Builder{}
+	isInArg := false
+	isEscaped := false
+	var quote rune
+
+	for _, r := range line {
+		switch {
+		case isEscaped:
+			current.WriteRune(r)
+			isEscaped = false
+		case r == '\\' && quote != '\'':
+			isEscaped = true
+			isInArg = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			isInArg = true
+		case unicode.IsSpace(r):
+			if isInArg {
+				args = append(args, current.String())
+				current.Reset()
+				isInArg = false
+			}
+		default:
+			current.WriteRune(r)
+			isInArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, errors.Errorf("unterminated %c quote", quote)
+	}
+	if isEscaped {
+		return nil, errors.New("the line ends with an escape character")
+	}
+	if isInArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+func longestCommonPrefix(values []string) string {
+	prefix := values[0]
+	for _, value := range values[1:] {
+		for !strings.HasPrefix(value, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"reflect"
+
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/server/grpcserver/protowire"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/rpcclient/grpcclient"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const subscribeCommandName = "subscribe"
+
+// subscribe subscribes to the notification given in args and prints every
+// notification as a single line of JSON until interrupted
+func subscribe(client *grpcclient.GRPCClient, args []string) error {
+	if len(args) == 0 {
+		return errors.Errorf("%s requires a notification name. Use --list-commands to list all notifications",
+			subscribeCommandName)
+	}
+	message, err := parseCommand(args, subscriptionDescriptions())
+	if err != nil {
+		return err
+	}
+
+	response, err := client.Post(message)
+	if err != nil {
+		return errors.Wrapf(err, "error posting the request to the RPC server")
+	}
+	if rpcError := responseError(response); rpcError != nil {
+		return errors.Errorf("error subscribing to %s: %s", args[0], rpcError.Message)
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			notification, err := client.Receive()
+			if err != nil {
+				errChan <- err
+				return
+			}
+			notificationBytes, err := protojson.Marshal(notification)
+			if err != nil {
+				errChan <- errors.Wrapf(err, "error parsing the notification from the RPC server")
+				return
+			}
+			fmt.Println(string(notificationBytes))
+		}
+	}()
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-interrupt:
+		return nil
+	}
+}
+
+// responseError returns the error of the given response, or nil if it has none
+func responseError(response *protowire.HoosatdMessage) *protowire.RPCError {
+	if response.Payload == nil {
+		return nil
+	}
+	responseValue := unwrapCommandValue(reflect.ValueOf(response.Payload))
+	responseWithError, ok := responseValue.Interface().(interface{ GetError() *protowire.RPCError })
+	if !ok {
+		return nil
+	}
+	return responseWithError.GetError()
+}
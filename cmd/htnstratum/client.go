@@ -0,0 +1,86 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/infrastructure/logger"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/rpcclient"
+	"github.com/Hoosat-Oy/HTND/version"
+	"github.com/pkg/errors"
+)
+
+const nodeTimeout = 10 * time.Second
+
+// nodeClient is the bridge's connection to htnd.
+//
+// Responses of the RPC client are routed by their type, so concurrent requests
+// of the same type could receive each other's responses. requestLock serializes
+// all requests to prevent that.
+type nodeClient struct {
+	*rpcclient.RPCClient
+
+	cfg                              *configFlags
+	newBlockTemplateNotificationChan chan struct{}
+	requestLock                      sync.Mutex
+}
+
+func newNodeClient(cfg *configFlags) (*nodeClient, error) {
+	client := &nodeClient{
+		cfg:                              cfg,
+		newBlockTemplateNotificationChan: make(chan struct{}, 1),
+	}
+
+	err := client.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func (nc *nodeClient) connect() error {
+	rpcAddress, err := nc.cfg.NetParams().NormalizeRPCServerAddress(nc.cfg.RPCServer)
+	if err != nil {
+		return err
+	}
+	rpcClient, err := rpcclient.NewRPCClientWithAuthToken(rpcAddress, nc.cfg.RPCToken)
+	if err != nil {
+		return err
+	}
+	nc.RPCClient = rpcClient
+	nc.SetTimeout(nodeTimeout)
+	nc.SetLogger(backendLog, logger.LevelTrace)
+
+	err = nc.RegisterForNewBlockTemplateNotifications(func(_ *appmessage.NewBlockTemplateNotificationMessage) {
+		select {
+		case nc.newBlockTemplateNotificationChan <- struct{}{}:
+		default:
+		}
+	})
+	if err != nil {
+		return errors.Wrapf(err, "error requesting new-block-template notifications")
+	}
+
+	log.Infof("Connected to %s", rpcAddress)
+
+	return nil
+}
+
+// getBlockTemplate requests a block template paying to the given address
+func (nc *nodeClient) getBlockTemplate(payAddress string) (*appmessage.GetBlockTemplateResponseMessage, error) {
+	nc.requestLock.Lock()
+	defer nc.requestLock.Unlock()
+
+	return nc.GetBlockTemplate(payAddress, "htnstratum-"+version.Version())
+}
+
+// submitBlock submits a solved block along with its PoW hash
+func (nc *nodeClient) submitBlock(block *externalapi.DomainBlock) (appmessage.RejectReason, error) {
+	nc.requestLock.Lock()
+	defer nc.requestLock.Unlock()
+
+	return nc.SubmitBlock(block, block.PoWHash)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/infrastructure/config"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/Hoosat-Oy/HTND/version"
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLogFilename     = "htnstratum.log"
+	defaultErrLogFilename  = "htnstratum_err.log"
+	defaultListen          = ":5555"
+	defaultShareDifficulty = 1.0
+	defaultExtranonceSize  = 2
+	defaultStatsInterval   = 30 * time.Second
+	maxExtranonceSize      = 3
+)
+
+const (
+	jobFormatWords = "words"
+	jobFormatHex   = "hex"
+)
+
+var (
+	// Default configuration options
+	defaultAppDir     = util.AppDir("htnstratum", false)
+	defaultLogFile    = filepath.Join(defaultAppDir, defaultLogFilename)
+	defaultErrLogFile = filepath.Join(defaultAppDir, defaultErrLogFilename)
+	defaultRPCServer  = "localhost"
+)
+
+type configFlags struct {
+	ShowVersion       bool          `short:"V" long:"version" description:"Display version information and exit"`
+	RPCServer         string        `short:"s" long:"rpcserver" description:"RPC server to connect to"`
+	RPCToken          string        `long:"rpctoken" description:"Authentication token to present to the RPC server"`
+	Listen            string        `short:"l" long:"listen" description:"Interface/port to listen for stratum connections on"`
+	MiningAddr        string        `long:"miningaddr" description:"Address to mine to for workers whose username is not an address"`
+	ShareDifficulty   float64       `long:"sharediff" description:"Difficulty of shares requested from workers. Difficulty 1 corresponds to about 2^32 hashes per share"`
+	ExtranonceSize    int           `long:"extranonce-size" description:"Number of nonce bytes reserved to give every worker its own nonce range (0-3)"`
+	JobFormat         string        `long:"job-format" description:"Format of mining.notify jobs {words, hex}. words sends the pre-PoW hash as four little-endian uint64s, hex sends the pre-PoW hash and timestamp as a single hex string"`
+	StatsInterval     time.Duration `long:"stats-interval" description:"How often to log per-worker statistics. 0 disables statistics logging"`
+	MineWhenNotSynced bool          `long:"mine-when-not-synced" description:"Send jobs even if the node is not synced with the rest of the network."`
+	Profile           string        `long:"profile" description:"Enable HTTP profiling on given port -- NOTE port must be between 1024 and 65536"`
+	config.NetworkFlags
+}
+
+func parseConfig() (*configFlags, error) {
+	cfg := &configFlags{
+		RPCServer:       defaultRPCServer,
+		Listen:          defaultListen,
+		ShareDifficulty: defaultShareDifficulty,
+		ExtranonceSize:  defaultExtranonceSize,
+		JobFormat:       jobFormatWords,
+		StatsInterval:   defaultStatsInterval,
+	}
+	parser := flags.NewParser(cfg, flags.PrintErrors|flags.HelpFlag)
+	_, err := parser.Parse()
+
+	// Show the version and exit if the version flag was specified.
+	if cfg.ShowVersion {
+		appName := filepath.Base(os.Args[0])
+		appName = strings.TrimSuffix(appName, filepath.Ext(appName))
+		fmt.Println(appName, "version", version.Version())
+		os.Exit(0)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = cfg.ResolveNetwork(parser)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.Profile != "" {
+		profilePort, err := strconv.Atoi(cfg.Profile)
+		if err != nil || profilePort < 1024 || profilePort > 65535 {
+			return nil, errors.New("The profile port must be between 1024 and 65535")
+		}
+	}
+
+	if cfg.ShareDifficulty <= 0 {
+		return nil, errors.New("--sharediff must be positive")
+	}
+
+	if cfg.ExtranonceSize < 0 || cfg.ExtranonceSize > maxExtranonceSize {
+		return nil, errors.Errorf("--extranonce-size must be between 0 and %d", maxExtranonceSize)
+	}
+
+	if cfg.JobFormat != jobFormatWords && cfg.JobFormat != jobFormatHex {
+		return nil, errors.Errorf("--job-format must be either %s or %s", jobFormatWords, jobFormatHex)
+	}
+
+	if cfg.MiningAddr != "" {
+		_, err := util.DecodeAddress(cfg.MiningAddr, cfg.ActiveNetParams.Prefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error decoding --miningaddr")
+		}
+	}
+
+	initLog(defaultLogFile, defaultErrLogFile)
+
+	return cfg, nil
+}
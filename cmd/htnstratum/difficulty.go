@@ -0,0 +1,40 @@
+package main
+
+import (
+	"math"
+	"math/big"
+)
+
+// diff1Target is the share target at stratum difficulty 1. It follows the
+// convention of most stratum pools, so that a share at difficulty 1 takes about
+// 2^32 hashes to find.
+var diff1Target = new(big.Int).Lsh(big.NewInt(0xffff), 208)
+
+// maxTarget is the largest possible PoW value
+var maxTarget = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// hashesPerDifficultyUnit is the expected number of hashes needed to find a
+// share at difficulty 1
+var hashesPerDifficultyUnit = math.Exp2(256) / float64FromBig(diff1Target)
+
+// difficultyToTarget returns the share target that corresponds to the given stratum difficulty
+func difficultyToTarget(difficulty float64) *big.Int {
+	target, _ := new(big.Float).Quo(new(big.Float).SetInt(diff1Target), big.NewFloat(difficulty)).Int(nil)
+	if target.Cmp(maxTarget) > 0 {
+		return new(big.Int).Set(maxTarget)
+	}
+	return target
+}
+
+// targetToDifficulty returns the stratum difficulty that corresponds to the given target
+func targetToDifficulty(target *big.Int) float64 {
+	if target.Sign() == 0 {
+		return math.Inf(1)
+	}
+	return float64FromBig(diff1Target) / float64FromBig(target)
+}
+
+func float64FromBig(value *big.Int) float64 {
+	result, _ := new(big.Float).SetInt(value).Float64()
+	return result
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"sync"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/pow"
+)
+
+// job is a unit of work handed to stratum workers. It wraps a single block template.
+type job struct {
+	id         string
+	payAddress string
+	block      *externalapi.DomainBlock
+	state      *pow.State
+	isSynced   bool
+
+	submittedNoncesLock sync.Mutex
+	submittedNonces     map[uint64]struct{}
+}
+
+func newJob(id string, payAddress string, block *externalapi.DomainBlock, isSynced bool) *job {
+	return &job{
+		id:              id,
+		payAddress:      payAddress,
+		block:           block,
+		state:           pow.NewState(block.Header.ToMutable()),
+		isSynced:        isSynced,
+		submittedNonces: make(map[uint64]struct{}),
+	}
+}
+
+// prePoWHash returns the hash of the job's header with its timestamp and nonce
+// zeroed. This, together with the timestamp, is everything a worker needs to mine.
+func (j *job) prePoWHash() *externalapi.DomainHash {
+	return &j.state.PrevHeader
+}
+
+// calculatePoW returns the PoW value and the PoW hash of the job's block with the given nonce
+func (j *job) calculatePoW(nonce uint64) (*big.Int, *externalapi.DomainHash) {
+	state := *j.state
+	state.Nonce = nonce
+	return state.CalculateProofOfWorkValue()
+}
+
+// blockTarget returns the target that a PoW value must not exceed for the block to be valid
+func (j *job) blockTarget() *big.Int {
+	return &j.state.Target
+}
+
+// markSubmitted records the given nonce as submitted, and returns false if it was already submitted
+func (j *job) markSubmitted(nonce uint64) bool {
+	j.submittedNoncesLock.Lock()
+	defer j.submittedNoncesLock.Unlock()
+
+	if _, ok := j.submittedNonces[nonce]; ok {
+		return false
+	}
+	j.submittedNonces[nonce] = struct{}{}
+	return true
+}
+
+// solvedBlock returns the job's block with the given nonce and its PoW hash filled in,
+// ready to be submitted. The PoW hash is required for blocks of version
+// constants.PoWIntegrityMinVersion and above.
+func (j *job) solvedBlock(nonce uint64, powHash *externalapi.DomainHash) *externalapi.DomainBlock {
+	mutableHeader := j.block.Header.ToMutable()
+	mutableHeader.SetNonce(nonce)
+	return &externalapi.DomainBlock{
+		Header:       mutableHeader.ToImmutable(),
+		Transactions: j.block.Transactions,
+		PoWHash:      powHash.String(),
+	}
+}
+
+// notifyParams returns the parameters of the mining.notify message for this job
+func (j *job) notifyParams(jobFormat string) []interface{} {
+	prePoWHash := j.prePoWHash().ByteSlice()
+	if jobFormat == jobFormatHex {
+		timestamp := make([]byte, 8)
+		binary.LittleEndian.PutUint64(timestamp, uint64(j.state.Timestamp))
+		return []interface{}{j.id, hex.EncodeToString(append(prePoWHash, timestamp...))}
+	}
+
+	words := make([]uint64, 4)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(prePoWHash[i*8:])
+	}
+	return []interface{}{j.id, words, j.state.Timestamp}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+)
+
+// maxJobsPerAddress is the number of most recent jobs per pay address that
+// still accept shares. Shares for older jobs are rejected as stale.
+const maxJobsPerAddress = 8
+
+// jobManager creates jobs out of block templates and keeps track of the pay
+// addresses that connected workers mine to
+type jobManager struct {
+	lock sync.RWMutex
+
+	nextJobID       uint64
+	jobs            map[string]*job
+	jobIDsByAddress map[string][]string
+
+	addressWorkerCounts map[string]int
+}
+
+func newJobManager() *jobManager {
+	return &jobManager{
+		nextJobID:           1,
+		jobs:                make(map[string]*job),
+		jobIDsByAddress:     make(map[string][]string),
+		addressWorkerCounts: make(map[string]int),
+	}
+}
+
+// addTemplate creates a job out of the given template. If the template has the same
+// pre-PoW hash as the latest job of the same pay address, no job is created and
+// false is returned. Templates that differ only in their timestamp are not worth
+// interrupting the workers for.
+func (jm *jobManager) addTemplate(payAddress string, template *appmessage.GetBlockTemplateResponseMessage) (*job, bool, error) {
+	block, err := appmessage.RPCBlockToDomainBlock(template.Block, "TEMPLATE_POW_HASH")
+	if err != nil {
+		return nil, false, err
+	}
+
+	jm.lock.Lock()
+	defer jm.lock.Unlock()
+
+	newJob := newJob(strconv.FormatUint(jm.nextJobID, 16), payAddress, block, template.IsSynced)
+	jobIDs := jm.jobIDsByAddress[payAddress]
+	if len(jobIDs) > 0 {
+		latestJob := jm.jobs[jobIDs[len(jobIDs)-1]]
+		if latestJob.prePoWHash().Equal(newJob.prePoWHash()) {
+			return latestJob, false, nil
+		}
+	}
+	jm.nextJobID++
+
+	jm.jobs[newJob.id] = newJob
+	jobIDs = append(jobIDs, newJob.id)
+	if len(jobIDs) > maxJobsPerAddress {
+		delete(jm.jobs, jobIDs[0])
+		jobIDs = jobIDs[1:]
+	}
+	jm.jobIDsByAddress[payAddress] = jobIDs
+
+	return newJob, true, nil
+}
+
+// job returns the job with the given ID, or nil if it does not exist or is stale
+func (jm *jobManager) job(jobID string) *job {
+	jm.lock.RLock()
+	defer jm.lock.RUnlock()
+
+	return jm.jobs[jobID]
+}
+
+// latestJob returns the latest job for the given pay address, or nil if there is none
+func (jm *jobManager) latestJob(payAddress string) *job {
+	jm.lock.RLock()
+	defer jm.lock.RUnlock()
+
+	jobIDs := jm.jobIDsByAddress[payAddress]
+	if len(jobIDs) == 0 {
+		return nil
+	}
+	return jm.jobs[jobIDs[len(jobIDs)-1]]
+}
+
+// addWorker registers a worker that mines to the given pay address
+func (jm *jobManager) addWorker(payAddress string) {
+	jm.lock.Lock()
+	defer jm.lock.Unlock()
+
+	jm.addressWorkerCounts[payAddress]++
+}
+
+// removeWorker unregisters a worker that mines to the given pay address. Once
+// an address has no workers left, its jobs are dropped.
+func (jm *jobManager) removeWorker(payAddress string) {
+	jm.lock.Lock()
+	defer jm.lock.Unlock()
+
+	jm.addressWorkerCounts[payAddress]--
+	if jm.addressWorkerCounts[payAddress] > 0 {
+		return
+	}
+	delete(jm.addressWorkerCounts, payAddress)
+	for _, jobID := range jm.jobIDsByAddress[payAddress] {
+		delete(jm.jobs, jobID)
+	}
+	delete(jm.jobIDsByAddress, payAddress)
+}
+
+// payAddresses returns the pay addresses that currently have workers
+func (jm *jobManager) payAddresses() []string {
+	jm.lock.RLock()
+	defer jm.lock.RUnlock()
+
+	payAddresses := make([]string, 0, len(jm.addressWorkerCounts))
+	for payAddress := range jm.addressWorkerCounts {
+		payAddresses = append(payAddresses, payAddress)
+	}
+	return payAddresses
+}
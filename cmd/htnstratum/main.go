@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	_ "net/http/pprof"
+
+	"github.com/Hoosat-Oy/HTND/infrastructure/os/signal"
+	"github.com/Hoosat-Oy/HTND/util/panics"
+	"github.com/Hoosat-Oy/HTND/util/profiling"
+	"github.com/Hoosat-Oy/HTND/version"
+	"github.com/pkg/errors"
+)
+
+func main() {
+	defer panics.HandlePanic(log, "MAIN", nil)
+	interrupt := signal.InterruptListener()
+
+	cfg, err := parseConfig()
+	if err != nil {
+		printErrorAndExit(errors.Errorf("Error parsing command-line arguments: %s", err))
+	}
+	defer backendLog.Close()
+
+	// Show version at startup.
+	log.Infof("Version %s", version.Version())
+
+	// Enable http profiling server if requested.
+	if cfg.Profile != "" {
+		profiling.Start(cfg.Profile, log)
+	}
+
+	client, err := newNodeClient(cfg)
+	if err != nil {
+		panic(errors.Wrap(err, "error connecting to the RPC server"))
+	}
+	defer func() { _ = client.Disconnect() }()
+
+	server := newStratumServer(cfg, client)
+	err = server.start()
+	if err != nil {
+		printErrorAndExit(err)
+	}
+	defer func() { _ = server.stop() }()
+
+	<-interrupt
+}
+
+func printErrorAndExit(err error) {
+	fmt.Fprintf(os.Stderr, "%+v\n", err)
+	os.Exit(1)
+}
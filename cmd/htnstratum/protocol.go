@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// The stratum methods supported by the bridge. The bridge speaks the
+// EthereumStratum/1.0.0 dialect that Hoohash GPU/ASIC miners use.
+const (
+	methodSubscribe           = "mining.subscribe"
+	methodExtranonceSubscribe = "mining.extranonce.subscribe"
+	methodAuthorize           = "mining.authorize"
+	methodSubmit              = "mining.submit"
+	methodNotify              = "mining.notify"
+	methodSetDifficulty       = "mining.set_difficulty"
+	methodSetExtranonce       = "mining.set_extranonce"
+)
+
+const stratumProtocolVersion = "EthereumStratum/1.0.0"
+
+// stratumRequest is a request sent by a worker
+type stratumRequest struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+// stratumResponse is the bridge's response to a stratumRequest
+type stratumResponse struct {
+	ID     interface{}   `json:"id"`
+	Result interface{}   `json:"result"`
+	Error  []interface{} `json:"error"`
+}
+
+// stratumNotification is a message sent by the bridge on its own accord
+type stratumNotification struct {
+	ID     interface{}   `json:"id"`
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+// stratumError is an error reported to a worker
+type stratumError struct {
+	code    int
+	message string
+}
+
+func (e *stratumError) Error() string {
+	return e.message
+}
+
+func (e *stratumError) toJSON() []interface{} {
+	return []interface{}{e.code, e.message, nil}
+}
+
+// The standard stratum error codes
+var (
+	errOther          = &stratumError{code: 20, message: "Other/Unknown"}
+	errStaleJob       = &stratumError{code: 21, message: "Job not found (=stale)"}
+	errDuplicateShare = &stratumError{code: 22, message: "Duplicate share"}
+	errLowDifficulty  = &stratumError{code: 23, message: "Low difficulty share"}
+	errUnauthorized   = &stratumError{code: 24, message: "Unauthorized worker"}
+	errNotSubscribed  = &stratumError{code: 25, message: "Not subscribed"}
+)
+
+func newOtherError(message string) *stratumError {
+	return &stratumError{code: errOther.code, message: message}
+}
+
+// stringParam returns the request parameter at the given index as a string
+func (request *stratumRequest) stringParam(index int) (string, error) {
+	if index >= len(request.Params) {
+		return "", errors.Errorf("%s requires at least %d parameters", request.Method, index+1)
+	}
+	value, ok := request.Params[index].(string)
+	if !ok {
+		return "", errors.Errorf("parameter %d of %s must be a string", index, request.Method)
+	}
+	return value, nil
+}
+
+// extranonceHex returns the hex encoding of the given extranonce, padded to extranonceSize bytes
+func extranonceHex(extranonce uint32, extranonceSize int) string {
+	if extranonceSize == 0 {
+		return ""
+	}
+	encoded := strconv.FormatUint(uint64(extranonce), 16)
+	return strings.Repeat("0", extranonceSize*2-len(encoded)) + encoded
+}
+
+// parseNonce parses a nonce submitted by a worker. The extranonce occupies the
+// most significant bytes of the nonce. Workers may submit either the full
+// nonce, in which case it must start with their extranonce, or only the part
+// of the nonce that follows the extranonce.
+func parseNonce(nonceString string, extranonce string) (uint64, error) {
+	nonceString = strings.TrimPrefix(strings.ToLower(nonceString), "0x")
+	if _, err := hex.DecodeString(nonceString); err != nil || len(nonceString) == 0 {
+		return 0, errors.Errorf("nonce '%s' is not a hex string", nonceString)
+	}
+
+	const fullNonceLength = 16
+	switch len(nonceString) {
+	case fullNonceLength:
+		if !strings.HasPrefix(nonceString, extranonce) {
+			return 0, errors.Errorf("nonce %s does not start with the extranonce %s", nonceString, extranonce)
+		}
+	case fullNonceLength - len(extranonce):
+		nonceString = extranonce + nonceString
+	default:
+		return 0, errors.Errorf("nonce %s must be either %d or %d hex characters long",
+			nonceString, fullNonceLength, fullNonceLength-len(extranonce))
+	}
+
+	return strconv.ParseUint(nonceString, 16, 64)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseNonce(t *testing.T) {
+	tests := []struct {
+		name          string
+		nonce         string
+		extranonce    string
+		expectedNonce uint64
+		expectedError bool
+	}{
+		{name: "suffix only", nonce: "000000000001", extranonce: "abcd", expectedNonce: 0xabcd000000000001},
+		{name: "full nonce", nonce: "0xabcd000000000002", extranonce: "abcd", expectedNonce: 0xabcd000000000002},
+		{name: "no extranonce", nonce: "ffffffffffffffff", extranonce: "", expectedNonce: math.MaxUint64},
+		{name: "foreign extranonce", nonce: "abce000000000002", extranonce: "abcd", expectedError: true},
+		{name: "wrong length", nonce: "0001", extranonce: "abcd", expectedError: true},
+		{name: "not hex", nonce: "zz0000000000", extranonce: "abcd", expectedError: true},
+	}
+
+	for _, test := range tests {
+		nonce, err := parseNonce(test.nonce, test.extranonce)
+		if test.expectedError {
+			if err == nil {
+				t.Errorf("%s: expected an error but got nonce %x", test.name, nonce)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if nonce != test.expectedNonce {
+			t.Errorf("%s: expected nonce %x but got %x", test.name, test.expectedNonce, nonce)
+		}
+	}
+}
+
+func TestExtranonceHex(t *testing.T) {
+	if extranonceHex(0x1, 2) != "0001" {
+		t.Errorf("unexpected extranonce %s", extranonceHex(0x1, 2))
+	}
+	if extranonceHex(0xabcdef, 3) != "abcdef" {
+		t.Errorf("unexpected extranonce %s", extranonceHex(0xabcdef, 3))
+	}
+	if extranonceHex(0x5, 0) != "" {
+		t.Errorf("unexpected extranonce %s", extranonceHex(0x5, 0))
+	}
+}
+
+func TestDifficultyToTarget(t *testing.T) {
+	if difficultyToTarget(1).Cmp(diff1Target) != 0 {
+		t.Fatalf("difficulty 1 does not correspond to diff1Target")
+	}
+	for _, difficulty := range []float64{0.001, 1, 16, 4096.5} {
+		roundTrip := targetToDifficulty(difficultyToTarget(difficulty))
+		if math.Abs(roundTrip-difficulty)/difficulty > 1e-9 {
+			t.Errorf("difficulty %g round-tripped to %g", difficulty, roundTrip)
+		}
+	}
+	if difficultyToTarget(1e-30).Cmp(maxTarget) != 0 {
+		t.Errorf("tiny difficulties should be capped at maxTarget")
+	}
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// maxRequestSize is the maximum size of a single stratum request in bytes
+	maxRequestSize = 16 * 1024
+
+	writeTimeout = 10 * time.Second
+)
+
+// stratumConnection is a single worker connection
+type stratumConnection struct {
+	server        *stratumServer
+	conn          net.Conn
+	remoteAddress string
+	extranonce    string
+
+	writeLock sync.Mutex
+
+	stateLock    sync.Mutex
+	isSubscribed bool
+	payAddress   string
+	workerName   string
+}
+
+func newStratumConnection(server *stratumServer, conn net.Conn, extranonce string) *stratumConnection {
+	return &stratumConnection{
+		server:        server,
+		conn:          conn,
+		remoteAddress: conn.RemoteAddr().String(),
+		extranonce:    extranonce,
+	}
+}
+
+// handle reads and handles requests until the connection is closed
+func (c *stratumConnection) handle() {
+	defer c.close()
+
+	scanner := bufio.NewScanner(c.conn)
+	scanner.Buffer(make([]byte, 0, 1024), maxRequestSize)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		request := &stratumRequest{}
+		err := json.Unmarshal([]byte(line), request)
+		if err != nil {
+			log.Warnf("Malformed stratum request from %s: %s", c.remoteAddress, err)
+			return
+		}
+
+		err = c.handleRequest(request)
+		if err != nil {
+			log.Warnf("Error handling %s from %s: %s", request.Method, c.remoteAddress, err)
+			return
+		}
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, net.ErrClosed) {
+		log.Debugf("Error reading from %s: %s", c.remoteAddress, err)
+	}
+}
+
+func (c *stratumConnection) close() {
+	_ = c.conn.Close()
+	c.server.removeConnection(c)
+
+	c.stateLock.Lock()
+	payAddress := c.payAddress
+	c.payAddress = ""
+	c.stateLock.Unlock()
+	if payAddress != "" {
+		c.server.jobManager.removeWorker(payAddress)
+	}
+}
+
+// handleRequest handles a single request. Errors caused by the worker are reported
+// back to it; only errors that warrant closing the connection are returned.
+func (c *stratumConnection) handleRequest(request *stratumRequest) error {
+	switch request.Method {
+	case methodSubscribe:
+		return c.handleSubscribe(request)
+	case methodExtranonceSubscribe:
+		return c.respond(request, true)
+	case methodAuthorize:
+		return c.handleAuthorize(request)
+	case methodSubmit:
+		return c.handleSubmit(request)
+	default:
+		log.Debugf("Unsupported stratum method %s from %s", request.Method, c.remoteAddress)
+		return c.respondError(request, newOtherError("unsupported method "+request.Method))
+	}
+}
+
+func (c *stratumConnection) handleSubscribe(request *stratumRequest) error {
+	c.stateLock.Lock()
+	c.isSubscribed = true
+	c.stateLock.Unlock()
+
+	err := c.respond(request, []interface{}{true, stratumProtocolVersion})
+	if err != nil {
+		return err
+	}
+	return c.notify(methodSetExtranonce, c.extranonce, 8-c.server.cfg.ExtranonceSize)
+}
+
+// handleAuthorize authorizes the worker. Usernames are in the form
+// <address>.<worker name>. Workers whose username is not an address mine to
+// --miningaddr, and the username is used as their worker name.
+func (c *stratumConnection) handleAuthorize(request *stratumRequest) error {
+	username, err := request.stringParam(0)
+	if err != nil {
+		return c.respondError(request, newOtherError(err.Error()))
+	}
+
+	c.stateLock.Lock()
+	isSubscribed, isAuthorized := c.isSubscribed, c.payAddress != ""
+	c.stateLock.Unlock()
+	if !isSubscribed {
+		return c.respondError(request, errNotSubscribed)
+	}
+	if isAuthorized {
+		return c.respond(request, true)
+	}
+
+	payAddress, workerName, err := c.parseUsername(username)
+	if err != nil {
+		log.Infof("Rejected worker %s from %s: %s", username, c.remoteAddress, err)
+		return c.respondError(request, errUnauthorized)
+	}
+
+	err = c.respond(request, true)
+	if err != nil {
+		return err
+	}
+	err = c.notify(methodSetDifficulty, c.server.cfg.ShareDifficulty)
+	if err != nil {
+		return err
+	}
+
+	c.stateLock.Lock()
+	c.payAddress = payAddress
+	c.workerName = workerName
+	c.stateLock.Unlock()
+	c.server.jobManager.addWorker(payAddress)
+	log.Infof("Authorized worker %s from %s, mining to %s", workerName, c.remoteAddress, payAddress)
+
+	job := c.server.jobManager.latestJob(payAddress)
+	if job == nil {
+		// refreshJob sends the job to this connection as well if it is a new one
+		c.server.refreshJob(payAddress)
+		return nil
+	}
+	c.sendJob(job)
+	return nil
+}
+
+func (c *stratumConnection) parseUsername(username string) (payAddress string, workerName string, err error) {
+	cfg := c.server.cfg
+
+	addressPart, workerPart := username, ""
+	if separatorIndex := strings.LastIndex(username, "."); separatorIndex >= 0 {
+		addressPart, workerPart = username[:separatorIndex], username[separatorIndex+1:]
+	}
+	if !strings.HasPrefix(addressPart, cfg.ActiveNetParams.Prefix.String()+":") {
+		addressPart = cfg.ActiveNetParams.Prefix.String() + ":" + addressPart
+	}
+	address, err := util.DecodeAddress(addressPart, cfg.ActiveNetParams.Prefix)
+	if err == nil {
+		if workerPart == "" {
+			workerPart = "default"
+		}
+		return address.EncodeAddress(), workerPart, nil
+	}
+
+	if cfg.MiningAddr == "" {
+		return "", "", errors.Errorf("username is not an address and --miningaddr is not set")
+	}
+	if username == "" {
+		username = "default"
+	}
+	return cfg.MiningAddr, username, nil
+}
+
+func (c *stratumConnection) handleSubmit(request *stratumRequest) error {
+	c.stateLock.Lock()
+	payAddress, workerName := c.payAddress, c.workerName
+	c.stateLock.Unlock()
+	if payAddress == "" {
+		return c.respondError(request, errUnauthorized)
+	}
+
+	jobID, err := request.stringParam(1)
+	if err != nil {
+		return c.respondError(request, newOtherError(err.Error()))
+	}
+	nonceString, err := request.stringParam(2)
+	if err != nil {
+		return c.respondError(request, newOtherError(err.Error()))
+	}
+
+	stats := c.server.workerStats
+	job := c.server.jobManager.job(jobID)
+	if job == nil || job.payAddress != payAddress {
+		stats.addStaleShare(workerName)
+		return c.respondError(request, errStaleJob)
+	}
+
+	nonce, err := parseNonce(nonceString, c.extranonce)
+	if err != nil {
+		stats.addInvalidShare(workerName)
+		return c.respondError(request, newOtherError(err.Error()))
+	}
+	if !job.markSubmitted(nonce) {
+		stats.addInvalidShare(workerName)
+		return c.respondError(request, errDuplicateShare)
+	}
+
+	// The block target is checked first, since it may be easier than the share
+	// target, and a solved block must never be dropped as a low difficulty share
+	powNum, powHash := job.calculatePoW(nonce)
+	isBlock := powNum.Cmp(job.blockTarget()) <= 0
+	if !isBlock && powNum.Cmp(c.server.shareTarget) > 0 {
+		stats.addInvalidShare(workerName)
+		return c.respondError(request, errLowDifficulty)
+	}
+	stats.addAcceptedShare(workerName, c.server.cfg.ShareDifficulty)
+
+	if isBlock {
+		c.submitBlock(job, nonce, powHash, workerName)
+	}
+
+	return c.respond(request, true)
+}
+
+func (c *stratumConnection) submitBlock(job *job, nonce uint64, powHash *externalapi.DomainHash, workerName string) {
+	block := job.solvedBlock(nonce, powHash)
+	blockHash := consensushashing.BlockHash(block)
+	log.Infof("Worker %s found block %s with PoW hash %s", workerName, blockHash, block.PoWHash)
+
+	rejectReason, err := c.server.client.submitBlock(block)
+	if err != nil {
+		if rejectReason != appmessage.RejectReasonNone {
+			log.Warnf("Block %s was rejected (%s): %s", blockHash, rejectReason, err)
+			return
+		}
+		log.Warnf("Error submitting block %s: %s", blockHash, err)
+		return
+	}
+	c.server.workerStats.addBlock(workerName)
+	log.Infof("Block %s was accepted", blockHash)
+}
+
+// authorizedPayAddress returns the pay address of the connection, or an empty
+// string if the worker is not authorized yet
+func (c *stratumConnection) authorizedPayAddress() string {
+	c.stateLock.Lock()
+	defer c.stateLock.Unlock()
+
+	return c.payAddress
+}
+
+func (c *stratumConnection) sendJob(job *job) {
+	err := c.notify(methodNotify, job.notifyParams(c.server.cfg.JobFormat)...)
+	if err != nil {
+		log.Debugf("Error sending job %s to %s: %s", job.id, c.remoteAddress, err)
+		_ = c.conn.Close()
+	}
+}
+
+func (c *stratumConnection) respond(request *stratumRequest, result interface{}) error {
+	return c.write(&stratumResponse{ID: request.ID, Result: result})
+}
+
+func (c *stratumConnection) respondError(request *stratumRequest, stratumErr *stratumError) error {
+	return c.write(&stratumResponse{ID: request.ID, Result: nil, Error: stratumErr.toJSON()})
+}
+
+func (c *stratumConnection) notify(method string, params ...interface{}) error {
+	return c.write(&stratumNotification{Method: method, Params: params})
+}
+
+func (c *stratumConnection) write(message interface{}) error {
+	serialized, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	err = c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err != nil {
+		return err
+	}
+	_, err = c.conn.Write(append(serialized, '\n'))
+	return err
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"math/big"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// jobRefreshInterval is how often the bridge polls for new templates in case
+// a new-block-template notification was missed
+const jobRefreshInterval = 500 * time.Millisecond
+
+// stratumServer accepts stratum connections and distributes jobs to them
+type stratumServer struct {
+	cfg         *configFlags
+	client      *nodeClient
+	jobManager  *jobManager
+	workerStats *workerStats
+	shareTarget *big.Int
+
+	listener net.Listener
+
+	connectionsLock sync.RWMutex
+	connections     map[*stratumConnection]struct{}
+	nextExtranonce  uint32
+}
+
+func newStratumServer(cfg *configFlags, client *nodeClient) *stratumServer {
+	return &stratumServer{
+		cfg:         cfg,
+		client:      client,
+		jobManager:  newJobManager(),
+		workerStats: newWorkerStats(),
+		shareTarget: difficultyToTarget(cfg.ShareDifficulty),
+		connections: make(map[*stratumConnection]struct{}),
+	}
+}
+
+// start starts listening for stratum connections and spawns the bridge's loops
+func (s *stratumServer) start() error {
+	listener, err := net.Listen("tcp", s.cfg.Listen)
+	if err != nil {
+		return errors.Wrapf(err, "error listening on %s", s.cfg.Listen)
+	}
+	s.listener = listener
+	log.Infof("Listening for stratum connections on %s with share difficulty %g",
+		listener.Addr(), s.cfg.ShareDifficulty)
+
+	spawn("acceptLoop", s.acceptLoop)
+	spawn("jobsLoop", s.jobsLoop)
+	if s.cfg.StatsInterval > 0 {
+		spawn("statsLoop", s.statsLoop)
+	}
+	return nil
+}
+
+func (s *stratumServer) stop() error {
+	return s.listener.Close()
+}
+
+func (s *stratumServer) acceptLoop() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			log.Warnf("Error accepting stratum connection: %s", err)
+			continue
+		}
+
+		connection := s.addConnection(conn)
+		spawn("stratumConnection.handle", connection.handle)
+	}
+}
+
+func (s *stratumServer) addConnection(conn net.Conn) *stratumConnection {
+	s.connectionsLock.Lock()
+	defer s.connectionsLock.Unlock()
+
+	// Extranonces wrap around once all 2^(8*ExtranonceSize) values are taken. Workers that
+	// share an extranonce also share their nonce range, which wastes some of their work
+	// on duplicate shares, but is otherwise harmless.
+	extranonce := s.nextExtranonce
+	s.nextExtranonce = (s.nextExtranonce + 1) % (1 << (8 * s.cfg.ExtranonceSize))
+
+	connection := newStratumConnection(s, conn, extranonceHex(extranonce, s.cfg.ExtranonceSize))
+	s.connections[connection] = struct{}{}
+	log.Infof("Stratum connection from %s, extranonce '%s'", connection.remoteAddress, connection.extranonce)
+	return connection
+}
+
+func (s *stratumServer) removeConnection(connection *stratumConnection) {
+	s.connectionsLock.Lock()
+	defer s.connectionsLock.Unlock()
+
+	delete(s.connections, connection)
+	log.Infof("Stratum connection from %s closed", connection.remoteAddress)
+}
+
+// jobsLoop fetches a new template for every pay address whenever the node
+// notifies that a new template is available
+func (s *stratumServer) jobsLoop() {
+	ticker := time.NewTicker(jobRefreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.client.newBlockTemplateNotificationChan:
+		case <-ticker.C:
+		}
+		for _, payAddress := range s.jobManager.payAddresses() {
+			s.refreshJob(payAddress)
+		}
+	}
+}
+
+// refreshJob fetches a template for the given pay address, and sends it to the
+// relevant workers if it results in a new job. It returns the latest job for
+// the pay address, or nil if there is none.
+func (s *stratumServer) refreshJob(payAddress string) *job {
+	template, err := s.client.getBlockTemplate(payAddress)
+	if err != nil {
+		log.Warnf("Error getting block template for %s: %s", payAddress, err)
+		return s.jobManager.latestJob(payAddress)
+	}
+	if !template.IsSynced && !s.cfg.MineWhenNotSynced {
+		log.Warnf("HTND is not synced. Skipping current block template")
+		return nil
+	}
+
+	job, isNew, err := s.jobManager.addTemplate(payAddress, template)
+	if err != nil {
+		log.Warnf("Error creating a job out of the block template for %s: %s", payAddress, err)
+		return s.jobManager.latestJob(payAddress)
+	}
+	if isNew {
+		log.Debugf("New job %s for %s", job.id, payAddress)
+		s.broadcastJob(job)
+	}
+	return job
+}
+
+// broadcastJob sends the given job to all workers that mine to its pay address
+func (s *stratumServer) broadcastJob(job *job) {
+	s.connectionsLock.RLock()
+	defer s.connectionsLock.RUnlock()
+
+	for connection := range s.connections {
+		if connection.authorizedPayAddress() == job.payAddress {
+			connection.sendJob(job)
+		}
+	}
+}
+
+func (s *stratumServer) statsLoop() {
+	ticker := time.NewTicker(s.cfg.StatsInterval)
+	defer ticker.Stop()
+
+	for now := range ticker.C {
+		s.workerStats.log(now)
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// workerStat holds the statistics of a single worker
+type workerStat struct {
+	acceptedShares uint64
+	staleShares    uint64
+	invalidShares  uint64
+	blocksFound    uint64
+
+	// windowDifficulty is the sum of the difficulties of the shares
+	// accepted since windowStart, and is used to estimate the hashrate
+	windowDifficulty float64
+	windowStart      time.Time
+}
+
+// workerStats tracks share statistics and hashrate per worker
+type workerStats struct {
+	lock    sync.Mutex
+	workers map[string]*workerStat
+}
+
+func newWorkerStats() *workerStats {
+	return &workerStats{workers: make(map[string]*workerStat)}
+}
+
+func (ws *workerStats) worker(workerName string) *workerStat {
+	stat, ok := ws.workers[workerName]
+	if !ok {
+		stat = &workerStat{windowStart: time.Now()}
+		ws.workers[workerName] = stat
+	}
+	return stat
+}
+
+func (ws *workerStats) addAcceptedShare(workerName string, difficulty float64) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	stat := ws.worker(workerName)
+	stat.acceptedShares++
+	stat.windowDifficulty += difficulty
+}
+
+func (ws *workerStats) addStaleShare(workerName string) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	ws.worker(workerName).staleShares++
+}
+
+func (ws *workerStats) addInvalidShare(workerName string) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	ws.worker(workerName).invalidShares++
+}
+
+func (ws *workerStats) addBlock(workerName string) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	ws.worker(workerName).blocksFound++
+}
+
+// log logs the statistics of every worker and starts a new hashrate window
+func (ws *workerStats) log(now time.Time) {
+	ws.lock.Lock()
+	defer ws.lock.Unlock()
+
+	workerNames := make([]string, 0, len(ws.workers))
+	for workerName := range ws.workers {
+		workerNames = append(workerNames, workerName)
+	}
+	sort.Strings(workerNames)
+
+	totalHashrate := 0.0
+	for _, workerName := range workerNames {
+		stat := ws.workers[workerName]
+		hashrate := estimateHashrate(stat.windowDifficulty, now.Sub(stat.windowStart))
+		totalHashrate += hashrate
+		log.Infof("Worker %s: %s, accepted/stale/invalid shares: %d/%d/%d, blocks: %d", workerName,
+			formatHashrate(hashrate), stat.acceptedShares, stat.staleShares, stat.invalidShares, stat.blocksFound)
+
+		stat.windowDifficulty = 0
+		stat.windowStart = now
+	}
+	if len(workerNames) > 0 {
+		log.Infof("Total hashrate of %d workers: %s", len(workerNames), formatHashrate(totalHashrate))
+	}
+}
+
+// estimateHashrate estimates the hashrate that is expected to find shares
+// with the given total difficulty within the given duration
+func estimateHashrate(totalDifficulty float64, duration time.Duration) float64 {
+	if duration <= 0 {
+		return 0
+	}
+	return totalDifficulty * hashesPerDifficultyUnit / duration.Seconds()
+}
+
+func formatHashrate(hashrate float64) string {
+	units := []string{"H/s", "KH/s", "MH/s", "GH/s", "TH/s", "PH/s"}
+	unitIndex := 0
+	for hashrate >= 1000 && unitIndex < len(units)-1 {
+		hashrate /= 1000
+		unitIndex++
+	}
+	return fmt.Sprintf("%.2f %s", hashrate, units[unitIndex])
+}
@@ -29,6 +29,10 @@ func autoCompound(conf *autoCompoundConfig) error {
 		return errors.Wrap(err, "reading keys file")
 	}
 
+	if keysFile.IsWatchOnly() {
+		return keys.ErrWatchOnly
+	}
+
 	if len(keysFile.ExtendedPublicKeys) > len(keysFile.EncryptedMnemonics) {
 		return errors.New("multisig wallet detected but not all private keys present")
 	}
@@ -38,6 +38,10 @@ func balance(conf *balanceConfig) error {
 		print("                                                 ")
 	}
 	fmt.Printf("Total balance, HTN %s %s%s\n", utils.FomatHSAT(response.Available), utils.FomatHSAT(response.Pending), pendingSuffix)
+	if response.Locked > 0 || response.Unlocked > 0 {
+		fmt.Printf("Time locks and vaults, HTN %s locked, %s spendable with spend-locked-outputs\n",
+			utils.FomatHSAT(response.Locked), utils.FomatHSAT(response.Unlocked))
+	}
 
 	return nil
 }
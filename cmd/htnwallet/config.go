@@ -25,6 +25,25 @@ const (
 	startDaemonSubCmd               = "start-daemon"
 	versionSubCmd                   = "version"
 	getDaemonVersionSubCmd          = "get-daemon-version"
+	historySubCmd                   = "history"
+	labelTransactionSubCmd          = "label-transaction"
+	signMessageSubCmd               = "sign-message"
+	verifyMessageSubCmd             = "verify-message"
+	newTimeLockAddressSubCmd        = "new-timelock-address"
+	newVaultAddressSubCmd           = "new-vault-address"
+	showLockedOutputsSubCmd         = "show-locked-outputs"
+	spendLockedOutputsSubCmd        = "spend-locked-outputs"
+	htlcInitiateSubCmd              = "htlc-initiate"
+	htlcRedeemSubCmd                = "htlc-redeem"
+	htlcRefundSubCmd                = "htlc-refund"
+	htlcAuditSubCmd                 = "htlc-audit"
+	pstxSubCmd                      = "pstx"
+	pstxCombineSubCmd               = "combine"
+	pstxFinalizeSubCmd              = "finalize"
+	pstxInspectSubCmd               = "inspect"
+	listUTXOsSubCmd                 = "list-utxos"
+	lockUTXOsSubCmd                 = "lock-utxos"
+	unlockUTXOsSubCmd               = "unlock-utxos"
 )
 
 const (
@@ -38,14 +57,16 @@ type configFlags struct {
 }
 
 type createConfig struct {
-	KeysFile          string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
-	Password          string `long:"password" short:"p" description:"Wallet password"`
-	Yes               bool   `long:"yes" short:"y" description:"Assume \"yes\" to all questions"`
-	MinimumSignatures uint32 `long:"min-signatures" short:"m" description:"Minimum required signatures" default:"1"`
-	NumPrivateKeys    uint32 `long:"num-private-keys" short:"k" description:"Number of private keys" default:"1"`
-	NumPublicKeys     uint32 `long:"num-public-keys" short:"n" description:"Total number of keys" default:"1"`
-	ECDSA             bool   `long:"ecdsa" description:"Create an ECDSA wallet"`
-	Import            bool   `long:"import" short:"i" description:"Import private keys (as opposed to generating them)"`
+	KeysFile           string   `long:"keys-file" short:"f" description:"Keys file location (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
+	Password           string   `long:"password" short:"p" description:"Wallet password"`
+	Yes                bool     `long:"yes" short:"y" description:"Assume \"yes\" to all questions"`
+	MinimumSignatures  uint32   `long:"min-signatures" short:"m" description:"Minimum required signatures" default:"1"`
+	NumPrivateKeys     uint32   `long:"num-private-keys" short:"k" description:"Number of private keys" default:"1"`
+	NumPublicKeys      uint32   `long:"num-public-keys" short:"n" description:"Total number of keys" default:"1"`
+	ECDSA              bool     `long:"ecdsa" description:"Create an ECDSA wallet"`
+	Import             bool     `long:"import" short:"i" description:"Import private keys (as opposed to generating them)"`
+	WatchOnly          bool     `long:"watch-only" description:"Create a watch-only wallet out of extended public keys alone. It can show balances and addresses and create unsigned transactions, but not sign them"`
+	ExtendedPublicKeys []string `long:"xpub" description:"Extended public key of a cosigner, or of the wallet itself with --watch-only. Repeat multiple times (adding --xpub before each) for multisig wallets. Keys that are not given are asked for interactively"`
 	config.NetworkFlags
 }
 
@@ -59,10 +80,13 @@ type sendConfig struct {
 	KeysFile                 string   `long:"keys-file" short:"f" description:"Keys file location (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
 	Password                 string   `long:"password" short:"p" description:"Wallet password"`
 	DaemonAddress            string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
-	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Hoosat to" required:"true"`
+	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Hoosat to"`
 	FromAddresses            []string `long:"from-address" short:"a" description:"Specific public address to send Hoosat from. Repeat multiple times (adding -a before each) to accept several addresses" required:"false"`
+	UTXOs                    []string `long:"utxo" description:"A specific output to spend, as <transaction ID>:<index>, even if it's locked. Repeat multiple times (adding --utxo before each) to spend exactly the given outputs (mutually exclusive with --from-address)"`
 	SendAmount               string   `long:"send-amount" short:"v" description:"An amount to send in Hoosat (e.g. 1234.12345678)"`
-	IsSendAll                bool     `long:"send-all" description:"Send all the Hoosat in the wallet (mutually exclusive with --send-amount). If --from-address was used, will send all only from the specified addresses."`
+	IsSendAll                bool     `long:"send-all" description:"Send all the Hoosat in the wallet (mutually exclusive with --send-amount). If --from-address or --utxo was used, will send all only from the specified addresses or outputs."`
+	Outputs                  []string `long:"output" description:"A recipient and an amount in Hoosat to send to it, as <address>=<amount>. Repeat multiple times (adding --output before each) to pay several recipients at once (mutually exclusive with --to-address)"`
+	OutputsFile              string   `long:"outputs-file" description:"A CSV file of address,amount lines, or a JSON file (.json) of [{\"address\": ..., \"amount\": ...}] entries, with recipients and amounts in Hoosat to pay at once (mutually exclusive with --to-address)"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
 	Verbose                  bool     `long:"show-serialized" short:"s" description:"Show a list of hex encoded sent transactions"`
 	config.NetworkFlags
@@ -88,10 +112,13 @@ type sweepConfig struct {
 
 type createUnsignedTransactionConfig struct {
 	DaemonAddress            string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
-	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Hoosat to" required:"true"`
+	ToAddress                string   `long:"to-address" short:"t" description:"The public address to send Hoosat to"`
 	FromAddresses            []string `long:"from-address" short:"a" description:"Specific public address to send Hoosat from. Use multiple times to accept several addresses" required:"false"`
+	UTXOs                    []string `long:"utxo" description:"A specific output to spend, as <transaction ID>:<index>, even if it's locked. Use multiple times to spend exactly the given outputs (mutually exclusive with --from-address)"`
 	SendAmount               string   `long:"send-amount" short:"v" description:"An amount to send in Hoosat (e.g. 1234.12345678)"`
 	IsSendAll                bool     `long:"send-all" description:"Send all the Hoosat in the wallet (mutually exclusive with --send-amount)"`
+	Outputs                  []string `long:"output" description:"A recipient and an amount in Hoosat to send to it, as <address>=<amount>. Use multiple times to pay several recipients at once (mutually exclusive with --to-address)"`
+	OutputsFile              string   `long:"outputs-file" description:"A CSV file of address,amount lines, or a JSON file (.json) of [{\"address\": ..., \"amount\": ...}] entries, with recipients and amounts in Hoosat to pay at once (mutually exclusive with --to-address)"`
 	UseExistingChangeAddress bool     `long:"use-existing-change-address" short:"u" description:"Will use an existing change address (in case no change address was ever used, it will use a new one)"`
 	config.NetworkFlags
 }
@@ -123,11 +150,150 @@ type showAddressesConfig struct {
 	config.NetworkFlags
 }
 
+type historyConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	TransactionID string `long:"txid" description:"Show only the transaction with the given ID"`
+	Format        string `long:"format" description:"Output format: table, csv or json" default:"table"`
+	OutputFile    string `long:"output" short:"o" description:"Write the history to the given file instead of the standard output"`
+	Limit         uint32 `long:"limit" short:"n" description:"Show at most this number of transactions, newest first (0 for all)"`
+	Offset        uint32 `long:"offset" description:"Skip this number of newest transactions"`
+	config.NetworkFlags
+}
+
+type labelTransactionConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	TransactionID string `long:"txid" description:"The ID of the transaction to label" required:"true"`
+	Label         string `long:"label" short:"l" description:"The label to set. An empty label removes the existing one"`
+	config.NetworkFlags
+}
+
+type signMessageConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Password      string `long:"password" short:"p" description:"Wallet password"`
+	Address       string `long:"address" short:"a" description:"The wallet address whose key signs the message" required:"true"`
+	Message       string `long:"message" short:"m" description:"The message to sign" required:"true"`
+	config.NetworkFlags
+}
+
+type verifyMessageConfig struct {
+	Address   string `long:"address" short:"a" description:"The address that allegedly signed the message" required:"true"`
+	Message   string `long:"message" short:"m" description:"The signed message" required:"true"`
+	Signature string `long:"signature" short:"s" description:"The signature to verify (encoded in hex)" required:"true"`
+	config.NetworkFlags
+}
+
 type newAddressConfig struct {
 	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
 	config.NetworkFlags
 }
 
+type newTimeLockAddressConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	LockDAAScore  uint64 `long:"lock-daa-score" description:"The DAA score the funds sent to the address are locked until (mutually exclusive with --lock-time)"`
+	LockTime      string `long:"lock-time" description:"The time the funds sent to the address are locked until, in RFC3339 format (e.g. 2027-01-01T00:00:00Z) (mutually exclusive with --lock-daa-score)"`
+	config.NetworkFlags
+}
+
+type newVaultAddressConfig struct {
+	DaemonAddress             string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Delay                     uint64 `long:"delay" description:"The number of DAA scores the funds sent to the address are locked for after they are received" required:"true"`
+	RecoveryExtendedPublicKey string `long:"recovery-xpub" description:"The extended public key of the recovery wallet, which may spend the funds at any time" required:"true"`
+	config.NetworkFlags
+}
+
+type showLockedOutputsConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	config.NetworkFlags
+}
+
+type listUTXOsConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	FromAddresses []string `long:"from-address" short:"a" description:"Show only the outputs of this address. Repeat multiple times (adding -a before each) to show several addresses"`
+	config.NetworkFlags
+}
+
+type lockUTXOsConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	UTXOs         []string `long:"utxo" description:"The output to lock, as <transaction ID>:<index>. Repeat multiple times (adding --utxo before each) to lock several outputs" required:"true"`
+	config.NetworkFlags
+}
+
+type unlockUTXOsConfig struct {
+	DaemonAddress string   `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	UTXOs         []string `long:"utxo" description:"The output to unlock, as <transaction ID>:<index>. Repeat multiple times (adding --utxo before each) to unlock several outputs" required:"true"`
+	config.NetworkFlags
+}
+
+type spendLockedOutputsConfig struct {
+	KeysFile      string `long:"keys-file" short:"f" description:"Keys file location, of the recovery wallet with --recovery (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
+	Password      string `long:"password" short:"p" description:"Wallet password"`
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Address       string `long:"address" short:"a" description:"The time lock or vault address whose outputs to spend" required:"true"`
+	ToAddress     string `long:"to-address" short:"t" description:"The public address to send the funds to (default: a new change address of the wallet)"`
+	IsRecovery    bool   `long:"recovery" description:"Spend the outputs of a vault with its recovery key, regardless of its delay"`
+	config.NetworkFlags
+}
+
+type htlcInitiateConfig struct {
+	KeysFile      string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
+	Password      string `long:"password" short:"p" description:"Wallet password"`
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	ToAddress     string `long:"to-address" short:"t" description:"The pay-to-pubkey address of the counterparty, which may redeem the contract with the secret" required:"true"`
+	SendAmount    string `long:"send-amount" short:"v" description:"An amount to lock in the contract in Hoosat (e.g. 1234.12345678)" required:"true"`
+	SecretHash    string `long:"secret-hash" description:"The SHA256 hash of the secret of the counterparty's contract (encoded in hex), to participate in a swap it initiated. A new secret is generated if it's not given"`
+	LockDAAScore  uint64 `long:"lock-daa-score" description:"The DAA score from which the contract may be refunded (mutually exclusive with --lock-time)"`
+	LockTime      string `long:"lock-time" description:"The time from which the contract may be refunded, in RFC3339 format (e.g. 2027-01-01T00:00:00Z) (mutually exclusive with --lock-daa-score)"`
+	config.NetworkFlags
+}
+
+type htlcRedeemConfig struct {
+	KeysFile      string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
+	Password      string `long:"password" short:"p" description:"Wallet password"`
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Contract      string `long:"contract" short:"c" description:"The contract to redeem (encoded in hex)" required:"true"`
+	Secret        string `long:"secret" short:"s" description:"The secret that redeems the contract (encoded in hex)" required:"true"`
+	ToAddress     string `long:"to-address" short:"t" description:"The public address to send the funds to (default: a new change address of the wallet)"`
+	config.NetworkFlags
+}
+
+type htlcRefundConfig struct {
+	KeysFile      string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
+	Password      string `long:"password" short:"p" description:"Wallet password"`
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Contract      string `long:"contract" short:"c" description:"The contract to refund (encoded in hex)" required:"true"`
+	ToAddress     string `long:"to-address" short:"t" description:"The public address to send the funds to (default: a new change address of the wallet)"`
+	config.NetworkFlags
+}
+
+type htlcAuditConfig struct {
+	DaemonAddress string `long:"daemonaddress" short:"d" description:"Wallet daemon server to connect to"`
+	Contract      string `long:"contract" short:"c" description:"The contract to audit (encoded in hex)" required:"true"`
+	config.NetworkFlags
+}
+
+type pstxConfig struct{}
+
+type pstxCombineConfig struct {
+	KeysFile         string   `long:"keys-file" short:"f" description:"Keys file location, only read for transactions that aren't PSTXs (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
+	Transactions     []string `long:"transaction" short:"t" description:"A copy of the partially signed transaction(s) to combine (encoded in hex). Pass once per copy"`
+	TransactionFiles []string `long:"transaction-file" short:"F" description:"A file containing a copy of the partially signed transaction(s) to combine (encoded in hex). Pass once per copy"`
+	config.NetworkFlags
+}
+
+type pstxFinalizeConfig struct {
+	KeysFile        string `long:"keys-file" short:"f" description:"Keys file location, only read for transactions that aren't PSTXs (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
+	Transaction     string `long:"transaction" short:"t" description:"The signed transaction(s) to finalize (encoded in hex)"`
+	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the signed transaction(s) to finalize (encoded in hex)"`
+	config.NetworkFlags
+}
+
+type pstxInspectConfig struct {
+	KeysFile        string `long:"keys-file" short:"f" description:"Keys file location, only read for transactions that aren't PSTXs (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
+	Transaction     string `long:"transaction" short:"t" description:"The transaction(s) to inspect (encoded in hex)"`
+	TransactionFile string `long:"transaction-file" short:"F" description:"The file containing the transaction(s) to inspect (encoded in hex)"`
+	config.NetworkFlags
+}
+
 type startDaemonConfig struct {
 	KeysFile  string `long:"keys-file" short:"f" description:"Keys file location (default: ~/.htnwallet/keys.json (*nix), %USERPROFILE%\\AppData\\Local\\Hoosatwallet\\key.json (Windows))"`
 	Password  string `long:"password" short:"p" description:"Wallet password"`
@@ -203,6 +369,98 @@ func parseCommandLine() (subCommand string, config interface{}) {
 	_, _ = parser.AddCommand(newAddressSubCmd, "Generates new public address of the current wallet and shows it",
 		"Generates new public address of the current wallet and shows it", newAddressConf)
 
+	historyConf := &historyConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(historySubCmd, "Shows the transaction history of the wallet",
+		"Shows the incoming and outgoing transactions of the wallet recorded by the daemon, newest first. "+
+			"Use `--format=csv` or `--format=json` together with `--output` to export it. "+
+			"Incoming transactions show up once they are accepted, and transactions that were accepted "+
+			"before the daemon first synced the wallet are only partially known.", historyConf)
+
+	labelTransactionConf := &labelTransactionConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(labelTransactionSubCmd, "Sets a label on a transaction in the wallet history",
+		"Sets a label on a transaction in the wallet history", labelTransactionConf)
+
+	signMessageConf := &signMessageConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(signMessageSubCmd, "Signs a message with the key of one of the wallet addresses",
+		"Signs a message with the key of one of the wallet addresses, to prove that the wallet owns the address. "+
+			"Prints the signature encoded in hex", signMessageConf)
+
+	verifyMessageConf := &verifyMessageConfig{}
+	_, _ = parser.AddCommand(verifyMessageSubCmd, "Verifies a message signature made by sign-message",
+		"Verifies that the given signature on the given message was made by the owner of the given address", verifyMessageConf)
+
+	newTimeLockAddressConf := &newTimeLockAddressConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(newTimeLockAddressSubCmd, "Generates a new time lock address of the current wallet and shows it",
+		"Generates a new pay-to-script-hash address whose funds can only be spent by the wallet once the given DAA score "+
+			"or time is reached. Fund it with `send`, and spend it with `spend-locked-outputs`", newTimeLockAddressConf)
+
+	newVaultAddressConf := &newVaultAddressConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(newVaultAddressSubCmd, "Generates a new vault address of the current wallet and shows it",
+		"Generates a new pay-to-script-hash address whose funds can be spent by the wallet only once they are `--delay` "+
+			"DAA scores old, and by the recovery wallet at any time. Fund it with `send`, and spend it with "+
+			"`spend-locked-outputs`", newVaultAddressConf)
+
+	showLockedOutputsConf := &showLockedOutputsConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(showLockedOutputsSubCmd, "Shows the outputs of the time lock and vault addresses of the current wallet",
+		"Shows the outputs of the time lock and vault addresses of the current wallet, and when they unlock", showLockedOutputsConf)
+
+	spendLockedOutputsConf := &spendLockedOutputsConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(spendLockedOutputsSubCmd, "Sends the unlocked outputs of a time lock or vault address",
+		"Sends the unlocked outputs of a time lock or vault address to the given address. With `--recovery`, sends all the "+
+			"outputs of a vault address and signs them with the keys file of the recovery wallet", spendLockedOutputsConf)
+
+	htlcInitiateConf := &htlcInitiateConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(htlcInitiateSubCmd, "Creates and funds a hash time-locked contract for an atomic swap",
+		"Creates a hash time-locked contract that the given address may redeem with a secret, and that the wallet "+
+			"may refund once the lock time is reached, and sends the given amount to it. Generates a new secret "+
+			"unless `--secret-hash` is given to participate in a swap initiated by the counterparty", htlcInitiateConf)
+
+	htlcRedeemConf := &htlcRedeemConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(htlcRedeemSubCmd, "Redeems a hash time-locked contract with its secret",
+		"Sends the funds of a hash time-locked contract whose recipient is the current wallet, using its secret. "+
+			"Redeeming reveals the secret to the counterparty", htlcRedeemConf)
+
+	htlcRefundConf := &htlcRefundConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(htlcRefundSubCmd, "Refunds a hash time-locked contract after its lock time",
+		"Sends the funds of a hash time-locked contract that the current wallet created back to the wallet, "+
+			"once its lock time is reached", htlcRefundConf)
+
+	htlcAuditConf := &htlcAuditConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(htlcAuditSubCmd, "Shows the terms and the funds of a hash time-locked contract",
+		"Shows the terms and the funds of a hash time-locked contract, to verify the contract of the counterparty "+
+			"before participating in or redeeming a swap", htlcAuditConf)
+
+	listUTXOsConf := &listUTXOsConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(listUTXOsSubCmd, "Shows the unspent outputs of the current wallet",
+		"Shows the unspent outputs of the current wallet with their outpoints, amounts and ages in DAA scores, "+
+			"and whether they are locked by `lock-utxos`", listUTXOsConf)
+
+	lockUTXOsConf := &lockUTXOsConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(lockUTXOsSubCmd, "Locks outputs of the current wallet so that they are never selected automatically",
+		"Locks the given outputs of the current wallet, so that `send`, `create-unsigned-transaction` and compounding "+
+			"never select them automatically. Locked outputs are only spent when given with `--utxo`. The locks are "+
+			"saved in the keys file", lockUTXOsConf)
+
+	unlockUTXOsConf := &unlockUTXOsConfig{DaemonAddress: defaultListen}
+	_, _ = parser.AddCommand(unlockUTXOsSubCmd, "Unlocks outputs that were locked by lock-utxos",
+		"Unlocks outputs that were locked by `lock-utxos`, so that they may be selected automatically again", unlockUTXOsConf)
+
+	pstxCommand, _ := parser.AddCommand(pstxSubCmd, "Works on transactions in the PSTX interchange format",
+		"Combines, finalizes and inspects partially signed transactions in the PSTX interchange format, "+
+			"which third-party signers and multisig coordinators can read and write", &pstxConfig{})
+	pstxCombineConf := &pstxCombineConfig{}
+	_, _ = pstxCommand.AddCommand(pstxCombineSubCmd, "Combines the signatures of several copies of a transaction",
+		"Combines the signatures of several copies of a partially signed transaction into a single PSTX. "+
+			"A single copy in the wallet's own format is converted to a PSTX", pstxCombineConf)
+	pstxFinalizeConf := &pstxFinalizeConfig{}
+	_, _ = pstxCommand.AddCommand(pstxFinalizeSubCmd, "Finalizes a fully signed transaction",
+		"Builds the signature scripts of a fully signed transaction, and prints it as a finalized PSTX "+
+			"that can be broadcast", pstxFinalizeConf)
+	pstxInspectConf := &pstxInspectConfig{}
+	_, _ = pstxCommand.AddCommand(pstxInspectSubCmd, "Prints the contents of a partially signed transaction",
+		"Prints the global, input and output maps of a partially signed transaction, and which signatures "+
+			"it's still missing", pstxInspectConf)
+
 	dumpUnencryptedDataConf := &dumpUnencryptedDataConfig{}
 	_, _ = parser.AddCommand(dumpUnencryptedDataSubCmd, "Prints the unencrypted wallet data",
 		"Prints the unencrypted wallet data including its private keys. Anyone that sees it can access "+
@@ -228,13 +486,18 @@ func parseCommandLine() (subCommand string, config interface{}) {
 		return "", nil
 	}
 
-	switch parser.Command.Active.Name {
+	subCmd := parser.Command.Active.Name
+	switch subCmd {
 	case createSubCmd:
 		combineNetworkFlags(&createConf.NetworkFlags, &cfg.NetworkFlags)
 		err := createConf.ResolveNetwork(parser)
 		if err != nil {
 			printErrorAndExit(err)
 		}
+		err = validateCreateConfig(createConf)
+		if err != nil {
+			printErrorAndExit(err)
+		}
 		config = createConf
 	case balanceSubCmd:
 		combineNetworkFlags(&balanceConf.NetworkFlags, &cfg.NetworkFlags)
@@ -314,6 +577,148 @@ func parseCommandLine() (subCommand string, config interface{}) {
 			printErrorAndExit(err)
 		}
 		config = newAddressConf
+	case historySubCmd:
+		combineNetworkFlags(&historyConf.NetworkFlags, &cfg.NetworkFlags)
+		err := historyConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateHistoryConfig(historyConf)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = historyConf
+	case labelTransactionSubCmd:
+		combineNetworkFlags(&labelTransactionConf.NetworkFlags, &cfg.NetworkFlags)
+		err := labelTransactionConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = labelTransactionConf
+	case signMessageSubCmd:
+		combineNetworkFlags(&signMessageConf.NetworkFlags, &cfg.NetworkFlags)
+		err := signMessageConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = signMessageConf
+	case verifyMessageSubCmd:
+		combineNetworkFlags(&verifyMessageConf.NetworkFlags, &cfg.NetworkFlags)
+		err := verifyMessageConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = verifyMessageConf
+	case newTimeLockAddressSubCmd:
+		combineNetworkFlags(&newTimeLockAddressConf.NetworkFlags, &cfg.NetworkFlags)
+		err := newTimeLockAddressConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateNewTimeLockAddressConfig(newTimeLockAddressConf)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = newTimeLockAddressConf
+	case newVaultAddressSubCmd:
+		combineNetworkFlags(&newVaultAddressConf.NetworkFlags, &cfg.NetworkFlags)
+		err := newVaultAddressConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = newVaultAddressConf
+	case showLockedOutputsSubCmd:
+		combineNetworkFlags(&showLockedOutputsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := showLockedOutputsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = showLockedOutputsConf
+	case spendLockedOutputsSubCmd:
+		combineNetworkFlags(&spendLockedOutputsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := spendLockedOutputsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = spendLockedOutputsConf
+	case htlcInitiateSubCmd:
+		combineNetworkFlags(&htlcInitiateConf.NetworkFlags, &cfg.NetworkFlags)
+		err := htlcInitiateConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		err = validateHTLCInitiateConfig(htlcInitiateConf)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = htlcInitiateConf
+	case htlcRedeemSubCmd:
+		combineNetworkFlags(&htlcRedeemConf.NetworkFlags, &cfg.NetworkFlags)
+		err := htlcRedeemConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = htlcRedeemConf
+	case htlcRefundSubCmd:
+		combineNetworkFlags(&htlcRefundConf.NetworkFlags, &cfg.NetworkFlags)
+		err := htlcRefundConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = htlcRefundConf
+	case htlcAuditSubCmd:
+		combineNetworkFlags(&htlcAuditConf.NetworkFlags, &cfg.NetworkFlags)
+		err := htlcAuditConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = htlcAuditConf
+	case listUTXOsSubCmd:
+		combineNetworkFlags(&listUTXOsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := listUTXOsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = listUTXOsConf
+	case lockUTXOsSubCmd:
+		combineNetworkFlags(&lockUTXOsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := lockUTXOsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = lockUTXOsConf
+	case unlockUTXOsSubCmd:
+		combineNetworkFlags(&unlockUTXOsConf.NetworkFlags, &cfg.NetworkFlags)
+		err := unlockUTXOsConf.ResolveNetwork(parser)
+		if err != nil {
+			printErrorAndExit(err)
+		}
+		config = unlockUTXOsConf
+	case pstxSubCmd:
+		subCmd = parser.Command.Active.Active.Name
+		switch subCmd {
+		case pstxCombineSubCmd:
+			combineNetworkFlags(&pstxCombineConf.NetworkFlags, &cfg.NetworkFlags)
+			err := pstxCombineConf.ResolveNetwork(parser)
+			if err != nil {
+				printErrorAndExit(err)
+			}
+			config = pstxCombineConf
+		case pstxFinalizeSubCmd:
+			combineNetworkFlags(&pstxFinalizeConf.NetworkFlags, &cfg.NetworkFlags)
+			err := pstxFinalizeConf.ResolveNetwork(parser)
+			if err != nil {
+				printErrorAndExit(err)
+			}
+			config = pstxFinalizeConf
+		case pstxInspectSubCmd:
+			combineNetworkFlags(&pstxInspectConf.NetworkFlags, &cfg.NetworkFlags)
+			err := pstxInspectConf.ResolveNetwork(parser)
+			if err != nil {
+				printErrorAndExit(err)
+			}
+			config = pstxInspectConf
+		}
 	case dumpUnencryptedDataSubCmd:
 		combineNetworkFlags(&dumpUnencryptedDataConf.NetworkFlags, &cfg.NetworkFlags)
 		err := dumpUnencryptedDataConf.ResolveNetwork(parser)
@@ -333,23 +738,61 @@ func parseCommandLine() (subCommand string, config interface{}) {
 		config = getDaemonVersionConf
 	}
 
-	return parser.Command.Active.Name, config
+	return subCmd, config
 }
 
-func validateCreateUnsignedTransactionConf(conf *createUnsignedTransactionConfig) error {
-	if (!conf.IsSendAll && conf.SendAmount == "") ||
-		(conf.IsSendAll && conf.SendAmount != "") {
+func validateCreateConfig(conf *createConfig) error {
+	if conf.WatchOnly {
+		if conf.Import {
+			return errors.New("'--watch-only' and '--import' cannot be used together")
+		}
+		conf.NumPrivateKeys = 0
+	}
 
-		return errors.New("exactly one of '--send-amount' or '--send-all' must be specified")
+	numKeys := conf.NumPrivateKeys + uint32(len(conf.ExtendedPublicKeys))
+	if conf.NumPublicKeys < numKeys {
+		conf.NumPublicKeys = numKeys
+	}
+	if conf.NumPublicKeys == 0 {
+		return errors.New("a wallet must have at least one key")
+	}
+	if conf.NumPrivateKeys > conf.NumPublicKeys {
+		return errors.New("'--num-private-keys' cannot be greater than '--num-public-keys'")
+	}
+	if conf.MinimumSignatures == 0 || conf.MinimumSignatures > conf.NumPublicKeys {
+		return errors.Errorf("'--min-signatures' must be between 1 and the number of keys (%d)", conf.NumPublicKeys)
 	}
 	return nil
 }
 
+func validateCreateUnsignedTransactionConf(conf *createUnsignedTransactionConfig) error {
+	err := validateUTXOFlags(conf.UTXOs, conf.FromAddresses)
+	if err != nil {
+		return err
+	}
+	return validatePaymentFlags(conf.ToAddress, conf.SendAmount, conf.IsSendAll, conf.Outputs, conf.OutputsFile)
+}
+
 func validateSendConfig(conf *sendConfig) error {
-	if (!conf.IsSendAll && conf.SendAmount == "") ||
-		(conf.IsSendAll && conf.SendAmount != "") {
+	err := validateUTXOFlags(conf.UTXOs, conf.FromAddresses)
+	if err != nil {
+		return err
+	}
+	return validatePaymentFlags(conf.ToAddress, conf.SendAmount, conf.IsSendAll, conf.Outputs, conf.OutputsFile)
+}
+
+func validateUTXOFlags(utxos []string, fromAddresses []string) error {
+	if len(utxos) > 0 && len(fromAddresses) > 0 {
+		return errors.New("'--utxo' and '--from-address' cannot be used together")
+	}
+	return nil
+}
 
-		return errors.New("exactly one of '--send-amount' or '--send-all' must be specified")
+func validateHistoryConfig(conf *historyConfig) error {
+	switch conf.Format {
+	case historyFormatTable, historyFormatCSV, historyFormatJSON:
+	default:
+		return errors.Errorf("'--format' must be one of %s, %s or %s", historyFormatTable, historyFormatCSV, historyFormatJSON)
 	}
 	return nil
 }
@@ -362,3 +805,18 @@ func combineNetworkFlags(dst, src *config.NetworkFlags) {
 		dst.OverrideDAGParamsFile = src.OverrideDAGParamsFile
 	}
 }
+
+func validateNewTimeLockAddressConfig(conf *newTimeLockAddressConfig) error {
+	return validateLockTimeFlags(conf.LockDAAScore, conf.LockTime)
+}
+
+func validateHTLCInitiateConfig(conf *htlcInitiateConfig) error {
+	return validateLockTimeFlags(conf.LockDAAScore, conf.LockTime)
+}
+
+func validateLockTimeFlags(lockDAAScore uint64, lockTime string) error {
+	if (lockDAAScore == 0) == (lockTime == "") {
+		return errors.New("exactly one of '--lock-daa-score' or '--lock-time' must be specified")
+	}
+	return nil
+}
@@ -6,7 +6,6 @@ import (
 	"os"
 
 	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
-	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet/bip32"
 	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/utils"
 	"github.com/pkg/errors"
 
@@ -17,37 +16,47 @@ func create(conf *createConfig) error {
 	var encryptedMnemonics []*keys.EncryptedMnemonic
 	var signerExtendedPublicKeys []string
 	var err error
-	isMultisig := conf.NumPublicKeys > 1
-	if !conf.Import {
-		encryptedMnemonics, signerExtendedPublicKeys, err = keys.CreateMnemonics(conf.NetParams(), conf.NumPrivateKeys, conf.Password, isMultisig)
-	} else {
-		encryptedMnemonics, signerExtendedPublicKeys, err = keys.ImportMnemonics(conf.NetParams(), conf.NumPrivateKeys, conf.Password, isMultisig)
-	}
-	if err != nil {
-		return err
+	for _, extendedPublicKey := range conf.ExtendedPublicKeys {
+		err := libhtnwallet.ValidateExtendedPublicKey(conf.NetParams(), extendedPublicKey)
+		if err != nil {
+			return err
+		}
 	}
 
-	for i, extendedPublicKey := range signerExtendedPublicKeys {
-		fmt.Printf("Extended public key of mnemonic #%d:\n%s\n\n", i+1, extendedPublicKey)
-	}
+	isMultisig := conf.NumPublicKeys > 1
+	if conf.NumPrivateKeys > 0 {
+		if !conf.Import {
+			encryptedMnemonics, signerExtendedPublicKeys, err = keys.CreateMnemonics(conf.NetParams(), conf.NumPrivateKeys, conf.Password, isMultisig)
+		} else {
+			encryptedMnemonics, signerExtendedPublicKeys, err = keys.ImportMnemonics(conf.NetParams(), conf.NumPrivateKeys, conf.Password, isMultisig)
+		}
+		if err != nil {
+			return err
+		}
 
-	fmt.Printf("Notice the above is neither a secret key to your wallet " +
-		"(use \"htnwallet dump-unencrypted-data\" to see a secret seed phrase) " +
-		"nor a wallet public address (use \"htnwallet new-address\" to create and see one)\n\n")
+		for i, extendedPublicKey := range signerExtendedPublicKeys {
+			fmt.Printf("Extended public key of mnemonic #%d:\n%s\n\n", i+1, extendedPublicKey)
+		}
+
+		fmt.Printf("Notice the above is neither a secret key to your wallet " +
+			"(use \"htnwallet dump-unencrypted-data\" to see a secret seed phrase) " +
+			"nor a wallet public address (use \"htnwallet new-address\" to create and see one)\n\n")
+	}
 
 	extendedPublicKeys := make([]string, conf.NumPrivateKeys, conf.NumPublicKeys)
 	copy(extendedPublicKeys, signerExtendedPublicKeys)
+	extendedPublicKeys = append(extendedPublicKeys, conf.ExtendedPublicKeys...)
 	reader := bufio.NewReader(os.Stdin)
-	for i := conf.NumPrivateKeys; i < conf.NumPublicKeys; i++ {
+	for i := uint32(len(extendedPublicKeys)); i < conf.NumPublicKeys; i++ {
 		fmt.Printf("Enter public key #%d here:\n", i+1)
 		extendedPublicKey, err := utils.ReadLine(reader)
 		if err != nil {
 			return err
 		}
 
-		_, err = bip32.DeserializeExtendedKey(string(extendedPublicKey))
+		err = libhtnwallet.ValidateExtendedPublicKey(conf.NetParams(), string(extendedPublicKey))
 		if err != nil {
-			return errors.Wrapf(err, "%s is invalid extended public key", string(extendedPublicKey))
+			return err
 		}
 
 		fmt.Println()
@@ -55,6 +64,14 @@ func create(conf *createConfig) error {
 		extendedPublicKeys = append(extendedPublicKeys, string(extendedPublicKey))
 	}
 
+	uniqueExtendedPublicKeys := make(map[string]struct{}, len(extendedPublicKeys))
+	for _, extendedPublicKey := range extendedPublicKeys {
+		if _, exists := uniqueExtendedPublicKeys[extendedPublicKey]; exists {
+			return errors.Errorf("extended public key %s was given more than once", extendedPublicKey)
+		}
+		uniqueExtendedPublicKeys[extendedPublicKey] = struct{}{}
+	}
+
 	// For a read only wallet the cosigner index is 0
 	cosignerIndex := uint32(0)
 	if len(signerExtendedPublicKeys) > 0 {
@@ -89,5 +106,9 @@ func create(conf *createConfig) error {
 	}
 
 	fmt.Printf("Wrote the keys into %s\n", file.Path())
+	if file.IsWatchOnly() {
+		fmt.Printf("The wallet is watch-only. Transactions it creates with \"htnwallet create-unsigned-transaction\" " +
+			"must be signed with \"htnwallet sign\" on the machine that holds the private keys\n")
+	}
 	return nil
 }
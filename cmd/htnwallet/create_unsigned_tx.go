@@ -20,16 +20,30 @@ func createUnsignedTransaction(conf *createUnsignedTransactionConfig) error {
 	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
 	defer cancel()
 
-	sendAmountSompi, err := utils.KasToSompi(conf.SendAmount)
+	var sendAmountSompi uint64
+	if !conf.IsSendAll && conf.SendAmount != "" {
+		sendAmountSompi, err = utils.KasToSompi(conf.SendAmount)
+		if err != nil {
+			return err
+		}
+	}
+
+	outputs, err := parsePaymentOutputs(conf.Outputs, conf.OutputsFile)
+	if err != nil {
+		return err
+	}
 
+	outpoints, err := parseOutpoints(conf.UTXOs)
 	if err != nil {
 		return err
 	}
 
 	response, err := daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 		From:                     conf.FromAddresses,
+		Outpoints:                outpoints,
 		Address:                  conf.ToAddress,
 		Amount:                   sendAmountSompi,
+		Outputs:                  outputs,
 		IsSendAll:                conf.IsSendAll,
 		UseExistingChangeAddress: conf.UseExistingChangeAddress,
 	})
@@ -37,7 +51,7 @@ func createUnsignedTransaction(conf *createUnsignedTransactionConfig) error {
 		return err
 	}
 
-	fmt.Fprintln(os.Stderr, "Created unsigned transaction")
+	fmt.Fprintf(os.Stderr, "Created %d unsigned transaction(s)\n", len(response.UnsignedTransactions))
 	fmt.Println(encodeTransactionsToHex(response.UnsignedTransactions))
 
 	return nil
@@ -62,8 +62,12 @@ type GetBalanceResponse struct {
 	Available       uint64                 `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
 	Pending         uint64                 `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
 	AddressBalances []*AddressBalances     `protobuf:"bytes,3,rep,name=addressBalances,proto3" json:"addressBalances,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// locked is the amount in time-locked outputs and vaults that can't be spent yet, and
+	// unlocked is the amount in those that can. Neither is included in available or pending
+	Locked        uint64 `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`
+	Unlocked      uint64 `protobuf:"varint,5,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetBalanceResponse) Reset() {
@@ -117,6 +121,20 @@ func (x *GetBalanceResponse) GetAddressBalances() []*AddressBalances {
 	return nil
 }
 
+func (x *GetBalanceResponse) GetLocked() uint64 {
+	if x != nil {
+		return x.Locked
+	}
+	return 0
+}
+
+func (x *GetBalanceResponse) GetUnlocked() uint64 {
+	if x != nil {
+		return x.Unlocked
+	}
+	return 0
+}
+
 type AddressBalances struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -184,8 +202,12 @@ type CreateUnsignedTransactionsRequest struct {
 	From                     []string               `protobuf:"bytes,3,rep,name=from,proto3" json:"from,omitempty"`
 	UseExistingChangeAddress bool                   `protobuf:"varint,4,opt,name=useExistingChangeAddress,proto3" json:"useExistingChangeAddress,omitempty"`
 	IsSendAll                bool                   `protobuf:"varint,5,opt,name=isSendAll,proto3" json:"isSendAll,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// outputs pays several recipients at once, and is mutually exclusive with address and amount
+	Outputs []*PaymentOutput `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// outpoints are spent instead of automatically selected ones, and are mutually exclusive with from
+	Outpoints     []*Outpoint `protobuf:"bytes,7,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateUnsignedTransactionsRequest) Reset() {
@@ -253,6 +275,72 @@ func (x *CreateUnsignedTransactionsRequest) GetIsSendAll() bool {
 	return false
 }
 
+func (x *CreateUnsignedTransactionsRequest) GetOutputs() []*PaymentOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *CreateUnsignedTransactionsRequest) GetOutpoints() []*Outpoint {
+	if x != nil {
+		return x.Outpoints
+	}
+	return nil
+}
+
+type PaymentOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentOutput) Reset() {
+	*x = PaymentOutput{}
+	mi := &file_htnwalletd_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentOutput) ProtoMessage() {}
+
+func (x *PaymentOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentOutput.ProtoReflect.Descriptor instead.
+func (*PaymentOutput) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PaymentOutput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PaymentOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
 type CreateUnsignedTransactionsResponse struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	UnsignedTransactions [][]byte               `protobuf:"bytes,1,rep,name=unsignedTransactions,proto3" json:"unsignedTransactions,omitempty"`
@@ -262,7 +350,7 @@ type CreateUnsignedTransactionsResponse struct {
 
 func (x *CreateUnsignedTransactionsResponse) Reset() {
 	*x = CreateUnsignedTransactionsResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[4]
+	mi := &file_htnwalletd_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -274,7 +362,7 @@ func (x *CreateUnsignedTransactionsResponse) String() string {
 func (*CreateUnsignedTransactionsResponse) ProtoMessage() {}
 
 func (x *CreateUnsignedTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[4]
+	mi := &file_htnwalletd_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,7 +375,7 @@ func (x *CreateUnsignedTransactionsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CreateUnsignedTransactionsResponse.ProtoReflect.Descriptor instead.
 func (*CreateUnsignedTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{4}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateUnsignedTransactionsResponse) GetUnsignedTransactions() [][]byte {
@@ -308,7 +396,7 @@ type CreateUnsignedCompoundTransactionRequest struct {
 
 func (x *CreateUnsignedCompoundTransactionRequest) Reset() {
 	*x = CreateUnsignedCompoundTransactionRequest{}
-	mi := &file_htnwalletd_proto_msgTypes[5]
+	mi := &file_htnwalletd_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -320,7 +408,7 @@ func (x *CreateUnsignedCompoundTransactionRequest) String() string {
 func (*CreateUnsignedCompoundTransactionRequest) ProtoMessage() {}
 
 func (x *CreateUnsignedCompoundTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[5]
+	mi := &file_htnwalletd_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -333,7 +421,7 @@ func (x *CreateUnsignedCompoundTransactionRequest) ProtoReflect() protoreflect.M
 
 // Deprecated: Use CreateUnsignedCompoundTransactionRequest.ProtoReflect.Descriptor instead.
 func (*CreateUnsignedCompoundTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{5}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateUnsignedCompoundTransactionRequest) GetAddress() string {
@@ -366,7 +454,7 @@ type CreateUnsignedCompoundTransactionResponse struct {
 
 func (x *CreateUnsignedCompoundTransactionResponse) Reset() {
 	*x = CreateUnsignedCompoundTransactionResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[6]
+	mi := &file_htnwalletd_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -378,7 +466,7 @@ func (x *CreateUnsignedCompoundTransactionResponse) String() string {
 func (*CreateUnsignedCompoundTransactionResponse) ProtoMessage() {}
 
 func (x *CreateUnsignedCompoundTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[6]
+	mi := &file_htnwalletd_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,7 +479,7 @@ func (x *CreateUnsignedCompoundTransactionResponse) ProtoReflect() protoreflect.
 
 // Deprecated: Use CreateUnsignedCompoundTransactionResponse.ProtoReflect.Descriptor instead.
 func (*CreateUnsignedCompoundTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{6}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreateUnsignedCompoundTransactionResponse) GetUnsignedTransactions() [][]byte {
@@ -409,7 +497,7 @@ type ShowAddressesRequest struct {
 
 func (x *ShowAddressesRequest) Reset() {
 	*x = ShowAddressesRequest{}
-	mi := &file_htnwalletd_proto_msgTypes[7]
+	mi := &file_htnwalletd_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -421,7 +509,7 @@ func (x *ShowAddressesRequest) String() string {
 func (*ShowAddressesRequest) ProtoMessage() {}
 
 func (x *ShowAddressesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[7]
+	mi := &file_htnwalletd_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -434,7 +522,7 @@ func (x *ShowAddressesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowAddressesRequest.ProtoReflect.Descriptor instead.
 func (*ShowAddressesRequest) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{7}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{8}
 }
 
 type ShowAddressesResponse struct {
@@ -446,7 +534,7 @@ type ShowAddressesResponse struct {
 
 func (x *ShowAddressesResponse) Reset() {
 	*x = ShowAddressesResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[8]
+	mi := &file_htnwalletd_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +546,7 @@ func (x *ShowAddressesResponse) String() string {
 func (*ShowAddressesResponse) ProtoMessage() {}
 
 func (x *ShowAddressesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[8]
+	mi := &file_htnwalletd_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +559,7 @@ func (x *ShowAddressesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShowAddressesResponse.ProtoReflect.Descriptor instead.
 func (*ShowAddressesResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{8}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ShowAddressesResponse) GetAddress() []string {
@@ -489,7 +577,7 @@ type NewAddressRequest struct {
 
 func (x *NewAddressRequest) Reset() {
 	*x = NewAddressRequest{}
-	mi := &file_htnwalletd_proto_msgTypes[9]
+	mi := &file_htnwalletd_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +589,7 @@ func (x *NewAddressRequest) String() string {
 func (*NewAddressRequest) ProtoMessage() {}
 
 func (x *NewAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[9]
+	mi := &file_htnwalletd_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +602,7 @@ func (x *NewAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewAddressRequest.ProtoReflect.Descriptor instead.
 func (*NewAddressRequest) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{9}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{10}
 }
 
 type NewAddressResponse struct {
@@ -526,7 +614,7 @@ type NewAddressResponse struct {
 
 func (x *NewAddressResponse) Reset() {
 	*x = NewAddressResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[10]
+	mi := &file_htnwalletd_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -538,7 +626,7 @@ func (x *NewAddressResponse) String() string {
 func (*NewAddressResponse) ProtoMessage() {}
 
 func (x *NewAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[10]
+	mi := &file_htnwalletd_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +639,7 @@ func (x *NewAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewAddressResponse.ProtoReflect.Descriptor instead.
 func (*NewAddressResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{10}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *NewAddressResponse) GetAddress() string {
@@ -571,7 +659,7 @@ type BroadcastRequest struct {
 
 func (x *BroadcastRequest) Reset() {
 	*x = BroadcastRequest{}
-	mi := &file_htnwalletd_proto_msgTypes[11]
+	mi := &file_htnwalletd_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -583,7 +671,7 @@ func (x *BroadcastRequest) String() string {
 func (*BroadcastRequest) ProtoMessage() {}
 
 func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[11]
+	mi := &file_htnwalletd_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +684,7 @@ func (x *BroadcastRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastRequest.ProtoReflect.Descriptor instead.
 func (*BroadcastRequest) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{11}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BroadcastRequest) GetIsDomain() bool {
@@ -622,7 +710,7 @@ type BroadcastResponse struct {
 
 func (x *BroadcastResponse) Reset() {
 	*x = BroadcastResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[12]
+	mi := &file_htnwalletd_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -634,7 +722,7 @@ func (x *BroadcastResponse) String() string {
 func (*BroadcastResponse) ProtoMessage() {}
 
 func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[12]
+	mi := &file_htnwalletd_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,7 +735,7 @@ func (x *BroadcastResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BroadcastResponse.ProtoReflect.Descriptor instead.
 func (*BroadcastResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{12}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BroadcastResponse) GetTxIDs() []string {
@@ -665,7 +753,7 @@ type ShutdownRequest struct {
 
 func (x *ShutdownRequest) Reset() {
 	*x = ShutdownRequest{}
-	mi := &file_htnwalletd_proto_msgTypes[13]
+	mi := &file_htnwalletd_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -677,7 +765,7 @@ func (x *ShutdownRequest) String() string {
 func (*ShutdownRequest) ProtoMessage() {}
 
 func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[13]
+	mi := &file_htnwalletd_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,7 +778,7 @@ func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
 func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{13}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{14}
 }
 
 type ShutdownResponse struct {
@@ -701,7 +789,7 @@ type ShutdownResponse struct {
 
 func (x *ShutdownResponse) Reset() {
 	*x = ShutdownResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[14]
+	mi := &file_htnwalletd_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -713,7 +801,7 @@ func (x *ShutdownResponse) String() string {
 func (*ShutdownResponse) ProtoMessage() {}
 
 func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[14]
+	mi := &file_htnwalletd_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -726,7 +814,7 @@ func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
 func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{14}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{15}
 }
 
 type Outpoint struct {
@@ -739,7 +827,7 @@ type Outpoint struct {
 
 func (x *Outpoint) Reset() {
 	*x = Outpoint{}
-	mi := &file_htnwalletd_proto_msgTypes[15]
+	mi := &file_htnwalletd_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -751,7 +839,7 @@ func (x *Outpoint) String() string {
 func (*Outpoint) ProtoMessage() {}
 
 func (x *Outpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[15]
+	mi := &file_htnwalletd_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +852,7 @@ func (x *Outpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Outpoint.ProtoReflect.Descriptor instead.
 func (*Outpoint) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{15}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Outpoint) GetTransactionId() string {
@@ -792,7 +880,7 @@ type UtxosByAddressesEntry struct {
 
 func (x *UtxosByAddressesEntry) Reset() {
 	*x = UtxosByAddressesEntry{}
-	mi := &file_htnwalletd_proto_msgTypes[16]
+	mi := &file_htnwalletd_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -804,7 +892,7 @@ func (x *UtxosByAddressesEntry) String() string {
 func (*UtxosByAddressesEntry) ProtoMessage() {}
 
 func (x *UtxosByAddressesEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[16]
+	mi := &file_htnwalletd_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +905,7 @@ func (x *UtxosByAddressesEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UtxosByAddressesEntry.ProtoReflect.Descriptor instead.
 func (*UtxosByAddressesEntry) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{16}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *UtxosByAddressesEntry) GetAddress() string {
@@ -851,7 +939,7 @@ type ScriptPublicKey struct {
 
 func (x *ScriptPublicKey) Reset() {
 	*x = ScriptPublicKey{}
-	mi := &file_htnwalletd_proto_msgTypes[17]
+	mi := &file_htnwalletd_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -863,7 +951,7 @@ func (x *ScriptPublicKey) String() string {
 func (*ScriptPublicKey) ProtoMessage() {}
 
 func (x *ScriptPublicKey) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[17]
+	mi := &file_htnwalletd_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +964,7 @@ func (x *ScriptPublicKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptPublicKey.ProtoReflect.Descriptor instead.
 func (*ScriptPublicKey) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{17}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ScriptPublicKey) GetVersion() uint32 {
@@ -905,7 +993,7 @@ type UtxoEntry struct {
 
 func (x *UtxoEntry) Reset() {
 	*x = UtxoEntry{}
-	mi := &file_htnwalletd_proto_msgTypes[18]
+	mi := &file_htnwalletd_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -917,7 +1005,7 @@ func (x *UtxoEntry) String() string {
 func (*UtxoEntry) ProtoMessage() {}
 
 func (x *UtxoEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[18]
+	mi := &file_htnwalletd_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +1018,7 @@ func (x *UtxoEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UtxoEntry.ProtoReflect.Descriptor instead.
 func (*UtxoEntry) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{18}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UtxoEntry) GetAmount() uint64 {
@@ -970,7 +1058,7 @@ type GetExternalSpendableUTXOsRequest struct {
 
 func (x *GetExternalSpendableUTXOsRequest) Reset() {
 	*x = GetExternalSpendableUTXOsRequest{}
-	mi := &file_htnwalletd_proto_msgTypes[19]
+	mi := &file_htnwalletd_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -982,7 +1070,7 @@ func (x *GetExternalSpendableUTXOsRequest) String() string {
 func (*GetExternalSpendableUTXOsRequest) ProtoMessage() {}
 
 func (x *GetExternalSpendableUTXOsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[19]
+	mi := &file_htnwalletd_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -995,7 +1083,7 @@ func (x *GetExternalSpendableUTXOsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetExternalSpendableUTXOsRequest.ProtoReflect.Descriptor instead.
 func (*GetExternalSpendableUTXOsRequest) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{19}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetExternalSpendableUTXOsRequest) GetAddress() string {
@@ -1014,7 +1102,7 @@ type GetExternalSpendableUTXOsResponse struct {
 
 func (x *GetExternalSpendableUTXOsResponse) Reset() {
 	*x = GetExternalSpendableUTXOsResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[20]
+	mi := &file_htnwalletd_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1026,7 +1114,7 @@ func (x *GetExternalSpendableUTXOsResponse) String() string {
 func (*GetExternalSpendableUTXOsResponse) ProtoMessage() {}
 
 func (x *GetExternalSpendableUTXOsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[20]
+	mi := &file_htnwalletd_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1039,7 +1127,7 @@ func (x *GetExternalSpendableUTXOsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetExternalSpendableUTXOsResponse.ProtoReflect.Descriptor instead.
 func (*GetExternalSpendableUTXOsResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{20}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetExternalSpendableUTXOsResponse) GetEntries() []*UtxosByAddressesEntry {
@@ -1058,13 +1146,17 @@ type SendRequest struct {
 	From                     []string               `protobuf:"bytes,4,rep,name=from,proto3" json:"from,omitempty"`
 	UseExistingChangeAddress bool                   `protobuf:"varint,5,opt,name=useExistingChangeAddress,proto3" json:"useExistingChangeAddress,omitempty"`
 	IsSendAll                bool                   `protobuf:"varint,6,opt,name=isSendAll,proto3" json:"isSendAll,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// outputs pays several recipients at once, and is mutually exclusive with toAddress and amount
+	Outputs []*PaymentOutput `protobuf:"bytes,7,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	// outpoints are spent instead of automatically selected ones, and are mutually exclusive with from
+	Outpoints     []*Outpoint `protobuf:"bytes,8,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SendRequest) Reset() {
 	*x = SendRequest{}
-	mi := &file_htnwalletd_proto_msgTypes[21]
+	mi := &file_htnwalletd_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1076,7 +1168,7 @@ func (x *SendRequest) String() string {
 func (*SendRequest) ProtoMessage() {}
 
 func (x *SendRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[21]
+	mi := &file_htnwalletd_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1089,7 +1181,7 @@ func (x *SendRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendRequest.ProtoReflect.Descriptor instead.
 func (*SendRequest) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{21}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *SendRequest) GetToAddress() string {
@@ -1134,6 +1226,20 @@ func (x *SendRequest) GetIsSendAll() bool {
 	return false
 }
 
+func (x *SendRequest) GetOutputs() []*PaymentOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *SendRequest) GetOutpoints() []*Outpoint {
+	if x != nil {
+		return x.Outpoints
+	}
+	return nil
+}
+
 type SendResponse struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	TxIDs              []string               `protobuf:"bytes,1,rep,name=txIDs,proto3" json:"txIDs,omitempty"`
@@ -1144,7 +1250,7 @@ type SendResponse struct {
 
 func (x *SendResponse) Reset() {
 	*x = SendResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[22]
+	mi := &file_htnwalletd_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1156,7 +1262,7 @@ func (x *SendResponse) String() string {
 func (*SendResponse) ProtoMessage() {}
 
 func (x *SendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[22]
+	mi := &file_htnwalletd_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1169,7 +1275,7 @@ func (x *SendResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendResponse.ProtoReflect.Descriptor instead.
 func (*SendResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{22}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *SendResponse) GetTxIDs() []string {
@@ -1197,7 +1303,7 @@ type SignRequest struct {
 
 func (x *SignRequest) Reset() {
 	*x = SignRequest{}
-	mi := &file_htnwalletd_proto_msgTypes[23]
+	mi := &file_htnwalletd_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1209,7 +1315,7 @@ func (x *SignRequest) String() string {
 func (*SignRequest) ProtoMessage() {}
 
 func (x *SignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[23]
+	mi := &file_htnwalletd_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1328,7 @@ func (x *SignRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignRequest.ProtoReflect.Descriptor instead.
 func (*SignRequest) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{23}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *SignRequest) GetUnsignedTransactions() [][]byte {
@@ -1248,7 +1354,7 @@ type SignResponse struct {
 
 func (x *SignResponse) Reset() {
 	*x = SignResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[24]
+	mi := &file_htnwalletd_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1260,7 +1366,7 @@ func (x *SignResponse) String() string {
 func (*SignResponse) ProtoMessage() {}
 
 func (x *SignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[24]
+	mi := &file_htnwalletd_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1273,7 +1379,7 @@ func (x *SignResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignResponse.ProtoReflect.Descriptor instead.
 func (*SignResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{24}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *SignResponse) GetSignedTransactions() [][]byte {
@@ -1291,7 +1397,7 @@ type GetVersionRequest struct {
 
 func (x *GetVersionRequest) Reset() {
 	*x = GetVersionRequest{}
-	mi := &file_htnwalletd_proto_msgTypes[25]
+	mi := &file_htnwalletd_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1303,7 +1409,7 @@ func (x *GetVersionRequest) String() string {
 func (*GetVersionRequest) ProtoMessage() {}
 
 func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[25]
+	mi := &file_htnwalletd_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1316,7 +1422,7 @@ func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
 func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{25}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{26}
 }
 
 type GetVersionResponse struct {
@@ -1328,7 +1434,7 @@ type GetVersionResponse struct {
 
 func (x *GetVersionResponse) Reset() {
 	*x = GetVersionResponse{}
-	mi := &file_htnwalletd_proto_msgTypes[26]
+	mi := &file_htnwalletd_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1340,7 +1446,7 @@ func (x *GetVersionResponse) String() string {
 func (*GetVersionResponse) ProtoMessage() {}
 
 func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_htnwalletd_proto_msgTypes[26]
+	mi := &file_htnwalletd_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,7 +1459,7 @@ func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
 func (*GetVersionResponse) Descriptor() ([]byte, []int) {
-	return file_htnwalletd_proto_rawDescGZIP(), []int{26}
+	return file_htnwalletd_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *GetVersionResponse) GetVersion() string {
@@ -1363,44 +1469,1959 @@ func (x *GetVersionResponse) GetVersion() string {
 	return ""
 }
 
-var File_htnwalletd_proto protoreflect.FileDescriptor
+// WalletTransaction is an entry of the wallet transaction history.
+// direction is one of "incoming", "outgoing" or "self", and status is
+// one of "pending", "confirmed" or "rejected". A transaction is rejected
+// once another transaction that spends one of the same outputs is accepted.
+type WalletTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	Direction     string                 `protobuf:"bytes,2,opt,name=direction,proto3" json:"direction,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// amount is the amount received for incoming transactions, and the amount
+	// sent to addresses outside of the wallet for outgoing ones
+	Amount            uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee               uint64   `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	AcceptingDaaScore uint64   `protobuf:"varint,6,opt,name=acceptingDaaScore,proto3" json:"acceptingDaaScore,omitempty"`
+	Confirmations     uint64   `protobuf:"varint,7,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	IsCoinbase        bool     `protobuf:"varint,8,opt,name=isCoinbase,proto3" json:"isCoinbase,omitempty"`
+	Counterparties    []string `protobuf:"bytes,9,rep,name=counterparties,proto3" json:"counterparties,omitempty"`
+	WalletAddresses   []string `protobuf:"bytes,10,rep,name=walletAddresses,proto3" json:"walletAddresses,omitempty"`
+	Label             string   `protobuf:"bytes,11,opt,name=label,proto3" json:"label,omitempty"`
+	Timestamp         int64    `protobuf:"varint,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WalletTransaction) Reset() {
+	*x = WalletTransaction{}
+	mi := &file_htnwalletd_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_htnwalletd_proto_rawDesc = "" +
-	"\n" +
-	"\x10htnwalletd.proto\x12\n" +
-	"htnwalletd\"\x13\n" +
-	"\x11GetBalanceRequest\"\x93\x01\n" +
-	"\x12GetBalanceResponse\x12\x1c\n" +
-	"\tavailable\x18\x01 \x01(\x04R\tavailable\x12\x18\n" +
-	"\apending\x18\x02 \x01(\x04R\apending\x12E\n" +
-	"\x0faddressBalances\x18\x03 \x03(\v2\x1b.htnwalletd.AddressBalancesR\x0faddressBalances\"c\n" +
-	"\x0fAddressBalances\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1c\n" +
-	"\tavailable\x18\x02 \x01(\x04R\tavailable\x12\x18\n" +
-	"\apending\x18\x03 \x01(\x04R\apending\"\xc3\x01\n" +
-	"!CreateUnsignedTransactionsRequest\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12\x12\n" +
-	"\x04from\x18\x03 \x03(\tR\x04from\x12:\n" +
-	"\x18useExistingChangeAddress\x18\x04 \x01(\bR\x18useExistingChangeAddress\x12\x1c\n" +
-	"\tisSendAll\x18\x05 \x01(\bR\tisSendAll\"X\n" +
-	"\"CreateUnsignedTransactionsResponse\x122\n" +
-	"\x14unsignedTransactions\x18\x01 \x03(\fR\x14unsignedTransactions\"\x94\x01\n" +
-	"(CreateUnsignedCompoundTransactionRequest\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
-	"\x04from\x18\x02 \x03(\tR\x04from\x12:\n" +
-	"\x18useExistingChangeAddress\x18\x03 \x01(\bR\x18useExistingChangeAddress\"_\n" +
-	")CreateUnsignedCompoundTransactionResponse\x122\n" +
-	"\x14unsignedTransactions\x18\x01 \x03(\fR\x14unsignedTransactions\"\x16\n" +
-	"\x14ShowAddressesRequest\"1\n" +
-	"\x15ShowAddressesResponse\x12\x18\n" +
-	"\aaddress\x18\x01 \x03(\tR\aaddress\"\x13\n" +
-	"\x11NewAddressRequest\".\n" +
-	"\x12NewAddressResponse\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\"R\n" +
-	"\x10BroadcastRequest\x12\x1a\n" +
-	"\bisDomain\x18\x01 \x01(\bR\bisDomain\x12\"\n" +
-	"\ftransactions\x18\x02 \x03(\fR\ftransactions\")\n" +
+func (x *WalletTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletTransaction) ProtoMessage() {}
+
+func (x *WalletTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletTransaction.ProtoReflect.Descriptor instead.
+func (*WalletTransaction) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WalletTransaction) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetAcceptingDaaScore() uint64 {
+	if x != nil {
+		return x.AcceptingDaaScore
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *WalletTransaction) GetIsCoinbase() bool {
+	if x != nil {
+		return x.IsCoinbase
+	}
+	return false
+}
+
+func (x *WalletTransaction) GetCounterparties() []string {
+	if x != nil {
+		return x.Counterparties
+	}
+	return nil
+}
+
+func (x *WalletTransaction) GetWalletAddresses() []string {
+	if x != nil {
+		return x.WalletAddresses
+	}
+	return nil
+}
+
+func (x *WalletTransaction) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WalletTransaction) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type GetTransactionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         uint32                 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        uint32                 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsRequest) Reset() {
+	*x = GetTransactionsRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsRequest) ProtoMessage() {}
+
+func (x *GetTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTransactionsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetTransactionsRequest) GetOffset() uint32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetTransactionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*WalletTransaction   `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsResponse) Reset() {
+	*x = GetTransactionsResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsResponse) ProtoMessage() {}
+
+func (x *GetTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTransactionsResponse) GetTransactions() []*WalletTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetTransactionsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionRequest) ProtoMessage() {}
+
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTransactionRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *WalletTransaction     `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *WalletTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type SetTransactionLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionLabelRequest) Reset() {
+	*x = SetTransactionLabelRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionLabelRequest) ProtoMessage() {}
+
+func (x *SetTransactionLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionLabelRequest.ProtoReflect.Descriptor instead.
+func (*SetTransactionLabelRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetTransactionLabelRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *SetTransactionLabelRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type SetTransactionLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTransactionLabelResponse) Reset() {
+	*x = SetTransactionLabelResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTransactionLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTransactionLabelResponse) ProtoMessage() {}
+
+func (x *SetTransactionLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTransactionLabelResponse.ProtoReflect.Descriptor instead.
+func (*SetTransactionLabelResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{34}
+}
+
+// Since SignMessageRequest contains a password - this command should only be used on a trusted or secure connection
+type SignMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignMessageRequest) Reset() {
+	*x = SignMessageRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignMessageRequest) ProtoMessage() {}
+
+func (x *SignMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignMessageRequest.ProtoReflect.Descriptor instead.
+func (*SignMessageRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SignMessageRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SignMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SignMessageRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// signature is a Schnorr signature, or an ECDSA signature for ECDSA wallets
+type SignMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignMessageResponse) Reset() {
+	*x = SignMessageResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignMessageResponse) ProtoMessage() {}
+
+func (x *SignMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignMessageResponse.ProtoReflect.Descriptor instead.
+func (*SignMessageResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SignMessageResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type VerifyMessageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Signature     []byte                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMessageRequest) Reset() {
+	*x = VerifyMessageRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMessageRequest) ProtoMessage() {}
+
+func (x *VerifyMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMessageRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VerifyMessageRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VerifyMessageRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyMessageRequest) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type VerifyMessageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsValid       bool                   `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMessageResponse) Reset() {
+	*x = VerifyMessageResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMessageResponse) ProtoMessage() {}
+
+func (x *VerifyMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMessageResponse.ProtoReflect.Descriptor instead.
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VerifyMessageResponse) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+// lockTime is a DAA score, or a UNIX timestamp in milliseconds if it's 500000000000 or above
+type NewTimeLockAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LockTime      uint64                 `protobuf:"varint,1,opt,name=lockTime,proto3" json:"lockTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewTimeLockAddressRequest) Reset() {
+	*x = NewTimeLockAddressRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewTimeLockAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewTimeLockAddressRequest) ProtoMessage() {}
+
+func (x *NewTimeLockAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewTimeLockAddressRequest.ProtoReflect.Descriptor instead.
+func (*NewTimeLockAddressRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NewTimeLockAddressRequest) GetLockTime() uint64 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+// redeemScript is encoded in hex, and is also kept in the keys file
+type NewTimeLockAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RedeemScript  string                 `protobuf:"bytes,2,opt,name=redeemScript,proto3" json:"redeemScript,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewTimeLockAddressResponse) Reset() {
+	*x = NewTimeLockAddressResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewTimeLockAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewTimeLockAddressResponse) ProtoMessage() {}
+
+func (x *NewTimeLockAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewTimeLockAddressResponse.ProtoReflect.Descriptor instead.
+func (*NewTimeLockAddressResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NewTimeLockAddressResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NewTimeLockAddressResponse) GetRedeemScript() string {
+	if x != nil {
+		return x.RedeemScript
+	}
+	return ""
+}
+
+// delay is the number of DAA scores a vault output is locked for after it's accepted.
+// recoveryExtendedPublicKey is the extended public key of a single signer wallet with the
+// same signature type, which may spend the vault outputs at any time
+type NewVaultAddressRequest struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Delay                     uint64                 `protobuf:"varint,1,opt,name=delay,proto3" json:"delay,omitempty"`
+	RecoveryExtendedPublicKey string                 `protobuf:"bytes,2,opt,name=recoveryExtendedPublicKey,proto3" json:"recoveryExtendedPublicKey,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *NewVaultAddressRequest) Reset() {
+	*x = NewVaultAddressRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewVaultAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewVaultAddressRequest) ProtoMessage() {}
+
+func (x *NewVaultAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewVaultAddressRequest.ProtoReflect.Descriptor instead.
+func (*NewVaultAddressRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *NewVaultAddressRequest) GetDelay() uint64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *NewVaultAddressRequest) GetRecoveryExtendedPublicKey() string {
+	if x != nil {
+		return x.RecoveryExtendedPublicKey
+	}
+	return ""
+}
+
+type NewVaultAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RedeemScript  string                 `protobuf:"bytes,2,opt,name=redeemScript,proto3" json:"redeemScript,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewVaultAddressResponse) Reset() {
+	*x = NewVaultAddressResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewVaultAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewVaultAddressResponse) ProtoMessage() {}
+
+func (x *NewVaultAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewVaultAddressResponse.ProtoReflect.Descriptor instead.
+func (*NewVaultAddressResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NewVaultAddressResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NewVaultAddressResponse) GetRedeemScript() string {
+	if x != nil {
+		return x.RedeemScript
+	}
+	return ""
+}
+
+type GetLockedOutputsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLockedOutputsRequest) Reset() {
+	*x = GetLockedOutputsRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLockedOutputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLockedOutputsRequest) ProtoMessage() {}
+
+func (x *GetLockedOutputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLockedOutputsRequest.ProtoReflect.Descriptor instead.
+func (*GetLockedOutputsRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{43}
+}
+
+// LockedOutput is an output of a time lock or a vault address. type is one of "timelock" or "vault".
+// The output may be spent with the wallet key from unlockDaaScore, or from unlockTimestamp (in
+// milliseconds) for time locks until a timestamp
+type LockedOutput struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Address         string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Outpoint        *Outpoint              `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	Amount          uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	UnlockDaaScore  uint64                 `protobuf:"varint,5,opt,name=unlockDaaScore,proto3" json:"unlockDaaScore,omitempty"`
+	UnlockTimestamp int64                  `protobuf:"varint,6,opt,name=unlockTimestamp,proto3" json:"unlockTimestamp,omitempty"`
+	IsSpendable     bool                   `protobuf:"varint,7,opt,name=isSpendable,proto3" json:"isSpendable,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *LockedOutput) Reset() {
+	*x = LockedOutput{}
+	mi := &file_htnwalletd_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockedOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockedOutput) ProtoMessage() {}
+
+func (x *LockedOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockedOutput.ProtoReflect.Descriptor instead.
+func (*LockedOutput) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LockedOutput) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LockedOutput) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LockedOutput) GetOutpoint() *Outpoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *LockedOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *LockedOutput) GetUnlockDaaScore() uint64 {
+	if x != nil {
+		return x.UnlockDaaScore
+	}
+	return 0
+}
+
+func (x *LockedOutput) GetUnlockTimestamp() int64 {
+	if x != nil {
+		return x.UnlockTimestamp
+	}
+	return 0
+}
+
+func (x *LockedOutput) GetIsSpendable() bool {
+	if x != nil {
+		return x.IsSpendable
+	}
+	return false
+}
+
+type GetLockedOutputsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outputs       []*LockedOutput        `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLockedOutputsResponse) Reset() {
+	*x = GetLockedOutputsResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLockedOutputsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLockedOutputsResponse) ProtoMessage() {}
+
+func (x *GetLockedOutputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLockedOutputsResponse.ProtoReflect.Descriptor instead.
+func (*GetLockedOutputsResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetLockedOutputsResponse) GetOutputs() []*LockedOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+// CreateUnsignedLockedOutputsTransactionRequest spends all the outputs of a time lock or a vault
+// address that can be spent, to toAddress or to a new change address if it's empty.
+// isRecovery spends the outputs of a vault with its recovery key, regardless of their delay
+type CreateUnsignedLockedOutputsTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ToAddress     string                 `protobuf:"bytes,2,opt,name=toAddress,proto3" json:"toAddress,omitempty"`
+	IsRecovery    bool                   `protobuf:"varint,3,opt,name=isRecovery,proto3" json:"isRecovery,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUnsignedLockedOutputsTransactionRequest) Reset() {
+	*x = CreateUnsignedLockedOutputsTransactionRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUnsignedLockedOutputsTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUnsignedLockedOutputsTransactionRequest) ProtoMessage() {}
+
+func (x *CreateUnsignedLockedOutputsTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUnsignedLockedOutputsTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CreateUnsignedLockedOutputsTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateUnsignedLockedOutputsTransactionRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateUnsignedLockedOutputsTransactionRequest) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+func (x *CreateUnsignedLockedOutputsTransactionRequest) GetIsRecovery() bool {
+	if x != nil {
+		return x.IsRecovery
+	}
+	return false
+}
+
+type CreateUnsignedLockedOutputsTransactionResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UnsignedTransaction []byte                 `protobuf:"bytes,1,opt,name=unsignedTransaction,proto3" json:"unsignedTransaction,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateUnsignedLockedOutputsTransactionResponse) Reset() {
+	*x = CreateUnsignedLockedOutputsTransactionResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUnsignedLockedOutputsTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUnsignedLockedOutputsTransactionResponse) ProtoMessage() {}
+
+func (x *CreateUnsignedLockedOutputsTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUnsignedLockedOutputsTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CreateUnsignedLockedOutputsTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateUnsignedLockedOutputsTransactionResponse) GetUnsignedTransaction() []byte {
+	if x != nil {
+		return x.UnsignedTransaction
+	}
+	return nil
+}
+
+// NewHTLCRequest creates a hash time-locked contract that recipientAddress may redeem with the secret
+// whose SHA256 hash is secretHash, and that a new address of this wallet may refund from lockTime.
+// lockTime is a DAA score, or a timestamp in milliseconds if it's at least 5e11
+type NewHTLCRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RecipientAddress string                 `protobuf:"bytes,1,opt,name=recipientAddress,proto3" json:"recipientAddress,omitempty"`
+	SecretHash       []byte                 `protobuf:"bytes,2,opt,name=secretHash,proto3" json:"secretHash,omitempty"`
+	LockTime         uint64                 `protobuf:"varint,3,opt,name=lockTime,proto3" json:"lockTime,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NewHTLCRequest) Reset() {
+	*x = NewHTLCRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewHTLCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewHTLCRequest) ProtoMessage() {}
+
+func (x *NewHTLCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewHTLCRequest.ProtoReflect.Descriptor instead.
+func (*NewHTLCRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *NewHTLCRequest) GetRecipientAddress() string {
+	if x != nil {
+		return x.RecipientAddress
+	}
+	return ""
+}
+
+func (x *NewHTLCRequest) GetSecretHash() []byte {
+	if x != nil {
+		return x.SecretHash
+	}
+	return nil
+}
+
+func (x *NewHTLCRequest) GetLockTime() uint64 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+type NewHTLCResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Contract      []byte                 `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	RefundAddress string                 `protobuf:"bytes,3,opt,name=refundAddress,proto3" json:"refundAddress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewHTLCResponse) Reset() {
+	*x = NewHTLCResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewHTLCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewHTLCResponse) ProtoMessage() {}
+
+func (x *NewHTLCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewHTLCResponse.ProtoReflect.Descriptor instead.
+func (*NewHTLCResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NewHTLCResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *NewHTLCResponse) GetContract() []byte {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *NewHTLCResponse) GetRefundAddress() string {
+	if x != nil {
+		return x.RefundAddress
+	}
+	return ""
+}
+
+type AuditHTLCRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contract      []byte                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditHTLCRequest) Reset() {
+	*x = AuditHTLCRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditHTLCRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditHTLCRequest) ProtoMessage() {}
+
+func (x *AuditHTLCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditHTLCRequest.ProtoReflect.Descriptor instead.
+func (*AuditHTLCRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AuditHTLCRequest) GetContract() []byte {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+type HTLCOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outpoint      *Outpoint              `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	Amount        uint64                 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HTLCOutput) Reset() {
+	*x = HTLCOutput{}
+	mi := &file_htnwalletd_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HTLCOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTLCOutput) ProtoMessage() {}
+
+func (x *HTLCOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTLCOutput.ProtoReflect.Descriptor instead.
+func (*HTLCOutput) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *HTLCOutput) GetOutpoint() *Outpoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *HTLCOutput) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type AuditHTLCResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Address          string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	RecipientAddress string                 `protobuf:"bytes,2,opt,name=recipientAddress,proto3" json:"recipientAddress,omitempty"`
+	RefundAddress    string                 `protobuf:"bytes,3,opt,name=refundAddress,proto3" json:"refundAddress,omitempty"`
+	SecretHash       []byte                 `protobuf:"bytes,4,opt,name=secretHash,proto3" json:"secretHash,omitempty"`
+	LockTime         uint64                 `protobuf:"varint,5,opt,name=lockTime,proto3" json:"lockTime,omitempty"`
+	Outputs          []*HTLCOutput          `protobuf:"bytes,6,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	IsRecipient      bool                   `protobuf:"varint,7,opt,name=isRecipient,proto3" json:"isRecipient,omitempty"`
+	IsRefunder       bool                   `protobuf:"varint,8,opt,name=isRefunder,proto3" json:"isRefunder,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AuditHTLCResponse) Reset() {
+	*x = AuditHTLCResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditHTLCResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditHTLCResponse) ProtoMessage() {}
+
+func (x *AuditHTLCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditHTLCResponse.ProtoReflect.Descriptor instead.
+func (*AuditHTLCResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AuditHTLCResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AuditHTLCResponse) GetRecipientAddress() string {
+	if x != nil {
+		return x.RecipientAddress
+	}
+	return ""
+}
+
+func (x *AuditHTLCResponse) GetRefundAddress() string {
+	if x != nil {
+		return x.RefundAddress
+	}
+	return ""
+}
+
+func (x *AuditHTLCResponse) GetSecretHash() []byte {
+	if x != nil {
+		return x.SecretHash
+	}
+	return nil
+}
+
+func (x *AuditHTLCResponse) GetLockTime() uint64 {
+	if x != nil {
+		return x.LockTime
+	}
+	return 0
+}
+
+func (x *AuditHTLCResponse) GetOutputs() []*HTLCOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *AuditHTLCResponse) GetIsRecipient() bool {
+	if x != nil {
+		return x.IsRecipient
+	}
+	return false
+}
+
+func (x *AuditHTLCResponse) GetIsRefunder() bool {
+	if x != nil {
+		return x.IsRefunder
+	}
+	return false
+}
+
+// CreateUnsignedHTLCTransactionRequest spends all the outputs of the given hash time-locked contract
+// to toAddress, or to a new change address if it's empty. The outputs are redeemed with secret by the
+// recipient key, or refunded by the refund key if isRefund is set
+type CreateUnsignedHTLCTransactionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contract      []byte                 `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Secret        []byte                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	IsRefund      bool                   `protobuf:"varint,3,opt,name=isRefund,proto3" json:"isRefund,omitempty"`
+	ToAddress     string                 `protobuf:"bytes,4,opt,name=toAddress,proto3" json:"toAddress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUnsignedHTLCTransactionRequest) Reset() {
+	*x = CreateUnsignedHTLCTransactionRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUnsignedHTLCTransactionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUnsignedHTLCTransactionRequest) ProtoMessage() {}
+
+func (x *CreateUnsignedHTLCTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUnsignedHTLCTransactionRequest.ProtoReflect.Descriptor instead.
+func (*CreateUnsignedHTLCTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateUnsignedHTLCTransactionRequest) GetContract() []byte {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *CreateUnsignedHTLCTransactionRequest) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CreateUnsignedHTLCTransactionRequest) GetIsRefund() bool {
+	if x != nil {
+		return x.IsRefund
+	}
+	return false
+}
+
+func (x *CreateUnsignedHTLCTransactionRequest) GetToAddress() string {
+	if x != nil {
+		return x.ToAddress
+	}
+	return ""
+}
+
+type CreateUnsignedHTLCTransactionResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UnsignedTransaction []byte                 `protobuf:"bytes,1,opt,name=unsignedTransaction,proto3" json:"unsignedTransaction,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateUnsignedHTLCTransactionResponse) Reset() {
+	*x = CreateUnsignedHTLCTransactionResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUnsignedHTLCTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUnsignedHTLCTransactionResponse) ProtoMessage() {}
+
+func (x *CreateUnsignedHTLCTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUnsignedHTLCTransactionResponse.ProtoReflect.Descriptor instead.
+func (*CreateUnsignedHTLCTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateUnsignedHTLCTransactionResponse) GetUnsignedTransaction() []byte {
+	if x != nil {
+		return x.UnsignedTransaction
+	}
+	return nil
+}
+
+type ListUTXOsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          []string               `protobuf:"bytes,1,rep,name=from,proto3" json:"from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUTXOsRequest) Reset() {
+	*x = ListUTXOsRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUTXOsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUTXOsRequest) ProtoMessage() {}
+
+func (x *ListUTXOsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUTXOsRequest.ProtoReflect.Descriptor instead.
+func (*ListUTXOsRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListUTXOsRequest) GetFrom() []string {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+type WalletUTXO struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outpoint      *Outpoint              `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount        uint64                 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	BlockDaaScore uint64                 `protobuf:"varint,4,opt,name=blockDaaScore,proto3" json:"blockDaaScore,omitempty"`
+	// age is the number of DAA scores since the UTXO was accepted
+	Age         uint64 `protobuf:"varint,5,opt,name=age,proto3" json:"age,omitempty"`
+	IsCoinbase  bool   `protobuf:"varint,6,opt,name=isCoinbase,proto3" json:"isCoinbase,omitempty"`
+	IsSpendable bool   `protobuf:"varint,7,opt,name=isSpendable,proto3" json:"isSpendable,omitempty"`
+	// isLocked is set for UTXOs that were locked with LockUTXOs, and are never selected automatically
+	IsLocked bool `protobuf:"varint,8,opt,name=isLocked,proto3" json:"isLocked,omitempty"`
+	// isPending is set for UTXOs that are spent by a broadcast transaction that isn't accepted yet
+	IsPending     bool `protobuf:"varint,9,opt,name=isPending,proto3" json:"isPending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletUTXO) Reset() {
+	*x = WalletUTXO{}
+	mi := &file_htnwalletd_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletUTXO) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletUTXO) ProtoMessage() {}
+
+func (x *WalletUTXO) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletUTXO.ProtoReflect.Descriptor instead.
+func (*WalletUTXO) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WalletUTXO) GetOutpoint() *Outpoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *WalletUTXO) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WalletUTXO) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WalletUTXO) GetBlockDaaScore() uint64 {
+	if x != nil {
+		return x.BlockDaaScore
+	}
+	return 0
+}
+
+func (x *WalletUTXO) GetAge() uint64 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *WalletUTXO) GetIsCoinbase() bool {
+	if x != nil {
+		return x.IsCoinbase
+	}
+	return false
+}
+
+func (x *WalletUTXO) GetIsSpendable() bool {
+	if x != nil {
+		return x.IsSpendable
+	}
+	return false
+}
+
+func (x *WalletUTXO) GetIsLocked() bool {
+	if x != nil {
+		return x.IsLocked
+	}
+	return false
+}
+
+func (x *WalletUTXO) GetIsPending() bool {
+	if x != nil {
+		return x.IsPending
+	}
+	return false
+}
+
+type ListUTXOsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Utxos         []*WalletUTXO          `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUTXOsResponse) Reset() {
+	*x = ListUTXOsResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUTXOsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUTXOsResponse) ProtoMessage() {}
+
+func (x *ListUTXOsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUTXOsResponse.ProtoReflect.Descriptor instead.
+func (*ListUTXOsResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListUTXOsResponse) GetUtxos() []*WalletUTXO {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+type LockUTXOsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outpoints     []*Outpoint            `protobuf:"bytes,1,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockUTXOsRequest) Reset() {
+	*x = LockUTXOsRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockUTXOsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockUTXOsRequest) ProtoMessage() {}
+
+func (x *LockUTXOsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockUTXOsRequest.ProtoReflect.Descriptor instead.
+func (*LockUTXOsRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LockUTXOsRequest) GetOutpoints() []*Outpoint {
+	if x != nil {
+		return x.Outpoints
+	}
+	return nil
+}
+
+type LockUTXOsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LockUTXOsResponse) Reset() {
+	*x = LockUTXOsResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockUTXOsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockUTXOsResponse) ProtoMessage() {}
+
+func (x *LockUTXOsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockUTXOsResponse.ProtoReflect.Descriptor instead.
+func (*LockUTXOsResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{59}
+}
+
+type UnlockUTXOsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Outpoints     []*Outpoint            `protobuf:"bytes,1,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUTXOsRequest) Reset() {
+	*x = UnlockUTXOsRequest{}
+	mi := &file_htnwalletd_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUTXOsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUTXOsRequest) ProtoMessage() {}
+
+func (x *UnlockUTXOsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUTXOsRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUTXOsRequest) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UnlockUTXOsRequest) GetOutpoints() []*Outpoint {
+	if x != nil {
+		return x.Outpoints
+	}
+	return nil
+}
+
+type UnlockUTXOsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUTXOsResponse) Reset() {
+	*x = UnlockUTXOsResponse{}
+	mi := &file_htnwalletd_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUTXOsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUTXOsResponse) ProtoMessage() {}
+
+func (x *UnlockUTXOsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_htnwalletd_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUTXOsResponse.ProtoReflect.Descriptor instead.
+func (*UnlockUTXOsResponse) Descriptor() ([]byte, []int) {
+	return file_htnwalletd_proto_rawDescGZIP(), []int{61}
+}
+
+var File_htnwalletd_proto protoreflect.FileDescriptor
+
+const file_htnwalletd_proto_rawDesc = "" +
+	"\n" +
+	"\x10htnwalletd.proto\x12\n" +
+	"htnwalletd\"\x13\n" +
+	"\x11GetBalanceRequest\"\xc7\x01\n" +
+	"\x12GetBalanceResponse\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\x04R\tavailable\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x04R\apending\x12E\n" +
+	"\x0faddressBalances\x18\x03 \x03(\v2\x1b.htnwalletd.AddressBalancesR\x0faddressBalances\x12\x16\n" +
+	"\x06locked\x18\x04 \x01(\x04R\x06locked\x12\x1a\n" +
+	"\bunlocked\x18\x05 \x01(\x04R\bunlocked\"c\n" +
+	"\x0fAddressBalances\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1c\n" +
+	"\tavailable\x18\x02 \x01(\x04R\tavailable\x12\x18\n" +
+	"\apending\x18\x03 \x01(\x04R\apending\"\xac\x02\n" +
+	"!CreateUnsignedTransactionsRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12\x12\n" +
+	"\x04from\x18\x03 \x03(\tR\x04from\x12:\n" +
+	"\x18useExistingChangeAddress\x18\x04 \x01(\bR\x18useExistingChangeAddress\x12\x1c\n" +
+	"\tisSendAll\x18\x05 \x01(\bR\tisSendAll\x123\n" +
+	"\aoutputs\x18\x06 \x03(\v2\x19.htnwalletd.PaymentOutputR\aoutputs\x122\n" +
+	"\toutpoints\x18\a \x03(\v2\x14.htnwalletd.OutpointR\toutpoints\"A\n" +
+	"\rPaymentOutput\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"X\n" +
+	"\"CreateUnsignedTransactionsResponse\x122\n" +
+	"\x14unsignedTransactions\x18\x01 \x03(\fR\x14unsignedTransactions\"\x94\x01\n" +
+	"(CreateUnsignedCompoundTransactionRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04from\x18\x02 \x03(\tR\x04from\x12:\n" +
+	"\x18useExistingChangeAddress\x18\x03 \x01(\bR\x18useExistingChangeAddress\"_\n" +
+	")CreateUnsignedCompoundTransactionResponse\x122\n" +
+	"\x14unsignedTransactions\x18\x01 \x03(\fR\x14unsignedTransactions\"\x16\n" +
+	"\x14ShowAddressesRequest\"1\n" +
+	"\x15ShowAddressesResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x03(\tR\aaddress\"\x13\n" +
+	"\x11NewAddressRequest\".\n" +
+	"\x12NewAddressResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"R\n" +
+	"\x10BroadcastRequest\x12\x1a\n" +
+	"\bisDomain\x18\x01 \x01(\bR\bisDomain\x12\"\n" +
+	"\ftransactions\x18\x02 \x03(\fR\ftransactions\")\n" +
 	"\x11BroadcastResponse\x12\x14\n" +
 	"\x05txIDs\x18\x01 \x03(\tR\x05txIDs\"\x11\n" +
 	"\x0fShutdownRequest\"\x12\n" +
@@ -1425,14 +3446,16 @@ const file_htnwalletd_proto_rawDesc = "" +
 	" GetExternalSpendableUTXOsRequest\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\"`\n" +
 	"!GetExternalSpendableUTXOsResponse\x12;\n" +
-	"\aEntries\x18\x01 \x03(\v2!.htnwalletd.UtxosByAddressesEntryR\aEntries\"\xcd\x01\n" +
+	"\aEntries\x18\x01 \x03(\v2!.htnwalletd.UtxosByAddressesEntryR\aEntries\"\xb6\x02\n" +
 	"\vSendRequest\x12\x1c\n" +
 	"\ttoAddress\x18\x01 \x01(\tR\ttoAddress\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\x04R\x06amount\x12\x1a\n" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x12\n" +
 	"\x04from\x18\x04 \x03(\tR\x04from\x12:\n" +
 	"\x18useExistingChangeAddress\x18\x05 \x01(\bR\x18useExistingChangeAddress\x12\x1c\n" +
-	"\tisSendAll\x18\x06 \x01(\bR\tisSendAll\"T\n" +
+	"\tisSendAll\x18\x06 \x01(\bR\tisSendAll\x123\n" +
+	"\aoutputs\x18\a \x03(\v2\x19.htnwalletd.PaymentOutputR\aoutputs\x122\n" +
+	"\toutpoints\x18\b \x03(\v2\x14.htnwalletd.OutpointR\toutpoints\"T\n" +
 	"\fSendResponse\x12\x14\n" +
 	"\x05txIDs\x18\x01 \x03(\tR\x05txIDs\x12.\n" +
 	"\x12signedTransactions\x18\x02 \x03(\fR\x12signedTransactions\"]\n" +
@@ -1443,7 +3466,138 @@ const file_htnwalletd_proto_rawDesc = "" +
 	"\x12signedTransactions\x18\x01 \x03(\fR\x12signedTransactions\"\x13\n" +
 	"\x11GetVersionRequest\".\n" +
 	"\x12GetVersionResponse\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\tR\aversion2\xf0\a\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\"\x93\x03\n" +
+	"\x11WalletTransaction\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x1c\n" +
+	"\tdirection\x18\x02 \x01(\tR\tdirection\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\x12\x10\n" +
+	"\x03fee\x18\x05 \x01(\x04R\x03fee\x12,\n" +
+	"\x11acceptingDaaScore\x18\x06 \x01(\x04R\x11acceptingDaaScore\x12$\n" +
+	"\rconfirmations\x18\a \x01(\x04R\rconfirmations\x12\x1e\n" +
+	"\n" +
+	"isCoinbase\x18\b \x01(\bR\n" +
+	"isCoinbase\x12&\n" +
+	"\x0ecounterparties\x18\t \x03(\tR\x0ecounterparties\x12(\n" +
+	"\x0fwalletAddresses\x18\n" +
+	" \x03(\tR\x0fwalletAddresses\x12\x14\n" +
+	"\x05label\x18\v \x01(\tR\x05label\x12\x1c\n" +
+	"\ttimestamp\x18\f \x01(\x03R\ttimestamp\"F\n" +
+	"\x16GetTransactionsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\rR\x06offset\"r\n" +
+	"\x17GetTransactionsResponse\x12A\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1d.htnwalletd.WalletTransactionR\ftransactions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"=\n" +
+	"\x15GetTransactionRequest\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\"Y\n" +
+	"\x16GetTransactionResponse\x12?\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x1d.htnwalletd.WalletTransactionR\vtransaction\"X\n" +
+	"\x1aSetTransactionLabelRequest\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\"\x1d\n" +
+	"\x1bSetTransactionLabelResponse\"d\n" +
+	"\x12SignMessageRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"3\n" +
+	"\x13SignMessageResponse\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\fR\tsignature\"h\n" +
+	"\x14VerifyMessageRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\fR\tsignature\"1\n" +
+	"\x15VerifyMessageResponse\x12\x18\n" +
+	"\aisValid\x18\x01 \x01(\bR\aisValid\"7\n" +
+	"\x19NewTimeLockAddressRequest\x12\x1a\n" +
+	"\blockTime\x18\x01 \x01(\x04R\blockTime\"Z\n" +
+	"\x1aNewTimeLockAddressResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\"\n" +
+	"\fredeemScript\x18\x02 \x01(\tR\fredeemScript\"l\n" +
+	"\x16NewVaultAddressRequest\x12\x14\n" +
+	"\x05delay\x18\x01 \x01(\x04R\x05delay\x12<\n" +
+	"\x19recoveryExtendedPublicKey\x18\x02 \x01(\tR\x19recoveryExtendedPublicKey\"W\n" +
+	"\x17NewVaultAddressResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\"\n" +
+	"\fredeemScript\x18\x02 \x01(\tR\fredeemScript\"\x19\n" +
+	"\x17GetLockedOutputsRequest\"\xfa\x01\n" +
+	"\fLockedOutput\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x120\n" +
+	"\boutpoint\x18\x03 \x01(\v2\x14.htnwalletd.OutpointR\boutpoint\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\x12&\n" +
+	"\x0eunlockDaaScore\x18\x05 \x01(\x04R\x0eunlockDaaScore\x12(\n" +
+	"\x0funlockTimestamp\x18\x06 \x01(\x03R\x0funlockTimestamp\x12 \n" +
+	"\visSpendable\x18\a \x01(\bR\visSpendable\"N\n" +
+	"\x18GetLockedOutputsResponse\x122\n" +
+	"\aoutputs\x18\x01 \x03(\v2\x18.htnwalletd.LockedOutputR\aoutputs\"\x87\x01\n" +
+	"-CreateUnsignedLockedOutputsTransactionRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1c\n" +
+	"\ttoAddress\x18\x02 \x01(\tR\ttoAddress\x12\x1e\n" +
+	"\n" +
+	"isRecovery\x18\x03 \x01(\bR\n" +
+	"isRecovery\"b\n" +
+	".CreateUnsignedLockedOutputsTransactionResponse\x120\n" +
+	"\x13unsignedTransaction\x18\x01 \x01(\fR\x13unsignedTransaction\"x\n" +
+	"\x0eNewHTLCRequest\x12*\n" +
+	"\x10recipientAddress\x18\x01 \x01(\tR\x10recipientAddress\x12\x1e\n" +
+	"\n" +
+	"secretHash\x18\x02 \x01(\fR\n" +
+	"secretHash\x12\x1a\n" +
+	"\blockTime\x18\x03 \x01(\x04R\blockTime\"m\n" +
+	"\x0fNewHTLCResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1a\n" +
+	"\bcontract\x18\x02 \x01(\fR\bcontract\x12$\n" +
+	"\rrefundAddress\x18\x03 \x01(\tR\rrefundAddress\".\n" +
+	"\x10AuditHTLCRequest\x12\x1a\n" +
+	"\bcontract\x18\x01 \x01(\fR\bcontract\"V\n" +
+	"\n" +
+	"HTLCOutput\x120\n" +
+	"\boutpoint\x18\x01 \x01(\v2\x14.htnwalletd.OutpointR\boutpoint\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x04R\x06amount\"\xaf\x02\n" +
+	"\x11AuditHTLCResponse\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12*\n" +
+	"\x10recipientAddress\x18\x02 \x01(\tR\x10recipientAddress\x12$\n" +
+	"\rrefundAddress\x18\x03 \x01(\tR\rrefundAddress\x12\x1e\n" +
+	"\n" +
+	"secretHash\x18\x04 \x01(\fR\n" +
+	"secretHash\x12\x1a\n" +
+	"\blockTime\x18\x05 \x01(\x04R\blockTime\x120\n" +
+	"\aoutputs\x18\x06 \x03(\v2\x16.htnwalletd.HTLCOutputR\aoutputs\x12 \n" +
+	"\visRecipient\x18\a \x01(\bR\visRecipient\x12\x1e\n" +
+	"\n" +
+	"isRefunder\x18\b \x01(\bR\n" +
+	"isRefunder\"\x94\x01\n" +
+	"$CreateUnsignedHTLCTransactionRequest\x12\x1a\n" +
+	"\bcontract\x18\x01 \x01(\fR\bcontract\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\fR\x06secret\x12\x1a\n" +
+	"\bisRefund\x18\x03 \x01(\bR\bisRefund\x12\x1c\n" +
+	"\ttoAddress\x18\x04 \x01(\tR\ttoAddress\"Y\n" +
+	"%CreateUnsignedHTLCTransactionResponse\x120\n" +
+	"\x13unsignedTransaction\x18\x01 \x01(\fR\x13unsignedTransaction\"&\n" +
+	"\x10ListUTXOsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x03(\tR\x04from\"\xa4\x02\n" +
+	"\n" +
+	"WalletUTXO\x120\n" +
+	"\boutpoint\x18\x01 \x01(\v2\x14.htnwalletd.OutpointR\boutpoint\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x04R\x06amount\x12$\n" +
+	"\rblockDaaScore\x18\x04 \x01(\x04R\rblockDaaScore\x12\x10\n" +
+	"\x03age\x18\x05 \x01(\x04R\x03age\x12\x1e\n" +
+	"\n" +
+	"isCoinbase\x18\x06 \x01(\bR\n" +
+	"isCoinbase\x12 \n" +
+	"\visSpendable\x18\a \x01(\bR\visSpendable\x12\x1a\n" +
+	"\bisLocked\x18\b \x01(\bR\bisLocked\x12\x1c\n" +
+	"\tisPending\x18\t \x01(\bR\tisPending\"A\n" +
+	"\x11ListUTXOsResponse\x12,\n" +
+	"\x05utxos\x18\x01 \x03(\v2\x16.htnwalletd.WalletUTXOR\x05utxos\"F\n" +
+	"\x10LockUTXOsRequest\x122\n" +
+	"\toutpoints\x18\x01 \x03(\v2\x14.htnwalletd.OutpointR\toutpoints\"\x13\n" +
+	"\x11LockUTXOsResponse\"H\n" +
+	"\x12UnlockUTXOsRequest\x122\n" +
+	"\toutpoints\x18\x01 \x03(\v2\x14.htnwalletd.OutpointR\toutpoints\"\x15\n" +
+	"\x13UnlockUTXOsResponse2\x8c\x13\n" +
 	"\n" +
 	"htnwalletd\x12M\n" +
 	"\n" +
@@ -1459,7 +3613,22 @@ const file_htnwalletd_proto_rawDesc = "" +
 	"\x04Sign\x12\x17.htnwalletd.SignRequest\x1a\x18.htnwalletd.SignResponse\"\x00\x12M\n" +
 	"\n" +
 	"GetVersion\x12\x1d.htnwalletd.GetVersionRequest\x1a\x1e.htnwalletd.GetVersionResponse\"\x00\x12\x92\x01\n" +
-	"!CreateUnsignedCompoundTransaction\x124.htnwalletd.CreateUnsignedCompoundTransactionRequest\x1a5.htnwalletd.CreateUnsignedCompoundTransactionResponse\"\x00B3Z1github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pbb\x06proto3"
+	"!CreateUnsignedCompoundTransaction\x124.htnwalletd.CreateUnsignedCompoundTransactionRequest\x1a5.htnwalletd.CreateUnsignedCompoundTransactionResponse\"\x00\x12\\\n" +
+	"\x0fGetTransactions\x12\".htnwalletd.GetTransactionsRequest\x1a#.htnwalletd.GetTransactionsResponse\"\x00\x12Y\n" +
+	"\x0eGetTransaction\x12!.htnwalletd.GetTransactionRequest\x1a\".htnwalletd.GetTransactionResponse\"\x00\x12h\n" +
+	"\x13SetTransactionLabel\x12&.htnwalletd.SetTransactionLabelRequest\x1a'.htnwalletd.SetTransactionLabelResponse\"\x00\x12P\n" +
+	"\vSignMessage\x12\x1e.htnwalletd.SignMessageRequest\x1a\x1f.htnwalletd.SignMessageResponse\"\x00\x12V\n" +
+	"\rVerifyMessage\x12 .htnwalletd.VerifyMessageRequest\x1a!.htnwalletd.VerifyMessageResponse\"\x00\x12e\n" +
+	"\x12NewTimeLockAddress\x12%.htnwalletd.NewTimeLockAddressRequest\x1a&.htnwalletd.NewTimeLockAddressResponse\"\x00\x12\\\n" +
+	"\x0fNewVaultAddress\x12\".htnwalletd.NewVaultAddressRequest\x1a#.htnwalletd.NewVaultAddressResponse\"\x00\x12_\n" +
+	"\x10GetLockedOutputs\x12#.htnwalletd.GetLockedOutputsRequest\x1a$.htnwalletd.GetLockedOutputsResponse\"\x00\x12\xa1\x01\n" +
+	"&CreateUnsignedLockedOutputsTransaction\x129.htnwalletd.CreateUnsignedLockedOutputsTransactionRequest\x1a:.htnwalletd.CreateUnsignedLockedOutputsTransactionResponse\"\x00\x12D\n" +
+	"\aNewHTLC\x12\x1a.htnwalletd.NewHTLCRequest\x1a\x1b.htnwalletd.NewHTLCResponse\"\x00\x12J\n" +
+	"\tAuditHTLC\x12\x1c.htnwalletd.AuditHTLCRequest\x1a\x1d.htnwalletd.AuditHTLCResponse\"\x00\x12\x86\x01\n" +
+	"\x1dCreateUnsignedHTLCTransaction\x120.htnwalletd.CreateUnsignedHTLCTransactionRequest\x1a1.htnwalletd.CreateUnsignedHTLCTransactionResponse\"\x00\x12J\n" +
+	"\tListUTXOs\x12\x1c.htnwalletd.ListUTXOsRequest\x1a\x1d.htnwalletd.ListUTXOsResponse\"\x00\x12J\n" +
+	"\tLockUTXOs\x12\x1c.htnwalletd.LockUTXOsRequest\x1a\x1d.htnwalletd.LockUTXOsResponse\"\x00\x12P\n" +
+	"\vUnlockUTXOs\x12\x1e.htnwalletd.UnlockUTXOsRequest\x1a\x1f.htnwalletd.UnlockUTXOsResponse\"\x00B3Z1github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pbb\x06proto3"
 
 var (
 	file_htnwalletd_proto_rawDescOnce sync.Once
@@ -1473,69 +3642,148 @@ func file_htnwalletd_proto_rawDescGZIP() []byte {
 	return file_htnwalletd_proto_rawDescData
 }
 
-var file_htnwalletd_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_htnwalletd_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_htnwalletd_proto_goTypes = []any{
-	(*GetBalanceRequest)(nil),                         // 0: htnwalletd.GetBalanceRequest
-	(*GetBalanceResponse)(nil),                        // 1: htnwalletd.GetBalanceResponse
-	(*AddressBalances)(nil),                           // 2: htnwalletd.AddressBalances
-	(*CreateUnsignedTransactionsRequest)(nil),         // 3: htnwalletd.CreateUnsignedTransactionsRequest
-	(*CreateUnsignedTransactionsResponse)(nil),        // 4: htnwalletd.CreateUnsignedTransactionsResponse
-	(*CreateUnsignedCompoundTransactionRequest)(nil),  // 5: htnwalletd.CreateUnsignedCompoundTransactionRequest
-	(*CreateUnsignedCompoundTransactionResponse)(nil), // 6: htnwalletd.CreateUnsignedCompoundTransactionResponse
-	(*ShowAddressesRequest)(nil),                      // 7: htnwalletd.ShowAddressesRequest
-	(*ShowAddressesResponse)(nil),                     // 8: htnwalletd.ShowAddressesResponse
-	(*NewAddressRequest)(nil),                         // 9: htnwalletd.NewAddressRequest
-	(*NewAddressResponse)(nil),                        // 10: htnwalletd.NewAddressResponse
-	(*BroadcastRequest)(nil),                          // 11: htnwalletd.BroadcastRequest
-	(*BroadcastResponse)(nil),                         // 12: htnwalletd.BroadcastResponse
-	(*ShutdownRequest)(nil),                           // 13: htnwalletd.ShutdownRequest
-	(*ShutdownResponse)(nil),                          // 14: htnwalletd.ShutdownResponse
-	(*Outpoint)(nil),                                  // 15: htnwalletd.Outpoint
-	(*UtxosByAddressesEntry)(nil),                     // 16: htnwalletd.UtxosByAddressesEntry
-	(*ScriptPublicKey)(nil),                           // 17: htnwalletd.ScriptPublicKey
-	(*UtxoEntry)(nil),                                 // 18: htnwalletd.UtxoEntry
-	(*GetExternalSpendableUTXOsRequest)(nil),          // 19: htnwalletd.GetExternalSpendableUTXOsRequest
-	(*GetExternalSpendableUTXOsResponse)(nil),         // 20: htnwalletd.GetExternalSpendableUTXOsResponse
-	(*SendRequest)(nil),                               // 21: htnwalletd.SendRequest
-	(*SendResponse)(nil),                              // 22: htnwalletd.SendResponse
-	(*SignRequest)(nil),                               // 23: htnwalletd.SignRequest
-	(*SignResponse)(nil),                              // 24: htnwalletd.SignResponse
-	(*GetVersionRequest)(nil),                         // 25: htnwalletd.GetVersionRequest
-	(*GetVersionResponse)(nil),                        // 26: htnwalletd.GetVersionResponse
+	(*GetBalanceRequest)(nil),                              // 0: htnwalletd.GetBalanceRequest
+	(*GetBalanceResponse)(nil),                             // 1: htnwalletd.GetBalanceResponse
+	(*AddressBalances)(nil),                                // 2: htnwalletd.AddressBalances
+	(*CreateUnsignedTransactionsRequest)(nil),              // 3: htnwalletd.CreateUnsignedTransactionsRequest
+	(*PaymentOutput)(nil),                                  // 4: htnwalletd.PaymentOutput
+	(*CreateUnsignedTransactionsResponse)(nil),             // 5: htnwalletd.CreateUnsignedTransactionsResponse
+	(*CreateUnsignedCompoundTransactionRequest)(nil),       // 6: htnwalletd.CreateUnsignedCompoundTransactionRequest
+	(*CreateUnsignedCompoundTransactionResponse)(nil),      // 7: htnwalletd.CreateUnsignedCompoundTransactionResponse
+	(*ShowAddressesRequest)(nil),                           // 8: htnwalletd.ShowAddressesRequest
+	(*ShowAddressesResponse)(nil),                          // 9: htnwalletd.ShowAddressesResponse
+	(*NewAddressRequest)(nil),                              // 10: htnwalletd.NewAddressRequest
+	(*NewAddressResponse)(nil),                             // 11: htnwalletd.NewAddressResponse
+	(*BroadcastRequest)(nil),                               // 12: htnwalletd.BroadcastRequest
+	(*BroadcastResponse)(nil),                              // 13: htnwalletd.BroadcastResponse
+	(*ShutdownRequest)(nil),                                // 14: htnwalletd.ShutdownRequest
+	(*ShutdownResponse)(nil),                               // 15: htnwalletd.ShutdownResponse
+	(*Outpoint)(nil),                                       // 16: htnwalletd.Outpoint
+	(*UtxosByAddressesEntry)(nil),                          // 17: htnwalletd.UtxosByAddressesEntry
+	(*ScriptPublicKey)(nil),                                // 18: htnwalletd.ScriptPublicKey
+	(*UtxoEntry)(nil),                                      // 19: htnwalletd.UtxoEntry
+	(*GetExternalSpendableUTXOsRequest)(nil),               // 20: htnwalletd.GetExternalSpendableUTXOsRequest
+	(*GetExternalSpendableUTXOsResponse)(nil),              // 21: htnwalletd.GetExternalSpendableUTXOsResponse
+	(*SendRequest)(nil),                                    // 22: htnwalletd.SendRequest
+	(*SendResponse)(nil),                                   // 23: htnwalletd.SendResponse
+	(*SignRequest)(nil),                                    // 24: htnwalletd.SignRequest
+	(*SignResponse)(nil),                                   // 25: htnwalletd.SignResponse
+	(*GetVersionRequest)(nil),                              // 26: htnwalletd.GetVersionRequest
+	(*GetVersionResponse)(nil),                             // 27: htnwalletd.GetVersionResponse
+	(*WalletTransaction)(nil),                              // 28: htnwalletd.WalletTransaction
+	(*GetTransactionsRequest)(nil),                         // 29: htnwalletd.GetTransactionsRequest
+	(*GetTransactionsResponse)(nil),                        // 30: htnwalletd.GetTransactionsResponse
+	(*GetTransactionRequest)(nil),                          // 31: htnwalletd.GetTransactionRequest
+	(*GetTransactionResponse)(nil),                         // 32: htnwalletd.GetTransactionResponse
+	(*SetTransactionLabelRequest)(nil),                     // 33: htnwalletd.SetTransactionLabelRequest
+	(*SetTransactionLabelResponse)(nil),                    // 34: htnwalletd.SetTransactionLabelResponse
+	(*SignMessageRequest)(nil),                             // 35: htnwalletd.SignMessageRequest
+	(*SignMessageResponse)(nil),                            // 36: htnwalletd.SignMessageResponse
+	(*VerifyMessageRequest)(nil),                           // 37: htnwalletd.VerifyMessageRequest
+	(*VerifyMessageResponse)(nil),                          // 38: htnwalletd.VerifyMessageResponse
+	(*NewTimeLockAddressRequest)(nil),                      // 39: htnwalletd.NewTimeLockAddressRequest
+	(*NewTimeLockAddressResponse)(nil),                     // 40: htnwalletd.NewTimeLockAddressResponse
+	(*NewVaultAddressRequest)(nil),                         // 41: htnwalletd.NewVaultAddressRequest
+	(*NewVaultAddressResponse)(nil),                        // 42: htnwalletd.NewVaultAddressResponse
+	(*GetLockedOutputsRequest)(nil),                        // 43: htnwalletd.GetLockedOutputsRequest
+	(*LockedOutput)(nil),                                   // 44: htnwalletd.LockedOutput
+	(*GetLockedOutputsResponse)(nil),                       // 45: htnwalletd.GetLockedOutputsResponse
+	(*CreateUnsignedLockedOutputsTransactionRequest)(nil),  // 46: htnwalletd.CreateUnsignedLockedOutputsTransactionRequest
+	(*CreateUnsignedLockedOutputsTransactionResponse)(nil), // 47: htnwalletd.CreateUnsignedLockedOutputsTransactionResponse
+	(*NewHTLCRequest)(nil),                                 // 48: htnwalletd.NewHTLCRequest
+	(*NewHTLCResponse)(nil),                                // 49: htnwalletd.NewHTLCResponse
+	(*AuditHTLCRequest)(nil),                               // 50: htnwalletd.AuditHTLCRequest
+	(*HTLCOutput)(nil),                                     // 51: htnwalletd.HTLCOutput
+	(*AuditHTLCResponse)(nil),                              // 52: htnwalletd.AuditHTLCResponse
+	(*CreateUnsignedHTLCTransactionRequest)(nil),           // 53: htnwalletd.CreateUnsignedHTLCTransactionRequest
+	(*CreateUnsignedHTLCTransactionResponse)(nil),          // 54: htnwalletd.CreateUnsignedHTLCTransactionResponse
+	(*ListUTXOsRequest)(nil),                               // 55: htnwalletd.ListUTXOsRequest
+	(*WalletUTXO)(nil),                                     // 56: htnwalletd.WalletUTXO
+	(*ListUTXOsResponse)(nil),                              // 57: htnwalletd.ListUTXOsResponse
+	(*LockUTXOsRequest)(nil),                               // 58: htnwalletd.LockUTXOsRequest
+	(*LockUTXOsResponse)(nil),                              // 59: htnwalletd.LockUTXOsResponse
+	(*UnlockUTXOsRequest)(nil),                             // 60: htnwalletd.UnlockUTXOsRequest
+	(*UnlockUTXOsResponse)(nil),                            // 61: htnwalletd.UnlockUTXOsResponse
 }
 var file_htnwalletd_proto_depIdxs = []int32{
 	2,  // 0: htnwalletd.GetBalanceResponse.addressBalances:type_name -> htnwalletd.AddressBalances
-	15, // 1: htnwalletd.UtxosByAddressesEntry.outpoint:type_name -> htnwalletd.Outpoint
-	18, // 2: htnwalletd.UtxosByAddressesEntry.utxoEntry:type_name -> htnwalletd.UtxoEntry
-	17, // 3: htnwalletd.UtxoEntry.scriptPublicKey:type_name -> htnwalletd.ScriptPublicKey
-	16, // 4: htnwalletd.GetExternalSpendableUTXOsResponse.Entries:type_name -> htnwalletd.UtxosByAddressesEntry
-	0,  // 5: htnwalletd.htnwalletd.GetBalance:input_type -> htnwalletd.GetBalanceRequest
-	19, // 6: htnwalletd.htnwalletd.GetExternalSpendableUTXOs:input_type -> htnwalletd.GetExternalSpendableUTXOsRequest
-	3,  // 7: htnwalletd.htnwalletd.CreateUnsignedTransactions:input_type -> htnwalletd.CreateUnsignedTransactionsRequest
-	7,  // 8: htnwalletd.htnwalletd.ShowAddresses:input_type -> htnwalletd.ShowAddressesRequest
-	9,  // 9: htnwalletd.htnwalletd.NewAddress:input_type -> htnwalletd.NewAddressRequest
-	13, // 10: htnwalletd.htnwalletd.Shutdown:input_type -> htnwalletd.ShutdownRequest
-	11, // 11: htnwalletd.htnwalletd.Broadcast:input_type -> htnwalletd.BroadcastRequest
-	21, // 12: htnwalletd.htnwalletd.Send:input_type -> htnwalletd.SendRequest
-	23, // 13: htnwalletd.htnwalletd.Sign:input_type -> htnwalletd.SignRequest
-	25, // 14: htnwalletd.htnwalletd.GetVersion:input_type -> htnwalletd.GetVersionRequest
-	5,  // 15: htnwalletd.htnwalletd.CreateUnsignedCompoundTransaction:input_type -> htnwalletd.CreateUnsignedCompoundTransactionRequest
-	1,  // 16: htnwalletd.htnwalletd.GetBalance:output_type -> htnwalletd.GetBalanceResponse
-	20, // 17: htnwalletd.htnwalletd.GetExternalSpendableUTXOs:output_type -> htnwalletd.GetExternalSpendableUTXOsResponse
-	4,  // 18: htnwalletd.htnwalletd.CreateUnsignedTransactions:output_type -> htnwalletd.CreateUnsignedTransactionsResponse
-	8,  // 19: htnwalletd.htnwalletd.ShowAddresses:output_type -> htnwalletd.ShowAddressesResponse
-	10, // 20: htnwalletd.htnwalletd.NewAddress:output_type -> htnwalletd.NewAddressResponse
-	14, // 21: htnwalletd.htnwalletd.Shutdown:output_type -> htnwalletd.ShutdownResponse
-	12, // 22: htnwalletd.htnwalletd.Broadcast:output_type -> htnwalletd.BroadcastResponse
-	22, // 23: htnwalletd.htnwalletd.Send:output_type -> htnwalletd.SendResponse
-	24, // 24: htnwalletd.htnwalletd.Sign:output_type -> htnwalletd.SignResponse
-	26, // 25: htnwalletd.htnwalletd.GetVersion:output_type -> htnwalletd.GetVersionResponse
-	6,  // 26: htnwalletd.htnwalletd.CreateUnsignedCompoundTransaction:output_type -> htnwalletd.CreateUnsignedCompoundTransactionResponse
-	16, // [16:27] is the sub-list for method output_type
-	5,  // [5:16] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	4,  // 1: htnwalletd.CreateUnsignedTransactionsRequest.outputs:type_name -> htnwalletd.PaymentOutput
+	16, // 2: htnwalletd.CreateUnsignedTransactionsRequest.outpoints:type_name -> htnwalletd.Outpoint
+	16, // 3: htnwalletd.UtxosByAddressesEntry.outpoint:type_name -> htnwalletd.Outpoint
+	19, // 4: htnwalletd.UtxosByAddressesEntry.utxoEntry:type_name -> htnwalletd.UtxoEntry
+	18, // 5: htnwalletd.UtxoEntry.scriptPublicKey:type_name -> htnwalletd.ScriptPublicKey
+	17, // 6: htnwalletd.GetExternalSpendableUTXOsResponse.Entries:type_name -> htnwalletd.UtxosByAddressesEntry
+	4,  // 7: htnwalletd.SendRequest.outputs:type_name -> htnwalletd.PaymentOutput
+	16, // 8: htnwalletd.SendRequest.outpoints:type_name -> htnwalletd.Outpoint
+	28, // 9: htnwalletd.GetTransactionsResponse.transactions:type_name -> htnwalletd.WalletTransaction
+	28, // 10: htnwalletd.GetTransactionResponse.transaction:type_name -> htnwalletd.WalletTransaction
+	16, // 11: htnwalletd.LockedOutput.outpoint:type_name -> htnwalletd.Outpoint
+	44, // 12: htnwalletd.GetLockedOutputsResponse.outputs:type_name -> htnwalletd.LockedOutput
+	16, // 13: htnwalletd.HTLCOutput.outpoint:type_name -> htnwalletd.Outpoint
+	51, // 14: htnwalletd.AuditHTLCResponse.outputs:type_name -> htnwalletd.HTLCOutput
+	16, // 15: htnwalletd.WalletUTXO.outpoint:type_name -> htnwalletd.Outpoint
+	56, // 16: htnwalletd.ListUTXOsResponse.utxos:type_name -> htnwalletd.WalletUTXO
+	16, // 17: htnwalletd.LockUTXOsRequest.outpoints:type_name -> htnwalletd.Outpoint
+	16, // 18: htnwalletd.UnlockUTXOsRequest.outpoints:type_name -> htnwalletd.Outpoint
+	0,  // 19: htnwalletd.htnwalletd.GetBalance:input_type -> htnwalletd.GetBalanceRequest
+	20, // 20: htnwalletd.htnwalletd.GetExternalSpendableUTXOs:input_type -> htnwalletd.GetExternalSpendableUTXOsRequest
+	3,  // 21: htnwalletd.htnwalletd.CreateUnsignedTransactions:input_type -> htnwalletd.CreateUnsignedTransactionsRequest
+	8,  // 22: htnwalletd.htnwalletd.ShowAddresses:input_type -> htnwalletd.ShowAddressesRequest
+	10, // 23: htnwalletd.htnwalletd.NewAddress:input_type -> htnwalletd.NewAddressRequest
+	14, // 24: htnwalletd.htnwalletd.Shutdown:input_type -> htnwalletd.ShutdownRequest
+	12, // 25: htnwalletd.htnwalletd.Broadcast:input_type -> htnwalletd.BroadcastRequest
+	22, // 26: htnwalletd.htnwalletd.Send:input_type -> htnwalletd.SendRequest
+	24, // 27: htnwalletd.htnwalletd.Sign:input_type -> htnwalletd.SignRequest
+	26, // 28: htnwalletd.htnwalletd.GetVersion:input_type -> htnwalletd.GetVersionRequest
+	6,  // 29: htnwalletd.htnwalletd.CreateUnsignedCompoundTransaction:input_type -> htnwalletd.CreateUnsignedCompoundTransactionRequest
+	29, // 30: htnwalletd.htnwalletd.GetTransactions:input_type -> htnwalletd.GetTransactionsRequest
+	31, // 31: htnwalletd.htnwalletd.GetTransaction:input_type -> htnwalletd.GetTransactionRequest
+	33, // 32: htnwalletd.htnwalletd.SetTransactionLabel:input_type -> htnwalletd.SetTransactionLabelRequest
+	35, // 33: htnwalletd.htnwalletd.SignMessage:input_type -> htnwalletd.SignMessageRequest
+	37, // 34: htnwalletd.htnwalletd.VerifyMessage:input_type -> htnwalletd.VerifyMessageRequest
+	39, // 35: htnwalletd.htnwalletd.NewTimeLockAddress:input_type -> htnwalletd.NewTimeLockAddressRequest
+	41, // 36: htnwalletd.htnwalletd.NewVaultAddress:input_type -> htnwalletd.NewVaultAddressRequest
+	43, // 37: htnwalletd.htnwalletd.GetLockedOutputs:input_type -> htnwalletd.GetLockedOutputsRequest
+	46, // 38: htnwalletd.htnwalletd.CreateUnsignedLockedOutputsTransaction:input_type -> htnwalletd.CreateUnsignedLockedOutputsTransactionRequest
+	48, // 39: htnwalletd.htnwalletd.NewHTLC:input_type -> htnwalletd.NewHTLCRequest
+	50, // 40: htnwalletd.htnwalletd.AuditHTLC:input_type -> htnwalletd.AuditHTLCRequest
+	53, // 41: htnwalletd.htnwalletd.CreateUnsignedHTLCTransaction:input_type -> htnwalletd.CreateUnsignedHTLCTransactionRequest
+	55, // 42: htnwalletd.htnwalletd.ListUTXOs:input_type -> htnwalletd.ListUTXOsRequest
+	58, // 43: htnwalletd.htnwalletd.LockUTXOs:input_type -> htnwalletd.LockUTXOsRequest
+	60, // 44: htnwalletd.htnwalletd.UnlockUTXOs:input_type -> htnwalletd.UnlockUTXOsRequest
+	1,  // 45: htnwalletd.htnwalletd.GetBalance:output_type -> htnwalletd.GetBalanceResponse
+	21, // 46: htnwalletd.htnwalletd.GetExternalSpendableUTXOs:output_type -> htnwalletd.GetExternalSpendableUTXOsResponse
+	5,  // 47: htnwalletd.htnwalletd.CreateUnsignedTransactions:output_type -> htnwalletd.CreateUnsignedTransactionsResponse
+	9,  // 48: htnwalletd.htnwalletd.ShowAddresses:output_type -> htnwalletd.ShowAddressesResponse
+	11, // 49: htnwalletd.htnwalletd.NewAddress:output_type -> htnwalletd.NewAddressResponse
+	15, // 50: htnwalletd.htnwalletd.Shutdown:output_type -> htnwalletd.ShutdownResponse
+	13, // 51: htnwalletd.htnwalletd.Broadcast:output_type -> htnwalletd.BroadcastResponse
+	23, // 52: htnwalletd.htnwalletd.Send:output_type -> htnwalletd.SendResponse
+	25, // 53: htnwalletd.htnwalletd.Sign:output_type -> htnwalletd.SignResponse
+	27, // 54: htnwalletd.htnwalletd.GetVersion:output_type -> htnwalletd.GetVersionResponse
+	7,  // 55: htnwalletd.htnwalletd.CreateUnsignedCompoundTransaction:output_type -> htnwalletd.CreateUnsignedCompoundTransactionResponse
+	30, // 56: htnwalletd.htnwalletd.GetTransactions:output_type -> htnwalletd.GetTransactionsResponse
+	32, // 57: htnwalletd.htnwalletd.GetTransaction:output_type -> htnwalletd.GetTransactionResponse
+	34, // 58: htnwalletd.htnwalletd.SetTransactionLabel:output_type -> htnwalletd.SetTransactionLabelResponse
+	36, // 59: htnwalletd.htnwalletd.SignMessage:output_type -> htnwalletd.SignMessageResponse
+	38, // 60: htnwalletd.htnwalletd.VerifyMessage:output_type -> htnwalletd.VerifyMessageResponse
+	40, // 61: htnwalletd.htnwalletd.NewTimeLockAddress:output_type -> htnwalletd.NewTimeLockAddressResponse
+	42, // 62: htnwalletd.htnwalletd.NewVaultAddress:output_type -> htnwalletd.NewVaultAddressResponse
+	45, // 63: htnwalletd.htnwalletd.GetLockedOutputs:output_type -> htnwalletd.GetLockedOutputsResponse
+	47, // 64: htnwalletd.htnwalletd.CreateUnsignedLockedOutputsTransaction:output_type -> htnwalletd.CreateUnsignedLockedOutputsTransactionResponse
+	49, // 65: htnwalletd.htnwalletd.NewHTLC:output_type -> htnwalletd.NewHTLCResponse
+	52, // 66: htnwalletd.htnwalletd.AuditHTLC:output_type -> htnwalletd.AuditHTLCResponse
+	54, // 67: htnwalletd.htnwalletd.CreateUnsignedHTLCTransaction:output_type -> htnwalletd.CreateUnsignedHTLCTransactionResponse
+	57, // 68: htnwalletd.htnwalletd.ListUTXOs:output_type -> htnwalletd.ListUTXOsResponse
+	59, // 69: htnwalletd.htnwalletd.LockUTXOs:output_type -> htnwalletd.LockUTXOsResponse
+	61, // 70: htnwalletd.htnwalletd.UnlockUTXOs:output_type -> htnwalletd.UnlockUTXOsResponse
+	45, // [45:71] is the sub-list for method output_type
+	19, // [19:45] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_htnwalletd_proto_init() }
@@ -1549,7 +3797,7 @@ func file_htnwalletd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_htnwalletd_proto_rawDesc), len(file_htnwalletd_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,17 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Htnwalletd_GetBalance_FullMethodName                        = "/htnwalletd.htnwalletd/GetBalance"
-	Htnwalletd_GetExternalSpendableUTXOs_FullMethodName         = "/htnwalletd.htnwalletd/GetExternalSpendableUTXOs"
-	Htnwalletd_CreateUnsignedTransactions_FullMethodName        = "/htnwalletd.htnwalletd/CreateUnsignedTransactions"
-	Htnwalletd_ShowAddresses_FullMethodName                     = "/htnwalletd.htnwalletd/ShowAddresses"
-	Htnwalletd_NewAddress_FullMethodName                        = "/htnwalletd.htnwalletd/NewAddress"
-	Htnwalletd_Shutdown_FullMethodName                          = "/htnwalletd.htnwalletd/Shutdown"
-	Htnwalletd_Broadcast_FullMethodName                         = "/htnwalletd.htnwalletd/Broadcast"
-	Htnwalletd_Send_FullMethodName                              = "/htnwalletd.htnwalletd/Send"
-	Htnwalletd_Sign_FullMethodName                              = "/htnwalletd.htnwalletd/Sign"
-	Htnwalletd_GetVersion_FullMethodName                        = "/htnwalletd.htnwalletd/GetVersion"
-	Htnwalletd_CreateUnsignedCompoundTransaction_FullMethodName = "/htnwalletd.htnwalletd/CreateUnsignedCompoundTransaction"
+	Htnwalletd_GetBalance_FullMethodName                             = "/htnwalletd.htnwalletd/GetBalance"
+	Htnwalletd_GetExternalSpendableUTXOs_FullMethodName              = "/htnwalletd.htnwalletd/GetExternalSpendableUTXOs"
+	Htnwalletd_CreateUnsignedTransactions_FullMethodName             = "/htnwalletd.htnwalletd/CreateUnsignedTransactions"
+	Htnwalletd_ShowAddresses_FullMethodName                          = "/htnwalletd.htnwalletd/ShowAddresses"
+	Htnwalletd_NewAddress_FullMethodName                             = "/htnwalletd.htnwalletd/NewAddress"
+	Htnwalletd_Shutdown_FullMethodName                               = "/htnwalletd.htnwalletd/Shutdown"
+	Htnwalletd_Broadcast_FullMethodName                              = "/htnwalletd.htnwalletd/Broadcast"
+	Htnwalletd_Send_FullMethodName                                   = "/htnwalletd.htnwalletd/Send"
+	Htnwalletd_Sign_FullMethodName                                   = "/htnwalletd.htnwalletd/Sign"
+	Htnwalletd_GetVersion_FullMethodName                             = "/htnwalletd.htnwalletd/GetVersion"
+	Htnwalletd_CreateUnsignedCompoundTransaction_FullMethodName      = "/htnwalletd.htnwalletd/CreateUnsignedCompoundTransaction"
+	Htnwalletd_GetTransactions_FullMethodName                        = "/htnwalletd.htnwalletd/GetTransactions"
+	Htnwalletd_GetTransaction_FullMethodName                         = "/htnwalletd.htnwalletd/GetTransaction"
+	Htnwalletd_SetTransactionLabel_FullMethodName                    = "/htnwalletd.htnwalletd/SetTransactionLabel"
+	Htnwalletd_SignMessage_FullMethodName                            = "/htnwalletd.htnwalletd/SignMessage"
+	Htnwalletd_VerifyMessage_FullMethodName                          = "/htnwalletd.htnwalletd/VerifyMessage"
+	Htnwalletd_NewTimeLockAddress_FullMethodName                     = "/htnwalletd.htnwalletd/NewTimeLockAddress"
+	Htnwalletd_NewVaultAddress_FullMethodName                        = "/htnwalletd.htnwalletd/NewVaultAddress"
+	Htnwalletd_GetLockedOutputs_FullMethodName                       = "/htnwalletd.htnwalletd/GetLockedOutputs"
+	Htnwalletd_CreateUnsignedLockedOutputsTransaction_FullMethodName = "/htnwalletd.htnwalletd/CreateUnsignedLockedOutputsTransaction"
+	Htnwalletd_NewHTLC_FullMethodName                                = "/htnwalletd.htnwalletd/NewHTLC"
+	Htnwalletd_AuditHTLC_FullMethodName                              = "/htnwalletd.htnwalletd/AuditHTLC"
+	Htnwalletd_CreateUnsignedHTLCTransaction_FullMethodName          = "/htnwalletd.htnwalletd/CreateUnsignedHTLCTransaction"
+	Htnwalletd_ListUTXOs_FullMethodName                              = "/htnwalletd.htnwalletd/ListUTXOs"
+	Htnwalletd_LockUTXOs_FullMethodName                              = "/htnwalletd.htnwalletd/LockUTXOs"
+	Htnwalletd_UnlockUTXOs_FullMethodName                            = "/htnwalletd.htnwalletd/UnlockUTXOs"
 )
 
 // HtnwalletdClient is the client API for Htnwalletd service.
@@ -49,6 +64,22 @@ type HtnwalletdClient interface {
 	Sign(ctx context.Context, in *SignRequest, opts ...grpc.CallOption) (*SignResponse, error)
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	CreateUnsignedCompoundTransaction(ctx context.Context, in *CreateUnsignedCompoundTransactionRequest, opts ...grpc.CallOption) (*CreateUnsignedCompoundTransactionResponse, error)
+	GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	SetTransactionLabel(ctx context.Context, in *SetTransactionLabelRequest, opts ...grpc.CallOption) (*SetTransactionLabelResponse, error)
+	// Since SignMessageRequest contains a password - this command should only be used on a trusted or secure connection
+	SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error)
+	VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error)
+	NewTimeLockAddress(ctx context.Context, in *NewTimeLockAddressRequest, opts ...grpc.CallOption) (*NewTimeLockAddressResponse, error)
+	NewVaultAddress(ctx context.Context, in *NewVaultAddressRequest, opts ...grpc.CallOption) (*NewVaultAddressResponse, error)
+	GetLockedOutputs(ctx context.Context, in *GetLockedOutputsRequest, opts ...grpc.CallOption) (*GetLockedOutputsResponse, error)
+	CreateUnsignedLockedOutputsTransaction(ctx context.Context, in *CreateUnsignedLockedOutputsTransactionRequest, opts ...grpc.CallOption) (*CreateUnsignedLockedOutputsTransactionResponse, error)
+	NewHTLC(ctx context.Context, in *NewHTLCRequest, opts ...grpc.CallOption) (*NewHTLCResponse, error)
+	AuditHTLC(ctx context.Context, in *AuditHTLCRequest, opts ...grpc.CallOption) (*AuditHTLCResponse, error)
+	CreateUnsignedHTLCTransaction(ctx context.Context, in *CreateUnsignedHTLCTransactionRequest, opts ...grpc.CallOption) (*CreateUnsignedHTLCTransactionResponse, error)
+	ListUTXOs(ctx context.Context, in *ListUTXOsRequest, opts ...grpc.CallOption) (*ListUTXOsResponse, error)
+	LockUTXOs(ctx context.Context, in *LockUTXOsRequest, opts ...grpc.CallOption) (*LockUTXOsResponse, error)
+	UnlockUTXOs(ctx context.Context, in *UnlockUTXOsRequest, opts ...grpc.CallOption) (*UnlockUTXOsResponse, error)
 }
 
 type htnwalletdClient struct {
@@ -169,6 +200,156 @@ func (c *htnwalletdClient) CreateUnsignedCompoundTransaction(ctx context.Context
 	return out, nil
 }
 
+func (c *htnwalletdClient) GetTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (*GetTransactionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionsResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_GetTransactions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_GetTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) SetTransactionLabel(ctx context.Context, in *SetTransactionLabelRequest, opts ...grpc.CallOption) (*SetTransactionLabelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetTransactionLabelResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_SetTransactionLabel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) SignMessage(ctx context.Context, in *SignMessageRequest, opts ...grpc.CallOption) (*SignMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SignMessageResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_SignMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) VerifyMessage(ctx context.Context, in *VerifyMessageRequest, opts ...grpc.CallOption) (*VerifyMessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyMessageResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_VerifyMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) NewTimeLockAddress(ctx context.Context, in *NewTimeLockAddressRequest, opts ...grpc.CallOption) (*NewTimeLockAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NewTimeLockAddressResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_NewTimeLockAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) NewVaultAddress(ctx context.Context, in *NewVaultAddressRequest, opts ...grpc.CallOption) (*NewVaultAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NewVaultAddressResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_NewVaultAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) GetLockedOutputs(ctx context.Context, in *GetLockedOutputsRequest, opts ...grpc.CallOption) (*GetLockedOutputsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLockedOutputsResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_GetLockedOutputs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) CreateUnsignedLockedOutputsTransaction(ctx context.Context, in *CreateUnsignedLockedOutputsTransactionRequest, opts ...grpc.CallOption) (*CreateUnsignedLockedOutputsTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUnsignedLockedOutputsTransactionResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_CreateUnsignedLockedOutputsTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) NewHTLC(ctx context.Context, in *NewHTLCRequest, opts ...grpc.CallOption) (*NewHTLCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NewHTLCResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_NewHTLC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) AuditHTLC(ctx context.Context, in *AuditHTLCRequest, opts ...grpc.CallOption) (*AuditHTLCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuditHTLCResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_AuditHTLC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) CreateUnsignedHTLCTransaction(ctx context.Context, in *CreateUnsignedHTLCTransactionRequest, opts ...grpc.CallOption) (*CreateUnsignedHTLCTransactionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateUnsignedHTLCTransactionResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_CreateUnsignedHTLCTransaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) ListUTXOs(ctx context.Context, in *ListUTXOsRequest, opts ...grpc.CallOption) (*ListUTXOsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUTXOsResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_ListUTXOs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) LockUTXOs(ctx context.Context, in *LockUTXOsRequest, opts ...grpc.CallOption) (*LockUTXOsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LockUTXOsResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_LockUTXOs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *htnwalletdClient) UnlockUTXOs(ctx context.Context, in *UnlockUTXOsRequest, opts ...grpc.CallOption) (*UnlockUTXOsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockUTXOsResponse)
+	err := c.cc.Invoke(ctx, Htnwalletd_UnlockUTXOs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HtnwalletdServer is the server API for Htnwalletd service.
 // All implementations must embed UnimplementedHtnwalletdServer
 // for forward compatibility.
@@ -186,6 +367,22 @@ type HtnwalletdServer interface {
 	Sign(context.Context, *SignRequest) (*SignResponse, error)
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	CreateUnsignedCompoundTransaction(context.Context, *CreateUnsignedCompoundTransactionRequest) (*CreateUnsignedCompoundTransactionResponse, error)
+	GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	SetTransactionLabel(context.Context, *SetTransactionLabelRequest) (*SetTransactionLabelResponse, error)
+	// Since SignMessageRequest contains a password - this command should only be used on a trusted or secure connection
+	SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error)
+	VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error)
+	NewTimeLockAddress(context.Context, *NewTimeLockAddressRequest) (*NewTimeLockAddressResponse, error)
+	NewVaultAddress(context.Context, *NewVaultAddressRequest) (*NewVaultAddressResponse, error)
+	GetLockedOutputs(context.Context, *GetLockedOutputsRequest) (*GetLockedOutputsResponse, error)
+	CreateUnsignedLockedOutputsTransaction(context.Context, *CreateUnsignedLockedOutputsTransactionRequest) (*CreateUnsignedLockedOutputsTransactionResponse, error)
+	NewHTLC(context.Context, *NewHTLCRequest) (*NewHTLCResponse, error)
+	AuditHTLC(context.Context, *AuditHTLCRequest) (*AuditHTLCResponse, error)
+	CreateUnsignedHTLCTransaction(context.Context, *CreateUnsignedHTLCTransactionRequest) (*CreateUnsignedHTLCTransactionResponse, error)
+	ListUTXOs(context.Context, *ListUTXOsRequest) (*ListUTXOsResponse, error)
+	LockUTXOs(context.Context, *LockUTXOsRequest) (*LockUTXOsResponse, error)
+	UnlockUTXOs(context.Context, *UnlockUTXOsRequest) (*UnlockUTXOsResponse, error)
 	mustEmbedUnimplementedHtnwalletdServer()
 }
 
@@ -229,6 +426,51 @@ func (UnimplementedHtnwalletdServer) GetVersion(context.Context, *GetVersionRequ
 func (UnimplementedHtnwalletdServer) CreateUnsignedCompoundTransaction(context.Context, *CreateUnsignedCompoundTransactionRequest) (*CreateUnsignedCompoundTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUnsignedCompoundTransaction not implemented")
 }
+func (UnimplementedHtnwalletdServer) GetTransactions(context.Context, *GetTransactionsRequest) (*GetTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (UnimplementedHtnwalletdServer) GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedHtnwalletdServer) SetTransactionLabel(context.Context, *SetTransactionLabelRequest) (*SetTransactionLabelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransactionLabel not implemented")
+}
+func (UnimplementedHtnwalletdServer) SignMessage(context.Context, *SignMessageRequest) (*SignMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignMessage not implemented")
+}
+func (UnimplementedHtnwalletdServer) VerifyMessage(context.Context, *VerifyMessageRequest) (*VerifyMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMessage not implemented")
+}
+func (UnimplementedHtnwalletdServer) NewTimeLockAddress(context.Context, *NewTimeLockAddressRequest) (*NewTimeLockAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewTimeLockAddress not implemented")
+}
+func (UnimplementedHtnwalletdServer) NewVaultAddress(context.Context, *NewVaultAddressRequest) (*NewVaultAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewVaultAddress not implemented")
+}
+func (UnimplementedHtnwalletdServer) GetLockedOutputs(context.Context, *GetLockedOutputsRequest) (*GetLockedOutputsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLockedOutputs not implemented")
+}
+func (UnimplementedHtnwalletdServer) CreateUnsignedLockedOutputsTransaction(context.Context, *CreateUnsignedLockedOutputsTransactionRequest) (*CreateUnsignedLockedOutputsTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUnsignedLockedOutputsTransaction not implemented")
+}
+func (UnimplementedHtnwalletdServer) NewHTLC(context.Context, *NewHTLCRequest) (*NewHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NewHTLC not implemented")
+}
+func (UnimplementedHtnwalletdServer) AuditHTLC(context.Context, *AuditHTLCRequest) (*AuditHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditHTLC not implemented")
+}
+func (UnimplementedHtnwalletdServer) CreateUnsignedHTLCTransaction(context.Context, *CreateUnsignedHTLCTransactionRequest) (*CreateUnsignedHTLCTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUnsignedHTLCTransaction not implemented")
+}
+func (UnimplementedHtnwalletdServer) ListUTXOs(context.Context, *ListUTXOsRequest) (*ListUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUTXOs not implemented")
+}
+func (UnimplementedHtnwalletdServer) LockUTXOs(context.Context, *LockUTXOsRequest) (*LockUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockUTXOs not implemented")
+}
+func (UnimplementedHtnwalletdServer) UnlockUTXOs(context.Context, *UnlockUTXOsRequest) (*UnlockUTXOsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUTXOs not implemented")
+}
 func (UnimplementedHtnwalletdServer) mustEmbedUnimplementedHtnwalletdServer() {}
 func (UnimplementedHtnwalletdServer) testEmbeddedByValue()                    {}
 
@@ -448,6 +690,276 @@ func _Htnwalletd_CreateUnsignedCompoundTransaction_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Htnwalletd_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_GetTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).GetTransactions(ctx, req.(*GetTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_GetTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_SetTransactionLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).SetTransactionLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_SetTransactionLabel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).SetTransactionLabel(ctx, req.(*SetTransactionLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_SignMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).SignMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_SignMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).SignMessage(ctx, req.(*SignMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_VerifyMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).VerifyMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_VerifyMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).VerifyMessage(ctx, req.(*VerifyMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_NewTimeLockAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewTimeLockAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).NewTimeLockAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_NewTimeLockAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).NewTimeLockAddress(ctx, req.(*NewTimeLockAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_NewVaultAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewVaultAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).NewVaultAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_NewVaultAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).NewVaultAddress(ctx, req.(*NewVaultAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_GetLockedOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLockedOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).GetLockedOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_GetLockedOutputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).GetLockedOutputs(ctx, req.(*GetLockedOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_CreateUnsignedLockedOutputsTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUnsignedLockedOutputsTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).CreateUnsignedLockedOutputsTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_CreateUnsignedLockedOutputsTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).CreateUnsignedLockedOutputsTransaction(ctx, req.(*CreateUnsignedLockedOutputsTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_NewHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewHTLCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).NewHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_NewHTLC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).NewHTLC(ctx, req.(*NewHTLCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_AuditHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditHTLCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).AuditHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_AuditHTLC_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).AuditHTLC(ctx, req.(*AuditHTLCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_CreateUnsignedHTLCTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUnsignedHTLCTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).CreateUnsignedHTLCTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_CreateUnsignedHTLCTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).CreateUnsignedHTLCTransaction(ctx, req.(*CreateUnsignedHTLCTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_ListUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).ListUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_ListUTXOs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).ListUTXOs(ctx, req.(*ListUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_LockUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).LockUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_LockUTXOs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).LockUTXOs(ctx, req.(*LockUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Htnwalletd_UnlockUTXOs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUTXOsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HtnwalletdServer).UnlockUTXOs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Htnwalletd_UnlockUTXOs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HtnwalletdServer).UnlockUTXOs(ctx, req.(*UnlockUTXOsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Htnwalletd_ServiceDesc is the grpc.ServiceDesc for Htnwalletd service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -499,6 +1011,66 @@ var Htnwalletd_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateUnsignedCompoundTransaction",
 			Handler:    _Htnwalletd_CreateUnsignedCompoundTransaction_Handler,
 		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _Htnwalletd_GetTransactions_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _Htnwalletd_GetTransaction_Handler,
+		},
+		{
+			MethodName: "SetTransactionLabel",
+			Handler:    _Htnwalletd_SetTransactionLabel_Handler,
+		},
+		{
+			MethodName: "SignMessage",
+			Handler:    _Htnwalletd_SignMessage_Handler,
+		},
+		{
+			MethodName: "VerifyMessage",
+			Handler:    _Htnwalletd_VerifyMessage_Handler,
+		},
+		{
+			MethodName: "NewTimeLockAddress",
+			Handler:    _Htnwalletd_NewTimeLockAddress_Handler,
+		},
+		{
+			MethodName: "NewVaultAddress",
+			Handler:    _Htnwalletd_NewVaultAddress_Handler,
+		},
+		{
+			MethodName: "GetLockedOutputs",
+			Handler:    _Htnwalletd_GetLockedOutputs_Handler,
+		},
+		{
+			MethodName: "CreateUnsignedLockedOutputsTransaction",
+			Handler:    _Htnwalletd_CreateUnsignedLockedOutputsTransaction_Handler,
+		},
+		{
+			MethodName: "NewHTLC",
+			Handler:    _Htnwalletd_NewHTLC_Handler,
+		},
+		{
+			MethodName: "AuditHTLC",
+			Handler:    _Htnwalletd_AuditHTLC_Handler,
+		},
+		{
+			MethodName: "CreateUnsignedHTLCTransaction",
+			Handler:    _Htnwalletd_CreateUnsignedHTLCTransaction_Handler,
+		},
+		{
+			MethodName: "ListUTXOs",
+			Handler:    _Htnwalletd_ListUTXOs_Handler,
+		},
+		{
+			MethodName: "LockUTXOs",
+			Handler:    _Htnwalletd_LockUTXOs_Handler,
+		},
+		{
+			MethodName: "UnlockUTXOs",
+			Handler:    _Htnwalletd_UnlockUTXOs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "htnwalletd.proto",
@@ -79,6 +79,16 @@ func (s *server) NewAddress(_ context.Context, request *pb.NewAddressRequest) (*
 		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
 	}
 
+	address, err := s.newExternalAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NewAddressResponse{Address: address.String()}, nil
+}
+
+// newExternalAddress gives out the next address of the external key chain
+func (s *server) newExternalAddress() (util.Address, error) {
 	err := s.keysFile.SetLastUsedExternalIndex(s.keysFile.LastUsedExternalIndex() + 1)
 	if err != nil {
 		return nil, err
@@ -95,12 +105,7 @@ func (s *server) NewAddress(_ context.Context, request *pb.NewAddressRequest) (*
 		keyChain:      libhtnwallet.ExternalKeychain,
 	}
 	path := s.walletAddressPath(walletAddr)
-	address, err := libhtnwallet.Address(s.params, s.keysFile.ExtendedPublicKeys, s.keysFile.MinimumSignatures, path, s.keysFile.ECDSA)
-	if err != nil {
-		return nil, err
-	}
-
-	return &pb.NewAddressResponse{Address: address.String()}, nil
+	return libhtnwallet.Address(s.params, s.keysFile.ExtendedPublicKeys, s.keysFile.MinimumSignatures, path, s.keysFile.ECDSA)
 }
 
 func (s *server) walletAddressString(wAddr *walletAddress) (string, error) {
@@ -24,6 +24,7 @@ func (s *server) GetBalance(_ context.Context, _ *pb.GetBalanceRequest) (*pb.Get
 		return nil, err
 	}
 	daaScore := dagInfo.VirtualDAAScore
+	pastMedianTime := dagInfo.PastMedianTime
 	balancesMap := make(balancesMapType, 0)
 	for _, entry := range s.utxosSortedByAmount {
 		amount := entry.UTXOEntry.Amount()
@@ -33,7 +34,7 @@ func (s *server) GetBalance(_ context.Context, _ *pb.GetBalanceRequest) (*pb.Get
 			balances = new(balancesType)
 			balancesMap[address] = balances
 		}
-		if s.isUTXOSpendable(entry, daaScore) {
+		if s.isUTXOSpendable(entry, daaScore, pastMedianTime) {
 			balances.available += amount
 		} else {
 			balances.pending += amount
@@ -58,16 +59,33 @@ func (s *server) GetBalance(_ context.Context, _ *pb.GetBalanceRequest) (*pb.Get
 		pending += balances.pending
 	}
 
+	var locked, unlocked uint64
+	for _, entry := range s.lockedUTXOs {
+		if s.isUTXOSpendable(entry, daaScore, pastMedianTime) {
+			unlocked += entry.UTXOEntry.Amount()
+		} else {
+			locked += entry.UTXOEntry.Amount()
+		}
+	}
+
 	log.Infof("GetBalance request scanned %d UTXOs overall over %d addresses", len(s.utxosSortedByAmount), len(balancesMap))
 
 	return &pb.GetBalanceResponse{
 		Available:       available,
 		Pending:         pending,
 		AddressBalances: addressBalances,
+		Locked:          locked,
+		Unlocked:        unlocked,
 	}, nil
 }
 
-func (s *server) isUTXOSpendable(entry *walletUTXO, virtualDAAScore uint64) bool {
+// isUTXOSpendable returns whether the given UTXO may be spent by the wallet key in a transaction that
+// is validated against the virtual. That's never the case for immature coinbase outputs, and for
+// outputs of lock scripts before they unlock.
+func (s *server) isUTXOSpendable(entry *walletUTXO, virtualDAAScore uint64, pastMedianTime int64) bool {
+	if entry.lockScript != nil && !isLockScriptUnlocked(entry, virtualDAAScore, pastMedianTime) {
+		return false
+	}
 	if !entry.UTXOEntry.IsCoinbase() {
 		return true
 	}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/Hoosat-Oy/HTND/domain/miningmanager/mempool"
+	"github.com/pkg/errors"
+)
+
+// maximumOutputsMassPerTransaction is the mass the outputs of a single transaction may take.
+// The rest of the standard transaction mass is left for the inputs that fund them, which
+// maybeSplitAndMergeTransaction reduces to a single input per split if needed.
+const maximumOutputsMassPerTransaction = mempool.MaximumStandardTransactionMass / 2
+
+// paymentBatch is a group of payments that are paid by a single transaction
+type paymentBatch struct {
+	payments []*libhtnwallet.Payment
+
+	// outputsFee is the fee paid for the outputs beyond the first one, which feePerInput
+	// doesn't account for. It is the minimum relay fee of their mass, at 1 sompi per gram.
+	outputsFee uint64
+}
+
+// batchPayments groups the given payments into batches whose outputs, together with a change output,
+// fit in a standard transaction. The payments keep their order, and most requests fit in a single batch.
+func (s *server) batchPayments(payments []*libhtnwallet.Payment) ([]*paymentBatch, error) {
+	// All change addresses have the same script type, so the current one is as good as
+	// the one that would eventually be used to estimate the change output mass.
+	changeAddress, err := libhtnwallet.Address(s.params, s.keysFile.ExtendedPublicKeys, s.keysFile.MinimumSignatures,
+		s.walletAddressPath(&walletAddress{
+			index:         s.keysFile.LastUsedInternalIndex(),
+			cosignerIndex: s.keysFile.CosignerIndex,
+			keyChain:      libhtnwallet.InternalKeychain,
+		}), s.keysFile.ECDSA)
+	if err != nil {
+		return nil, err
+	}
+
+	emptyTransactionMass := s.txMassCalculator.CalculateTransactionMass(&externalapi.DomainTransaction{})
+	changeOutputMass, err := s.paymentOutputMass(&libhtnwallet.Payment{Address: changeAddress}, emptyTransactionMass)
+	if err != nil {
+		return nil, err
+	}
+
+	var batches []*paymentBatch
+	batch := &paymentBatch{}
+	batchMass := emptyTransactionMass + changeOutputMass
+	for _, payment := range payments {
+		outputMass, err := s.paymentOutputMass(payment, emptyTransactionMass)
+		if err != nil {
+			return nil, err
+		}
+		if emptyTransactionMass+changeOutputMass+outputMass > maximumOutputsMassPerTransaction {
+			return nil, errors.Errorf("the output to %s is too large to fit in a standard transaction", payment.Address)
+		}
+
+		if batchMass+outputMass > maximumOutputsMassPerTransaction {
+			batches = append(batches, batch)
+			batch = &paymentBatch{}
+			batchMass = emptyTransactionMass + changeOutputMass
+		}
+		if len(batch.payments) > 0 {
+			batch.outputsFee += outputMass
+		}
+		batch.payments = append(batch.payments, payment)
+		batchMass += outputMass
+	}
+	if len(batch.payments) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches, nil
+}
+
+// paymentOutputMass returns the mass the output of the given payment adds to a transaction
+func (s *server) paymentOutputMass(payment *libhtnwallet.Payment, emptyTransactionMass uint64) (uint64, error) {
+	scriptPublicKey, err := txscript.PayToAddrScript(payment.Address)
+	if err != nil {
+		return 0, err
+	}
+
+	transaction := &externalapi.DomainTransaction{
+		Outputs: []*externalapi.DomainTransactionOutput{{
+			Value:           payment.Amount,
+			ScriptPublicKey: scriptPublicKey,
+		}},
+	}
+	return s.txMassCalculator.CalculateTransactionMass(transaction) - emptyTransactionMass, nil
+}
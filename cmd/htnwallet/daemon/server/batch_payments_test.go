@@ -0,0 +1,136 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/keys"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/Hoosat-Oy/HTND/util/txmass"
+)
+
+func testPublicKeyAndAddress(t *testing.T, params *dagconfig.Params) (string, util.Address) {
+	mnemonic, err := libhtnwallet.CreateMnemonic()
+	if err != nil {
+		t.Fatalf("CreateMnemonic: %+v", err)
+	}
+	publicKey, err := libhtnwallet.MasterPublicKeyFromMnemonic(params, mnemonic, false)
+	if err != nil {
+		t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+	}
+	address, err := libhtnwallet.Address(params, []string{publicKey}, 1, "m/0/0", false)
+	if err != nil {
+		t.Fatalf("Address: %+v", err)
+	}
+	return publicKey, address
+}
+
+func TestBatchPayments(t *testing.T) {
+	params := &dagconfig.DevnetParams
+	publicKey, recipient := testPublicKeyAndAddress(t, params)
+
+	serverInstance := &server{
+		params:           params,
+		keysFile:         &keys.File{ExtendedPublicKeys: []string{publicKey}, MinimumSignatures: 1},
+		txMassCalculator: txmass.NewCalculator(params.MassPerTxByte, params.MassPerScriptPubKeyByte, params.MassPerSigOp),
+	}
+
+	const numOutputs = 1000
+	outputs := make([]*pb.PaymentOutput, numOutputs)
+	for i := range outputs {
+		outputs[i] = &pb.PaymentOutput{Address: recipient.String(), Amount: uint64(i+1) * constants.SompiPerHoosat}
+	}
+	payments, err := serverInstance.requestedPayments("", 0, outputs, false)
+	if err != nil {
+		t.Fatalf("requestedPayments: %+v", err)
+	}
+
+	batches, err := serverInstance.batchPayments(payments)
+	if err != nil {
+		t.Fatalf("batchPayments: %+v", err)
+	}
+	if len(batches) < 2 {
+		t.Fatalf("expected %d outputs to be split into several batches, got %d", numOutputs, len(batches))
+	}
+
+	// All the payments are kept in order, and every batch fits in a standard transaction along with the change
+	paymentIndex := 0
+	for i, batch := range batches {
+		if batch.outputsFee == 0 || batch.outputsFee > maximumOutputsMassPerTransaction {
+			t.Fatalf("unexpected outputs fee %d for batch #%d", batch.outputsFee, i)
+		}
+		for _, payment := range batch.payments {
+			if payment != payments[paymentIndex] {
+				t.Fatalf("payment #%d is out of order in batch #%d", paymentIndex, i)
+			}
+			paymentIndex++
+		}
+	}
+	if paymentIndex != numOutputs {
+		t.Fatalf("expected %d payments in all batches, got %d", numOutputs, paymentIndex)
+	}
+
+	// A single payment is paid by feePerInput alone
+	batches, err = serverInstance.batchPayments(payments[:1])
+	if err != nil {
+		t.Fatalf("batchPayments: %+v", err)
+	}
+	if len(batches) != 1 || len(batches[0].payments) != 1 || batches[0].outputsFee != 0 {
+		t.Fatalf("unexpected batches for a single payment")
+	}
+}
+
+func TestRequestedPayments(t *testing.T) {
+	serverInstance := &server{params: &dagconfig.DevnetParams}
+	_, recipient := testPublicKeyAndAddress(t, serverInstance.params)
+	address := recipient.String()
+
+	payments, err := serverInstance.requestedPayments(address, 100, nil, false)
+	if err != nil {
+		t.Fatalf("requestedPayments: %+v", err)
+	}
+	if len(payments) != 1 || payments[0].Amount != 100 {
+		t.Fatalf("unexpected payments for a single address")
+	}
+
+	tests := []struct {
+		name      string
+		address   string
+		amount    uint64
+		outputs   []*pb.PaymentOutput
+		isSendAll bool
+	}{
+		{
+			name:    "address and outputs",
+			address: address,
+			amount:  100,
+			outputs: []*pb.PaymentOutput{{Address: address, Amount: 100}},
+		},
+		{
+			name:      "send all to several outputs",
+			outputs:   []*pb.PaymentOutput{{Address: address}, {Address: address}},
+			isSendAll: true,
+		},
+		{
+			name:    "zero amount",
+			outputs: []*pb.PaymentOutput{{Address: address, Amount: 100}, {Address: address}},
+		},
+		{
+			name:    "invalid address",
+			outputs: []*pb.PaymentOutput{{Address: "hoosatdev:invalid", Amount: 100}},
+		},
+		{
+			name:    "overflow",
+			outputs: []*pb.PaymentOutput{{Address: address, Amount: ^uint64(0)}, {Address: address, Amount: 1}},
+		},
+	}
+	for _, test := range tests {
+		_, err := serverInstance.requestedPayments(test.address, test.amount, test.outputs, test.isSendAll)
+		if err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
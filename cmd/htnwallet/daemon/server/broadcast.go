@@ -10,6 +10,7 @@ import (
 	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet/serialization"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
 	"github.com/Hoosat-Oy/HTND/infrastructure/network/rpcclient"
 	"github.com/pkg/errors"
 )
@@ -51,8 +52,27 @@ func (s *server) broadcast(transactions [][]byte, isDomain bool) ([]string, erro
 			return nil, err
 		}
 
+		var spentLockedOutpoints []externalapi.DomainOutpoint
 		for _, input := range tx.Inputs {
 			s.usedOutpoints[input.PreviousOutpoint] = time.Now()
+			if s.keysFile.IsOutpointLocked(input.PreviousOutpoint) {
+				spentLockedOutpoints = append(spentLockedOutpoints, input.PreviousOutpoint)
+			}
+		}
+
+		// Locked outpoints that were spent explicitly are unlocked, so that the keys file doesn't keep them forever
+		if len(spentLockedOutpoints) > 0 {
+			err = s.keysFile.UnlockOutpoints(spentLockedOutpoints)
+			if err != nil {
+				log.Errorf("Error unlocking the outpoints spent by transaction %s: %s", txIDs[i], err)
+			}
+		}
+
+		// The transaction is already out, so failing to record it only affects its history entry
+		// until the next sync picks it up from the mempool.
+		err = s.addBroadcastTransactionToHistory(txIDs[i], tx)
+		if err != nil {
+			log.Errorf("Error adding transaction %s to the history: %s", txIDs[i], err)
 		}
 	}
 
@@ -67,3 +87,71 @@ func sendTransaction(client *rpcclient.RPCClient, tx *externalapi.DomainTransact
 	}
 	return submitTransactionResponse.TransactionID, nil
 }
+
+func (s *server) addBroadcastTransactionToHistory(txID string, tx *externalapi.DomainTransaction) error {
+	utxosByOutpoint := make(map[externalapi.DomainOutpoint]*walletUTXO, len(s.utxosSortedByAmount)+len(s.lockedUTXOs))
+	for _, utxo := range s.utxosSortedByAmount {
+		utxosByOutpoint[*utxo.Outpoint] = utxo
+	}
+	for _, utxo := range s.lockedUTXOs {
+		utxosByOutpoint[*utxo.Outpoint] = utxo
+	}
+
+	spent := make([]*historyOutput, 0, len(tx.Inputs))
+	for _, input := range tx.Inputs {
+		utxo, ok := utxosByOutpoint[input.PreviousOutpoint]
+		if !ok {
+			continue
+		}
+		address, err := s.walletUTXOAddressString(utxo)
+		if err != nil {
+			return err
+		}
+		spent = append(spent, &historyOutput{
+			TransactionID: input.PreviousOutpoint.TransactionID.String(),
+			Index:         input.PreviousOutpoint.Index,
+			Address:       address,
+			Amount:        utxo.UTXOEntry.Amount(),
+		})
+	}
+
+	outputs := make([]*historyOutput, len(tx.Outputs))
+	for i, output := range tx.Outputs {
+		outputs[i] = &historyOutput{
+			Index:  uint32(i),
+			Amount: output.Value,
+		}
+		// Non-standard outputs have no address and are kept with an empty one
+		_, address, err := txscript.ExtractScriptPubKeyAddress(output.ScriptPublicKey, s.params)
+		if err == nil && address != nil {
+			outputs[i].Address = address.String()
+		}
+	}
+
+	// Change goes to the last used internal address, which the sync only finds once it has a balance
+	lastChangeAddress, err := s.walletAddressString(&walletAddress{
+		index:         s.keysFile.LastUsedInternalIndex(),
+		cosignerIndex: s.keysFile.CosignerIndex,
+		keyChain:      libhtnwallet.InternalKeychain,
+	})
+	if err != nil {
+		return err
+	}
+	isWalletAddress := func(address string) bool {
+		_, isLockScriptAddress := s.lockScripts[address]
+		return address == lastChangeAddress || isLockScriptAddress || s.isKnownAddress(address)
+	}
+
+	return s.history.addBroadcastTransaction(txID, spent, outputs, isWalletAddress, time.Now())
+}
+
+func (s *server) walletUTXOAddressString(utxo *walletUTXO) (string, error) {
+	if utxo.lockScript != nil {
+		address, err := lockScriptAddress(s.params, utxo.lockScript)
+		if err != nil {
+			return "", err
+		}
+		return address.String(), nil
+	}
+	return s.walletAddressString(utxo.address)
+}
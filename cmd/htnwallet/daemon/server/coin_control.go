@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionid"
+	"github.com/pkg/errors"
+)
+
+func (s *server) ListUTXOs(_ context.Context, request *pb.ListUTXOsRequest) (*pb.ListUTXOsResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	var fromAddresses []*walletAddress
+	for _, from := range request.From {
+		fromAddress, exists := s.addressSet[from]
+		if !exists {
+			return nil, fmt.Errorf("specified from address %s does not exists", from)
+		}
+		fromAddresses = append(fromAddresses, fromAddress)
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make([]*pb.WalletUTXO, 0, len(s.utxosSortedByAmount))
+	for _, utxo := range s.utxosSortedByAmount {
+		if fromAddresses != nil && !walletAddressesContain(fromAddresses, utxo.address) {
+			continue
+		}
+
+		address, err := s.walletAddressString(utxo.address)
+		if err != nil {
+			return nil, err
+		}
+
+		age := uint64(0)
+		if blockDAAScore := utxo.UTXOEntry.BlockDAAScore(); dagInfo.VirtualDAAScore > blockDAAScore {
+			age = dagInfo.VirtualDAAScore - blockDAAScore
+		}
+		broadcastTime, isUsed := s.usedOutpoints[*utxo.Outpoint]
+
+		utxos = append(utxos, &pb.WalletUTXO{
+			Outpoint: &pb.Outpoint{
+				TransactionId: utxo.Outpoint.TransactionID.String(),
+				Index:         utxo.Outpoint.Index,
+			},
+			Address:       address,
+			Amount:        utxo.UTXOEntry.Amount(),
+			BlockDaaScore: utxo.UTXOEntry.BlockDAAScore(),
+			Age:           age,
+			IsCoinbase:    utxo.UTXOEntry.IsCoinbase(),
+			IsSpendable:   s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, dagInfo.PastMedianTime),
+			IsLocked:      s.keysFile.IsOutpointLocked(*utxo.Outpoint),
+			IsPending:     isUsed && !s.usedOutpointHasExpired(broadcastTime),
+		})
+	}
+
+	return &pb.ListUTXOsResponse{Utxos: utxos}, nil
+}
+
+func (s *server) LockUTXOs(_ context.Context, request *pb.LockUTXOsRequest) (*pb.LockUTXOsResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	outpoints, err := outpointsFromProto(request.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+	if len(outpoints) == 0 {
+		return nil, errors.New("no outpoints to lock were given")
+	}
+
+	for _, outpoint := range outpoints {
+		if s.walletUTXO(outpoint) == nil {
+			return nil, errors.Errorf("outpoint %s:%d is not an unspent output of this wallet",
+				outpoint.TransactionID, outpoint.Index)
+		}
+	}
+
+	err = s.keysFile.LockOutpoints(outpoints)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LockUTXOsResponse{}, nil
+}
+
+func (s *server) UnlockUTXOs(_ context.Context, request *pb.UnlockUTXOsRequest) (*pb.UnlockUTXOsResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	outpoints, err := outpointsFromProto(request.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+	if len(outpoints) == 0 {
+		return nil, errors.New("no outpoints to unlock were given")
+	}
+
+	for _, outpoint := range outpoints {
+		if !s.keysFile.IsOutpointLocked(outpoint) {
+			return nil, errors.Errorf("outpoint %s:%d is not locked", outpoint.TransactionID, outpoint.Index)
+		}
+	}
+
+	err = s.keysFile.UnlockOutpoints(outpoints)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnlockUTXOsResponse{}, nil
+}
+
+// selectExplicitUTXOs selects exactly the UTXOs of the given outpoints, whether or not they're locked
+func (s *server) selectExplicitUTXOs(outpoints []externalapi.DomainOutpoint, spendAmount uint64, isSendAll bool,
+	feePerInput uint64) (selectedUTXOs []*libhtnwallet.UTXO, totalReceived uint64, changeSompi uint64, err error) {
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	selectedOutpoints := make(map[externalapi.DomainOutpoint]struct{}, len(outpoints))
+	totalValue := uint64(0)
+	for _, outpoint := range outpoints {
+		if _, ok := selectedOutpoints[outpoint]; ok {
+			return nil, 0, 0, errors.Errorf("outpoint %s:%d is given more than once", outpoint.TransactionID, outpoint.Index)
+		}
+		selectedOutpoints[outpoint] = struct{}{}
+
+		utxo := s.walletUTXO(outpoint)
+		if utxo == nil {
+			return nil, 0, 0, errors.Errorf("outpoint %s:%d is not an unspent output of this wallet",
+				outpoint.TransactionID, outpoint.Index)
+		}
+		if !s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, dagInfo.PastMedianTime) {
+			return nil, 0, 0, errors.Errorf("outpoint %s:%d can't be spent yet", outpoint.TransactionID, outpoint.Index)
+		}
+		if broadcastTime, ok := s.usedOutpoints[outpoint]; ok {
+			if s.usedOutpointHasExpired(broadcastTime) {
+				delete(s.usedOutpoints, outpoint)
+			} else {
+				return nil, 0, 0, errors.Errorf("outpoint %s:%d is already spent by a pending transaction",
+					outpoint.TransactionID, outpoint.Index)
+			}
+		}
+
+		selectedUTXOs = append(selectedUTXOs, &libhtnwallet.UTXO{
+			Outpoint:       utxo.Outpoint,
+			UTXOEntry:      utxo.UTXOEntry,
+			DerivationPath: s.walletAddressPath(utxo.address),
+		})
+		totalValue += utxo.UTXOEntry.Amount()
+	}
+
+	totalReceived, changeSompi, err = selectionTotals(totalValue, len(selectedUTXOs), spendAmount, isSendAll, feePerInput)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return selectedUTXOs, totalReceived, changeSompi, nil
+}
+
+// walletUTXO returns the wallet UTXO of the given outpoint, or nil if the wallet has no such UTXO
+func (s *server) walletUTXO(outpoint externalapi.DomainOutpoint) *walletUTXO {
+	for _, utxo := range s.utxosSortedByAmount {
+		if *utxo.Outpoint == outpoint {
+			return utxo
+		}
+	}
+	return nil
+}
+
+func outpointsFromProto(protoOutpoints []*pb.Outpoint) ([]externalapi.DomainOutpoint, error) {
+	outpoints := make([]externalapi.DomainOutpoint, len(protoOutpoints))
+	for i, protoOutpoint := range protoOutpoints {
+		transactionID, err := transactionid.FromString(protoOutpoint.TransactionId)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid transaction ID %s", protoOutpoint.TransactionId)
+		}
+		outpoints[i] = externalapi.DomainOutpoint{
+			TransactionID: *transactionID,
+			Index:         protoOutpoint.Index,
+		}
+	}
+	return outpoints, nil
+}
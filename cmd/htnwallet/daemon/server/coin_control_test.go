@@ -0,0 +1,116 @@
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/keys"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/utxo"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+)
+
+func TestLockUTXOs(t *testing.T) {
+	params := &dagconfig.DevnetParams
+	publicKey, _ := testPublicKeyAndAddress(t, params)
+
+	keysFile := &keys.File{ExtendedPublicKeys: []string{publicKey}, MinimumSignatures: 1}
+	keysFilePath := filepath.Join(t.TempDir(), "keys.json")
+	err := keysFile.SetPath(params, keysFilePath, true)
+	if err != nil {
+		t.Fatalf("SetPath: %+v", err)
+	}
+
+	walletOutpoint := &externalapi.DomainOutpoint{
+		TransactionID: *externalapi.NewDomainTransactionIDFromByteArray(&[externalapi.DomainHashSize]byte{1}),
+		Index:         2,
+	}
+	serverInstance := &server{
+		params:   params,
+		keysFile: keysFile,
+		utxosSortedByAmount: []*walletUTXO{{
+			Outpoint:  walletOutpoint,
+			UTXOEntry: utxo.NewUTXOEntry(100, &externalapi.ScriptPublicKey{}, false, 0),
+		}},
+	}
+	protoOutpoint := &pb.Outpoint{TransactionId: walletOutpoint.TransactionID.String(), Index: walletOutpoint.Index}
+
+	_, err = serverInstance.LockUTXOs(context.Background(), &pb.LockUTXOsRequest{
+		Outpoints: []*pb.Outpoint{{TransactionId: walletOutpoint.TransactionID.String(), Index: 3}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "is not an unspent output of this wallet") {
+		t.Fatalf("Unexpected error locking an outpoint that isn't in the wallet: %v", err)
+	}
+
+	_, err = serverInstance.LockUTXOs(context.Background(), &pb.LockUTXOsRequest{Outpoints: []*pb.Outpoint{protoOutpoint}})
+	if err != nil {
+		t.Fatalf("LockUTXOs: %+v", err)
+	}
+
+	// The lock is kept in the keys file
+	readKeysFile, err := keys.ReadKeysFile(params, keysFilePath)
+	if err != nil {
+		t.Fatalf("ReadKeysFile: %+v", err)
+	}
+	if !readKeysFile.IsOutpointLocked(*walletOutpoint) {
+		t.Fatalf("The locked outpoint wasn't saved in the keys file")
+	}
+
+	_, err = serverInstance.UnlockUTXOs(context.Background(), &pb.UnlockUTXOsRequest{Outpoints: []*pb.Outpoint{protoOutpoint}})
+	if err != nil {
+		t.Fatalf("UnlockUTXOs: %+v", err)
+	}
+	readKeysFile, err = keys.ReadKeysFile(params, keysFilePath)
+	if err != nil {
+		t.Fatalf("ReadKeysFile: %+v", err)
+	}
+	if readKeysFile.IsOutpointLocked(*walletOutpoint) {
+		t.Fatalf("The unlocked outpoint is still locked in the keys file")
+	}
+
+	_, err = serverInstance.UnlockUTXOs(context.Background(), &pb.UnlockUTXOsRequest{Outpoints: []*pb.Outpoint{protoOutpoint}})
+	if err == nil || !strings.Contains(err.Error(), "is not locked") {
+		t.Fatalf("Unexpected error unlocking an outpoint that isn't locked: %v", err)
+	}
+}
+
+func TestSelectionTotals(t *testing.T) {
+	tests := []struct {
+		name                  string
+		totalValue            uint64
+		numUTXOs              int
+		spendAmount           uint64
+		isSendAll             bool
+		expectedTotalReceived uint64
+		expectedChange        uint64
+		expectedErr           bool
+	}{
+		{name: "with change", totalValue: 1000, numUTXOs: 2, spendAmount: 500, expectedTotalReceived: 500, expectedChange: 480},
+		{name: "exact", totalValue: 1000, numUTXOs: 2, spendAmount: 980, expectedTotalReceived: 980, expectedChange: 0},
+		{name: "insufficient", totalValue: 1000, numUTXOs: 2, spendAmount: 990, expectedErr: true},
+		{name: "send all", totalValue: 1000, numUTXOs: 2, isSendAll: true, expectedTotalReceived: 980, expectedChange: 0},
+		{name: "send all below the fee", totalValue: 10, numUTXOs: 2, isSendAll: true, expectedErr: true},
+	}
+
+	const feePerInput = 10
+	for _, test := range tests {
+		totalReceived, change, err := selectionTotals(test.totalValue, test.numUTXOs, test.spendAmount, test.isSendAll,
+			feePerInput)
+		if test.expectedErr {
+			if err == nil {
+				t.Fatalf("%s: expected an error", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: selectionTotals: %+v", test.name, err)
+		}
+		if totalReceived != test.expectedTotalReceived || change != test.expectedChange {
+			t.Fatalf("%s: expected %d received and %d change, got %d and %d", test.name,
+				test.expectedTotalReceived, test.expectedChange, totalReceived, change)
+		}
+	}
+}
@@ -1,11 +1,17 @@
 package server
 
-import "github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+import (
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/keys"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+)
 
+// walletUTXO is a UTXO of a wallet address, or of a lock script address, in which case
+// address is nil and lockScript is set
 type walletUTXO struct {
-	Outpoint  *externalapi.DomainOutpoint
-	UTXOEntry externalapi.UTXOEntry
-	address   *walletAddress
+	Outpoint   *externalapi.DomainOutpoint
+	UTXOEntry  externalapi.UTXOEntry
+	address    *walletAddress
+	lockScript *keys.LockScript
 }
 
 type walletAddress struct {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
 	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
 	"github.com/Hoosat-Oy/HTND/util"
 	"github.com/pkg/errors"
@@ -26,8 +27,8 @@ func (s *server) CreateUnsignedTransactions(_ context.Context, request *pb.Creat
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	unsignedTransactions, err := s.createUnsignedTransactions(request.Address, request.Amount, request.IsSendAll,
-		request.From, request.UseExistingChangeAddress)
+	unsignedTransactions, err := s.createUnsignedTransactions(request.Address, request.Amount, request.Outputs,
+		request.IsSendAll, request.From, request.Outpoints, request.UseExistingChangeAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -96,7 +97,8 @@ func (s *server) createUnsignedCompoundTransaction(address string, fromAddresses
 		return nil, err
 	}
 
-	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, toAddress, changeAddress, changeWalletAddress)
+	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, payments, 0, changeAddress,
+		changeWalletAddress, map[externalapi.DomainOutpoint]struct{}{})
 	if err != nil {
 		return nil, err
 	}
@@ -123,7 +125,8 @@ func (s *server) selectCompoundUTXOs(feePerInput int, fromAddresses []*walletAdd
 			break
 		}
 		if (fromAddresses != nil && !walletAddressesContain(fromAddresses, highestUTXO.address)) ||
-			!s.isUTXOSpendable(highestUTXO, dagInfo.VirtualDAAScore) {
+			!s.isUTXOSpendable(highestUTXO, dagInfo.VirtualDAAScore, dagInfo.PastMedianTime) ||
+			s.keysFile.IsOutpointLocked(*highestUTXO.Outpoint) {
 			continue
 		}
 
@@ -151,7 +154,8 @@ func (s *server) selectCompoundUTXOs(feePerInput int, fromAddresses []*walletAdd
 			break
 		}
 		if (fromAddresses != nil && !walletAddressesContain(fromAddresses, utxo.address)) ||
-			!s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) {
+			!s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, dagInfo.PastMedianTime) ||
+			s.keysFile.IsOutpointLocked(*utxo.Outpoint) {
 			continue
 		}
 
@@ -185,16 +189,29 @@ func (s *server) selectCompoundUTXOs(feePerInput int, fromAddresses []*walletAdd
 
 	return selectedUTXOs, totalValue, changeSompi, nil
 }
-func (s *server) createUnsignedTransactions(address string, amount uint64, isSendAll bool, fromAddressesString []string, useExistingChangeAddress bool) ([][]byte, error) {
+
+// createUnsignedTransactions creates the transactions that pay the requested outputs. They spend the given outpoints
+// if there are any, or automatically selected UTXOs otherwise.
+func (s *server) createUnsignedTransactions(address string, amount uint64, outputs []*pb.PaymentOutput, isSendAll bool,
+	fromAddressesString []string, outpoints []*pb.Outpoint, useExistingChangeAddress bool) ([][]byte, error) {
+
 	if !s.isSynced() {
 		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
 	}
-	// make sure address string is correct before proceeding to a
+	// make sure the addresses are correct before proceeding to a
 	// potentially long UTXO refreshment operation
-	toAddress, err := util.DecodeAddress(address, s.params.Prefix)
+	payments, err := s.requestedPayments(address, amount, outputs, isSendAll)
+	if err != nil {
+		return nil, err
+	}
+
+	explicitOutpoints, err := outpointsFromProto(outpoints)
 	if err != nil {
 		return nil, err
 	}
+	if len(explicitOutpoints) > 0 && len(fromAddressesString) > 0 {
+		return nil, errors.New("explicit outpoints cannot be combined with from addresses")
+	}
 
 	var fromAddresses []*walletAddress
 	for _, from := range fromAddressesString {
@@ -205,42 +222,120 @@ func (s *server) createUnsignedTransactions(address string, amount uint64, isSen
 		fromAddresses = append(fromAddresses, fromAddress)
 	}
 
-	selectedUTXOs, spendValue, changeSompi, err := s.selectUTXOs(amount, isSendAll, feePerInput, fromAddresses)
+	paymentBatches, err := s.batchPayments(payments)
 	if err != nil {
 		return nil, err
 	}
-
-	if len(selectedUTXOs) == 0 {
-		return nil, errors.Errorf("couldn't find funds to spend")
+	if len(explicitOutpoints) > 0 && len(paymentBatches) > 1 {
+		return nil, errors.Errorf("the outputs require %d transactions, but explicit outpoints can only fund one",
+			len(paymentBatches))
 	}
 
-	changeAddress, changeWalletAddress, err := s.changeAddress(useExistingChangeAddress, fromAddresses)
-	if err != nil {
-		return nil, err
+	// Outpoints that were already selected for previous batches, so that no two transactions spend the same output
+	selectedOutpoints := make(map[externalapi.DomainOutpoint]struct{})
+	var changeAddress util.Address
+	var changeWalletAddress *walletAddress
+	var unsignedTransactions [][]byte
+	for _, batch := range paymentBatches {
+		batchAmount := uint64(0)
+		for _, payment := range batch.payments {
+			batchAmount += payment.Amount
+		}
+
+		// The outputs fee is selected along with the payments, and is left out of the change
+		var selectedUTXOs []*libhtnwallet.UTXO
+		var spendValue, changeSompi uint64
+		if len(explicitOutpoints) > 0 {
+			selectedUTXOs, spendValue, changeSompi, err = s.selectExplicitUTXOs(explicitOutpoints,
+				batchAmount+batch.outputsFee, isSendAll, feePerInput)
+		} else {
+			selectedUTXOs, spendValue, changeSompi, err = s.selectUTXOs(batchAmount+batch.outputsFee, isSendAll,
+				feePerInput, fromAddresses, selectedOutpoints)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if len(selectedUTXOs) == 0 {
+			return nil, errors.Errorf("couldn't find funds to spend")
+		}
+		for _, selectedUTXO := range selectedUTXOs {
+			selectedOutpoints[*selectedUTXO.Outpoint] = struct{}{}
+		}
+
+		// The change address is only taken once there are funds to spend, so that failed attempts don't use up new ones
+		if changeAddress == nil {
+			changeAddress, changeWalletAddress, err = s.changeAddress(useExistingChangeAddress, fromAddresses)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		payments := batch.payments
+		if isSendAll {
+			payments = []*libhtnwallet.Payment{{
+				Address: payments[0].Address,
+				Amount:  spendValue,
+			}}
+		}
+		transactionOutputs := payments
+		if changeSompi > 0 {
+			transactionOutputs = append(append([]*libhtnwallet.Payment{}, payments...), &libhtnwallet.Payment{
+				Address: changeAddress,
+				Amount:  changeSompi,
+			})
+		}
+		unsignedTransaction, err := libhtnwallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
+			s.keysFile.MinimumSignatures,
+			transactionOutputs, selectedUTXOs)
+		if err != nil {
+			return nil, err
+		}
+
+		batchTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, payments, batch.outputsFee,
+			changeAddress, changeWalletAddress, selectedOutpoints)
+		if err != nil {
+			return nil, err
+		}
+		unsignedTransactions = append(unsignedTransactions, batchTransactions...)
 	}
+	return unsignedTransactions, nil
+}
 
-	payments := []*libhtnwallet.Payment{{
-		Address: toAddress,
-		Amount:  spendValue,
-	}}
-	if changeSompi > 0 {
-		payments = append(payments, &libhtnwallet.Payment{
-			Address: changeAddress,
-			Amount:  changeSompi,
-		})
+// requestedPayments decodes the recipients of a request, given either as a single address and amount,
+// or as a list of outputs
+func (s *server) requestedPayments(address string, amount uint64, outputs []*pb.PaymentOutput, isSendAll bool) (
+	[]*libhtnwallet.Payment, error) {
+
+	if len(outputs) == 0 {
+		outputs = []*pb.PaymentOutput{{Address: address, Amount: amount}}
+	} else if address != "" || amount != 0 {
+		return nil, errors.New("a single address and amount cannot be combined with a list of outputs")
 	}
-	unsignedTransaction, err := libhtnwallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
-		s.keysFile.MinimumSignatures,
-		payments, selectedUTXOs)
-	if err != nil {
-		return nil, err
+	if isSendAll && len(outputs) > 1 {
+		return nil, errors.New("sending all funds is only possible to a single address")
 	}
 
-	unsignedTransactions, err := s.maybeAutoCompoundTransaction(unsignedTransaction, toAddress, changeAddress, changeWalletAddress)
-	if err != nil {
-		return nil, err
+	payments := make([]*libhtnwallet.Payment, len(outputs))
+	totalAmount := uint64(0)
+	for i, output := range outputs {
+		toAddress, err := util.DecodeAddress(output.Address, s.params.Prefix)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid address of output #%d", i)
+		}
+		if !isSendAll && output.Amount == 0 {
+			return nil, errors.Errorf("output #%d to %s has a zero amount", i, output.Address)
+		}
+		if totalAmount+output.Amount < totalAmount {
+			return nil, errors.New("the total amount of the outputs overflows")
+		}
+		totalAmount += output.Amount
+		payments[i] = &libhtnwallet.Payment{
+			Address: toAddress,
+			Amount:  output.Amount,
+		}
 	}
-	return unsignedTransactions, nil
+	return payments, nil
 }
 
 func (s *server) sortUTXOsByAmountAscending() {
@@ -269,7 +364,8 @@ func (s *server) sortUTXOsByAmountDescending() {
 	})
 }
 
-func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uint64, fromAddresses []*walletAddress) (
+func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uint64, fromAddresses []*walletAddress,
+	excludedOutpoints map[externalapi.DomainOutpoint]struct{}) (
 	selectedUTXOs []*libhtnwallet.UTXO, totalReceived uint64, changeSompi uint64, err error) {
 
 	selectedUTXOs = []*libhtnwallet.UTXO{}
@@ -284,7 +380,11 @@ func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uin
 
 	for _, utxo := range s.utxosSortedByAmount {
 		if (fromAddresses != nil && !walletAddressesContain(fromAddresses, utxo.address)) ||
-			!s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) {
+			!s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, dagInfo.PastMedianTime) ||
+			s.keysFile.IsOutpointLocked(*utxo.Outpoint) {
+			continue
+		}
+		if _, ok := excludedOutpoints[*utxo.Outpoint]; ok {
 			continue
 		}
 
@@ -316,21 +416,36 @@ func (s *server) selectUTXOs(spendAmount uint64, isSendAll bool, feePerInput uin
 		}
 	}
 
-	fee := feePerInput * uint64(len(selectedUTXOs))
+	totalReceived, changeSompi, err = selectionTotals(totalValue, len(selectedUTXOs), spendAmount, isSendAll, feePerInput)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return selectedUTXOs, totalReceived, changeSompi, nil
+}
+
+// selectionTotals returns the amount the recipients receive and the change of spending UTXOs with the given total value
+func selectionTotals(totalValue uint64, numUTXOs int, spendAmount uint64, isSendAll bool, feePerInput uint64) (
+	totalReceived uint64, changeSompi uint64, err error) {
+
+	fee := feePerInput * uint64(numUTXOs)
 	var totalSpend uint64
 	if isSendAll {
 		totalSpend = totalValue
+		if totalValue < fee {
+			return 0, 0, errors.Errorf("Insufficient funds for send: %f required, while only %f available",
+				float64(fee)/constants.SompiPerHoosat, float64(totalValue)/constants.SompiPerHoosat)
+		}
 		totalReceived = totalValue - fee
 	} else {
 		totalSpend = spendAmount + fee
 		totalReceived = spendAmount
 	}
 	if totalValue < totalSpend {
-		return nil, 0, 0, errors.Errorf("Insufficient funds for send: %f required, while only %f available",
+		return 0, 0, errors.Errorf("Insufficient funds for send: %f required, while only %f available",
 			float64(totalSpend)/constants.SompiPerHoosat, float64(totalValue)/constants.SompiPerHoosat)
 	}
 
-	return selectedUTXOs, totalReceived, totalValue - totalSpend, nil
+	return totalReceived, totalValue - totalSpend, nil
 }
 
 func walletAddressesContain(addresses []*walletAddress, contain *walletAddress) bool {
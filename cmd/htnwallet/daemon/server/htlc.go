@@ -0,0 +1,296 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/pkg/errors"
+)
+
+func (s *server) NewHTLC(_ context.Context, request *pb.NewHTLCRequest) (*pb.NewHTLCResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.isMultisig() {
+		return nil, errors.New("hash time-locked contracts are only supported by single signer wallets")
+	}
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+	if len(request.SecretHash) != sha256.Size {
+		return nil, errors.Errorf("the secret hash must be %d bytes long", sha256.Size)
+	}
+
+	recipientAddress, err := util.DecodeAddress(request.RecipientAddress, s.params.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	if !s.isPublicKeyAddressOfWalletType(recipientAddress) {
+		return nil, errors.Errorf("the recipient address must be a pay-to-pubkey address of the same "+
+			"signature scheme as this wallet, but got %s", request.RecipientAddress)
+	}
+
+	refundAddress, err := s.newExternalAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	htlcScriptData := &txscript.HTLCScriptData{
+		RecipientPublicKey: recipientAddress.ScriptAddress(),
+		RefundPublicKey:    refundAddress.ScriptAddress(),
+		LockTime:           request.LockTime,
+		IsECDSA:            s.keysFile.ECDSA,
+	}
+	copy(htlcScriptData.SecretHash[:], request.SecretHash)
+	contract, err := txscript.PayToHTLCScript(htlcScriptData)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := util.NewAddressScriptHash(contract, s.params.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NewHTLCResponse{
+		Address:       address.String(),
+		Contract:      contract,
+		RefundAddress: refundAddress.String(),
+	}, nil
+}
+
+func (s *server) AuditHTLC(_ context.Context, request *pb.AuditHTLCRequest) (*pb.AuditHTLCResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	htlc, err := s.parseHTLC(request.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	utxos, err := s.htlcUTXOs(htlc)
+	if err != nil {
+		return nil, err
+	}
+	outputs := make([]*pb.HTLCOutput, len(utxos))
+	for i, utxo := range utxos {
+		outputs[i] = &pb.HTLCOutput{
+			Outpoint: &pb.Outpoint{
+				TransactionId: utxo.Outpoint.TransactionID.String(),
+				Index:         utxo.Outpoint.Index,
+			},
+			Amount: utxo.UTXOEntry.Amount(),
+		}
+	}
+
+	_, isRecipient, err := s.findWalletAddress(htlc.recipientAddress.String())
+	if err != nil {
+		return nil, err
+	}
+	_, isRefunder, err := s.findWalletAddress(htlc.refundAddress.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AuditHTLCResponse{
+		Address:          htlc.address.String(),
+		RecipientAddress: htlc.recipientAddress.String(),
+		RefundAddress:    htlc.refundAddress.String(),
+		SecretHash:       htlc.data.SecretHash[:],
+		LockTime:         htlc.data.LockTime,
+		Outputs:          outputs,
+		IsRecipient:      isRecipient,
+		IsRefunder:       isRefunder,
+	}, nil
+}
+
+func (s *server) CreateUnsignedHTLCTransaction(_ context.Context,
+	request *pb.CreateUnsignedHTLCTransactionRequest) (*pb.CreateUnsignedHTLCTransactionResponse, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.isMultisig() {
+		return nil, errors.New("hash time-locked contracts are only supported by single signer wallets")
+	}
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	htlc, err := s.parseHTLC(request.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	keyAddress := htlc.recipientAddress
+	lockTime := uint64(0)
+	if request.IsRefund {
+		keyAddress = htlc.refundAddress
+		lockTime = htlc.data.LockTime
+
+		dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+		if err != nil {
+			return nil, err
+		}
+		unlockDAAScore, unlockTimestamp := lockTimeUnlockTime(lockTime)
+		if !isUnlockTimeReached(unlockDAAScore, unlockTimestamp, dagInfo.VirtualDAAScore, dagInfo.PastMedianTime) {
+			return nil, errors.Errorf("the contract can't be refunded before its lock time %d", lockTime)
+		}
+	} else {
+		secretHash := sha256.Sum256(request.Secret)
+		if len(request.Secret) != txscript.HTLCSecretSize || !bytes.Equal(secretHash[:], htlc.data.SecretHash[:]) {
+			return nil, errors.New("the secret doesn't match the secret hash of the contract")
+		}
+	}
+
+	walletAddr, exists, err := s.findWalletAddress(keyAddress.String())
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Errorf("the key of address %s, that may spend the contract, doesn't belong to this wallet",
+			keyAddress)
+	}
+
+	var toAddress util.Address
+	if request.ToAddress == "" {
+		toAddress, _, err = s.changeAddress(false, nil)
+	} else {
+		toAddress, err = util.DecodeAddress(request.ToAddress, s.params.Prefix)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	utxos, err := s.htlcUTXOs(htlc)
+	if err != nil {
+		return nil, err
+	}
+	if len(utxos) == 0 {
+		return nil, errors.Errorf("contract address %s has no outputs", htlc.address)
+	}
+
+	selectedUTXOs := make([]*libhtnwallet.ScriptHashUTXO, len(utxos))
+	totalValue := uint64(0)
+	for i, utxo := range utxos {
+		selectedUTXOs[i] = &libhtnwallet.ScriptHashUTXO{
+			UTXO: &libhtnwallet.UTXO{
+				Outpoint:       utxo.Outpoint,
+				UTXOEntry:      utxo.UTXOEntry,
+				DerivationPath: s.walletAddressPath(walletAddr),
+			},
+			ExtendedPublicKey:     s.keysFile.ExtendedPublicKeys[0],
+			RedeemScript:          request.Contract,
+			RedeemScriptArguments: libhtnwallet.HTLCRedeemScriptArguments(request.Secret, request.IsRefund),
+		}
+		totalValue += utxo.UTXOEntry.Amount()
+	}
+
+	fee := feePerInput * uint64(len(selectedUTXOs))
+	if totalValue <= fee {
+		return nil, errors.Errorf("not enough funds: total %d sompi <= fee %d sompi", totalValue, fee)
+	}
+
+	unsignedTransaction, err := libhtnwallet.CreateUnsignedScriptHashTransaction(selectedUTXOs,
+		[]*libhtnwallet.Payment{{
+			Address: toAddress,
+			Amount:  totalValue - fee,
+		}}, lockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateUnsignedHTLCTransactionResponse{UnsignedTransaction: unsignedTransaction}, nil
+}
+
+// htlc is a hash time-locked contract along with the addresses of the contract itself and of its keys
+type htlc struct {
+	data             *txscript.HTLCScriptData
+	address          util.Address
+	recipientAddress util.Address
+	refundAddress    util.Address
+}
+
+func (s *server) parseHTLC(contract []byte) (*htlc, error) {
+	data, err := txscript.ExtractHTLCScriptData(contract)
+	if err != nil {
+		return nil, err
+	}
+	if data == nil {
+		return nil, errors.New("the contract is not a hash time-locked contract")
+	}
+
+	address, err := util.NewAddressScriptHash(contract, s.params.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	recipientAddress, err := s.publicKeyAddress(data.RecipientPublicKey, data.IsECDSA)
+	if err != nil {
+		return nil, err
+	}
+	refundAddress, err := s.publicKeyAddress(data.RefundPublicKey, data.IsECDSA)
+	if err != nil {
+		return nil, err
+	}
+
+	return &htlc{
+		data:             data,
+		address:          address,
+		recipientAddress: recipientAddress,
+		refundAddress:    refundAddress,
+	}, nil
+}
+
+func (s *server) publicKeyAddress(publicKey []byte, isECDSA bool) (util.Address, error) {
+	if isECDSA {
+		return util.NewAddressPublicKeyECDSA(publicKey, s.params.Prefix)
+	}
+	return util.NewAddressPublicKey(publicKey, s.params.Prefix)
+}
+
+// htlcUTXOs returns the outputs of the given contract, except for the ones this wallet already spent
+func (s *server) htlcUTXOs(htlc *htlc) ([]*walletUTXO, error) {
+	getUTXOsByAddressesResponse, err := s.rpcClient.GetUTXOsByAddresses([]string{htlc.address.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	utxos := make([]*walletUTXO, 0, len(getUTXOsByAddressesResponse.Entries))
+	for _, entry := range getUTXOsByAddressesResponse.Entries {
+		outpoint, err := appmessage.RPCOutpointToDomainOutpoint(entry.Outpoint)
+		if err != nil {
+			return nil, err
+		}
+		if broadcastTime, ok := s.usedOutpoints[*outpoint]; ok && !s.usedOutpointHasExpired(broadcastTime) {
+			continue
+		}
+
+		utxoEntry, err := appmessage.RPCUTXOEntryToUTXOEntry(entry.UTXOEntry)
+		if err != nil {
+			return nil, err
+		}
+		utxos = append(utxos, &walletUTXO{
+			Outpoint:  outpoint,
+			UTXOEntry: utxoEntry,
+		})
+	}
+	return utxos, nil
+}
+
+// isPublicKeyAddressOfWalletType returns whether the given address is a pay-to-pubkey address of the
+// signature scheme of this wallet
+func (s *server) isPublicKeyAddressOfWalletType(address util.Address) bool {
+	if s.keysFile.ECDSA {
+		_, ok := address.(*util.AddressPublicKeyECDSA)
+		return ok
+	}
+	_, ok := address.(*util.AddressPublicKey)
+	return ok
+}
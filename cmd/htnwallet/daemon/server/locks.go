@@ -0,0 +1,311 @@
+package server
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/keys"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/pkg/errors"
+)
+
+func lockScriptAddress(params *dagconfig.Params, lockScript *keys.LockScript) (util.Address, error) {
+	return util.NewAddressScriptHash(lockScript.RedeemScript, params.Prefix)
+}
+
+func lockScriptsByAddress(params *dagconfig.Params, lockScripts []*keys.LockScript) (map[string]*keys.LockScript, error) {
+	lockScriptsByAddress := make(map[string]*keys.LockScript, len(lockScripts))
+	for _, lockScript := range lockScripts {
+		address, err := lockScriptAddress(params, lockScript)
+		if err != nil {
+			return nil, err
+		}
+		lockScriptsByAddress[address.String()] = lockScript
+	}
+	return lockScriptsByAddress, nil
+}
+
+// lockScriptsWithLock returns a copy of the lock scripts by their addresses, for use outside of s.lock
+func (s *server) lockScriptsWithLock() map[string]*keys.LockScript {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	lockScripts := make(map[string]*keys.LockScript, len(s.lockScripts))
+	for address, lockScript := range s.lockScripts {
+		lockScripts[address] = lockScript
+	}
+	return lockScripts
+}
+
+// lockScriptUnlockTime returns the DAA score from which the given lock script output may be spent by
+// the wallet key, or the past median time from which it may be, for time locks until a timestamp.
+// Those are the first ones that a transaction validated against the virtual satisfies the lock with.
+func lockScriptUnlockTime(entry *walletUTXO) (unlockDAAScore uint64, unlockTimestamp int64) {
+	lockScript := entry.lockScript
+	if lockScript.Type == keys.LockScriptTypeVault {
+		return entry.UTXOEntry.BlockDAAScore() + lockScript.Delay, 0
+	}
+	return lockTimeUnlockTime(lockScript.LockTime)
+}
+
+// lockTimeUnlockTime returns the DAA score or the past median time from which a transaction with the
+// given lock time is final
+func lockTimeUnlockTime(lockTime uint64) (unlockDAAScore uint64, unlockTimestamp int64) {
+	if lockTime < constants.LockTimeThreshold {
+		return lockTime + 1, 0
+	}
+	return 0, int64(lockTime) + 1
+}
+
+func isLockScriptUnlocked(entry *walletUTXO, virtualDAAScore uint64, pastMedianTime int64) bool {
+	unlockDAAScore, unlockTimestamp := lockScriptUnlockTime(entry)
+	return isUnlockTimeReached(unlockDAAScore, unlockTimestamp, virtualDAAScore, pastMedianTime)
+}
+
+func isUnlockTimeReached(unlockDAAScore uint64, unlockTimestamp int64, virtualDAAScore uint64, pastMedianTime int64) bool {
+	if unlockTimestamp != 0 {
+		return unlockTimestamp <= pastMedianTime
+	}
+	return unlockDAAScore <= virtualDAAScore
+}
+
+// lockScriptOwnerPath returns the derivation path of the wallet key the outputs of the given lock script
+// are locked to
+func (s *server) lockScriptOwnerPath(lockScript *keys.LockScript) string {
+	return s.walletAddressPath(&walletAddress{
+		index:         lockScript.Index,
+		cosignerIndex: s.keysFile.CosignerIndex,
+		keyChain:      libhtnwallet.LockKeychain,
+	})
+}
+
+// vaultRecoveryPath returns the derivation path of the recovery key of the given vault, which is
+// derived from the extended public key of the recovery wallet
+func vaultRecoveryPath(lockScript *keys.LockScript) string {
+	return fmt.Sprintf("m/%d/%d", libhtnwallet.RecoveryKeychain, lockScript.Index)
+}
+
+func (s *server) NewTimeLockAddress(_ context.Context, request *pb.NewTimeLockAddressRequest) (*pb.NewTimeLockAddressResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	lockScript := &keys.LockScript{
+		Type:     keys.LockScriptTypeTimeLock,
+		LockTime: request.LockTime,
+	}
+	address, err := s.addLockScript(lockScript, func(ownerPath string) ([]byte, error) {
+		return libhtnwallet.TimeLockRedeemScript(s.keysFile.ExtendedPublicKeys[0], ownerPath, request.LockTime,
+			s.keysFile.ECDSA)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NewTimeLockAddressResponse{
+		Address:      address,
+		RedeemScript: hex.EncodeToString(lockScript.RedeemScript),
+	}, nil
+}
+
+func (s *server) NewVaultAddress(_ context.Context, request *pb.NewVaultAddressRequest) (*pb.NewVaultAddressResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	lockScript := &keys.LockScript{
+		Type:                      keys.LockScriptTypeVault,
+		Delay:                     request.Delay,
+		RecoveryExtendedPublicKey: request.RecoveryExtendedPublicKey,
+	}
+	address, err := s.addLockScript(lockScript, func(ownerPath string) ([]byte, error) {
+		return libhtnwallet.VaultRedeemScript(s.keysFile.ExtendedPublicKeys[0], ownerPath,
+			request.RecoveryExtendedPublicKey, vaultRecoveryPath(lockScript), request.Delay, s.keysFile.ECDSA)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NewVaultAddressResponse{
+		Address:      address,
+		RedeemScript: hex.EncodeToString(lockScript.RedeemScript),
+	}, nil
+}
+
+// addLockScript locks the given lock script to the next key in the lock key chain, builds its redeem
+// script with the given function, and saves it in the keys file. Returns the address of the lock script.
+func (s *server) addLockScript(lockScript *keys.LockScript,
+	redeemScript func(ownerPath string) ([]byte, error)) (string, error) {
+
+	if s.isMultisig() {
+		return "", errors.New("time locks and vaults are only supported by single signer wallets")
+	}
+
+	lockScript.Index = uint32(len(s.keysFile.LockScripts))
+	var err error
+	lockScript.RedeemScript, err = redeemScript(s.lockScriptOwnerPath(lockScript))
+	if err != nil {
+		return "", err
+	}
+
+	address, err := lockScriptAddress(s.params, lockScript)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.keysFile.AddLockScript(lockScript)
+	if err != nil {
+		return "", err
+	}
+	s.lockScripts[address.String()] = lockScript
+	s.forceSync()
+
+	return address.String(), nil
+}
+
+func (s *server) GetLockedOutputs(_ context.Context, _ *pb.GetLockedOutputsRequest) (*pb.GetLockedOutputsResponse, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	outputs := make([]*pb.LockedOutput, len(s.lockedUTXOs))
+	for i, utxo := range s.lockedUTXOs {
+		address, err := lockScriptAddress(s.params, utxo.lockScript)
+		if err != nil {
+			return nil, err
+		}
+		unlockDAAScore, unlockTimestamp := lockScriptUnlockTime(utxo)
+		outputs[i] = &pb.LockedOutput{
+			Address: address.String(),
+			Type:    utxo.lockScript.Type,
+			Outpoint: &pb.Outpoint{
+				TransactionId: utxo.Outpoint.TransactionID.String(),
+				Index:         utxo.Outpoint.Index,
+			},
+			Amount:          utxo.UTXOEntry.Amount(),
+			UnlockDaaScore:  unlockDAAScore,
+			UnlockTimestamp: unlockTimestamp,
+			IsSpendable:     s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, dagInfo.PastMedianTime),
+		}
+	}
+
+	return &pb.GetLockedOutputsResponse{Outputs: outputs}, nil
+}
+
+func (s *server) CreateUnsignedLockedOutputsTransaction(_ context.Context,
+	request *pb.CreateUnsignedLockedOutputsTransactionRequest) (*pb.CreateUnsignedLockedOutputsTransactionResponse, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.isSynced() {
+		return nil, errors.Errorf("wallet daemon is not synced yet, %s", s.formatSyncStateReport())
+	}
+
+	lockScript, ok := s.lockScripts[request.Address]
+	if !ok {
+		return nil, errors.Errorf("address %s is not a time lock or a vault address of this wallet", request.Address)
+	}
+	if request.IsRecovery && lockScript.Type != keys.LockScriptTypeVault {
+		return nil, errors.Errorf("only vault outputs can be spent with a recovery key")
+	}
+
+	var toAddress util.Address
+	var err error
+	if request.ToAddress == "" {
+		toAddress, _, err = s.changeAddress(false, nil)
+	} else {
+		toAddress, err = util.DecodeAddress(request.ToAddress, s.params.Prefix)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	var selectedUTXOs []*libhtnwallet.ScriptHashUTXO
+	totalValue := uint64(0)
+	for _, utxo := range s.lockedUTXOs {
+		if utxo.lockScript != lockScript {
+			continue
+		}
+		// The recovery key of a vault isn't subject to its delay
+		if !request.IsRecovery && !s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, dagInfo.PastMedianTime) {
+			continue
+		}
+		if broadcastTime, ok := s.usedOutpoints[*utxo.Outpoint]; ok {
+			if s.usedOutpointHasExpired(broadcastTime) {
+				delete(s.usedOutpoints, *utxo.Outpoint)
+			} else {
+				continue
+			}
+		}
+
+		selectedUTXOs = append(selectedUTXOs, s.lockScriptUTXO(utxo, request.IsRecovery))
+		totalValue += utxo.UTXOEntry.Amount()
+	}
+	if len(selectedUTXOs) == 0 {
+		return nil, errors.Errorf("address %s has no outputs that can be spent yet", request.Address)
+	}
+
+	fee := feePerInput * uint64(len(selectedUTXOs))
+	if totalValue <= fee {
+		return nil, errors.Errorf("not enough funds: total %d sompi <= fee %d sompi", totalValue, fee)
+	}
+
+	lockTime := uint64(0)
+	if lockScript.Type == keys.LockScriptTypeTimeLock {
+		lockTime = lockScript.LockTime
+	}
+	unsignedTransaction, err := libhtnwallet.CreateUnsignedScriptHashTransaction(selectedUTXOs,
+		[]*libhtnwallet.Payment{{
+			Address: toAddress,
+			Amount:  totalValue - fee,
+		}}, lockTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateUnsignedLockedOutputsTransactionResponse{UnsignedTransaction: unsignedTransaction}, nil
+}
+
+// lockScriptUTXO returns the given lock script output in the form it's spent in: with the wallet key
+// through the lock, or with the recovery key of a vault if isRecovery is set
+func (s *server) lockScriptUTXO(utxo *walletUTXO, isRecovery bool) *libhtnwallet.ScriptHashUTXO {
+	lockScript := utxo.lockScript
+	scriptHashUTXO := &libhtnwallet.ScriptHashUTXO{
+		UTXO: &libhtnwallet.UTXO{
+			Outpoint:       utxo.Outpoint,
+			UTXOEntry:      utxo.UTXOEntry,
+			DerivationPath: s.lockScriptOwnerPath(lockScript),
+		},
+		ExtendedPublicKey: s.keysFile.ExtendedPublicKeys[0],
+		RedeemScript:      lockScript.RedeemScript,
+	}
+	if lockScript.Type != keys.LockScriptTypeVault {
+		return scriptHashUTXO
+	}
+
+	scriptHashUTXO.RedeemScriptArguments = libhtnwallet.VaultRedeemScriptArguments(isRecovery)
+	if isRecovery {
+		scriptHashUTXO.UTXO.DerivationPath = vaultRecoveryPath(lockScript)
+		scriptHashUTXO.ExtendedPublicKey = lockScript.RecoveryExtendedPublicKey
+	} else {
+		scriptHashUTXO.Sequence = lockScript.Delay
+	}
+	return scriptHashUTXO
+}
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/pkg/errors"
+)
+
+func (s *server) SignMessage(_ context.Context, request *pb.SignMessageRequest) (*pb.SignMessageResponse, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.isMultisig() {
+		return nil, errors.New("messages can't be signed by a multisig wallet, since its addresses " +
+			"aren't backed by a single key")
+	}
+
+	walletAddr, exists, err := s.findWalletAddress(request.Address)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.Errorf("address %s doesn't belong to this wallet", request.Address)
+	}
+
+	mnemonics, err := s.keysFile.DecryptMnemonics(request.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := libhtnwallet.SignMessage(s.params, mnemonics[0], s.walletAddressPath(walletAddr),
+		[]byte(request.Message), s.keysFile.ECDSA)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SignMessageResponse{Signature: signature}, nil
+}
+
+// findWalletAddress looks for the given address among the addresses with a balance, and
+// among all the addresses that were given out so far, which might not have received any funds yet
+func (s *server) findWalletAddress(address string) (*walletAddress, bool, error) {
+	if walletAddr, exists := s.addressSet[address]; exists {
+		return walletAddr, true, nil
+	}
+
+	lastUsedIndexes := map[uint8]uint32{
+		libhtnwallet.ExternalKeychain: s.keysFile.LastUsedExternalIndex(),
+		libhtnwallet.InternalKeychain: s.keysFile.LastUsedInternalIndex(),
+	}
+	for _, keyChain := range keyChains {
+		for index := uint32(0); index <= lastUsedIndexes[keyChain]; index++ {
+			walletAddr := &walletAddress{
+				index:         index,
+				cosignerIndex: s.keysFile.CosignerIndex,
+				keyChain:      keyChain,
+			}
+			addressString, err := s.walletAddressString(walletAddr)
+			if err != nil {
+				return nil, false, err
+			}
+			if addressString == address {
+				return walletAddr, true, nil
+			}
+		}
+	}
+	return nil, false, nil
+}
+
+func (s *server) VerifyMessage(_ context.Context, request *pb.VerifyMessageRequest) (*pb.VerifyMessageResponse, error) {
+	address, err := util.DecodeAddress(request.Address, s.params.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	isValid, err := libhtnwallet.VerifyMessage(address, []byte(request.Message), request.Signature)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VerifyMessageResponse{IsValid: isValid}, nil
+}
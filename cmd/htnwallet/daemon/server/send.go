@@ -10,8 +10,8 @@ func (s *server) Send(_ context.Context, request *pb.SendRequest) (*pb.SendRespo
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	unsignedTransactions, err := s.createUnsignedTransactions(request.ToAddress, request.Amount, request.IsSendAll,
-		request.From, request.UseExistingChangeAddress)
+	unsignedTransactions, err := s.createUnsignedTransactions(request.ToAddress, request.Amount, request.Outputs,
+		request.IsSendAll, request.From, request.Outpoints, request.UseExistingChangeAddress)
 
 	if err != nil {
 		return nil, err
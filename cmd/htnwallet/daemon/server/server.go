@@ -45,6 +45,9 @@ type server struct {
 	txMassCalculator                *txmass.Calculator
 	usedOutpoints                   map[externalapi.DomainOutpoint]time.Time
 	firstSyncDone                   atomic.Bool
+	history                         *transactionHistory
+	lockScripts                     map[string]*keys.LockScript // Lock scripts by their addresses
+	lockedUTXOs                     []*walletUTXO
 
 	isLogFinalProgressLineShown bool
 	maxUsedAddressesForLog      uint32
@@ -93,6 +96,25 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 	if err != nil {
 		return err
 	}
+	if keysFile.IsWatchOnly() {
+		log.Infof("The wallet is watch-only. Signing and sending transactions is disabled")
+	}
+
+	lockScripts, err := lockScriptsByAddress(params, keysFile.LockScripts)
+	if err != nil {
+		return err
+	}
+
+	history, err := openTransactionHistory(historyDBPath(keysFile.Path()))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := history.close()
+		if err != nil {
+			log.Errorf("Error closing the transaction history database: %s", err)
+		}
+	}()
 
 	dagInfo, err := rpcClient.GetBlockDAGInfo()
 	if err != nil {
@@ -115,8 +137,11 @@ func Start(params *dagconfig.Params, listen, rpcServer string, keysFilePath stri
 		shutdown:                    make(chan struct{}),
 		forceSyncChan:               make(chan struct{}),
 		addressSet:                  make(walletAddressSet),
+		lockScripts:                 lockScripts,
+		lockedUTXOs:                 []*walletUTXO{},
 		txMassCalculator:            txmass.NewCalculator(params.MassPerTxByte, params.MassPerScriptPubKeyByte, params.MassPerSigOp),
 		usedOutpoints:               map[externalapi.DomainOutpoint]time.Time{},
+		history:                     history,
 		isLogFinalProgressLineShown: false,
 		maxUsedAddressesForLog:      0,
 		maxProcessedAddressesForLog: 0,
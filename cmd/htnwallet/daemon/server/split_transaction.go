@@ -18,16 +18,21 @@ import (
 // transaction.
 // If it is - the transaction is split into multiple transactions, each with a portion of the inputs and a single output
 // into a change address.
-// An additional `mergeTransaction` is generated - which merges the outputs of the above splits into a single output
-// paying to the original transaction's payee.
-func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, toAddress util.Address,
-	changeAddress util.Address, changeWalletAddress *walletAddress) ([][]byte, error) {
+// An additional `mergeTransaction` is generated - which merges the outputs of the above splits into the outputs
+// paying to the original transaction's payees.
+// outputsFee is the fee paid for the payment outputs on top of feePerInput.
+// selectedOutpoints holds the outpoints spent by the transactions created so far, and is updated with any
+// additional outpoint selected for the merge.
+func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, payments []*libhtnwallet.Payment,
+	outputsFee uint64, changeAddress util.Address, changeWalletAddress *walletAddress,
+	selectedOutpoints map[externalapi.DomainOutpoint]struct{}) ([][]byte, error) {
 	transaction, err := serialization.DeserializePartiallySignedTransaction(transactionBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	splitTransactions, err := s.maybeSplitAndMergeTransaction(transaction, toAddress, changeAddress, changeWalletAddress)
+	splitTransactions, err := s.maybeSplitAndMergeTransaction(transaction, payments, outputsFee, changeAddress,
+		changeWalletAddress, selectedOutpoints)
 	if err != nil {
 		return nil, err
 	}
@@ -44,21 +49,28 @@ func (s *server) maybeAutoCompoundTransaction(transactionBytes []byte, toAddress
 func (s *server) mergeTransaction(
 	splitTransactions []*serialization.PartiallySignedTransaction,
 	originalTransaction *serialization.PartiallySignedTransaction,
-	toAddress util.Address,
+	payments []*libhtnwallet.Payment,
+	outputsFee uint64,
 	changeAddress util.Address,
 	changeWalletAddress *walletAddress,
+	selectedOutpoints map[externalapi.DomainOutpoint]struct{},
 ) (*serialization.PartiallySignedTransaction, error) {
 	numOutputs := len(originalTransaction.Tx.Outputs)
-	if numOutputs > 2 || numOutputs == 0 {
-		// This is a sanity check to make sure originalTransaction has either 1 or 2 outputs:
-		// 1. For the payment itself
-		// 2. (optional) for change
-		return nil, errors.Errorf("original transaction has %d outputs, while 1 or 2 are expected",
-			len(originalTransaction.Tx.Outputs))
+	if len(payments) == 0 || (numOutputs != len(payments) && numOutputs != len(payments)+1) {
+		// This is a sanity check to make sure originalTransaction has:
+		// 1. An output for each of the payments
+		// 2. (optional) An output for change
+		return nil, errors.Errorf("original transaction has %d outputs, while %d or %d are expected",
+			len(originalTransaction.Tx.Outputs), len(payments), len(payments)+1)
 	}
 
 	totalValue := uint64(0)
-	sentValue := originalTransaction.Tx.Outputs[0].Value
+	sentValue := uint64(0)
+	for _, payment := range payments {
+		sentValue += payment.Amount
+	}
+	// The outputs fee is spent like a payment, so that it's left out of the change
+	spentValue := sentValue + outputsFee
 	utxos := make([]*libhtnwallet.UTXO, len(splitTransactions))
 	for i, splitTransaction := range splitTransactions {
 		output := splitTransaction.Tx.Outputs[0]
@@ -74,10 +86,10 @@ func (s *server) mergeTransaction(
 		totalValue -= feePerInput
 	}
 
-	if totalValue < sentValue {
+	if totalValue < spentValue {
 		// sometimes the fees from compound transactions make the total output higher than what's available from selected
 		// utxos, in such cases - find one more UTXO and use it.
-		additionalUTXOs, totalValueAdded, err := s.moreUTXOsForMergeTransaction(utxos, sentValue-totalValue)
+		additionalUTXOs, totalValueAdded, err := s.moreUTXOsForMergeTransaction(selectedOutpoints, spentValue-totalValue)
 		if err != nil {
 			return nil, err
 		}
@@ -85,19 +97,16 @@ func (s *server) mergeTransaction(
 		totalValue += totalValueAdded
 	}
 
-	payments := []*libhtnwallet.Payment{{
-		Address: toAddress,
-		Amount:  sentValue,
-	}}
-	if totalValue > sentValue {
-		payments = append(payments, &libhtnwallet.Payment{
+	outputs := append([]*libhtnwallet.Payment{}, payments...)
+	if totalValue > spentValue {
+		outputs = append(outputs, &libhtnwallet.Payment{
 			Address: changeAddress,
-			Amount:  totalValue - sentValue,
+			Amount:  totalValue - spentValue,
 		})
 	}
 
 	mergeTransactionBytes, err := libhtnwallet.CreateUnsignedTransaction(s.keysFile.ExtendedPublicKeys,
-		s.keysFile.MinimumSignatures, payments, utxos)
+		s.keysFile.MinimumSignatures, outputs, utxos)
 	if err != nil {
 		return nil, err
 	}
@@ -105,8 +114,9 @@ func (s *server) mergeTransaction(
 	return serialization.DeserializePartiallySignedTransaction(mergeTransactionBytes)
 }
 
-func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.PartiallySignedTransaction, toAddress util.Address,
-	changeAddress util.Address, changeWalletAddress *walletAddress) ([]*serialization.PartiallySignedTransaction, error) {
+func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.PartiallySignedTransaction,
+	payments []*libhtnwallet.Payment, outputsFee uint64, changeAddress util.Address, changeWalletAddress *walletAddress,
+	selectedOutpoints map[externalapi.DomainOutpoint]struct{}) ([]*serialization.PartiallySignedTransaction, error) {
 
 	transactionMass, err := s.estimateMassAfterSignatures(transaction)
 	if err != nil {
@@ -134,12 +144,14 @@ func (s *server) maybeSplitAndMergeTransaction(transaction *serialization.Partia
 	}
 
 	if len(splitTransactions) > 1 {
-		mergeTransaction, err := s.mergeTransaction(splitTransactions, transaction, toAddress, changeAddress, changeWalletAddress)
+		mergeTransaction, err := s.mergeTransaction(splitTransactions, transaction, payments, outputsFee, changeAddress,
+			changeWalletAddress, selectedOutpoints)
 		if err != nil {
 			return nil, err
 		}
 		// Recursion will be 2-3 iterations deep even in the rarest` cases, so considered safe..
-		splitMergeTransaction, err := s.maybeSplitAndMergeTransaction(mergeTransaction, toAddress, changeAddress, changeWalletAddress)
+		splitMergeTransaction, err := s.maybeSplitAndMergeTransaction(mergeTransaction, payments, outputsFee, changeAddress,
+			changeWalletAddress, selectedOutpoints)
 		if err != nil {
 			return nil, err
 		}
@@ -248,23 +260,20 @@ func (s *server) estimateMassAfterSignatures(transaction *serialization.Partiall
 	return s.txMassCalculator.CalculateTransactionMass(transactionWithSignatures), nil
 }
 
-func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libhtnwallet.UTXO, requiredAmount uint64) (
+func (s *server) moreUTXOsForMergeTransaction(selectedOutpoints map[externalapi.DomainOutpoint]struct{}, requiredAmount uint64) (
 	additionalUTXOs []*libhtnwallet.UTXO, totalValueAdded uint64, err error) {
 
 	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
 	if err != nil {
 		return nil, 0, err
 	}
-	alreadySelectedUTXOsMap := make(map[externalapi.DomainOutpoint]struct{}, len(alreadySelectedUTXOs))
-	for _, alreadySelectedUTXO := range alreadySelectedUTXOs {
-		alreadySelectedUTXOsMap[*alreadySelectedUTXO.Outpoint] = struct{}{}
-	}
 
 	for _, utxo := range s.utxosSortedByAmount {
-		if _, ok := alreadySelectedUTXOsMap[*utxo.Outpoint]; ok {
+		if _, ok := selectedOutpoints[*utxo.Outpoint]; ok {
 			continue
 		}
-		if !s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore) {
+		if !s.isUTXOSpendable(utxo, dagInfo.VirtualDAAScore, dagInfo.PastMedianTime) ||
+			s.keysFile.IsOutpointLocked(*utxo.Outpoint) {
 			continue
 		}
 		additionalUTXOs = append(additionalUTXOs, &libhtnwallet.UTXO{
@@ -279,6 +288,9 @@ func (s *server) moreUTXOsForMergeTransaction(alreadySelectedUTXOs []*libhtnwall
 	if totalValueAdded < requiredAmount {
 		return nil, 0, errors.Errorf("Insufficient funds for merge transaction")
 	}
+	for _, additionalUTXO := range additionalUTXOs {
+		selectedOutpoints[*additionalUTXO.Outpoint] = struct{}{}
+	}
 
 	return additionalUTXOs, totalValueAdded, nil
 }
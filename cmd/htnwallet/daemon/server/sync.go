@@ -5,6 +5,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/keys"
 	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
 
 	"github.com/Hoosat-Oy/HTND/app/appmessage"
@@ -227,9 +228,12 @@ func (s *server) usedOutpointHasExpired(outpointBroadcastTime time.Time) bool {
 	return s.startTimeOfLastCompletedRefresh.After(outpointBroadcastTime.Add(time.Minute))
 }
 
-// updateUTXOSet clears the current UTXO set, and re-fills it with the given entries
-func (s *server) updateUTXOSet(entries []*appmessage.UTXOsByAddressesEntry, mempoolEntries []*appmessage.MempoolEntryByAddress, refreshStart time.Time) error {
+// updateUTXOSet clears the current UTXO set and the set of lock script UTXOs, and re-fills them with the given entries
+func (s *server) updateUTXOSet(entries []*appmessage.UTXOsByAddressesEntry, mempoolEntries []*appmessage.MempoolEntryByAddress,
+	lockScripts map[string]*keys.LockScript, refreshStart time.Time) error {
+
 	utxos := make([]*walletUTXO, 0, len(entries))
+	lockedUTXOs := []*walletUTXO{}
 
 	exclude := make(map[appmessage.RPCOutpoint]struct{})
 	for _, entriesByAddress := range mempoolEntries {
@@ -258,6 +262,14 @@ func (s *server) updateUTXOSet(entries []*appmessage.UTXOsByAddressesEntry, memp
 		// No need to lock for reading since the only writer of this set is on `syncLoop` on the same goroutine.
 		address, ok := s.addressSet[entry.Address]
 		if !ok {
+			if lockScript, ok := lockScripts[entry.Address]; ok {
+				lockedUTXOs = append(lockedUTXOs, &walletUTXO{
+					Outpoint:   outpoint,
+					UTXOEntry:  utxoEntry,
+					lockScript: lockScript,
+				})
+				continue
+			}
 			return errors.Errorf("Got result from address %s even though it wasn't requested", entry.Address)
 		}
 		utxos = append(utxos, &walletUTXO{
@@ -272,6 +284,7 @@ func (s *server) updateUTXOSet(entries []*appmessage.UTXOsByAddressesEntry, memp
 	s.lock.Lock()
 	s.startTimeOfLastCompletedRefresh = refreshStart
 	s.utxosSortedByAmount = utxos
+	s.lockedUTXOs = lockedUTXOs
 
 	// Cleanup expired used outpoints to avoid a memory leak
 	for outpoint, broadcastTime := range s.usedOutpoints {
@@ -289,6 +302,10 @@ func (s *server) refreshUTXOs() error {
 
 	// No need to lock for reading since the only writer of this set is on `syncLoop` on the same goroutine.
 	addresses := s.addressSet.strings()
+	lockScripts := s.lockScriptsWithLock()
+	for address := range lockScripts {
+		addresses = append(addresses, address)
+	}
 	// It's important to check the mempool before calling `GetUTXOsByAddresses`:
 	// If we would do it the other way around an output can be spent in the mempool
 	// and not in consensus, and between the calls its spending transaction will be
@@ -299,12 +316,64 @@ func (s *server) refreshUTXOs() error {
 		return err
 	}
 
+	// The transaction history requires the chain changes to be taken before the UTXO set
+	chainChanges, err := s.historyChainChanges()
+	if err != nil {
+		return err
+	}
+
 	getUTXOsByAddressesResponse, err := s.backgroundRPCClient.GetUTXOsByAddresses(addresses)
 	if err != nil {
 		return err
 	}
 
-	return s.updateUTXOSet(getUTXOsByAddressesResponse.Entries, mempoolEntriesByAddresses.Entries, refreshStart)
+	err = s.updateUTXOSet(getUTXOsByAddressesResponse.Entries, mempoolEntriesByAddresses.Entries, lockScripts, refreshStart)
+	if err != nil {
+		return err
+	}
+
+	return s.history.update(chainChanges, getUTXOsByAddressesResponse.Entries,
+		func(address string) bool {
+			_, isLockScriptAddress := lockScripts[address]
+			return isLockScriptAddress || s.isKnownAddress(address)
+		}, s.backgroundGetBlock, refreshStart)
+}
+
+// historyChainChanges returns the changes of the selected chain since the last update of the transaction
+// history. The first update, or an update whose previous chain block is no longer known to the node,
+// starts following the chain from the current selected tip.
+func (s *server) historyChainChanges() (*appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage, error) {
+	if cursor := s.history.chainCursor(); cursor != "" {
+		chainChanges, err := s.backgroundRPCClient.GetVirtualSelectedParentChainFromBlock(cursor, true)
+		if err == nil {
+			return chainChanges, nil
+		}
+		log.Warnf("Could not follow the selected chain from %s, restarting the transaction history from the "+
+			"selected tip: %s", cursor, err)
+	}
+
+	selectedTip, err := s.backgroundRPCClient.GetSelectedTipHash()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage{
+		AddedChainBlockHashes: []string{selectedTip.SelectedTipHash},
+	}, nil
+}
+
+// isKnownAddress returns whether the given address is one of the wallet addresses found by the sync.
+// Callers must either hold s.lock or run on `syncLoop`, which is the only writer of the set.
+func (s *server) isKnownAddress(address string) bool {
+	_, ok := s.addressSet[address]
+	return ok
+}
+
+func (s *server) backgroundGetBlock(hash string, includeTransactions bool) (*appmessage.RPCBlock, error) {
+	response, err := s.backgroundRPCClient.GetBlock(hash, includeTransactions)
+	if err != nil {
+		return nil, err
+	}
+	return response.Block, nil
 }
 
 func (s *server) forceSync() {
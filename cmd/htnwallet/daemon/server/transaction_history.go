@@ -0,0 +1,614 @@
+package server
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/infrastructure/db/database"
+	"github.com/Hoosat-Oy/HTND/infrastructure/db/database/ldb"
+	"github.com/pkg/errors"
+)
+
+const (
+	historyDBCacheSizeMiB = 8
+
+	directionIncoming = "incoming"
+	directionOutgoing = "outgoing"
+	directionSelf     = "self"
+
+	statusPending   = "pending"
+	statusConfirmed = "confirmed"
+	// statusRejected is the status of a transaction that conflicts with an accepted one
+	statusRejected = "rejected"
+)
+
+var (
+	transactionsBucket = database.MakeBucket([]byte("transactions"))
+	chainCursorKey     = database.MakeBucket([]byte("state")).Key([]byte("chain-cursor"))
+)
+
+// historyOutput is a transaction output or a spent previous output,
+// as seen by the wallet
+type historyOutput struct {
+	TransactionID string `json:"transactionId,omitempty"`
+	Index         uint32 `json:"index"`
+	Address       string `json:"address"`
+	Amount        uint64 `json:"amount"`
+}
+
+// transactionRecord is the persisted state of a single transaction in the wallet history.
+// Received holds the outputs paying to the wallet, Spent holds the wallet outputs
+// the transaction spends, and Sent holds the outputs paying to anyone else.
+type transactionRecord struct {
+	TransactionID      string           `json:"transactionId"`
+	Received           []*historyOutput `json:"received,omitempty"`
+	Spent              []*historyOutput `json:"spent,omitempty"`
+	Sent               []*historyOutput `json:"sent,omitempty"`
+	Fee                uint64           `json:"fee"`
+	AcceptingBlockHash string           `json:"acceptingBlockHash,omitempty"`
+	AcceptingDAAScore  uint64           `json:"acceptingDaaScore"`
+	Status             string           `json:"status"`
+	IsCoinbase         bool             `json:"isCoinbase"`
+	Label              string           `json:"label,omitempty"`
+	FirstSeen          int64            `json:"firstSeen"`
+}
+
+func (r *transactionRecord) received() uint64 {
+	return sumHistoryOutputs(r.Received)
+}
+
+func (r *transactionRecord) spent() uint64 {
+	return sumHistoryOutputs(r.Spent)
+}
+
+func (r *transactionRecord) sent() uint64 {
+	return sumHistoryOutputs(r.Sent)
+}
+
+func sumHistoryOutputs(outputs []*historyOutput) uint64 {
+	sum := uint64(0)
+	for _, output := range outputs {
+		sum += output.Amount
+	}
+	return sum
+}
+
+func (r *transactionRecord) direction() string {
+	if r.spent() == 0 {
+		return directionIncoming
+	}
+	if len(r.Sent) == 0 {
+		return directionSelf
+	}
+	return directionOutgoing
+}
+
+func (r *transactionRecord) hasReceivedIndex(index uint32) bool {
+	for _, output := range r.Received {
+		if output.Index == index {
+			return true
+		}
+	}
+	return false
+}
+
+// addReceived records an output paying to the wallet. If the output was previously
+// recorded as sent to someone else (which happens when the wallet didn't yet know its
+// change address) it's moved from Sent to Received. Returns whether the record changed.
+func (r *transactionRecord) addReceived(output *historyOutput) bool {
+	if r.hasReceivedIndex(output.Index) {
+		return false
+	}
+	for i, sentOutput := range r.Sent {
+		if sentOutput.Index == output.Index {
+			r.Sent = append(r.Sent[:i], r.Sent[i+1:]...)
+			break
+		}
+	}
+	r.Received = append(r.Received, output)
+	return true
+}
+
+func (r *transactionRecord) addSpent(output *historyOutput) bool {
+	for _, spentOutput := range r.Spent {
+		if spentOutput.TransactionID == output.TransactionID && spentOutput.Index == output.Index {
+			return false
+		}
+	}
+	r.Spent = append(r.Spent, output)
+	return true
+}
+
+func (r *transactionRecord) addSent(output *historyOutput) bool {
+	if r.hasReceivedIndex(output.Index) {
+		return false
+	}
+	for _, sentOutput := range r.Sent {
+		if sentOutput.Index == output.Index {
+			return false
+		}
+	}
+	r.Sent = append(r.Sent, output)
+	return true
+}
+
+func (r *transactionRecord) toProto(virtualDAAScore uint64) *pb.WalletTransaction {
+	amount := r.received()
+	if r.direction() == directionOutgoing {
+		amount = r.sent()
+	}
+
+	confirmations := uint64(0)
+	if r.Status == statusConfirmed && virtualDAAScore >= r.AcceptingDAAScore {
+		confirmations = virtualDAAScore - r.AcceptingDAAScore + 1
+	}
+
+	counterparties := uniqueAddresses(r.Sent)
+	walletAddresses := uniqueAddresses(append(append([]*historyOutput{}, r.Received...), r.Spent...))
+
+	return &pb.WalletTransaction{
+		TransactionId:     r.TransactionID,
+		Direction:         r.direction(),
+		Status:            r.Status,
+		Amount:            amount,
+		Fee:               r.Fee,
+		AcceptingDaaScore: r.AcceptingDAAScore,
+		Confirmations:     confirmations,
+		IsCoinbase:        r.IsCoinbase,
+		Counterparties:    counterparties,
+		WalletAddresses:   walletAddresses,
+		Label:             r.Label,
+		Timestamp:         r.FirstSeen,
+	}
+}
+
+func uniqueAddresses(outputs []*historyOutput) []string {
+	seen := make(map[string]struct{}, len(outputs))
+	addresses := make([]string, 0, len(outputs))
+	for _, output := range outputs {
+		if _, ok := seen[output.Address]; ok {
+			continue
+		}
+		seen[output.Address] = struct{}{}
+		addresses = append(addresses, output.Address)
+	}
+	sort.Strings(addresses)
+	return addresses
+}
+
+// transactionHistory is the local, persistent history of the transactions
+// that affected the wallet. It's kept in memory in full and every change is
+// written through to the database.
+//
+// The history is built from the transactions the wallet broadcasts, the wallet
+// UTXO set and the transactions accepted by the selected chain since the history
+// was created, which imposes some limits:
+//   - Incoming transactions only show up once they are accepted.
+//   - Outputs that were received and spent before the history was created, or
+//     spent by another instance of the wallet without paying any change back,
+//     are not recorded.
+//   - A transaction whose outputs were all spent before the history started
+//     following the chain is shown as incoming even if it spent wallet outputs.
+type transactionHistory struct {
+	lock    sync.RWMutex
+	db      database.Database
+	records map[string]*transactionRecord
+	cursor  string
+
+	// conflicted holds the pending transactions that spend outputs which were
+	// missing from the UTXO set in the previous update
+	conflicted map[string]struct{}
+}
+
+// historyDBPath returns the path of the history database that belongs
+// to the keys file in the given path
+func historyDBPath(keysFilePath string) string {
+	base := strings.TrimSuffix(filepath.Base(keysFilePath), filepath.Ext(keysFilePath))
+	return filepath.Join(filepath.Dir(keysFilePath), base+"-history")
+}
+
+func openTransactionHistory(path string) (*transactionHistory, error) {
+	db, err := ldb.NewLevelDB(path, historyDBCacheSizeMiB)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening the transaction history database %s", path)
+	}
+
+	return newTransactionHistory(db)
+}
+
+func newTransactionHistory(db database.Database) (*transactionHistory, error) {
+	history := &transactionHistory{
+		db:         db,
+		records:    make(map[string]*transactionRecord),
+		conflicted: make(map[string]struct{}),
+	}
+
+	chainCursor, err := db.Get(chainCursorKey)
+	if err != nil && !database.IsNotFoundError(err) {
+		return nil, err
+	}
+	history.cursor = string(chainCursor)
+
+	cursor, err := db.Cursor(transactionsBucket)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close()
+
+	for ok := cursor.First(); ok; ok = cursor.Next() {
+		value, err := cursor.Value()
+		if err != nil {
+			return nil, err
+		}
+		record := &transactionRecord{}
+		err = json.Unmarshal(value, record)
+		if err != nil {
+			return nil, errors.Wrap(err, "error parsing the transaction history database")
+		}
+		history.records[record.TransactionID] = record
+	}
+
+	return history, nil
+}
+
+func (h *transactionHistory) close() error {
+	return h.db.Close()
+}
+
+func (h *transactionHistory) save(record *transactionRecord) error {
+	serialized, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	return h.db.Put(transactionsBucket.Key([]byte(record.TransactionID)), serialized)
+}
+
+func (h *transactionHistory) recordWithCreate(transactionID string, now time.Time) *transactionRecord {
+	record, ok := h.records[transactionID]
+	if !ok {
+		record = &transactionRecord{
+			TransactionID: transactionID,
+			Status:        statusPending,
+			FirstSeen:     now.UnixMilli(),
+		}
+		h.records[transactionID] = record
+	}
+	return record
+}
+
+// addBroadcastTransaction records a transaction the wallet has just broadcast. Any output
+// that doesn't pay to an address with known balance is recorded as sent, and is
+// reclassified once it shows up in the wallet UTXO set.
+func (h *transactionHistory) addBroadcastTransaction(transactionID string,
+	spent []*historyOutput, outputs []*historyOutput, isWalletAddress func(string) bool, now time.Time) error {
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	record := h.recordWithCreate(transactionID, now)
+	for _, output := range spent {
+		record.addSpent(output)
+	}
+
+	totalOutputs := uint64(0)
+	for _, output := range outputs {
+		totalOutputs += output.Amount
+		if isWalletAddress(output.Address) {
+			record.addReceived(output)
+		} else {
+			record.addSent(output)
+		}
+	}
+	if totalSpent := record.spent(); totalSpent > totalOutputs {
+		record.Fee = totalSpent - totalOutputs
+	}
+
+	return h.save(record)
+}
+
+// chainCursor returns the selected chain block the history followed the chain up to,
+// or an empty string if it didn't start following the chain yet
+func (h *transactionHistory) chainCursor() string {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.cursor
+}
+
+// update brings the history up to date with the wallet UTXO set and with the changes of the selected
+// chain since the previous update. chainChanges must be taken before utxoEntries: a transaction that
+// shows up in utxoEntries was then either accepted by chainChanges or by an earlier update, in which
+// case it was already known to that update, or it's accepted by the chain changes of the next update.
+// getBlock is only called for the accepting blocks of the transactions in the history.
+func (h *transactionHistory) update(chainChanges *appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage,
+	utxoEntries []*appmessage.UTXOsByAddressesEntry, isWalletAddress func(string) bool,
+	getBlock func(hash string, includeTransactions bool) (*appmessage.RPCBlock, error), now time.Time) error {
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	changed := make(map[string]*transactionRecord)
+
+	removedChainBlocks := make(map[string]struct{}, len(chainChanges.RemovedChainBlockHashes))
+	for _, removed := range chainChanges.RemovedChainBlockHashes {
+		removedChainBlocks[removed] = struct{}{}
+	}
+	for _, record := range h.records {
+		if record.AcceptingBlockHash == "" {
+			continue
+		}
+		if _, ok := removedChainBlocks[record.AcceptingBlockHash]; !ok {
+			continue
+		}
+		record.Status = statusPending
+		record.AcceptingBlockHash = ""
+		record.AcceptingDAAScore = 0
+		changed[record.TransactionID] = record
+	}
+
+	utxos := make(map[appmessage.RPCOutpoint]*appmessage.UTXOsByAddressesEntry, len(utxoEntries))
+	for _, entry := range utxoEntries {
+		utxos[*entry.Outpoint] = entry
+
+		record := h.recordWithCreate(entry.Outpoint.TransactionID, now)
+		recordChanged := record.addReceived(&historyOutput{
+			Index:   entry.Outpoint.Index,
+			Address: entry.Address,
+			Amount:  entry.UTXOEntry.Amount,
+		})
+		// All the outputs of a transaction share the DAA score of the chain block that accepted it
+		if record.Status != statusConfirmed || record.AcceptingDAAScore != entry.UTXOEntry.BlockDAAScore {
+			record.Status = statusConfirmed
+			record.AcceptingDAAScore = entry.UTXOEntry.BlockDAAScore
+			record.IsCoinbase = entry.UTXOEntry.IsCoinbase
+			recordChanged = true
+		}
+		if recordChanged {
+			changed[record.TransactionID] = record
+		}
+	}
+
+	for _, accepted := range chainChanges.AcceptedTransactionIDs {
+		err := h.updateFromAcceptedTransactionIDs(accepted, isWalletAddress, getBlock, changed)
+		if err != nil {
+			return err
+		}
+	}
+
+	// A pending transaction is rejected once another transaction that spends one of the same outputs is
+	// accepted. An output that is missing from the UTXO set may have been spent by the pending transaction
+	// itself after chainChanges were taken, so the transaction is only rejected if it's still not accepted
+	// by the chain changes of the next update.
+	conflicted := make(map[string]struct{})
+	for _, record := range h.records {
+		if len(record.Spent) == 0 || record.Status == statusConfirmed {
+			continue
+		}
+		isSpentOutputMissing := false
+		for _, spent := range record.Spent {
+			if _, ok := utxos[appmessage.RPCOutpoint{TransactionID: spent.TransactionID, Index: spent.Index}]; !ok {
+				isSpentOutputMissing = true
+				break
+			}
+		}
+
+		switch {
+		case !isSpentOutputMissing && record.Status == statusRejected:
+			// The transaction that conflicted with it was removed from the selected chain
+			record.Status = statusPending
+		case isSpentOutputMissing && record.Status == statusPending:
+			conflicted[record.TransactionID] = struct{}{}
+			if _, ok := h.conflicted[record.TransactionID]; !ok {
+				continue
+			}
+			record.Status = statusRejected
+		default:
+			continue
+		}
+		changed[record.TransactionID] = record
+	}
+	h.conflicted = conflicted
+
+	for _, record := range changed {
+		err := h.save(record)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(chainChanges.AddedChainBlockHashes) > 0 {
+		cursor := chainChanges.AddedChainBlockHashes[len(chainChanges.AddedChainBlockHashes)-1]
+		err := h.db.Put(chainCursorKey, []byte(cursor))
+		if err != nil {
+			return err
+		}
+		h.cursor = cursor
+	}
+	return nil
+}
+
+// updateFromAcceptedTransactionIDs confirms the transactions in the history that the given chain block
+// accepted. Transactions that were only seen through the outputs they pay to the wallet have their inputs
+// resolved as well, so that a transaction sent by another instance of the wallet is not shown as incoming.
+func (h *transactionHistory) updateFromAcceptedTransactionIDs(accepted *appmessage.AcceptedTransactionIDs,
+	isWalletAddress func(string) bool, getBlock func(hash string, includeTransactions bool) (*appmessage.RPCBlock, error),
+	changed map[string]*transactionRecord) error {
+
+	var acceptingBlock *appmessage.RPCBlock
+	for _, transactionID := range accepted.AcceptedTransactionIDs {
+		record, ok := h.records[transactionID]
+		if !ok {
+			continue
+		}
+		if acceptingBlock == nil {
+			var err error
+			acceptingBlock, err = getBlock(accepted.AcceptingBlockHash, false)
+			if err != nil {
+				return err
+			}
+		}
+
+		record.Status = statusConfirmed
+		record.AcceptingBlockHash = accepted.AcceptingBlockHash
+		record.AcceptingDAAScore = acceptingBlock.Header.DAAScore
+		changed[record.TransactionID] = record
+
+		if len(record.Spent) > 0 || record.IsCoinbase {
+			continue
+		}
+		err := h.resolveInputs(record, acceptingBlock, isWalletAddress, getBlock)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveInputs looks for the given transaction in the merge set of the chain block that accepted it, and
+// records the wallet outputs it spends along with the outputs it pays to anyone else
+func (h *transactionHistory) resolveInputs(record *transactionRecord, acceptingBlock *appmessage.RPCBlock,
+	isWalletAddress func(string) bool, getBlock func(hash string, includeTransactions bool) (*appmessage.RPCBlock, error)) error {
+
+	transaction, err := findMergedTransaction(record.TransactionID, acceptingBlock, getBlock)
+	if err != nil {
+		return err
+	}
+	if transaction == nil {
+		log.Warnf("Transaction %s is not in the merge set of its accepting block %s",
+			record.TransactionID, acceptingBlock.VerboseData.Hash)
+		return nil
+	}
+
+	for _, input := range transaction.Inputs {
+		spentOutput, ok := h.walletOutput(input.PreviousOutpoint)
+		if ok {
+			record.addSpent(spentOutput)
+		}
+	}
+	if len(record.Spent) == 0 {
+		return nil
+	}
+
+	totalOutputs := uint64(0)
+	for i, output := range transaction.Outputs {
+		totalOutputs += output.Amount
+		if output.VerboseData == nil {
+			continue
+		}
+		historyOutput := &historyOutput{
+			Index:   uint32(i),
+			Address: output.VerboseData.ScriptPublicKeyAddress,
+			Amount:  output.Amount,
+		}
+		if isWalletAddress(historyOutput.Address) {
+			record.addReceived(historyOutput)
+		} else {
+			record.addSent(historyOutput)
+		}
+	}
+	// The fee is only known if all the inputs belong to the wallet
+	if len(record.Spent) == len(transaction.Inputs) && record.spent() > totalOutputs {
+		record.Fee = record.spent() - totalOutputs
+	}
+	return nil
+}
+
+// walletOutput returns the wallet output in the given outpoint, if the history knows of it
+func (h *transactionHistory) walletOutput(outpoint *appmessage.RPCOutpoint) (*historyOutput, bool) {
+	record, ok := h.records[outpoint.TransactionID]
+	if !ok {
+		return nil, false
+	}
+	for _, output := range record.Received {
+		if output.Index == outpoint.Index {
+			return &historyOutput{
+				TransactionID: outpoint.TransactionID,
+				Index:         output.Index,
+				Address:       output.Address,
+				Amount:        output.Amount,
+			}, true
+		}
+	}
+	return nil, false
+}
+
+// findMergedTransaction returns the transaction with the given ID from the blocks in the merge set of
+// the given chain block, or nil if none of them contains it
+func findMergedTransaction(transactionID string, chainBlock *appmessage.RPCBlock,
+	getBlock func(hash string, includeTransactions bool) (*appmessage.RPCBlock, error)) (*appmessage.RPCTransaction, error) {
+
+	mergeSet := append(append([]string{}, chainBlock.VerboseData.MergeSetBluesHashes...),
+		chainBlock.VerboseData.MergeSetRedsHashes...)
+	for _, blockHash := range mergeSet {
+		block, err := getBlock(blockHash, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, transaction := range block.Transactions {
+			if transaction.VerboseData != nil && transaction.VerboseData.TransactionID == transactionID {
+				return transaction, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func (h *transactionHistory) setLabel(transactionID, label string) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	record, ok := h.records[transactionID]
+	if !ok {
+		return errors.Errorf("transaction %s is not in the wallet history", transactionID)
+	}
+	record.Label = label
+	return h.save(record)
+}
+
+func (h *transactionHistory) transaction(transactionID string, virtualDAAScore uint64) (*pb.WalletTransaction, error) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	record, ok := h.records[transactionID]
+	if !ok {
+		return nil, errors.Errorf("transaction %s is not in the wallet history", transactionID)
+	}
+	return record.toProto(virtualDAAScore), nil
+}
+
+// transactions returns the history sorted from newest to oldest: pending transactions
+// first, followed by the rest by their accepting DAA score.
+func (h *transactionHistory) transactions(virtualDAAScore uint64) []*pb.WalletTransaction {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	records := make([]*transactionRecord, 0, len(h.records))
+	for _, record := range h.records {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		iPending, jPending := records[i].Status == statusPending, records[j].Status == statusPending
+		if iPending != jPending {
+			return iPending
+		}
+		if records[i].AcceptingDAAScore != records[j].AcceptingDAAScore {
+			return records[i].AcceptingDAAScore > records[j].AcceptingDAAScore
+		}
+		if records[i].FirstSeen != records[j].FirstSeen {
+			return records[i].FirstSeen > records[j].FirstSeen
+		}
+		return records[i].TransactionID < records[j].TransactionID
+	})
+
+	transactions := make([]*pb.WalletTransaction, len(records))
+	for i, record := range records {
+		transactions[i] = record.toProto(virtualDAAScore)
+	}
+	return transactions
+}
@@ -0,0 +1,272 @@
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+const (
+	testWalletAddress   = "hoosat:wallet"
+	testChangeAddress   = "hoosat:change"
+	testExternalAddress = "hoosat:external"
+)
+
+func testUTXOEntry(transactionID string, index uint32, address string, amount, daaScore uint64) *appmessage.UTXOsByAddressesEntry {
+	return &appmessage.UTXOsByAddressesEntry{
+		Address:  address,
+		Outpoint: &appmessage.RPCOutpoint{TransactionID: transactionID, Index: index},
+		UTXOEntry: &appmessage.RPCUTXOEntry{
+			Amount:        amount,
+			BlockDAAScore: daaScore,
+		},
+	}
+}
+
+// testChain is a fake node that serves the blocks the history asks for
+type testChain map[string]*appmessage.RPCBlock
+
+func (c testChain) getBlock(hash string, _ bool) (*appmessage.RPCBlock, error) {
+	block, ok := c[hash]
+	if !ok {
+		return nil, errors.Errorf("block %s not found", hash)
+	}
+	return block, nil
+}
+
+func (c testChain) addChainBlock(hash string, daaScore uint64, mergeSet ...string) {
+	c[hash] = &appmessage.RPCBlock{
+		Header:      &appmessage.RPCBlockHeader{DAAScore: daaScore},
+		VerboseData: &appmessage.RPCBlockVerboseData{Hash: hash, MergeSetBluesHashes: mergeSet},
+	}
+}
+
+func testChainChanges(removed []string, acceptingBlockHash string, acceptedTransactionIDs ...string) *appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage {
+	return &appmessage.GetVirtualSelectedParentChainFromBlockResponseMessage{
+		RemovedChainBlockHashes: removed,
+		AddedChainBlockHashes:   []string{acceptingBlockHash},
+		AcceptedTransactionIDs: []*appmessage.AcceptedTransactionIDs{{
+			AcceptingBlockHash:     acceptingBlockHash,
+			AcceptedTransactionIDs: acceptedTransactionIDs,
+		}},
+	}
+}
+
+func TestTransactionHistory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys-history")
+	history, err := openTransactionHistory(path)
+	if err != nil {
+		t.Fatalf("openTransactionHistory: %+v", err)
+	}
+
+	knownAddresses := map[string]bool{testWalletAddress: true}
+	isWalletAddress := func(address string) bool { return knownAddresses[address] }
+	chain := testChain{}
+	now := time.Now()
+
+	// Receive 10 HTN
+	received := testUTXOEntry("incoming", 0, testWalletAddress, 1_000_000_000, 100)
+	chain.addChainBlock("chain-100", 100)
+	err = history.update(testChainChanges(nil, "chain-100"), []*appmessage.UTXOsByAddressesEntry{received},
+		isWalletAddress, chain.getBlock, now)
+	if err != nil {
+		t.Fatalf("update: %+v", err)
+	}
+
+	incoming, err := history.transaction("incoming", 109)
+	if err != nil {
+		t.Fatalf("transaction: %+v", err)
+	}
+	if incoming.Direction != directionIncoming || incoming.Status != statusConfirmed ||
+		incoming.Amount != 1_000_000_000 || incoming.AcceptingDaaScore != 100 || incoming.Confirmations != 10 {
+		t.Fatalf("unexpected incoming transaction: %s", incoming)
+	}
+
+	// Send 4 HTN of it to someone else, with the change going to a new change address
+	err = history.addBroadcastTransaction("outgoing",
+		[]*historyOutput{{TransactionID: "incoming", Index: 0, Address: testWalletAddress, Amount: 1_000_000_000}},
+		[]*historyOutput{
+			{Index: 0, Address: testExternalAddress, Amount: 400_000_000},
+			{Index: 1, Address: testChangeAddress, Amount: 599_990_000},
+		}, isWalletAddress, now)
+	if err != nil {
+		t.Fatalf("addBroadcastTransaction: %+v", err)
+	}
+
+	outgoing, err := history.transaction("outgoing", 109)
+	if err != nil {
+		t.Fatalf("transaction: %+v", err)
+	}
+	if outgoing.Status != statusPending || outgoing.Fee != 10_000 || len(outgoing.Counterparties) != 2 {
+		t.Fatalf("unexpected pending outgoing transaction: %s", outgoing)
+	}
+
+	// The transaction is accepted, and the sync finds the change address only later
+	chain.addChainBlock("chain-150", 150)
+	err = history.update(testChainChanges(nil, "chain-150", "outgoing"), []*appmessage.UTXOsByAddressesEntry{},
+		isWalletAddress, chain.getBlock, now)
+	if err != nil {
+		t.Fatalf("update: %+v", err)
+	}
+	outgoing, err = history.transaction("outgoing", 150)
+	if err != nil {
+		t.Fatalf("transaction: %+v", err)
+	}
+	if outgoing.Status != statusConfirmed || outgoing.AcceptingDaaScore != 150 || outgoing.Confirmations != 1 {
+		t.Fatalf("expected the outgoing transaction to take the DAA score of its accepting block: %s", outgoing)
+	}
+
+	knownAddresses[testChangeAddress] = true
+	change := testUTXOEntry("outgoing", 1, testChangeAddress, 599_990_000, 150)
+	chain.addChainBlock("chain-160", 160)
+	err = history.update(testChainChanges(nil, "chain-160"), []*appmessage.UTXOsByAddressesEntry{change},
+		isWalletAddress, chain.getBlock, now)
+	if err != nil {
+		t.Fatalf("update: %+v", err)
+	}
+
+	outgoing, err = history.transaction("outgoing", 150)
+	if err != nil {
+		t.Fatalf("transaction: %+v", err)
+	}
+	if outgoing.Direction != directionOutgoing || outgoing.Status != statusConfirmed || outgoing.Amount != 400_000_000 ||
+		outgoing.AcceptingDaaScore != 150 || outgoing.Confirmations != 1 ||
+		len(outgoing.Counterparties) != 1 || outgoing.Counterparties[0] != testExternalAddress {
+		t.Fatalf("unexpected confirmed outgoing transaction: %s", outgoing)
+	}
+
+	// A selected chain reorganization removes the accepting block of the transaction,
+	// and then the new selected chain accepts it again
+	err = history.update(testChainChanges([]string{"chain-150", "chain-160"}, "chain-160"),
+		[]*appmessage.UTXOsByAddressesEntry{}, isWalletAddress, chain.getBlock, now)
+	if err != nil {
+		t.Fatalf("update: %+v", err)
+	}
+	outgoing, err = history.transaction("outgoing", 160)
+	if err != nil {
+		t.Fatalf("transaction: %+v", err)
+	}
+	if outgoing.Status != statusPending {
+		t.Fatalf("expected the transaction to be pending after its accepting block was removed, got %s", outgoing.Status)
+	}
+	chain.addChainBlock("chain-170", 170)
+	change = testUTXOEntry("outgoing", 1, testChangeAddress, 599_990_000, 170)
+	err = history.update(testChainChanges(nil, "chain-170", "outgoing"), []*appmessage.UTXOsByAddressesEntry{change},
+		isWalletAddress, chain.getBlock, now)
+	if err != nil {
+		t.Fatalf("update: %+v", err)
+	}
+	outgoing, err = history.transaction("outgoing", 170)
+	if err != nil {
+		t.Fatalf("transaction: %+v", err)
+	}
+	if outgoing.Status != statusConfirmed || outgoing.AcceptingDaaScore != 170 {
+		t.Fatalf("unexpected reaccepted outgoing transaction: %s", outgoing)
+	}
+
+	// A transaction sent by another instance of the wallet from the change output
+	chain.addChainBlock("chain-200", 200, "merged-200")
+	chain["merged-200"] = &appmessage.RPCBlock{
+		Transactions: []*appmessage.RPCTransaction{{
+			Inputs: []*appmessage.RPCTransactionInput{{
+				PreviousOutpoint: &appmessage.RPCOutpoint{TransactionID: "outgoing", Index: 1},
+			}},
+			Outputs: []*appmessage.RPCTransactionOutput{
+				{
+					Amount:      100_000_000,
+					VerboseData: &appmessage.RPCTransactionOutputVerboseData{ScriptPublicKeyAddress: testExternalAddress},
+				},
+				{
+					Amount:      499_980_000,
+					VerboseData: &appmessage.RPCTransactionOutputVerboseData{ScriptPublicKeyAddress: testWalletAddress},
+				},
+			},
+			VerboseData: &appmessage.RPCTransactionVerboseData{TransactionID: "external"},
+		}},
+	}
+	externalChange := testUTXOEntry("external", 1, testWalletAddress, 499_980_000, 200)
+	err = history.update(testChainChanges(nil, "chain-200", "external"), []*appmessage.UTXOsByAddressesEntry{externalChange},
+		isWalletAddress, chain.getBlock, now)
+	if err != nil {
+		t.Fatalf("update: %+v", err)
+	}
+	external, err := history.transaction("external", 200)
+	if err != nil {
+		t.Fatalf("transaction: %+v", err)
+	}
+	if external.Direction != directionOutgoing || external.Amount != 100_000_000 || external.Fee != 10_000 ||
+		len(external.Counterparties) != 1 || external.Counterparties[0] != testExternalAddress {
+		t.Fatalf("unexpected transaction sent by another instance of the wallet: %s", external)
+	}
+
+	// A broadcast transaction that conflicts with the one above is only rejected once the
+	// chain changes that follow the UTXO set that lacks its spent output don't accept it
+	err = history.addBroadcastTransaction("conflicting",
+		[]*historyOutput{{TransactionID: "outgoing", Index: 1, Address: testChangeAddress, Amount: 599_990_000}},
+		[]*historyOutput{{Index: 0, Address: testExternalAddress, Amount: 599_980_000}}, isWalletAddress, now)
+	if err != nil {
+		t.Fatalf("addBroadcastTransaction: %+v", err)
+	}
+	for i, expectedStatus := range []string{statusPending, statusRejected} {
+		chainBlockHash := fmt.Sprintf("chain-21%d", i)
+		chain.addChainBlock(chainBlockHash, 210)
+		err = history.update(testChainChanges(nil, chainBlockHash),
+			[]*appmessage.UTXOsByAddressesEntry{externalChange}, isWalletAddress, chain.getBlock, now)
+		if err != nil {
+			t.Fatalf("update: %+v", err)
+		}
+		conflicting, err := history.transaction("conflicting", 210)
+		if err != nil {
+			t.Fatalf("transaction: %+v", err)
+		}
+		if conflicting.Status != expectedStatus {
+			t.Fatalf("expected the conflicting transaction to be %s after %d updates, got %s",
+				expectedStatus, i+1, conflicting.Status)
+		}
+	}
+
+	err = history.setLabel("outgoing", "rent")
+	if err != nil {
+		t.Fatalf("setLabel: %+v", err)
+	}
+	err = history.setLabel("unknown", "rent")
+	if err == nil {
+		t.Fatalf("expected an error when labeling an unknown transaction")
+	}
+
+	// The history survives a restart
+	err = history.close()
+	if err != nil {
+		t.Fatalf("close: %+v", err)
+	}
+	history, err = openTransactionHistory(path)
+	if err != nil {
+		t.Fatalf("openTransactionHistory: %+v", err)
+	}
+	defer history.close()
+
+	if history.chainCursor() != "chain-211" {
+		t.Fatalf("expected the history to continue from chain-211, got %s", history.chainCursor())
+	}
+	transactions := history.transactions(210)
+	if len(transactions) != 4 {
+		t.Fatalf("expected 4 transactions, got %d", len(transactions))
+	}
+	if transactions[0].TransactionId != "external" || transactions[1].TransactionId != "outgoing" ||
+		transactions[1].Label != "rent" || transactions[2].TransactionId != "incoming" ||
+		transactions[3].TransactionId != "conflicting" {
+		t.Fatalf("unexpected transaction order or content: %s", transactions)
+	}
+}
+
+func TestHistoryDBPath(t *testing.T) {
+	path := historyDBPath(filepath.Join("wallet", "mainnet", "keys.json"))
+	expected := filepath.Join("wallet", "mainnet", "keys-history")
+	if path != expected {
+		t.Fatalf("expected %s, got %s", expected, path)
+	}
+}
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+)
+
+func (s *server) GetTransactions(_ context.Context, request *pb.GetTransactionsRequest) (*pb.GetTransactionsResponse, error) {
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := s.history.transactions(dagInfo.VirtualDAAScore)
+	total := uint32(len(transactions))
+
+	if request.Offset >= total {
+		transactions = nil
+	} else {
+		transactions = transactions[request.Offset:]
+	}
+	if request.Limit > 0 && uint32(len(transactions)) > request.Limit {
+		transactions = transactions[:request.Limit]
+	}
+
+	return &pb.GetTransactionsResponse{Transactions: transactions, Total: total}, nil
+}
+
+func (s *server) GetTransaction(_ context.Context, request *pb.GetTransactionRequest) (*pb.GetTransactionResponse, error) {
+	dagInfo, err := s.rpcClient.GetBlockDAGInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := s.history.transaction(request.TransactionId, dagInfo.VirtualDAAScore)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTransactionResponse{Transaction: transaction}, nil
+}
+
+func (s *server) SetTransactionLabel(_ context.Context, request *pb.SetTransactionLabelRequest) (*pb.SetTransactionLabelResponse, error) {
+	err := s.history.setLabel(request.TransactionId, request.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SetTransactionLabelResponse{}, nil
+}
@@ -25,12 +25,16 @@ func dumpUnencryptedData(conf *dumpUnencryptedDataConfig) error {
 		return err
 	}
 
-	if len(conf.Password) == 0 {
-		conf.Password = keys.GetPassword("Password:")
-	}
-	mnemonics, err := keysFile.DecryptMnemonics(conf.Password)
-	if err != nil {
-		return err
+	// A watch-only wallet has no mnemonics, but its extended public keys are still worth showing
+	var mnemonics []string
+	if !keysFile.IsWatchOnly() {
+		if len(conf.Password) == 0 {
+			conf.Password = keys.GetPassword("Password:")
+		}
+		mnemonics, err = keysFile.DecryptMnemonics(conf.Password)
+		if err != nil {
+			return err
+		}
 	}
 
 	mnemonicPublicKeys := make(map[string]struct{})
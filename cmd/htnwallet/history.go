@@ -0,0 +1,199 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/client"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/utils"
+)
+
+const (
+	historyFormatTable = "table"
+	historyFormatCSV   = "csv"
+	historyFormatJSON  = "json"
+)
+
+// historyEntry is the exported form of a wallet transaction. Amounts are
+// given in HTN as exact decimal strings.
+type historyEntry struct {
+	TransactionID     string   `json:"transactionId"`
+	Time              string   `json:"time"`
+	Direction         string   `json:"direction"`
+	Status            string   `json:"status"`
+	Amount            string   `json:"amount"`
+	Fee               string   `json:"fee"`
+	AcceptingDAAScore uint64   `json:"acceptingDaaScore"`
+	Confirmations     uint64   `json:"confirmations"`
+	IsCoinbase        bool     `json:"isCoinbase"`
+	Counterparties    []string `json:"counterparties"`
+	WalletAddresses   []string `json:"walletAddresses"`
+	Label             string   `json:"label"`
+}
+
+func newHistoryEntry(transaction *pb.WalletTransaction) *historyEntry {
+	return &historyEntry{
+		TransactionID:     transaction.TransactionId,
+		Time:              time.UnixMilli(transaction.Timestamp).UTC().Format(time.RFC3339),
+		Direction:         transaction.Direction,
+		Status:            transaction.Status,
+		Amount:            utils.FormatHTN(transaction.Amount),
+		Fee:               utils.FormatHTN(transaction.Fee),
+		AcceptingDAAScore: transaction.AcceptingDaaScore,
+		Confirmations:     transaction.Confirmations,
+		IsCoinbase:        transaction.IsCoinbase,
+		Counterparties:    append([]string{}, transaction.Counterparties...),
+		WalletAddresses:   append([]string{}, transaction.WalletAddresses...),
+		Label:             transaction.Label,
+	}
+}
+
+func history(conf *historyConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	var transactions []*pb.WalletTransaction
+	total := uint32(0)
+	if conf.TransactionID != "" {
+		response, err := daemonClient.GetTransaction(ctx, &pb.GetTransactionRequest{TransactionId: conf.TransactionID})
+		if err != nil {
+			return err
+		}
+		transactions = []*pb.WalletTransaction{response.Transaction}
+		total = 1
+	} else {
+		response, err := daemonClient.GetTransactions(ctx, &pb.GetTransactionsRequest{Limit: conf.Limit, Offset: conf.Offset})
+		if err != nil {
+			return err
+		}
+		transactions = response.Transactions
+		total = response.Total
+	}
+
+	output := io.Writer(os.Stdout)
+	if conf.OutputFile != "" {
+		file, err := os.Create(conf.OutputFile)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		output = file
+	}
+
+	entries := make([]*historyEntry, len(transactions))
+	for i, transaction := range transactions {
+		entries[i] = newHistoryEntry(transaction)
+	}
+
+	switch conf.Format {
+	case historyFormatCSV:
+		err = writeHistoryCSV(output, entries)
+	case historyFormatJSON:
+		err = writeHistoryJSON(output, entries)
+	default:
+		err = writeHistoryTable(output, entries, total)
+	}
+	if err != nil {
+		return err
+	}
+
+	if conf.OutputFile != "" {
+		fmt.Printf("Wrote %d transactions to %s\n", len(entries), conf.OutputFile)
+	}
+	return nil
+}
+
+func writeHistoryTable(output io.Writer, entries []*historyEntry, total uint32) error {
+	writer := tabwriter.NewWriter(output, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "Time\tTransaction ID\tDirection\tStatus\tAmount, HTN\tFee, HTN\tConfirmations\tLabel\t")
+	for _, entry := range entries {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%d\t%s\t\n", entry.Time, entry.TransactionID,
+			entry.Direction, entry.Status, entry.Amount, entry.Fee, entry.Confirmations, entry.Label)
+	}
+	err := writer.Flush()
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(output, "Showing %d out of %d transactions\n", len(entries), total)
+	return err
+}
+
+func writeHistoryCSV(output io.Writer, entries []*historyEntry) error {
+	writer := csv.NewWriter(output)
+	err := writer.Write([]string{"transaction_id", "time", "direction", "status", "amount", "fee",
+		"accepting_daa_score", "confirmations", "is_coinbase", "counterparties", "wallet_addresses", "label"})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		err := writer.Write([]string{
+			entry.TransactionID,
+			entry.Time,
+			entry.Direction,
+			entry.Status,
+			entry.Amount,
+			entry.Fee,
+			strconv.FormatUint(entry.AcceptingDAAScore, 10),
+			strconv.FormatUint(entry.Confirmations, 10),
+			strconv.FormatBool(entry.IsCoinbase),
+			strings.Join(entry.Counterparties, ";"),
+			strings.Join(entry.WalletAddresses, ";"),
+			entry.Label,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeHistoryJSON(output io.Writer, entries []*historyEntry) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(entries)
+}
+
+func labelTransaction(conf *labelTransactionConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.SetTransactionLabel(ctx, &pb.SetTransactionLabelRequest{
+		TransactionId: conf.TransactionID,
+		Label:         conf.Label,
+	})
+	if err != nil {
+		return err
+	}
+
+	if conf.Label == "" {
+		fmt.Printf("Removed the label of transaction %s\n", conf.TransactionID)
+	} else {
+		fmt.Printf("Labeled transaction %s as %q\n", conf.TransactionID, conf.Label)
+	}
+	return nil
+}
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/client"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/keys"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/utils"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+func htlcInitiate(conf *htlcInitiateConfig) error {
+	keysFile, err := readSpendingKeysFile(conf.NetParams(), conf.KeysFile)
+	if err != nil {
+		return err
+	}
+
+	sendAmountSompi, err := utils.KasToSompi(conf.SendAmount)
+	if err != nil {
+		return err
+	}
+
+	lockTime, err := parseLockTime(conf.LockDAAScore, conf.LockTime)
+	if err != nil {
+		return err
+	}
+
+	var secret []byte
+	var secretHash []byte
+	if conf.SecretHash != "" {
+		secretHash, err = hex.DecodeString(conf.SecretHash)
+		if err != nil {
+			return errors.Wrapf(err, "'--secret-hash' could not be decoded")
+		}
+	} else {
+		secret = make([]byte, txscript.HTLCSecretSize)
+		_, err = rand.Read(secret)
+		if err != nil {
+			return err
+		}
+		secretHashArray := sha256.Sum256(secret)
+		secretHash = secretHashArray[:]
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	newHTLCResponse, err := daemonClient.NewHTLC(ctx, &pb.NewHTLCRequest{
+		RecipientAddress: conf.ToAddress,
+		SecretHash:       secretHash,
+		LockTime:         lockTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	createUnsignedTransactionsResponse, err := daemonClient.CreateUnsignedTransactions(ctx,
+		&pb.CreateUnsignedTransactionsRequest{
+			Address: newHTLCResponse.Address,
+			Amount:  sendAmountSompi,
+		})
+	if err != nil {
+		return err
+	}
+
+	if len(conf.Password) == 0 {
+		conf.Password = keys.GetPassword("Password:")
+	}
+	txIDs := make([]string, len(createUnsignedTransactionsResponse.UnsignedTransactions))
+	for i, unsignedTransaction := range createUnsignedTransactionsResponse.UnsignedTransactions {
+		txIDs[i], err = signAndBroadcast(conf.NetParams(), keysFile, conf.Password, daemonClient, unsignedTransaction)
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("Contract address:\t%s\n", newHTLCResponse.Address)
+	fmt.Printf("Refund address:\t\t%s\n", newHTLCResponse.RefundAddress)
+	fmt.Printf("Secret hash:\t\t%x\n", secretHash)
+	if secret != nil {
+		fmt.Printf("Secret:\t\t\t%x\n", secret)
+		fmt.Println("Keep the secret to yourself until the counterparty funds its contract, and redeem that contract with it.")
+	}
+	fmt.Printf("Contract:\n%x\n", newHTLCResponse.Contract)
+	fmt.Println("Send the contract to the counterparty so it can audit it with `htlc-audit`. Keep it as well, " +
+		"since refunding the contract requires it.")
+	fmt.Println("Funding Transaction ID(s): ")
+	for _, txID := range txIDs {
+		fmt.Printf("\t%s\n", txID)
+	}
+	return nil
+}
+
+func htlcRedeem(conf *htlcRedeemConfig) error {
+	secret, err := hex.DecodeString(conf.Secret)
+	if err != nil {
+		return errors.Wrapf(err, "'--secret' could not be decoded")
+	}
+	return spendHTLC(conf.NetParams(), conf.KeysFile, conf.Password, conf.DaemonAddress, conf.Contract,
+		&pb.CreateUnsignedHTLCTransactionRequest{
+			Secret:    secret,
+			ToAddress: conf.ToAddress,
+		})
+}
+
+func htlcRefund(conf *htlcRefundConfig) error {
+	return spendHTLC(conf.NetParams(), conf.KeysFile, conf.Password, conf.DaemonAddress, conf.Contract,
+		&pb.CreateUnsignedHTLCTransactionRequest{
+			IsRefund:  true,
+			ToAddress: conf.ToAddress,
+		})
+}
+
+func spendHTLC(params *dagconfig.Params, keysFilePath string, password string, daemonAddress string,
+	contract string, request *pb.CreateUnsignedHTLCTransactionRequest) error {
+
+	keysFile, err := readSpendingKeysFile(params, keysFilePath)
+	if err != nil {
+		return err
+	}
+
+	request.Contract, err = hex.DecodeString(contract)
+	if err != nil {
+		return errors.Wrapf(err, "'--contract' could not be decoded")
+	}
+
+	daemonClient, tearDown, err := client.Connect(daemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.CreateUnsignedHTLCTransaction(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	txID, err := signAndBroadcast(params, keysFile, password, daemonClient, response.UnsignedTransaction)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Broadcasted Transaction ID: ")
+	fmt.Printf("\t%s\n", txID)
+	return nil
+}
+
+func htlcAudit(conf *htlcAuditConfig) error {
+	contract, err := hex.DecodeString(conf.Contract)
+	if err != nil {
+		return errors.Wrapf(err, "'--contract' could not be decoded")
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.AuditHTLC(ctx, &pb.AuditHTLCRequest{Contract: contract})
+	if err != nil {
+		return err
+	}
+
+	lockTime := fmt.Sprintf("DAA score %d", response.LockTime)
+	if response.LockTime >= constants.LockTimeThreshold {
+		lockTime = time.UnixMilli(int64(response.LockTime)).UTC().Format(time.RFC3339)
+	}
+	role := "none"
+	switch {
+	case response.IsRecipient && response.IsRefunder:
+		role = "recipient and refunder"
+	case response.IsRecipient:
+		role = "recipient"
+	case response.IsRefunder:
+		role = "refunder"
+	}
+
+	fmt.Printf("Contract address:\t%s\n", response.Address)
+	fmt.Printf("Recipient address:\t%s\n", response.RecipientAddress)
+	fmt.Printf("Refund address:\t\t%s\n", response.RefundAddress)
+	fmt.Printf("Secret hash:\t\t%x\n", response.SecretHash)
+	fmt.Printf("Refundable from:\t%s\n", lockTime)
+	fmt.Printf("Role of this wallet:\t%s\n", role)
+
+	totalAmount := uint64(0)
+	fmt.Println("Outputs:")
+	for _, output := range response.Outputs {
+		fmt.Printf("\t%s:%d\t%s HTN\n", output.Outpoint.TransactionId, output.Outpoint.Index,
+			utils.FormatHTN(output.Amount))
+		totalAmount += output.Amount
+	}
+	fmt.Printf("Total locked in the contract: %s HTN\n", utils.FormatHTN(totalAmount))
+	return nil
+}
+
+// readSpendingKeysFile reads a keys file that is used to sign transactions on its own
+func readSpendingKeysFile(params *dagconfig.Params, keysFilePath string) (*keys.File, error) {
+	keysFile, err := keys.ReadKeysFile(params, keysFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if keysFile.IsWatchOnly() {
+		return nil, keys.ErrWatchOnly
+	}
+
+	if len(keysFile.ExtendedPublicKeys) > len(keysFile.EncryptedMnemonics) {
+		return nil, errors.Errorf("Cannot sign transactions for a multisig wallet without all of the keys")
+	}
+	return keysFile, nil
+}
@@ -9,12 +9,16 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/gofrs/flock"
 
 	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/utils"
 
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionid"
 	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
 	"github.com/Hoosat-Oy/HTND/util"
 	"github.com/pkg/errors"
@@ -29,6 +33,12 @@ var (
 // LastVersion is the most up to date file format version
 const LastVersion = 1
 
+// ErrWatchOnly is returned when an operation that requires the private keys
+// is attempted on a watch-only wallet
+var ErrWatchOnly = errors.New("the wallet is watch-only and holds no private keys. Use " +
+	"'htnwallet create-unsigned-transaction' and sign the transaction with 'htnwallet sign' " +
+	"on the machine that holds the private keys")
+
 func defaultKeysFile(netParams *dagconfig.Params) string {
 	return filepath.Join(defaultAppDir, netParams.Name, "keys.json")
 }
@@ -48,6 +58,41 @@ type keysFileJSON struct {
 	LastUsedExternalIndex uint32                     `json:"lastUsedExternalIndex"`
 	LastUsedInternalIndex uint32                     `json:"lastUsedInternalIndex"`
 	ECDSA                 bool                       `json:"ecdsa"`
+	LockScripts           []*lockScriptJSON          `json:"lockScripts,omitempty"`
+	LockedOutpoints       []string                   `json:"lockedOutpoints,omitempty"`
+}
+
+type lockScriptJSON struct {
+	Type                      string `json:"type"`
+	Index                     uint32 `json:"index"`
+	RedeemScript              string `json:"redeemScript"`
+	LockTime                  uint64 `json:"lockTime,omitempty"`
+	Delay                     uint64 `json:"delay,omitempty"`
+	RecoveryExtendedPublicKey string `json:"recoveryPublicKey,omitempty"`
+}
+
+// Lock script types
+const (
+	// LockScriptTypeTimeLock locks outputs to a wallet key until a DAA score or a timestamp
+	LockScriptTypeTimeLock = "timelock"
+	// LockScriptTypeVault locks outputs to a wallet key for a number of DAA scores after they're
+	// accepted, and lets a recovery key spend them at any time
+	LockScriptTypeVault = "vault"
+)
+
+// LockScript is the redeem script of outputs the wallet has locked, along with the parameters it
+// was built from. It's kept in the keys file since the locked outputs can't be spent without it,
+// and it can't be derived from the wallet keys alone.
+type LockScript struct {
+	Type string
+	// Index is the index of the key the outputs are locked to in the lock key chain
+	Index        uint32
+	RedeemScript []byte
+	// LockTime is the DAA score or timestamp a time lock is locked until
+	LockTime uint64
+	// Delay is the number of DAA scores a vault output is locked for after it's accepted
+	Delay                     uint64
+	RecoveryExtendedPublicKey string
 }
 
 // EncryptedMnemonic represents an encrypted mnemonic
@@ -67,7 +112,10 @@ type File struct {
 	lastUsedExternalIndex uint32
 	lastUsedInternalIndex uint32
 	ECDSA                 bool
-	path                  string
+	LockScripts           []*LockScript
+	// lockedOutpoints are the outpoints the user locked so that they're never selected automatically
+	lockedOutpoints map[externalapi.DomainOutpoint]struct{}
+	path            string
 }
 
 func (d *File) toJSON() *keysFileJSON {
@@ -79,6 +127,24 @@ func (d *File) toJSON() *keysFileJSON {
 		}
 	}
 
+	var lockScriptsJSON []*lockScriptJSON
+	for _, lockScript := range d.LockScripts {
+		lockScriptsJSON = append(lockScriptsJSON, &lockScriptJSON{
+			Type:                      lockScript.Type,
+			Index:                     lockScript.Index,
+			RedeemScript:              hex.EncodeToString(lockScript.RedeemScript),
+			LockTime:                  lockScript.LockTime,
+			Delay:                     lockScript.Delay,
+			RecoveryExtendedPublicKey: lockScript.RecoveryExtendedPublicKey,
+		})
+	}
+
+	lockedOutpointsJSON := make([]string, 0, len(d.lockedOutpoints))
+	for outpoint := range d.lockedOutpoints {
+		lockedOutpointsJSON = append(lockedOutpointsJSON, fmt.Sprintf("%s:%d", outpoint.TransactionID, outpoint.Index))
+	}
+	sort.Strings(lockedOutpointsJSON)
+
 	return &keysFileJSON{
 		Version:               d.Version,
 		NumThreads:            d.NumThreads,
@@ -89,6 +155,8 @@ func (d *File) toJSON() *keysFileJSON {
 		CosignerIndex:         d.CosignerIndex,
 		LastUsedExternalIndex: d.lastUsedExternalIndex,
 		LastUsedInternalIndex: d.lastUsedInternalIndex,
+		LockScripts:           lockScriptsJSON,
+		LockedOutpoints:       lockedOutpointsJSON,
 	}
 }
 
@@ -137,9 +205,58 @@ func (d *File) fromJSON(fileJSON *keysFileJSON) error {
 		}
 	}
 
+	d.LockScripts = make([]*LockScript, len(fileJSON.LockScripts))
+	for i, lockScriptJSON := range fileJSON.LockScripts {
+		redeemScript, err := hex.DecodeString(lockScriptJSON.RedeemScript)
+		if err != nil {
+			return err
+		}
+
+		d.LockScripts[i] = &LockScript{
+			Type:                      lockScriptJSON.Type,
+			Index:                     lockScriptJSON.Index,
+			RedeemScript:              redeemScript,
+			LockTime:                  lockScriptJSON.LockTime,
+			Delay:                     lockScriptJSON.Delay,
+			RecoveryExtendedPublicKey: lockScriptJSON.RecoveryExtendedPublicKey,
+		}
+	}
+
+	d.lockedOutpoints = make(map[externalapi.DomainOutpoint]struct{}, len(fileJSON.LockedOutpoints))
+	for _, lockedOutpointJSON := range fileJSON.LockedOutpoints {
+		outpoint, err := parseOutpoint(lockedOutpointJSON)
+		if err != nil {
+			return err
+		}
+		d.lockedOutpoints[*outpoint] = struct{}{}
+	}
+
 	return nil
 }
 
+// parseOutpoint parses an outpoint in the form <transaction ID>:<index>
+func parseOutpoint(outpointString string) (*externalapi.DomainOutpoint, error) {
+	separatorIndex := strings.LastIndex(outpointString, ":")
+	if separatorIndex == -1 {
+		return nil, errors.Errorf("outpoint %s is not in the form <transaction ID>:<index>", outpointString)
+	}
+
+	transactionID, err := transactionid.FromString(outpointString[:separatorIndex])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid transaction ID in outpoint %s", outpointString)
+	}
+
+	index, err := strconv.ParseUint(outpointString[separatorIndex+1:], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid index in outpoint %s", outpointString)
+	}
+
+	return &externalapi.DomainOutpoint{
+		TransactionID: *transactionID,
+		Index:         uint32(index),
+	}, nil
+}
+
 // SetPath sets the path where the file is saved to.
 func (d *File) SetPath(params *dagconfig.Params, path string, forceOverride bool) error {
 	if path == "" {
@@ -206,9 +323,51 @@ func (d *File) LastUsedInternalIndex() uint32 {
 	return d.lastUsedInternalIndex
 }
 
+// AddLockScript adds the given lock script to the file, and saves it.
+func (d *File) AddLockScript(lockScript *LockScript) error {
+	d.LockScripts = append(d.LockScripts, lockScript)
+	return d.Save()
+}
+
+// IsOutpointLocked returns whether the given outpoint was locked with LockOutpoints
+func (d *File) IsOutpointLocked(outpoint externalapi.DomainOutpoint) bool {
+	_, ok := d.lockedOutpoints[outpoint]
+	return ok
+}
+
+// LockOutpoints locks the given outpoints, so that they're only spent when they're selected explicitly,
+// and saves the file.
+func (d *File) LockOutpoints(outpoints []externalapi.DomainOutpoint) error {
+	if d.lockedOutpoints == nil {
+		d.lockedOutpoints = make(map[externalapi.DomainOutpoint]struct{}, len(outpoints))
+	}
+	for _, outpoint := range outpoints {
+		d.lockedOutpoints[outpoint] = struct{}{}
+	}
+	return d.Save()
+}
+
+// UnlockOutpoints unlocks the given outpoints, and saves the file.
+func (d *File) UnlockOutpoints(outpoints []externalapi.DomainOutpoint) error {
+	for _, outpoint := range outpoints {
+		delete(d.lockedOutpoints, outpoint)
+	}
+	return d.Save()
+}
+
+// IsWatchOnly returns whether the file holds only extended public keys. A watch-only
+// wallet can track its balance and create unsigned transactions, but cannot sign them.
+func (d *File) IsWatchOnly() bool {
+	return len(d.EncryptedMnemonics) == 0
+}
+
 // DecryptMnemonics asks the user to enter the password for the private keys and
 // returns the decrypted private keys.
 func (d *File) DecryptMnemonics(password string) ([]string, error) {
+	if d.IsWatchOnly() {
+		return nil, ErrWatchOnly
+	}
+
 	passwordBytes := []byte(password)
 
 	var numThreads uint8
@@ -291,7 +450,9 @@ func pathExists(path string) (bool, error) {
 	return false, err
 }
 
-// Save writes the file contents to the disk.
+// Save writes the file contents to the disk. The contents are written to a temporary
+// file that then replaces the existing one, so that the encrypted mnemonics are never
+// lost to a partially written file.
 func (d *File) Save() error {
 	if d.path == "" {
 		return errors.New("cannot save a file with uninitialized path")
@@ -302,19 +463,38 @@ func (d *File) Save() error {
 		return err
 	}
 
-	file, err := os.OpenFile(d.path, os.O_WRONLY|os.O_CREATE, 0600)
+	file, err := os.CreateTemp(filepath.Dir(d.path), filepath.Base(d.path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	tempPath := file.Name()
+	defer os.Remove(tempPath)
+
+	err = writeAndSync(file, d.toJSON())
+	closeErr := file.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	return os.Rename(tempPath, d.path)
+}
+
+func writeAndSync(file *os.File, value interface{}) error {
+	err := file.Chmod(0600)
 	if err != nil {
 		return err
 	}
-	defer file.Close()
 
 	encoder := json.NewEncoder(file)
-	err = encoder.Encode(d.toJSON())
+	err = encoder.Encode(value)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return file.Sync()
 }
 
 const defaultNumThreads = 8
@@ -84,3 +84,46 @@ func versionFromParams(params *dagconfig.Params) ([4]byte, error) {
 
 	return [4]byte{}, errors.Errorf("unknown network %s", params.Name)
 }
+
+func publicVersionFromParams(params *dagconfig.Params) ([4]byte, error) {
+	switch params.Name {
+	case dagconfig.MainnetParams.Name:
+		return bip32.HoosatMainnetPublic, nil
+	case dagconfig.TestnetParams.Name:
+		return bip32.HoosatTestnetPublic, nil
+	case dagconfig.TestnetParamsB5.Name:
+		return bip32.HoosatTestnetPublic, nil
+	case dagconfig.TestnetParamsB10.Name:
+		return bip32.HoosatTestnetPublic, nil
+	case dagconfig.DevnetParams.Name:
+		return bip32.HoosatDevnetPublic, nil
+	case dagconfig.SimnetParams.Name:
+		return bip32.HoosatSimnetPublic, nil
+	}
+
+	return [4]byte{}, errors.Errorf("unknown network %s", params.Name)
+}
+
+// ValidateExtendedPublicKey checks that the given string is a valid extended public key
+// of the network described by the given params.
+func ValidateExtendedPublicKey(params *dagconfig.Params, extendedPublicKey string) error {
+	extendedKey, err := bip32.DeserializeExtendedKey(extendedPublicKey)
+	if err != nil {
+		return errors.Wrapf(err, "%s is invalid extended public key", extendedPublicKey)
+	}
+
+	// Don't include the key in the error, since it's a secret
+	if extendedKey.IsPrivate() {
+		return errors.New("got an extended private key where an extended public key was expected")
+	}
+
+	expectedVersion, err := publicVersionFromParams(params)
+	if err != nil {
+		return err
+	}
+	if extendedKey.Version != expectedVersion {
+		return errors.Errorf("%s is not an extended public key of %s", extendedPublicKey, params.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package libhtnwallet_test
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+)
+
+func TestValidateExtendedPublicKey(t *testing.T) {
+	mnemonic, err := libhtnwallet.CreateMnemonic()
+	if err != nil {
+		t.Fatalf("CreateMnemonic: %+v", err)
+	}
+
+	mainnetPublicKey, err := libhtnwallet.MasterPublicKeyFromMnemonic(&dagconfig.MainnetParams, mnemonic, false)
+	if err != nil {
+		t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+	}
+
+	err = libhtnwallet.ValidateExtendedPublicKey(&dagconfig.MainnetParams, mainnetPublicKey)
+	if err != nil {
+		t.Fatalf("ValidateExtendedPublicKey: %+v", err)
+	}
+
+	err = libhtnwallet.ValidateExtendedPublicKey(&dagconfig.TestnetParams, mainnetPublicKey)
+	if err == nil {
+		t.Fatalf("ValidateExtendedPublicKey unexpectedly accepted a key of another network")
+	}
+
+	err = libhtnwallet.ValidateExtendedPublicKey(&dagconfig.MainnetParams, "not a key")
+	if err == nil {
+		t.Fatalf("ValidateExtendedPublicKey unexpectedly accepted an invalid key")
+	}
+}
@@ -0,0 +1,11 @@
+package libhtnwallet
+
+// HTLCRedeemScriptArguments returns the redeem script arguments that spend a hash time-locked contract,
+// as created by txscript.PayToHTLCScript, with its refund key if isRefund is set, or with its recipient
+// key and the given secret otherwise
+func HTLCRedeemScriptArguments(secret []byte, isRefund bool) [][]byte {
+	if isRefund {
+		return [][]byte{{}}
+	}
+	return [][]byte{secret, {1}}
+}
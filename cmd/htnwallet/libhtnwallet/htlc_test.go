@@ -0,0 +1,85 @@
+package libhtnwallet_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/consensus"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+)
+
+func TestHTLC(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		forSchnorrAndECDSA(t, func(t *testing.T, ecdsa bool) {
+			recipientMnemonic, recipientPublicKey := createMnemonicAndPublicKey(t, consensusConfig)
+			refundMnemonic, refundPublicKey := createMnemonicAndPublicKey(t, consensusConfig)
+
+			const path = "m/0/0"
+			const lockTime = 10
+			publicKey := func(extendedPublicKey string) []byte {
+				address, err := libhtnwallet.Address(&consensusConfig.Params, []string{extendedPublicKey}, 1, path, ecdsa)
+				if err != nil {
+					t.Fatalf("Address: %+v", err)
+				}
+				return address.ScriptAddress()
+			}
+
+			secret := make([]byte, txscript.HTLCSecretSize)
+			secret[0] = 1
+			redeemScript, err := txscript.PayToHTLCScript(&txscript.HTLCScriptData{
+				RecipientPublicKey: publicKey(recipientPublicKey),
+				RefundPublicKey:    publicKey(refundPublicKey),
+				SecretHash:         sha256.Sum256(secret),
+				LockTime:           lockTime,
+				IsECDSA:            ecdsa,
+			})
+			if err != nil {
+				t.Fatalf("PayToHTLCScript: %+v", err)
+			}
+
+			htlcUTXO := func(extendedPublicKey string, secret []byte, isRefund bool) *libhtnwallet.ScriptHashUTXO {
+				return &libhtnwallet.ScriptHashUTXO{
+					UTXO:                  &libhtnwallet.UTXO{DerivationPath: path},
+					ExtendedPublicKey:     extendedPublicKey,
+					RedeemScript:          redeemScript,
+					RedeemScriptArguments: libhtnwallet.HTLCRedeemScriptArguments(secret, isRefund),
+				}
+			}
+
+			t.Run("redeem", func(t *testing.T) {
+				ltc, teardown := newLockScriptTestContext(t, consensusConfig, "TestHTLCRedeem", redeemScript)
+				defer teardown(false)
+
+				wrongSecret := make([]byte, txscript.HTLCSecretSize)
+				if ltc.spend(recipientMnemonic, htlcUTXO(recipientPublicKey, wrongSecret, false), 0, ecdsa) {
+					t.Fatalf("The contract was unexpectedly redeemed with a wrong secret")
+				}
+				if ltc.spend(refundMnemonic, htlcUTXO(refundPublicKey, secret, false), 0, ecdsa) {
+					t.Fatalf("The contract was unexpectedly redeemed with the refund key")
+				}
+				if !ltc.spend(recipientMnemonic, htlcUTXO(recipientPublicKey, secret, false), 0, ecdsa) {
+					t.Fatalf("The contract wasn't redeemed with its secret")
+				}
+			})
+
+			t.Run("refund", func(t *testing.T) {
+				ltc, teardown := newLockScriptTestContext(t, consensusConfig, "TestHTLCRefund", redeemScript)
+				defer teardown(false)
+
+				if ltc.spend(refundMnemonic, htlcUTXO(refundPublicKey, nil, true), lockTime, ecdsa) {
+					t.Fatalf("The contract was unexpectedly refunded before its lock time")
+				}
+
+				ltc.extendChainToDAAScore(lockTime)
+				if ltc.spend(recipientMnemonic, htlcUTXO(recipientPublicKey, nil, true), lockTime, ecdsa) {
+					t.Fatalf("The contract was unexpectedly refunded with the recipient key")
+				}
+				if !ltc.spend(refundMnemonic, htlcUTXO(refundPublicKey, nil, true), lockTime, ecdsa) {
+					t.Fatalf("The contract wasn't refunded after its lock time")
+				}
+			})
+		})
+	})
+}
@@ -5,4 +5,8 @@ const (
 	ExternalKeychain = 0
 	// InternalKeychain is used to create change addresses
 	InternalKeychain = 1
+	// LockKeychain is used to create the keys that time-locked outputs and vaults are locked to
+	LockKeychain = 2
+	// RecoveryKeychain is used to create the recovery keys of vaults that belong to other wallets
+	RecoveryKeychain = 3
 )
@@ -0,0 +1,55 @@
+package libhtnwallet
+
+import (
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/Hoosat-Oy/HTND/util/signedmessage"
+	"github.com/kaspanet/go-secp256k1"
+	"github.com/pkg/errors"
+)
+
+// MessageHash returns the hash that is signed when signing on the given message
+func MessageHash(message []byte) *externalapi.DomainHash {
+	return signedmessage.Hash(message)
+}
+
+// SignMessage signs the given message with the private key of the single signer
+// wallet address at the given derivation path
+func SignMessage(params *dagconfig.Params, mnemonic string, path string, message []byte, ecdsa bool) ([]byte, error) {
+	extendedKey, err := extendedKeyFromMnemonicAndPath(mnemonic, defaultPath(false), params)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := extendedKey.DeriveFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	secpHash := secp256k1.Hash(*MessageHash(message).ByteArray())
+	privateKey := derivedKey.PrivateKey()
+	if ecdsa {
+		signature, err := privateKey.ECDSASign(&secpHash)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to sign the message")
+		}
+		return signature.Serialize()[:], nil
+	}
+
+	schnorrKeyPair, err := privateKey.ToSchnorr()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := schnorrKeyPair.SchnorrSign(&secpHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to sign the message")
+	}
+	return signature.Serialize()[:], nil
+}
+
+// VerifyMessage returns whether the given signature is a valid signature on the given
+// message by the owner of the given address
+func VerifyMessage(address util.Address, message []byte, signature []byte) (bool, error) {
+	return signedmessage.Verify(address, message, signature)
+}
@@ -0,0 +1,73 @@
+package libhtnwallet_test
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+	"github.com/Hoosat-Oy/HTND/util"
+)
+
+func TestSignAndVerifyMessage(t *testing.T) {
+	params := &dagconfig.DevnetParams
+	forSchnorrAndECDSA(t, func(t *testing.T, ecdsa bool) {
+		mnemonic, err := libhtnwallet.CreateMnemonic()
+		if err != nil {
+			t.Fatalf("CreateMnemonic: %+v", err)
+		}
+		publicKey, err := libhtnwallet.MasterPublicKeyFromMnemonic(params, mnemonic, false)
+		if err != nil {
+			t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+		}
+
+		const path = "m/0/1"
+		address, err := libhtnwallet.Address(params, []string{publicKey}, 1, path, ecdsa)
+		if err != nil {
+			t.Fatalf("Address: %+v", err)
+		}
+		otherAddress, err := libhtnwallet.Address(params, []string{publicKey}, 1, "m/0/2", ecdsa)
+		if err != nil {
+			t.Fatalf("Address: %+v", err)
+		}
+
+		message := []byte("I own this address")
+		signature, err := libhtnwallet.SignMessage(params, mnemonic, path, message, ecdsa)
+		if err != nil {
+			t.Fatalf("SignMessage: %+v", err)
+		}
+
+		tests := []struct {
+			name          string
+			message       []byte
+			isValid       bool
+			verifyAddress bool
+		}{
+			{name: "valid", message: message, isValid: true, verifyAddress: true},
+			{name: "different message", message: []byte("I own this address!"), isValid: false, verifyAddress: true},
+			{name: "different address", message: message, isValid: false, verifyAddress: false},
+		}
+		for _, test := range tests {
+			verifiedAddress := address
+			if !test.verifyAddress {
+				verifiedAddress = otherAddress
+			}
+			isValid, err := libhtnwallet.VerifyMessage(verifiedAddress, test.message, signature)
+			if err != nil {
+				t.Fatalf("%s: VerifyMessage: %+v", test.name, err)
+			}
+			if isValid != test.isValid {
+				t.Fatalf("%s: expected isValid %t, got %t", test.name, test.isValid, isValid)
+			}
+		}
+	})
+
+	scriptHashAddress, err := util.NewAddressScriptHash([]byte{txscript.OpTrue}, params.Prefix)
+	if err != nil {
+		t.Fatalf("NewAddressScriptHash: %+v", err)
+	}
+	_, err = libhtnwallet.VerifyMessage(scriptHashAddress, []byte("message"), make([]byte, 64))
+	if err == nil {
+		t.Fatalf("VerifyMessage unexpectedly succeeded for a script hash address")
+	}
+}
@@ -0,0 +1,323 @@
+package libhtnwallet
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet/serialization"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+// ToPSTX deserializes a transaction that is either a PSTX container or a partially signed transaction in
+// the wallet's own format. ecdsa is only used for the latter, which doesn't record the type of its keys.
+func ToPSTX(serializedTransaction []byte, ecdsa bool) (*serialization.PSTX, error) {
+	if serialization.IsPSTX(serializedTransaction) {
+		return serialization.DeserializePSTX(serializedTransaction)
+	}
+
+	partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(serializedTransaction)
+	if err != nil {
+		return nil, err
+	}
+	return partiallySignedTransactionToPSTX(partiallySignedTransaction, ecdsa)
+}
+
+// CombinePSTX merges the signatures and the finalized inputs of several copies of the same transaction,
+// each given either as a PSTX container or in the wallet's own format, into a single PSTX container
+func CombinePSTX(serializedTransactions [][]byte, ecdsa bool) ([]byte, error) {
+	if len(serializedTransactions) == 0 {
+		return nil, errors.Errorf("at least one transaction is required")
+	}
+
+	combined, err := ToPSTX(serializedTransactions[0], ecdsa)
+	if err != nil {
+		return nil, err
+	}
+
+	combinedUnsignedTx, err := serialization.SerializeDomainTransaction(combined.Global.UnsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, serializedTransaction := range serializedTransactions[1:] {
+		pstx, err := ToPSTX(serializedTransaction, ecdsa)
+		if err != nil {
+			return nil, err
+		}
+
+		unsignedTx, err := serialization.SerializeDomainTransaction(pstx.Global.UnsignedTx)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(unsignedTx, combinedUnsignedTx) || pstx.Global.ECDSA != combined.Global.ECDSA {
+			return nil, errors.Errorf("transaction #%d is not the same transaction as transaction #1", i+2)
+		}
+
+		err = combinePSTXInputs(combined.Inputs, pstx.Inputs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "transaction #%d can't be combined", i+2)
+		}
+		for j, output := range pstx.Outputs {
+			combinedOutput := combined.Outputs[j]
+			if len(combinedOutput.Keys) == 0 {
+				combinedOutput.Keys = output.Keys
+			}
+			if combinedOutput.RedeemScript == nil {
+				combinedOutput.RedeemScript = output.RedeemScript
+			}
+			combinedOutput.Proprietary = combineProprietary(combinedOutput.Proprietary, output.Proprietary)
+		}
+		combined.Global.Proprietary = combineProprietary(combined.Global.Proprietary, pstx.Global.Proprietary)
+	}
+
+	return serialization.SerializePSTX(combined)
+}
+
+func combinePSTXInputs(combinedInputs []*serialization.PSTXInput, inputs []*serialization.PSTXInput) error {
+	for i, input := range inputs {
+		combinedInput := combinedInputs[i]
+		if !combinedInput.PrevOutput.Equal(input.PrevOutput) ||
+			!bytes.Equal(combinedInput.RedeemScript, input.RedeemScript) ||
+			combinedInput.MinimumSignatures != input.MinimumSignatures ||
+			len(combinedInput.Keys) != len(input.Keys) {
+
+			return errors.Errorf("input %d spends a different output", i)
+		}
+
+		for j, key := range input.Keys {
+			combinedKey := combinedInput.Keys[j]
+			if combinedKey.ExtendedPublicKey != key.ExtendedPublicKey {
+				return errors.Errorf("key %d of input %d is a different key", j, i)
+			}
+			if combinedKey.Signature == nil {
+				combinedKey.Signature = key.Signature
+			}
+		}
+
+		if combinedInput.FinalSignatureScript == nil {
+			combinedInput.FinalSignatureScript = input.FinalSignatureScript
+		}
+		combinedInput.Proprietary = combineProprietary(combinedInput.Proprietary, input.Proprietary)
+	}
+	return nil
+}
+
+// combineProprietary adds the entries of other to combined. Entries that are already in combined are kept.
+func combineProprietary(combined map[string][]byte, other map[string][]byte) map[string][]byte {
+	for key, value := range other {
+		if combined == nil {
+			combined = make(map[string][]byte, len(other))
+		}
+		if _, ok := combined[key]; !ok {
+			combined[key] = value
+		}
+	}
+	return combined
+}
+
+// FinalizePSTX builds the signature scripts of all of the inputs of a transaction, given either as
+// a PSTX container or in the wallet's own format, and returns it as a finalized PSTX container
+func FinalizePSTX(serializedTransaction []byte, ecdsa bool) ([]byte, error) {
+	pstx, err := ToPSTX(serializedTransaction, ecdsa)
+	if err != nil {
+		return nil, err
+	}
+
+	err = finalizePSTX(pstx)
+	if err != nil {
+		return nil, err
+	}
+	return serialization.SerializePSTX(pstx)
+}
+
+func finalizePSTX(pstx *serialization.PSTX) error {
+	for i, input := range pstx.Inputs {
+		if input.FinalSignatureScript != nil {
+			continue
+		}
+
+		partiallySignedInput, err := partiallySignedInputFromPSTX(input)
+		if err != nil {
+			return errors.Wrapf(err, "input %d can't be finalized", i)
+		}
+
+		input.FinalSignatureScript, err = signatureScript(partiallySignedInput, pstx.Global.ECDSA)
+		if err != nil {
+			return errors.Wrapf(err, "input %d can't be finalized", i)
+		}
+	}
+	return nil
+}
+
+// IsPSTXInputFullySigned returns whether the given PSTX input is finalized or has all of its required signatures
+func IsPSTXInputFullySigned(input *serialization.PSTXInput) bool {
+	if input.FinalSignatureScript != nil {
+		return true
+	}
+
+	numSignatures := 0
+	for _, key := range input.Keys {
+		if key.Signature != nil {
+			numSignatures++
+		}
+	}
+	return numSignatures > 0 && uint32(numSignatures) >= input.MinimumSignatures
+}
+
+func isPSTXFullySigned(pstx *serialization.PSTX) bool {
+	for _, input := range pstx.Inputs {
+		if !IsPSTXInputFullySigned(input) {
+			return false
+		}
+	}
+	return true
+}
+
+func extractPSTXTransaction(pstx *serialization.PSTX) (*externalapi.DomainTransaction, error) {
+	err := finalizePSTX(pstx)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := pstx.Global.UnsignedTx.Clone()
+	for i, input := range pstx.Inputs {
+		tx.Inputs[i].SignatureScript = input.FinalSignatureScript
+	}
+	return tx, nil
+}
+
+func signPSTX(params *dagconfig.Params, mnemonics []string, pstx *serialization.PSTX, ecdsa bool) error {
+	if pstx.Global.ECDSA != ecdsa {
+		return errors.Errorf("the keys of the transaction are not of the wallet's key type")
+	}
+
+	partiallySignedTransaction, err := pstxToPartiallySignedTransaction(pstx)
+	if err != nil {
+		return err
+	}
+
+	for _, mnemonic := range mnemonics {
+		err = sign(params, mnemonic, partiallySignedTransaction, ecdsa)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, partiallySignedInput := range partiallySignedTransaction.PartiallySignedInputs {
+		for j, pair := range partiallySignedInput.PubKeySignaturePairs {
+			pstx.Inputs[i].Keys[j].Signature = pair.Signature
+		}
+	}
+	return nil
+}
+
+func partiallySignedTransactionToPSTX(partiallySignedTransaction *serialization.PartiallySignedTransaction,
+	ecdsa bool) (*serialization.PSTX, error) {
+
+	unsignedTx := partiallySignedTransaction.Tx.Clone()
+	inputs := make([]*serialization.PSTXInput, len(partiallySignedTransaction.PartiallySignedInputs))
+	for i, partiallySignedInput := range partiallySignedTransaction.PartiallySignedInputs {
+		sigOpCount, err := inputSigOpCount(partiallySignedInput)
+		if err != nil {
+			return nil, err
+		}
+		unsignedTx.Inputs[i].SignatureScript = nil
+		unsignedTx.Inputs[i].SigOpCount = sigOpCount
+		unsignedTx.Inputs[i].UTXOEntry = nil
+
+		isMultisig := len(partiallySignedInput.PubKeySignaturePairs) > 1
+		derivationPath := defaultPath(isMultisig) + strings.TrimPrefix(partiallySignedInput.DerivationPath, "m")
+		keys := make([]*serialization.PSTXKey, len(partiallySignedInput.PubKeySignaturePairs))
+		for j, pair := range partiallySignedInput.PubKeySignaturePairs {
+			publicKey, err := serializedPublicKey(pair.ExtendedPublicKey, "m", ecdsa)
+			if err != nil {
+				return nil, err
+			}
+			keys[j] = &serialization.PSTXKey{
+				ExtendedPublicKey: pair.ExtendedPublicKey,
+				DerivationPath:    derivationPath,
+				PublicKey:         publicKey,
+				Signature:         pair.Signature,
+			}
+		}
+
+		inputs[i] = &serialization.PSTXInput{
+			PrevOutput:            partiallySignedInput.PrevOutput,
+			SigHashType:           uint32(consensushashing.SigHashAll),
+			MinimumSignatures:     partiallySignedInput.MinimumSignatures,
+			Keys:                  keys,
+			RedeemScript:          partiallySignedInput.RedeemScript,
+			RedeemScriptArguments: partiallySignedInput.RedeemScriptArguments,
+		}
+	}
+
+	outputs := make([]*serialization.PSTXOutput, len(unsignedTx.Outputs))
+	for i := range outputs {
+		outputs[i] = &serialization.PSTXOutput{}
+	}
+
+	return &serialization.PSTX{
+		Global: &serialization.PSTXGlobal{
+			UnsignedTx: unsignedTx,
+			ECDSA:      ecdsa,
+		},
+		Inputs:  inputs,
+		Outputs: outputs,
+	}, nil
+}
+
+func pstxToPartiallySignedTransaction(pstx *serialization.PSTX) (*serialization.PartiallySignedTransaction, error) {
+	partiallySignedInputs := make([]*serialization.PartiallySignedInput, len(pstx.Inputs))
+	for i, input := range pstx.Inputs {
+		var err error
+		partiallySignedInputs[i], err = partiallySignedInputFromPSTX(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "input %d can't be signed by the wallet", i)
+		}
+	}
+
+	return &serialization.PartiallySignedTransaction{
+		Tx:                    pstx.Global.UnsignedTx.Clone(),
+		PartiallySignedInputs: partiallySignedInputs,
+	}, nil
+}
+
+// partiallySignedInputFromPSTX converts a PSTX input to the wallet's own format, which requires its keys
+// to be derived from wallet accounts and signed with SigHashAll
+func partiallySignedInputFromPSTX(input *serialization.PSTXInput) (*serialization.PartiallySignedInput, error) {
+	if input.SigHashType != uint32(consensushashing.SigHashAll) {
+		return nil, errors.Errorf("sighash type %d is not supported", input.SigHashType)
+	}
+	if len(input.Keys) == 0 {
+		return nil, errors.Errorf("the input has no keys")
+	}
+
+	isMultisig := len(input.Keys) > 1
+	accountPath := defaultPath(isMultisig)
+	derivationPath := "m" + strings.TrimPrefix(input.Keys[0].DerivationPath, accountPath)
+	pubKeySignaturePairs := make([]*serialization.PubKeySignaturePair, len(input.Keys))
+	for i, key := range input.Keys {
+		if !strings.HasPrefix(key.DerivationPath, accountPath+"/") ||
+			key.DerivationPath != input.Keys[0].DerivationPath {
+
+			return nil, errors.Errorf("derivation path %s is not a path of a wallet account", key.DerivationPath)
+		}
+
+		pubKeySignaturePairs[i] = &serialization.PubKeySignaturePair{
+			ExtendedPublicKey: key.ExtendedPublicKey,
+			Signature:         key.Signature,
+		}
+	}
+
+	return &serialization.PartiallySignedInput{
+		PrevOutput:            input.PrevOutput,
+		MinimumSignatures:     input.MinimumSignatures,
+		PubKeySignaturePairs:  pubKeySignaturePairs,
+		DerivationPath:        derivationPath,
+		RedeemScript:          input.RedeemScript,
+		RedeemScriptArguments: input.RedeemScriptArguments,
+	}, nil
+}
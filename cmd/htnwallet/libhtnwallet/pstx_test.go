@@ -0,0 +1,221 @@
+package libhtnwallet_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet/serialization"
+	"github.com/Hoosat-Oy/HTND/domain/consensus"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+)
+
+func TestPSTXMultisig(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		params := &consensusConfig.Params
+		forSchnorrAndECDSA(t, func(t *testing.T, ecdsa bool) {
+			const numKeys = 3
+			mnemonics := make([]string, numKeys)
+			publicKeys := make([]string, numKeys)
+			for i := 0; i < numKeys; i++ {
+				var err error
+				mnemonics[i], err = libhtnwallet.CreateMnemonic()
+				if err != nil {
+					t.Fatalf("CreateMnemonic: %+v", err)
+				}
+
+				publicKeys[i], err = libhtnwallet.MasterPublicKeyFromMnemonic(params, mnemonics[i], true)
+				if err != nil {
+					t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+				}
+			}
+
+			const minimumSignatures = 2
+			const path = "m/0/5"
+			address, err := libhtnwallet.Address(params, publicKeys, minimumSignatures, path, ecdsa)
+			if err != nil {
+				t.Fatalf("Address: %+v", err)
+			}
+
+			ltc, teardown := newFundedTestContext(t, consensusConfig, "TestPSTXMultisig", address)
+			defer teardown(false)
+
+			unsignedTransaction, err := libhtnwallet.CreateUnsignedTransaction(publicKeys, minimumSignatures,
+				[]*libhtnwallet.Payment{{
+					Address: address,
+					Amount:  10,
+				}}, []*libhtnwallet.UTXO{{
+					Outpoint:       ltc.fundingOutpoint,
+					UTXOEntry:      ltc.fundingEntry,
+					DerivationPath: path,
+				}})
+			if err != nil {
+				t.Fatalf("CreateUnsignedTransaction: %+v", err)
+			}
+
+			unsignedPSTX, err := libhtnwallet.CombinePSTX([][]byte{unsignedTransaction}, ecdsa)
+			if err != nil {
+				t.Fatalf("CombinePSTX: %+v", err)
+			}
+			if !serialization.IsPSTX(unsignedPSTX) {
+				t.Fatalf("CombinePSTX didn't return a PSTX")
+			}
+
+			pstx, err := serialization.DeserializePSTX(unsignedPSTX)
+			if err != nil {
+				t.Fatalf("DeserializePSTX: %+v", err)
+			}
+			for _, key := range pstx.Inputs[0].Keys {
+				if !strings.HasSuffix(key.DerivationPath, "'/0/5") {
+					t.Fatalf("Unexpected derivation path %s", key.DerivationPath)
+				}
+			}
+
+			// Each cosigner signs its own copy: the first one signs the PSTX, and the second one
+			// signs the transaction in the wallet's own format
+			signedByFirst, err := libhtnwallet.Sign(params, mnemonics[:1], unsignedPSTX, ecdsa)
+			if err != nil {
+				t.Fatalf("Sign: %+v", err)
+			}
+			if !serialization.IsPSTX(signedByFirst) {
+				t.Fatalf("Sign didn't keep the PSTX format")
+			}
+			signedBySecond, err := libhtnwallet.Sign(params, mnemonics[1:2], unsignedTransaction, ecdsa)
+			if err != nil {
+				t.Fatalf("Sign: %+v", err)
+			}
+
+			_, err = libhtnwallet.FinalizePSTX(signedByFirst, ecdsa)
+			if err == nil {
+				t.Fatalf("A PSTX with a missing signature was unexpectedly finalized")
+			}
+
+			combined, err := libhtnwallet.CombinePSTX([][]byte{signedByFirst, signedBySecond}, ecdsa)
+			if err != nil {
+				t.Fatalf("CombinePSTX: %+v", err)
+			}
+
+			isFullySigned, err := libhtnwallet.IsTransactionFullySigned(combined)
+			if err != nil {
+				t.Fatalf("IsTransactionFullySigned: %+v", err)
+			}
+			if !isFullySigned {
+				t.Fatalf("The combined transaction is expected to be fully signed")
+			}
+
+			finalized, err := libhtnwallet.FinalizePSTX(combined, ecdsa)
+			if err != nil {
+				t.Fatalf("FinalizePSTX: %+v", err)
+			}
+
+			tx, err := libhtnwallet.ExtractTransaction(finalized, ecdsa)
+			if err != nil {
+				t.Fatalf("ExtractTransaction: %+v", err)
+			}
+			if !ltc.accepts(tx) {
+				t.Fatalf("The transaction extracted from the finalized PSTX wasn't accepted")
+			}
+		})
+	})
+}
+
+func TestPSTXCombineMismatch(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		params := &consensusConfig.Params
+		_, publicKey := createMnemonicAndPublicKey(t, consensusConfig)
+
+		address, err := libhtnwallet.Address(params, []string{publicKey}, 1, "m/0/0", false)
+		if err != nil {
+			t.Fatalf("Address: %+v", err)
+		}
+
+		ltc, teardown := newFundedTestContext(t, consensusConfig, "TestPSTXCombineMismatch", address)
+		defer teardown(false)
+
+		createUnsignedTransaction := func(amount uint64) []byte {
+			unsignedTransaction, err := libhtnwallet.CreateUnsignedTransaction([]string{publicKey}, 1,
+				[]*libhtnwallet.Payment{{
+					Address: address,
+					Amount:  amount,
+				}}, []*libhtnwallet.UTXO{{
+					Outpoint:       ltc.fundingOutpoint,
+					UTXOEntry:      ltc.fundingEntry,
+					DerivationPath: "m/0/0",
+				}})
+			if err != nil {
+				t.Fatalf("CreateUnsignedTransaction: %+v", err)
+			}
+			return unsignedTransaction
+		}
+
+		_, err = libhtnwallet.CombinePSTX([][]byte{createUnsignedTransaction(10), createUnsignedTransaction(20)}, false)
+		if err == nil || !strings.Contains(err.Error(), "is not the same transaction") {
+			t.Fatalf("Unexpected error combining different transactions: %v", err)
+		}
+	})
+}
+
+func TestPSTXContainer(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		params := &consensusConfig.Params
+		_, publicKey := createMnemonicAndPublicKey(t, consensusConfig)
+
+		address, err := libhtnwallet.Address(params, []string{publicKey}, 1, "m/0/0", false)
+		if err != nil {
+			t.Fatalf("Address: %+v", err)
+		}
+
+		ltc, teardown := newFundedTestContext(t, consensusConfig, "TestPSTXContainer", address)
+		defer teardown(false)
+
+		unsignedTransaction, err := libhtnwallet.CreateUnsignedTransaction([]string{publicKey}, 1,
+			[]*libhtnwallet.Payment{{
+				Address: address,
+				Amount:  10,
+			}}, []*libhtnwallet.UTXO{{
+				Outpoint:       ltc.fundingOutpoint,
+				UTXOEntry:      ltc.fundingEntry,
+				DerivationPath: "m/0/0",
+			}})
+		if err != nil {
+			t.Fatalf("CreateUnsignedTransaction: %+v", err)
+		}
+
+		pstx, err := libhtnwallet.ToPSTX(unsignedTransaction, false)
+		if err != nil {
+			t.Fatalf("ToPSTX: %+v", err)
+		}
+		pstx.Global.Proprietary = map[string][]byte{"coordinator": {1, 2, 3}}
+		pstx.Outputs[0].Keys = []*serialization.PSTXKey{{
+			ExtendedPublicKey: publicKey,
+			DerivationPath:    "m/44'/111111'/0'/0/0",
+		}}
+
+		serialized, err := serialization.SerializePSTX(pstx)
+		if err != nil {
+			t.Fatalf("SerializePSTX: %+v", err)
+		}
+
+		deserialized, err := serialization.DeserializePSTX(serialized)
+		if err != nil {
+			t.Fatalf("DeserializePSTX: %+v", err)
+		}
+		if string(deserialized.Global.Proprietary["coordinator"]) != string([]byte{1, 2, 3}) ||
+			deserialized.Outputs[0].Keys[0].DerivationPath != "m/44'/111111'/0'/0/0" ||
+			!deserialized.Global.UnsignedTx.Equal(pstx.Global.UnsignedTx) {
+
+			t.Fatalf("The PSTX changed by serializing and deserializing it")
+		}
+
+		corrupted := append([]byte{}, serialized...)
+		corrupted[len(corrupted)/2] ^= 0xff
+		_, err = serialization.DeserializePSTX(corrupted)
+		if err == nil || !strings.Contains(err.Error(), "checksum") {
+			t.Fatalf("Unexpected error deserializing a corrupted PSTX: %v", err)
+		}
+
+		if serialization.IsPSTX(unsignedTransaction) {
+			t.Fatalf("A transaction in the wallet's own format was detected as a PSTX")
+		}
+	})
+}
@@ -74,14 +74,15 @@ func (x *PartiallySignedTransaction) GetPartiallySignedInputs() []*PartiallySign
 }
 
 type PartiallySignedInput struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	RedeemScript         []byte                 `protobuf:"bytes,1,opt,name=redeemScript,proto3" json:"redeemScript,omitempty"`
-	PrevOutput           *TransactionOutput     `protobuf:"bytes,2,opt,name=prevOutput,proto3" json:"prevOutput,omitempty"`
-	MinimumSignatures    uint32                 `protobuf:"varint,3,opt,name=minimumSignatures,proto3" json:"minimumSignatures,omitempty"`
-	PubKeySignaturePairs []*PubKeySignaturePair `protobuf:"bytes,4,rep,name=pubKeySignaturePairs,proto3" json:"pubKeySignaturePairs,omitempty"`
-	DerivationPath       string                 `protobuf:"bytes,5,opt,name=derivationPath,proto3" json:"derivationPath,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	RedeemScript          []byte                 `protobuf:"bytes,1,opt,name=redeemScript,proto3" json:"redeemScript,omitempty"`
+	PrevOutput            *TransactionOutput     `protobuf:"bytes,2,opt,name=prevOutput,proto3" json:"prevOutput,omitempty"`
+	MinimumSignatures     uint32                 `protobuf:"varint,3,opt,name=minimumSignatures,proto3" json:"minimumSignatures,omitempty"`
+	PubKeySignaturePairs  []*PubKeySignaturePair `protobuf:"bytes,4,rep,name=pubKeySignaturePairs,proto3" json:"pubKeySignaturePairs,omitempty"`
+	DerivationPath        string                 `protobuf:"bytes,5,opt,name=derivationPath,proto3" json:"derivationPath,omitempty"`
+	RedeemScriptArguments [][]byte               `protobuf:"bytes,6,rep,name=redeemScriptArguments,proto3" json:"redeemScriptArguments,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *PartiallySignedInput) Reset() {
@@ -149,6 +150,13 @@ func (x *PartiallySignedInput) GetDerivationPath() string {
 	return ""
 }
 
+func (x *PartiallySignedInput) GetRedeemScriptArguments() [][]byte {
+	if x != nil {
+		return x.RedeemScriptArguments
+	}
+	return nil
+}
+
 type PubKeySignaturePair struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	ExtendedPubKey string                 `protobuf:"bytes,1,opt,name=extendedPubKey,proto3" json:"extendedPubKey,omitempty"`
@@ -201,6 +209,356 @@ func (x *PubKeySignaturePair) GetSignature() []byte {
 	return nil
 }
 
+// PSTX is the payload of the partially signed transaction interchange container.
+// See ../README.md for the container format and the meaning of each field.
+type PSTX struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Global        *PSTXGlobal            `protobuf:"bytes,1,opt,name=global,proto3" json:"global,omitempty"`
+	Inputs        []*PSTXInput           `protobuf:"bytes,2,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Outputs       []*PSTXOutput          `protobuf:"bytes,3,rep,name=outputs,proto3" json:"outputs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PSTX) Reset() {
+	*x = PSTX{}
+	mi := &file_wallet_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PSTX) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PSTX) ProtoMessage() {}
+
+func (x *PSTX) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PSTX.ProtoReflect.Descriptor instead.
+func (*PSTX) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PSTX) GetGlobal() *PSTXGlobal {
+	if x != nil {
+		return x.Global
+	}
+	return nil
+}
+
+func (x *PSTX) GetInputs() []*PSTXInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *PSTX) GetOutputs() []*PSTXOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type PSTXGlobal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnsignedTx    *TransactionMessage    `protobuf:"bytes,1,opt,name=unsignedTx,proto3" json:"unsignedTx,omitempty"`
+	Ecdsa         bool                   `protobuf:"varint,2,opt,name=ecdsa,proto3" json:"ecdsa,omitempty"`
+	Proprietary   map[string][]byte      `protobuf:"bytes,3,rep,name=proprietary,proto3" json:"proprietary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PSTXGlobal) Reset() {
+	*x = PSTXGlobal{}
+	mi := &file_wallet_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PSTXGlobal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PSTXGlobal) ProtoMessage() {}
+
+func (x *PSTXGlobal) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PSTXGlobal.ProtoReflect.Descriptor instead.
+func (*PSTXGlobal) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PSTXGlobal) GetUnsignedTx() *TransactionMessage {
+	if x != nil {
+		return x.UnsignedTx
+	}
+	return nil
+}
+
+func (x *PSTXGlobal) GetEcdsa() bool {
+	if x != nil {
+		return x.Ecdsa
+	}
+	return false
+}
+
+func (x *PSTXGlobal) GetProprietary() map[string][]byte {
+	if x != nil {
+		return x.Proprietary
+	}
+	return nil
+}
+
+type PSTXInput struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	PrevOutput            *TransactionOutput     `protobuf:"bytes,1,opt,name=prevOutput,proto3" json:"prevOutput,omitempty"`
+	SigHashType           uint32                 `protobuf:"varint,2,opt,name=sigHashType,proto3" json:"sigHashType,omitempty"`
+	MinimumSignatures     uint32                 `protobuf:"varint,3,opt,name=minimumSignatures,proto3" json:"minimumSignatures,omitempty"`
+	Keys                  []*PSTXKey             `protobuf:"bytes,4,rep,name=keys,proto3" json:"keys,omitempty"`
+	RedeemScript          []byte                 `protobuf:"bytes,5,opt,name=redeemScript,proto3" json:"redeemScript,omitempty"`
+	RedeemScriptArguments [][]byte               `protobuf:"bytes,6,rep,name=redeemScriptArguments,proto3" json:"redeemScriptArguments,omitempty"`
+	FinalSignatureScript  []byte                 `protobuf:"bytes,7,opt,name=finalSignatureScript,proto3" json:"finalSignatureScript,omitempty"`
+	Proprietary           map[string][]byte      `protobuf:"bytes,8,rep,name=proprietary,proto3" json:"proprietary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PSTXInput) Reset() {
+	*x = PSTXInput{}
+	mi := &file_wallet_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PSTXInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PSTXInput) ProtoMessage() {}
+
+func (x *PSTXInput) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PSTXInput.ProtoReflect.Descriptor instead.
+func (*PSTXInput) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PSTXInput) GetPrevOutput() *TransactionOutput {
+	if x != nil {
+		return x.PrevOutput
+	}
+	return nil
+}
+
+func (x *PSTXInput) GetSigHashType() uint32 {
+	if x != nil {
+		return x.SigHashType
+	}
+	return 0
+}
+
+func (x *PSTXInput) GetMinimumSignatures() uint32 {
+	if x != nil {
+		return x.MinimumSignatures
+	}
+	return 0
+}
+
+func (x *PSTXInput) GetKeys() []*PSTXKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *PSTXInput) GetRedeemScript() []byte {
+	if x != nil {
+		return x.RedeemScript
+	}
+	return nil
+}
+
+func (x *PSTXInput) GetRedeemScriptArguments() [][]byte {
+	if x != nil {
+		return x.RedeemScriptArguments
+	}
+	return nil
+}
+
+func (x *PSTXInput) GetFinalSignatureScript() []byte {
+	if x != nil {
+		return x.FinalSignatureScript
+	}
+	return nil
+}
+
+func (x *PSTXInput) GetProprietary() map[string][]byte {
+	if x != nil {
+		return x.Proprietary
+	}
+	return nil
+}
+
+type PSTXOutput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*PSTXKey             `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	RedeemScript  []byte                 `protobuf:"bytes,2,opt,name=redeemScript,proto3" json:"redeemScript,omitempty"`
+	Proprietary   map[string][]byte      `protobuf:"bytes,3,rep,name=proprietary,proto3" json:"proprietary,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PSTXOutput) Reset() {
+	*x = PSTXOutput{}
+	mi := &file_wallet_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PSTXOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PSTXOutput) ProtoMessage() {}
+
+func (x *PSTXOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PSTXOutput.ProtoReflect.Descriptor instead.
+func (*PSTXOutput) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PSTXOutput) GetKeys() []*PSTXKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *PSTXOutput) GetRedeemScript() []byte {
+	if x != nil {
+		return x.RedeemScript
+	}
+	return nil
+}
+
+func (x *PSTXOutput) GetProprietary() map[string][]byte {
+	if x != nil {
+		return x.Proprietary
+	}
+	return nil
+}
+
+type PSTXKey struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExtendedPubKey string                 `protobuf:"bytes,1,opt,name=extendedPubKey,proto3" json:"extendedPubKey,omitempty"`
+	DerivationPath string                 `protobuf:"bytes,2,opt,name=derivationPath,proto3" json:"derivationPath,omitempty"`
+	PublicKey      []byte                 `protobuf:"bytes,3,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Signature      []byte                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PSTXKey) Reset() {
+	*x = PSTXKey{}
+	mi := &file_wallet_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PSTXKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PSTXKey) ProtoMessage() {}
+
+func (x *PSTXKey) ProtoReflect() protoreflect.Message {
+	mi := &file_wallet_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PSTXKey.ProtoReflect.Descriptor instead.
+func (*PSTXKey) Descriptor() ([]byte, []int) {
+	return file_wallet_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PSTXKey) GetExtendedPubKey() string {
+	if x != nil {
+		return x.ExtendedPubKey
+	}
+	return ""
+}
+
+func (x *PSTXKey) GetDerivationPath() string {
+	if x != nil {
+		return x.DerivationPath
+	}
+	return ""
+}
+
+func (x *PSTXKey) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *PSTXKey) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
 type SubnetworkId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Bytes         []byte                 `protobuf:"bytes,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
@@ -210,7 +568,7 @@ type SubnetworkId struct {
 
 func (x *SubnetworkId) Reset() {
 	*x = SubnetworkId{}
-	mi := &file_wallet_proto_msgTypes[3]
+	mi := &file_wallet_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -222,7 +580,7 @@ func (x *SubnetworkId) String() string {
 func (*SubnetworkId) ProtoMessage() {}
 
 func (x *SubnetworkId) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_proto_msgTypes[3]
+	mi := &file_wallet_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -235,7 +593,7 @@ func (x *SubnetworkId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubnetworkId.ProtoReflect.Descriptor instead.
 func (*SubnetworkId) Descriptor() ([]byte, []int) {
-	return file_wallet_proto_rawDescGZIP(), []int{3}
+	return file_wallet_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SubnetworkId) GetBytes() []byte {
@@ -260,7 +618,7 @@ type TransactionMessage struct {
 
 func (x *TransactionMessage) Reset() {
 	*x = TransactionMessage{}
-	mi := &file_wallet_proto_msgTypes[4]
+	mi := &file_wallet_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +630,7 @@ func (x *TransactionMessage) String() string {
 func (*TransactionMessage) ProtoMessage() {}
 
 func (x *TransactionMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_proto_msgTypes[4]
+	mi := &file_wallet_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +643,7 @@ func (x *TransactionMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionMessage.ProtoReflect.Descriptor instead.
 func (*TransactionMessage) Descriptor() ([]byte, []int) {
-	return file_wallet_proto_rawDescGZIP(), []int{4}
+	return file_wallet_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TransactionMessage) GetVersion() uint32 {
@@ -349,7 +707,7 @@ type TransactionInput struct {
 
 func (x *TransactionInput) Reset() {
 	*x = TransactionInput{}
-	mi := &file_wallet_proto_msgTypes[5]
+	mi := &file_wallet_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -361,7 +719,7 @@ func (x *TransactionInput) String() string {
 func (*TransactionInput) ProtoMessage() {}
 
 func (x *TransactionInput) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_proto_msgTypes[5]
+	mi := &file_wallet_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -374,7 +732,7 @@ func (x *TransactionInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionInput.ProtoReflect.Descriptor instead.
 func (*TransactionInput) Descriptor() ([]byte, []int) {
-	return file_wallet_proto_rawDescGZIP(), []int{5}
+	return file_wallet_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *TransactionInput) GetPreviousOutpoint() *Outpoint {
@@ -415,7 +773,7 @@ type Outpoint struct {
 
 func (x *Outpoint) Reset() {
 	*x = Outpoint{}
-	mi := &file_wallet_proto_msgTypes[6]
+	mi := &file_wallet_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -427,7 +785,7 @@ func (x *Outpoint) String() string {
 func (*Outpoint) ProtoMessage() {}
 
 func (x *Outpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_proto_msgTypes[6]
+	mi := &file_wallet_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -440,7 +798,7 @@ func (x *Outpoint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Outpoint.ProtoReflect.Descriptor instead.
 func (*Outpoint) Descriptor() ([]byte, []int) {
-	return file_wallet_proto_rawDescGZIP(), []int{6}
+	return file_wallet_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Outpoint) GetTransactionId() *TransactionId {
@@ -466,7 +824,7 @@ type TransactionId struct {
 
 func (x *TransactionId) Reset() {
 	*x = TransactionId{}
-	mi := &file_wallet_proto_msgTypes[7]
+	mi := &file_wallet_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -478,7 +836,7 @@ func (x *TransactionId) String() string {
 func (*TransactionId) ProtoMessage() {}
 
 func (x *TransactionId) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_proto_msgTypes[7]
+	mi := &file_wallet_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -491,7 +849,7 @@ func (x *TransactionId) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionId.ProtoReflect.Descriptor instead.
 func (*TransactionId) Descriptor() ([]byte, []int) {
-	return file_wallet_proto_rawDescGZIP(), []int{7}
+	return file_wallet_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *TransactionId) GetBytes() []byte {
@@ -511,7 +869,7 @@ type ScriptPublicKey struct {
 
 func (x *ScriptPublicKey) Reset() {
 	*x = ScriptPublicKey{}
-	mi := &file_wallet_proto_msgTypes[8]
+	mi := &file_wallet_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -523,7 +881,7 @@ func (x *ScriptPublicKey) String() string {
 func (*ScriptPublicKey) ProtoMessage() {}
 
 func (x *ScriptPublicKey) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_proto_msgTypes[8]
+	mi := &file_wallet_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,7 +894,7 @@ func (x *ScriptPublicKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScriptPublicKey.ProtoReflect.Descriptor instead.
 func (*ScriptPublicKey) Descriptor() ([]byte, []int) {
-	return file_wallet_proto_rawDescGZIP(), []int{8}
+	return file_wallet_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ScriptPublicKey) GetScript() []byte {
@@ -563,7 +921,7 @@ type TransactionOutput struct {
 
 func (x *TransactionOutput) Reset() {
 	*x = TransactionOutput{}
-	mi := &file_wallet_proto_msgTypes[9]
+	mi := &file_wallet_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -575,7 +933,7 @@ func (x *TransactionOutput) String() string {
 func (*TransactionOutput) ProtoMessage() {}
 
 func (x *TransactionOutput) ProtoReflect() protoreflect.Message {
-	mi := &file_wallet_proto_msgTypes[9]
+	mi := &file_wallet_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -588,7 +946,7 @@ func (x *TransactionOutput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransactionOutput.ProtoReflect.Descriptor instead.
 func (*TransactionOutput) Descriptor() ([]byte, []int) {
-	return file_wallet_proto_rawDescGZIP(), []int{9}
+	return file_wallet_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TransactionOutput) GetValue() uint64 {
@@ -612,7 +970,7 @@ const file_wallet_proto_rawDesc = "" +
 	"\fwallet.proto\x12\x12protoserialization\"\xb4\x01\n" +
 	"\x1aPartiallySignedTransaction\x126\n" +
 	"\x02tx\x18\x01 \x01(\v2&.protoserialization.TransactionMessageR\x02tx\x12^\n" +
-	"\x15partiallySignedInputs\x18\x02 \x03(\v2(.protoserialization.PartiallySignedInputR\x15partiallySignedInputs\"\xb4\x02\n" +
+	"\x15partiallySignedInputs\x18\x02 \x03(\v2(.protoserialization.PartiallySignedInputR\x15partiallySignedInputs\"\xea\x02\n" +
 	"\x14PartiallySignedInput\x12\"\n" +
 	"\fredeemScript\x18\x01 \x01(\fR\fredeemScript\x12E\n" +
 	"\n" +
@@ -620,10 +978,52 @@ const file_wallet_proto_rawDesc = "" +
 	"prevOutput\x12,\n" +
 	"\x11minimumSignatures\x18\x03 \x01(\rR\x11minimumSignatures\x12[\n" +
 	"\x14pubKeySignaturePairs\x18\x04 \x03(\v2'.protoserialization.PubKeySignaturePairR\x14pubKeySignaturePairs\x12&\n" +
-	"\x0ederivationPath\x18\x05 \x01(\tR\x0ederivationPath\"[\n" +
+	"\x0ederivationPath\x18\x05 \x01(\tR\x0ederivationPath\x124\n" +
+	"\x15redeemScriptArguments\x18\x06 \x03(\fR\x15redeemScriptArguments\"[\n" +
 	"\x13PubKeySignaturePair\x12&\n" +
 	"\x0eextendedPubKey\x18\x01 \x01(\tR\x0eextendedPubKey\x12\x1c\n" +
-	"\tsignature\x18\x02 \x01(\fR\tsignature\"$\n" +
+	"\tsignature\x18\x02 \x01(\fR\tsignature\"\xaf\x01\n" +
+	"\x04PSTX\x126\n" +
+	"\x06global\x18\x01 \x01(\v2\x1e.protoserialization.PSTXGlobalR\x06global\x125\n" +
+	"\x06inputs\x18\x02 \x03(\v2\x1d.protoserialization.PSTXInputR\x06inputs\x128\n" +
+	"\aoutputs\x18\x03 \x03(\v2\x1e.protoserialization.PSTXOutputR\aoutputs\"\xfd\x01\n" +
+	"\n" +
+	"PSTXGlobal\x12F\n" +
+	"\n" +
+	"unsignedTx\x18\x01 \x01(\v2&.protoserialization.TransactionMessageR\n" +
+	"unsignedTx\x12\x14\n" +
+	"\x05ecdsa\x18\x02 \x01(\bR\x05ecdsa\x12Q\n" +
+	"\vproprietary\x18\x03 \x03(\v2/.protoserialization.PSTXGlobal.ProprietaryEntryR\vproprietary\x1a>\n" +
+	"\x10ProprietaryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xf3\x03\n" +
+	"\tPSTXInput\x12E\n" +
+	"\n" +
+	"prevOutput\x18\x01 \x01(\v2%.protoserialization.TransactionOutputR\n" +
+	"prevOutput\x12 \n" +
+	"\vsigHashType\x18\x02 \x01(\rR\vsigHashType\x12,\n" +
+	"\x11minimumSignatures\x18\x03 \x01(\rR\x11minimumSignatures\x12/\n" +
+	"\x04keys\x18\x04 \x03(\v2\x1b.protoserialization.PSTXKeyR\x04keys\x12\"\n" +
+	"\fredeemScript\x18\x05 \x01(\fR\fredeemScript\x124\n" +
+	"\x15redeemScriptArguments\x18\x06 \x03(\fR\x15redeemScriptArguments\x122\n" +
+	"\x14finalSignatureScript\x18\a \x01(\fR\x14finalSignatureScript\x12P\n" +
+	"\vproprietary\x18\b \x03(\v2..protoserialization.PSTXInput.ProprietaryEntryR\vproprietary\x1a>\n" +
+	"\x10ProprietaryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\xf4\x01\n" +
+	"\n" +
+	"PSTXOutput\x12/\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1b.protoserialization.PSTXKeyR\x04keys\x12\"\n" +
+	"\fredeemScript\x18\x02 \x01(\fR\fredeemScript\x12Q\n" +
+	"\vproprietary\x18\x03 \x03(\v2/.protoserialization.PSTXOutput.ProprietaryEntryR\vproprietary\x1a>\n" +
+	"\x10ProprietaryEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"\x95\x01\n" +
+	"\aPSTXKey\x12&\n" +
+	"\x0eextendedPubKey\x18\x01 \x01(\tR\x0eextendedPubKey\x12&\n" +
+	"\x0ederivationPath\x18\x02 \x01(\tR\x0ederivationPath\x12\x1c\n" +
+	"\tpublicKey\x18\x03 \x01(\fR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\fR\tsignature\"$\n" +
 	"\fSubnetworkId\x12\x14\n" +
 	"\x05bytes\x18\x01 \x01(\fR\x05bytes\"\xbb\x02\n" +
 	"\x12TransactionMessage\x12\x18\n" +
@@ -665,35 +1065,53 @@ func file_wallet_proto_rawDescGZIP() []byte {
 	return file_wallet_proto_rawDescData
 }
 
-var file_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_wallet_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_wallet_proto_goTypes = []any{
 	(*PartiallySignedTransaction)(nil), // 0: protoserialization.PartiallySignedTransaction
 	(*PartiallySignedInput)(nil),       // 1: protoserialization.PartiallySignedInput
 	(*PubKeySignaturePair)(nil),        // 2: protoserialization.PubKeySignaturePair
-	(*SubnetworkId)(nil),               // 3: protoserialization.SubnetworkId
-	(*TransactionMessage)(nil),         // 4: protoserialization.TransactionMessage
-	(*TransactionInput)(nil),           // 5: protoserialization.TransactionInput
-	(*Outpoint)(nil),                   // 6: protoserialization.Outpoint
-	(*TransactionId)(nil),              // 7: protoserialization.TransactionId
-	(*ScriptPublicKey)(nil),            // 8: protoserialization.ScriptPublicKey
-	(*TransactionOutput)(nil),          // 9: protoserialization.TransactionOutput
+	(*PSTX)(nil),                       // 3: protoserialization.PSTX
+	(*PSTXGlobal)(nil),                 // 4: protoserialization.PSTXGlobal
+	(*PSTXInput)(nil),                  // 5: protoserialization.PSTXInput
+	(*PSTXOutput)(nil),                 // 6: protoserialization.PSTXOutput
+	(*PSTXKey)(nil),                    // 7: protoserialization.PSTXKey
+	(*SubnetworkId)(nil),               // 8: protoserialization.SubnetworkId
+	(*TransactionMessage)(nil),         // 9: protoserialization.TransactionMessage
+	(*TransactionInput)(nil),           // 10: protoserialization.TransactionInput
+	(*Outpoint)(nil),                   // 11: protoserialization.Outpoint
+	(*TransactionId)(nil),              // 12: protoserialization.TransactionId
+	(*ScriptPublicKey)(nil),            // 13: protoserialization.ScriptPublicKey
+	(*TransactionOutput)(nil),          // 14: protoserialization.TransactionOutput
+	nil,                                // 15: protoserialization.PSTXGlobal.ProprietaryEntry
+	nil,                                // 16: protoserialization.PSTXInput.ProprietaryEntry
+	nil,                                // 17: protoserialization.PSTXOutput.ProprietaryEntry
 }
 var file_wallet_proto_depIdxs = []int32{
-	4,  // 0: protoserialization.PartiallySignedTransaction.tx:type_name -> protoserialization.TransactionMessage
+	9,  // 0: protoserialization.PartiallySignedTransaction.tx:type_name -> protoserialization.TransactionMessage
 	1,  // 1: protoserialization.PartiallySignedTransaction.partiallySignedInputs:type_name -> protoserialization.PartiallySignedInput
-	9,  // 2: protoserialization.PartiallySignedInput.prevOutput:type_name -> protoserialization.TransactionOutput
+	14, // 2: protoserialization.PartiallySignedInput.prevOutput:type_name -> protoserialization.TransactionOutput
 	2,  // 3: protoserialization.PartiallySignedInput.pubKeySignaturePairs:type_name -> protoserialization.PubKeySignaturePair
-	5,  // 4: protoserialization.TransactionMessage.inputs:type_name -> protoserialization.TransactionInput
-	9,  // 5: protoserialization.TransactionMessage.outputs:type_name -> protoserialization.TransactionOutput
-	3,  // 6: protoserialization.TransactionMessage.subnetworkId:type_name -> protoserialization.SubnetworkId
-	6,  // 7: protoserialization.TransactionInput.previousOutpoint:type_name -> protoserialization.Outpoint
-	7,  // 8: protoserialization.Outpoint.transactionId:type_name -> protoserialization.TransactionId
-	8,  // 9: protoserialization.TransactionOutput.scriptPublicKey:type_name -> protoserialization.ScriptPublicKey
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	4,  // 4: protoserialization.PSTX.global:type_name -> protoserialization.PSTXGlobal
+	5,  // 5: protoserialization.PSTX.inputs:type_name -> protoserialization.PSTXInput
+	6,  // 6: protoserialization.PSTX.outputs:type_name -> protoserialization.PSTXOutput
+	9,  // 7: protoserialization.PSTXGlobal.unsignedTx:type_name -> protoserialization.TransactionMessage
+	15, // 8: protoserialization.PSTXGlobal.proprietary:type_name -> protoserialization.PSTXGlobal.ProprietaryEntry
+	14, // 9: protoserialization.PSTXInput.prevOutput:type_name -> protoserialization.TransactionOutput
+	7,  // 10: protoserialization.PSTXInput.keys:type_name -> protoserialization.PSTXKey
+	16, // 11: protoserialization.PSTXInput.proprietary:type_name -> protoserialization.PSTXInput.ProprietaryEntry
+	7,  // 12: protoserialization.PSTXOutput.keys:type_name -> protoserialization.PSTXKey
+	17, // 13: protoserialization.PSTXOutput.proprietary:type_name -> protoserialization.PSTXOutput.ProprietaryEntry
+	10, // 14: protoserialization.TransactionMessage.inputs:type_name -> protoserialization.TransactionInput
+	14, // 15: protoserialization.TransactionMessage.outputs:type_name -> protoserialization.TransactionOutput
+	8,  // 16: protoserialization.TransactionMessage.subnetworkId:type_name -> protoserialization.SubnetworkId
+	11, // 17: protoserialization.TransactionInput.previousOutpoint:type_name -> protoserialization.Outpoint
+	12, // 18: protoserialization.Outpoint.transactionId:type_name -> protoserialization.TransactionId
+	13, // 19: protoserialization.TransactionOutput.scriptPublicKey:type_name -> protoserialization.ScriptPublicKey
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_wallet_proto_init() }
@@ -707,7 +1125,7 @@ func file_wallet_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wallet_proto_rawDesc), len(file_wallet_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
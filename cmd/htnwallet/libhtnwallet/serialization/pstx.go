@@ -0,0 +1,250 @@
+package serialization
+
+import (
+	"bytes"
+	"crypto/sha256"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet/serialization/protoserialization"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// PSTXVersion is the version of the PSTX container written by SerializePSTX
+const PSTXVersion = 1
+
+// pstxMagic is the prefix of every serialized PSTX container
+var pstxMagic = []byte{'p', 's', 't', 'x', 0xff}
+
+const (
+	pstxVersionSize  = 1
+	pstxChecksumSize = 4
+)
+
+// PSTX is a partially signed transaction in the documented interchange format, which lets third-party signers
+// and multisig coordinators work on transactions together with htnwallet. See README.md for the format.
+type PSTX struct {
+	Global  *PSTXGlobal
+	Inputs  []*PSTXInput
+	Outputs []*PSTXOutput
+}
+
+// PSTXGlobal holds the data of a PSTX that applies to the whole transaction
+type PSTXGlobal struct {
+	// UnsignedTx is the transaction being signed. Its signature scripts are always empty.
+	UnsignedTx *externalapi.DomainTransaction
+	// ECDSA is set if the keys of the transaction are ECDSA keys rather than Schnorr keys
+	ECDSA       bool
+	Proprietary map[string][]byte
+}
+
+// PSTXInput holds the data needed to sign and finalize one input of a PSTX
+type PSTXInput struct {
+	PrevOutput        *externalapi.DomainTransactionOutput
+	SigHashType       uint32
+	MinimumSignatures uint32
+	Keys              []*PSTXKey
+	// RedeemScript is set for pay-to-script-hash inputs that aren't multisig, which are
+	// spent by the signature, followed by RedeemScriptArguments and the redeem script
+	RedeemScript          []byte
+	RedeemScriptArguments [][]byte
+	// FinalSignatureScript is set once the input is finalized, and then takes precedence over the other fields
+	FinalSignatureScript []byte
+	Proprietary          map[string][]byte
+}
+
+// PSTXOutput holds the data a signer may use to verify an output of a PSTX, such as the keys of a change output
+type PSTXOutput struct {
+	Keys         []*PSTXKey
+	RedeemScript []byte
+	Proprietary  map[string][]byte
+}
+
+// PSTXKey is a public key that takes part in an input or an output, along with its derivation path and,
+// for inputs, its signature once it's made
+type PSTXKey struct {
+	// ExtendedPublicKey is the extended public key at DerivationPath
+	ExtendedPublicKey string
+	// DerivationPath is the BIP32 path of the key from its master key
+	DerivationPath string
+	PublicKey      []byte
+	Signature      []byte
+}
+
+// IsPSTX returns whether the given serialized transaction is a PSTX container, as opposed to
+// a serialized PartiallySignedTransaction
+func IsPSTX(serialized []byte) bool {
+	return bytes.HasPrefix(serialized, pstxMagic)
+}
+
+// SerializePSTX serializes a PSTX into its container format
+func SerializePSTX(pstx *PSTX) ([]byte, error) {
+	payload, err := proto.Marshal(pstxToProto(pstx))
+	if err != nil {
+		return nil, err
+	}
+
+	serialized := make([]byte, 0, len(pstxMagic)+pstxVersionSize+len(payload)+pstxChecksumSize)
+	serialized = append(serialized, pstxMagic...)
+	serialized = append(serialized, PSTXVersion)
+	serialized = append(serialized, payload...)
+	return append(serialized, pstxChecksum(serialized)...), nil
+}
+
+// DeserializePSTX deserializes a PSTX container, and verifies its checksum and version
+func DeserializePSTX(serialized []byte) (*PSTX, error) {
+	if !IsPSTX(serialized) {
+		return nil, errors.Errorf("the transaction is not a PSTX")
+	}
+	if len(serialized) < len(pstxMagic)+pstxVersionSize+pstxChecksumSize {
+		return nil, errors.Errorf("the PSTX is too short")
+	}
+
+	checksumStart := len(serialized) - pstxChecksumSize
+	if !bytes.Equal(pstxChecksum(serialized[:checksumStart]), serialized[checksumStart:]) {
+		return nil, errors.Errorf("the PSTX checksum is invalid")
+	}
+
+	version := serialized[len(pstxMagic)]
+	if version != PSTXVersion {
+		return nil, errors.Errorf("PSTX version %d is not supported", version)
+	}
+
+	protoPSTX := &protoserialization.PSTX{}
+	err := proto.Unmarshal(serialized[len(pstxMagic)+pstxVersionSize:checksumStart], protoPSTX)
+	if err != nil {
+		return nil, err
+	}
+
+	return pstxFromProto(protoPSTX)
+}
+
+// pstxChecksum returns the first bytes of the double SHA256 of the given data
+func pstxChecksum(data []byte) []byte {
+	firstHash := sha256.Sum256(data)
+	secondHash := sha256.Sum256(firstHash[:])
+	return secondHash[:pstxChecksumSize]
+}
+
+func pstxFromProto(protoPSTX *protoserialization.PSTX) (*PSTX, error) {
+	if protoPSTX.Global == nil || protoPSTX.Global.UnsignedTx == nil {
+		return nil, errors.Errorf("the PSTX is missing its unsigned transaction")
+	}
+
+	unsignedTx, err := transactionFromProto(protoPSTX.Global.UnsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(protoPSTX.Inputs) != len(unsignedTx.Inputs) {
+		return nil, errors.Errorf("the PSTX has %d input maps, but its transaction has %d inputs",
+			len(protoPSTX.Inputs), len(unsignedTx.Inputs))
+	}
+	if len(protoPSTX.Outputs) != len(unsignedTx.Outputs) {
+		return nil, errors.Errorf("the PSTX has %d output maps, but its transaction has %d outputs",
+			len(protoPSTX.Outputs), len(unsignedTx.Outputs))
+	}
+
+	inputs := make([]*PSTXInput, len(protoPSTX.Inputs))
+	for i, protoInput := range protoPSTX.Inputs {
+		if protoInput.PrevOutput == nil {
+			return nil, errors.Errorf("input %d of the PSTX is missing its previous output", i)
+		}
+
+		prevOutput, err := transactionOutputFromProto(protoInput.PrevOutput)
+		if err != nil {
+			return nil, err
+		}
+
+		inputs[i] = &PSTXInput{
+			PrevOutput:            prevOutput,
+			SigHashType:           protoInput.SigHashType,
+			MinimumSignatures:     protoInput.MinimumSignatures,
+			Keys:                  pstxKeysFromProto(protoInput.Keys),
+			RedeemScript:          protoInput.RedeemScript,
+			RedeemScriptArguments: protoInput.RedeemScriptArguments,
+			FinalSignatureScript:  protoInput.FinalSignatureScript,
+			Proprietary:           protoInput.Proprietary,
+		}
+	}
+
+	outputs := make([]*PSTXOutput, len(protoPSTX.Outputs))
+	for i, protoOutput := range protoPSTX.Outputs {
+		outputs[i] = &PSTXOutput{
+			Keys:         pstxKeysFromProto(protoOutput.Keys),
+			RedeemScript: protoOutput.RedeemScript,
+			Proprietary:  protoOutput.Proprietary,
+		}
+	}
+
+	return &PSTX{
+		Global: &PSTXGlobal{
+			UnsignedTx:  unsignedTx,
+			ECDSA:       protoPSTX.Global.Ecdsa,
+			Proprietary: protoPSTX.Global.Proprietary,
+		},
+		Inputs:  inputs,
+		Outputs: outputs,
+	}, nil
+}
+
+func pstxToProto(pstx *PSTX) *protoserialization.PSTX {
+	protoInputs := make([]*protoserialization.PSTXInput, len(pstx.Inputs))
+	for i, input := range pstx.Inputs {
+		protoInputs[i] = &protoserialization.PSTXInput{
+			PrevOutput:            transactionOutputToProto(input.PrevOutput),
+			SigHashType:           input.SigHashType,
+			MinimumSignatures:     input.MinimumSignatures,
+			Keys:                  pstxKeysToProto(input.Keys),
+			RedeemScript:          input.RedeemScript,
+			RedeemScriptArguments: input.RedeemScriptArguments,
+			FinalSignatureScript:  input.FinalSignatureScript,
+			Proprietary:           input.Proprietary,
+		}
+	}
+
+	protoOutputs := make([]*protoserialization.PSTXOutput, len(pstx.Outputs))
+	for i, output := range pstx.Outputs {
+		protoOutputs[i] = &protoserialization.PSTXOutput{
+			Keys:         pstxKeysToProto(output.Keys),
+			RedeemScript: output.RedeemScript,
+			Proprietary:  output.Proprietary,
+		}
+	}
+
+	return &protoserialization.PSTX{
+		Global: &protoserialization.PSTXGlobal{
+			UnsignedTx:  transactionToProto(pstx.Global.UnsignedTx),
+			Ecdsa:       pstx.Global.ECDSA,
+			Proprietary: pstx.Global.Proprietary,
+		},
+		Inputs:  protoInputs,
+		Outputs: protoOutputs,
+	}
+}
+
+func pstxKeysFromProto(protoKeys []*protoserialization.PSTXKey) []*PSTXKey {
+	keys := make([]*PSTXKey, len(protoKeys))
+	for i, protoKey := range protoKeys {
+		keys[i] = &PSTXKey{
+			ExtendedPublicKey: protoKey.ExtendedPubKey,
+			DerivationPath:    protoKey.DerivationPath,
+			PublicKey:         protoKey.PublicKey,
+			Signature:         protoKey.Signature,
+		}
+	}
+	return keys
+}
+
+func pstxKeysToProto(keys []*PSTXKey) []*protoserialization.PSTXKey {
+	protoKeys := make([]*protoserialization.PSTXKey, len(keys))
+	for i, key := range keys {
+		protoKeys[i] = &protoserialization.PSTXKey{
+			ExtendedPubKey: key.ExtendedPublicKey,
+			DerivationPath: key.DerivationPath,
+			PublicKey:      key.PublicKey,
+			Signature:      key.Signature,
+		}
+	}
+	return protoKeys
+}
@@ -26,6 +26,10 @@ type PartiallySignedInput struct {
 	MinimumSignatures    uint32
 	PubKeySignaturePairs []*PubKeySignaturePair
 	DerivationPath       string
+	// RedeemScript is set for pay-to-script-hash inputs that aren't multisig, which are
+	// spent by the signature, followed by RedeemScriptArguments and the redeem script
+	RedeemScript          []byte
+	RedeemScriptArguments [][]byte
 }
 
 // PubKeySignaturePair is a pair of public key and (potentially) its associated signature
@@ -57,6 +61,17 @@ func (psi PartiallySignedInput) Clone() *PartiallySignedInput {
 	for i, pubKeySignaturePair := range psi.PubKeySignaturePairs {
 		clone.PubKeySignaturePairs[i] = pubKeySignaturePair.Clone()
 	}
+	if psi.RedeemScript != nil {
+		clone.RedeemScript = make([]byte, len(psi.RedeemScript))
+		copy(clone.RedeemScript, psi.RedeemScript)
+	}
+	if psi.RedeemScriptArguments != nil {
+		clone.RedeemScriptArguments = make([][]byte, len(psi.RedeemScriptArguments))
+		for i, argument := range psi.RedeemScriptArguments {
+			clone.RedeemScriptArguments[i] = make([]byte, len(argument))
+			copy(clone.RedeemScriptArguments[i], argument)
+		}
+	}
 	return clone
 }
 
@@ -148,10 +163,12 @@ func partiallySignedInputFromProto(protoPartiallySignedInput *protoserialization
 	}
 
 	return &PartiallySignedInput{
-		PrevOutput:           output,
-		MinimumSignatures:    protoPartiallySignedInput.MinimumSignatures,
-		PubKeySignaturePairs: pubKeySignaturePairs,
-		DerivationPath:       protoPartiallySignedInput.DerivationPath,
+		PrevOutput:            output,
+		MinimumSignatures:     protoPartiallySignedInput.MinimumSignatures,
+		PubKeySignaturePairs:  pubKeySignaturePairs,
+		DerivationPath:        protoPartiallySignedInput.DerivationPath,
+		RedeemScript:          protoPartiallySignedInput.RedeemScript,
+		RedeemScriptArguments: protoPartiallySignedInput.RedeemScriptArguments,
 	}, nil
 }
 
@@ -162,10 +179,12 @@ func partiallySignedInputToProto(partiallySignedInput *PartiallySignedInput) *pr
 	}
 
 	return &protoserialization.PartiallySignedInput{
-		PrevOutput:           transactionOutputToProto(partiallySignedInput.PrevOutput),
-		MinimumSignatures:    partiallySignedInput.MinimumSignatures,
-		PubKeySignaturePairs: protoPairs,
-		DerivationPath:       partiallySignedInput.DerivationPath,
+		PrevOutput:            transactionOutputToProto(partiallySignedInput.PrevOutput),
+		MinimumSignatures:     partiallySignedInput.MinimumSignatures,
+		PubKeySignaturePairs:  protoPairs,
+		DerivationPath:        partiallySignedInput.DerivationPath,
+		RedeemScript:          partiallySignedInput.RedeemScript,
+		RedeemScriptArguments: partiallySignedInput.RedeemScriptArguments,
 	}
 }
 
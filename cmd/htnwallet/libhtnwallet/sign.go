@@ -27,8 +27,21 @@ func rawTxInSignature(extendedKey *bip32.ExtendedKey, tx *externalapi.DomainTran
 	return txscript.RawTxInSignature(tx, idx, hashType, schnorrKeyPair, sighashReusedValues)
 }
 
-// Sign signs the transaction with the given private keys
+// Sign signs the transaction with the given private keys. A PSTX container is returned as a PSTX container.
 func Sign(params *dagconfig.Params, mnemonics []string, serializedPSTx []byte, ecdsa bool) ([]byte, error) {
+	if serialization.IsPSTX(serializedPSTx) {
+		pstx, err := serialization.DeserializePSTX(serializedPSTx)
+		if err != nil {
+			return nil, err
+		}
+
+		err = signPSTX(params, mnemonics, pstx, ecdsa)
+		if err != nil {
+			return nil, err
+		}
+		return serialization.SerializePSTX(pstx)
+	}
+
 	partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(serializedPSTx)
 	if err != nil {
 		return nil, err
@@ -57,7 +70,11 @@ func sign(params *dagconfig.Params, mnemonic string, partiallySignedTransaction
 			false, // This is a fake value, because it's irrelevant for the signature
 			0,     // This is a fake value, because it's irrelevant for the signature
 		)
-		partiallySignedTransaction.Tx.Inputs[i].SigOpCount = byte(len(partiallySignedInput.PubKeySignaturePairs))
+		sigOpCount, err := inputSigOpCount(partiallySignedInput)
+		if err != nil {
+			return err
+		}
+		partiallySignedTransaction.Tx.Inputs[i].SigOpCount = sigOpCount
 	}
 
 	signed := false
@@ -97,3 +114,15 @@ func sign(params *dagconfig.Params, mnemonic string, partiallySignedTransaction
 
 	return nil
 }
+
+// inputSigOpCount returns the number of signature operations that spending the given input takes
+func inputSigOpCount(partiallySignedInput *serialization.PartiallySignedInput) (byte, error) {
+	if partiallySignedInput.RedeemScript != nil {
+		sigOpCount, err := scriptHashSigOpCount(partiallySignedInput)
+		if err != nil {
+			return 0, err
+		}
+		return byte(sigOpCount), nil
+	}
+	return byte(len(partiallySignedInput.PubKeySignaturePairs)), nil
+}
@@ -0,0 +1,209 @@
+package libhtnwallet
+
+import (
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet/bip32"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet/serialization"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/subnetworks"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/pkg/errors"
+)
+
+// ScriptHashUTXO is a UTXO of a pay-to-script-hash output that is spent with a single signature,
+// through the branch of its redeem script that RedeemScriptArguments select
+type ScriptHashUTXO struct {
+	UTXO *UTXO
+	// ExtendedPublicKey is the extended public key the signing key is derived from by UTXO.DerivationPath
+	ExtendedPublicKey string
+	RedeemScript      []byte
+	// RedeemScriptArguments are pushed by the signature script between the signature and the redeem script
+	RedeemScriptArguments [][]byte
+	Sequence              uint64
+}
+
+// TimeLockRedeemScript returns the redeem script of an output that is locked to the key derived from
+// extendedPublicKey by path, until lockTime. A lock time below constants.LockTimeThreshold is a DAA
+// score, and any other lock time is a UNIX timestamp in milliseconds.
+func TimeLockRedeemScript(extendedPublicKey string, path string, lockTime uint64, ecdsa bool) ([]byte, error) {
+	if lockTime == 0 {
+		return nil, errors.New("the lock time must be greater than 0")
+	}
+
+	publicKey, err := serializedPublicKey(extendedPublicKey, path, ecdsa)
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.NewScriptBuilder().
+		AddLockTimeNumber(lockTime).
+		AddOp(txscript.OpCheckLockTimeVerify).
+		AddData(publicKey).
+		AddOp(checkSigOpcode(ecdsa)).
+		Script()
+}
+
+// VaultRedeemScript returns the redeem script of a vault output. The owner key may spend the output
+// once its DAA score is delay DAA scores behind the virtual, and the recovery key may spend it at any
+// time. Each key is derived from its extended public key by the respective path.
+func VaultRedeemScript(ownerExtendedPublicKey string, ownerPath string, recoveryExtendedPublicKey string,
+	recoveryPath string, delay uint64, ecdsa bool) ([]byte, error) {
+
+	if delay == 0 || delay > constants.SequenceLockTimeMask {
+		return nil, errors.Errorf("the delay must be between 1 and %d", constants.SequenceLockTimeMask)
+	}
+
+	ownerPublicKey, err := serializedPublicKey(ownerExtendedPublicKey, ownerPath, ecdsa)
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryPublicKey, err := serializedPublicKey(recoveryExtendedPublicKey, recoveryPath, ecdsa)
+	if err != nil {
+		return nil, err
+	}
+
+	return txscript.NewScriptBuilder().
+		AddOp(txscript.OpIf).
+		AddSequenceNumber(delay).
+		AddOp(txscript.OpCheckSequenceVerify).
+		AddData(ownerPublicKey).
+		AddOp(checkSigOpcode(ecdsa)).
+		AddOp(txscript.OpElse).
+		AddData(recoveryPublicKey).
+		AddOp(checkSigOpcode(ecdsa)).
+		AddOp(txscript.OpEndIf).
+		Script()
+}
+
+// VaultRedeemScriptArguments returns the redeem script arguments that select the branch of a
+// vault redeem script that is spent with the recovery key, or with the owner key otherwise
+func VaultRedeemScriptArguments(isRecovery bool) [][]byte {
+	if isRecovery {
+		return [][]byte{{}}
+	}
+	return [][]byte{{1}}
+}
+
+// CreateUnsignedScriptHashTransaction creates an unsigned transaction that spends the given
+// pay-to-script-hash UTXOs. lockTime must satisfy the lock times the redeem scripts verify.
+func CreateUnsignedScriptHashTransaction(utxos []*ScriptHashUTXO, payments []*Payment, lockTime uint64) ([]byte, error) {
+	inputs := make([]*externalapi.DomainTransactionInput, len(utxos))
+	partiallySignedInputs := make([]*serialization.PartiallySignedInput, len(utxos))
+	for i, utxo := range utxos {
+		extendedKey, err := bip32.DeserializeExtendedKey(utxo.ExtendedPublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		derivedKey, err := extendedKey.DeriveFromPath(utxo.UTXO.DerivationPath)
+		if err != nil {
+			return nil, err
+		}
+
+		inputs[i] = &externalapi.DomainTransactionInput{
+			PreviousOutpoint: *utxo.UTXO.Outpoint,
+			Sequence:         utxo.Sequence,
+		}
+		partiallySignedInputs[i] = &serialization.PartiallySignedInput{
+			PrevOutput: &externalapi.DomainTransactionOutput{
+				Value:           utxo.UTXO.UTXOEntry.Amount(),
+				ScriptPublicKey: utxo.UTXO.UTXOEntry.ScriptPublicKey(),
+			},
+			MinimumSignatures: 1,
+			PubKeySignaturePairs: []*serialization.PubKeySignaturePair{{
+				ExtendedPublicKey: derivedKey.String(),
+			}},
+			DerivationPath:        utxo.UTXO.DerivationPath,
+			RedeemScript:          utxo.RedeemScript,
+			RedeemScriptArguments: utxo.RedeemScriptArguments,
+		}
+	}
+
+	outputs, err := paymentOutputs(payments)
+	if err != nil {
+		return nil, err
+	}
+
+	return serialization.SerializePartiallySignedTransaction(&serialization.PartiallySignedTransaction{
+		Tx: &externalapi.DomainTransaction{
+			Version:      constants.MaxTransactionVersion,
+			Inputs:       inputs,
+			Outputs:      outputs,
+			LockTime:     lockTime,
+			SubnetworkID: subnetworks.SubnetworkIDNative,
+			Gas:          0,
+			Payload:      nil,
+		},
+		PartiallySignedInputs: partiallySignedInputs,
+	})
+}
+
+func scriptHashSignatureScript(input *serialization.PartiallySignedInput) ([]byte, error) {
+	if len(input.PubKeySignaturePairs) != 1 {
+		return nil, errors.Errorf("a pay-to-script-hash input must have a single signer, but has %d",
+			len(input.PubKeySignaturePairs))
+	}
+
+	if input.PubKeySignaturePairs[0].Signature == nil {
+		return nil, errors.Errorf("missing signature")
+	}
+
+	scriptBuilder := txscript.NewScriptBuilder().AddData(input.PubKeySignaturePairs[0].Signature)
+	for _, argument := range input.RedeemScriptArguments {
+		scriptBuilder.AddData(argument)
+	}
+	return scriptBuilder.AddData(input.RedeemScript).Script()
+}
+
+// scriptHashSigOpCount returns the number of signature operations the redeem script of the given input has
+func scriptHashSigOpCount(input *serialization.PartiallySignedInput) (int, error) {
+	signatureScript, err := txscript.PayToScriptHashSignatureScript(input.RedeemScript, nil)
+	if err != nil {
+		return 0, err
+	}
+	return txscript.GetPreciseSigOpCount(signatureScript, input.PrevOutput.ScriptPublicKey), nil
+}
+
+func serializedPublicKey(extendedPublicKey string, path string, ecdsa bool) ([]byte, error) {
+	extendedKey, err := bip32.DeserializeExtendedKey(extendedPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := extendedKey.DeriveFromPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := derivedKey.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	if ecdsa {
+		serializedECDSAPublicKey, err := publicKey.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		return serializedECDSAPublicKey[:], nil
+	}
+
+	schnorrPublicKey, err := publicKey.ToSchnorr()
+	if err != nil {
+		return nil, err
+	}
+
+	serializedSchnorrPublicKey, err := schnorrPublicKey.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return serializedSchnorrPublicKey[:], nil
+}
+
+func checkSigOpcode(ecdsa bool) byte {
+	if ecdsa {
+		return txscript.OpCheckSigECDSA
+	}
+	return txscript.OpCheckSig
+}
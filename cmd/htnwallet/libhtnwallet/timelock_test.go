@@ -0,0 +1,265 @@
+package libhtnwallet_test
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/consensus"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/testapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/utxo"
+	"github.com/Hoosat-Oy/HTND/util"
+)
+
+// lockScriptTestContext is a test consensus with a chain whose tip has a coinbase output that pays to a given
+// pay-to-script-hash address
+type lockScriptTestContext struct {
+	t               *testing.T
+	tc              testapi.TestConsensus
+	params          *consensus.Config
+	tipHash         *externalapi.DomainHash
+	fundingOutpoint *externalapi.DomainOutpoint
+	fundingEntry    externalapi.UTXOEntry
+}
+
+func newLockScriptTestContext(t *testing.T, consensusConfig *consensus.Config, testName string,
+	redeemScript []byte) (ltc *lockScriptTestContext, teardown func(keepDataDir bool)) {
+
+	address, err := util.NewAddressScriptHash(redeemScript, consensusConfig.Prefix)
+	if err != nil {
+		t.Fatalf("NewAddressScriptHash: %+v", err)
+	}
+	return newFundedTestContext(t, consensusConfig, testName, address)
+}
+
+// newFundedTestContext is like newLockScriptTestContext, but with a funding output that pays to any address
+func newFundedTestContext(t *testing.T, consensusConfig *consensus.Config, testName string,
+	address util.Address) (ltc *lockScriptTestContext, teardown func(keepDataDir bool)) {
+
+	consensusConfig.BlockCoinbaseMaturity = 0
+	tc, teardown, err := consensus.NewFactory().NewTestConsensus(consensusConfig, testName)
+	if err != nil {
+		t.Fatalf("Error setting up tc: %+v", err)
+	}
+
+	scriptPublicKey, err := txscript.PayToAddrScript(address)
+	if err != nil {
+		t.Fatalf("PayToAddrScript: %+v", err)
+	}
+
+	fundingBlockHash, _, err := tc.AddBlock([]*externalapi.DomainHash{consensusConfig.GenesisHash},
+		&externalapi.DomainCoinbaseData{ScriptPublicKey: scriptPublicKey}, nil)
+	if err != nil {
+		t.Fatalf("AddBlock: %+v", err)
+	}
+
+	block1Hash, _, err := tc.AddBlock([]*externalapi.DomainHash{fundingBlockHash}, nil, nil)
+	if err != nil {
+		t.Fatalf("AddBlock: %+v", err)
+	}
+
+	block1, _, err := tc.GetBlock(block1Hash)
+	if err != nil {
+		t.Fatalf("GetBlock: %+v", err)
+	}
+
+	block1TxOut := block1.Transactions[0].Outputs[0]
+	return &lockScriptTestContext{
+		t:       t,
+		tc:      tc,
+		params:  consensusConfig,
+		tipHash: block1Hash,
+		fundingOutpoint: &externalapi.DomainOutpoint{
+			TransactionID: *consensushashing.TransactionID(block1.Transactions[0]),
+			Index:         0,
+		},
+		fundingEntry: utxo.NewUTXOEntry(block1TxOut.Value, block1TxOut.ScriptPublicKey, true, 0),
+	}, teardown
+}
+
+// spend signs a transaction that spends the funding output with the given mnemonic, and returns whether
+// a block on top of the tip accepts it
+func (ltc *lockScriptTestContext) spend(mnemonic string, utxo *libhtnwallet.ScriptHashUTXO, lockTime uint64,
+	ecdsa bool) bool {
+
+	utxo.UTXO.Outpoint = ltc.fundingOutpoint
+	utxo.UTXO.UTXOEntry = ltc.fundingEntry
+
+	address, err := libhtnwallet.Address(&ltc.params.Params, []string{utxo.ExtendedPublicKey}, 1, "m/0/0", ecdsa)
+	if err != nil {
+		ltc.t.Fatalf("Address: %+v", err)
+	}
+
+	unsignedTransaction, err := libhtnwallet.CreateUnsignedScriptHashTransaction([]*libhtnwallet.ScriptHashUTXO{utxo},
+		[]*libhtnwallet.Payment{{
+			Address: address,
+			Amount:  10,
+		}}, lockTime)
+	if err != nil {
+		ltc.t.Fatalf("CreateUnsignedScriptHashTransaction: %+v", err)
+	}
+
+	signedTx, err := libhtnwallet.Sign(&ltc.params.Params, []string{mnemonic}, unsignedTransaction, ecdsa)
+	if err != nil {
+		ltc.t.Fatalf("Sign: %+v", err)
+	}
+
+	tx, err := libhtnwallet.ExtractTransaction(signedTx, ecdsa)
+	if err != nil {
+		ltc.t.Fatalf("ExtractTransaction: %+v", err)
+	}
+	return ltc.accepts(tx)
+}
+
+// accepts returns whether a block on top of the tip accepts the given transaction
+func (ltc *lockScriptTestContext) accepts(tx *externalapi.DomainTransaction) bool {
+	_, virtualChangeSet, err := ltc.tc.AddBlock([]*externalapi.DomainHash{ltc.tipHash}, nil,
+		[]*externalapi.DomainTransaction{tx})
+	if err != nil {
+		return false
+	}
+
+	return virtualChangeSet.VirtualUTXODiff.ToAdd().Contains(&externalapi.DomainOutpoint{
+		TransactionID: *consensushashing.TransactionID(tx),
+		Index:         0,
+	})
+}
+
+// extendChainToDAAScore adds blocks on top of the tip until its DAA score reaches the given one
+func (ltc *lockScriptTestContext) extendChainToDAAScore(daaScore uint64) {
+	for {
+		header, err := ltc.tc.GetBlockHeader(ltc.tipHash)
+		if err != nil {
+			ltc.t.Fatalf("GetBlockHeader: %+v", err)
+		}
+		if header.DAAScore() >= daaScore {
+			return
+		}
+
+		ltc.tipHash, _, err = ltc.tc.AddBlock([]*externalapi.DomainHash{ltc.tipHash}, nil, nil)
+		if err != nil {
+			ltc.t.Fatalf("AddBlock: %+v", err)
+		}
+	}
+}
+
+func (ltc *lockScriptTestContext) tipDAAScore() uint64 {
+	header, err := ltc.tc.GetBlockHeader(ltc.tipHash)
+	if err != nil {
+		ltc.t.Fatalf("GetBlockHeader: %+v", err)
+	}
+	return header.DAAScore()
+}
+
+func createMnemonicAndPublicKey(t *testing.T, consensusConfig *consensus.Config) (string, string) {
+	mnemonic, err := libhtnwallet.CreateMnemonic()
+	if err != nil {
+		t.Fatalf("CreateMnemonic: %+v", err)
+	}
+
+	publicKey, err := libhtnwallet.MasterPublicKeyFromMnemonic(&consensusConfig.Params, mnemonic, false)
+	if err != nil {
+		t.Fatalf("MasterPublicKeyFromMnemonic: %+v", err)
+	}
+	return mnemonic, publicKey
+}
+
+func TestTimeLock(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		forSchnorrAndECDSA(t, func(t *testing.T, ecdsa bool) {
+			mnemonic, publicKey := createMnemonicAndPublicKey(t, consensusConfig)
+
+			const path = "m/2/0"
+			const lockTime = 10
+			redeemScript, err := libhtnwallet.TimeLockRedeemScript(publicKey, path, lockTime, ecdsa)
+			if err != nil {
+				t.Fatalf("TimeLockRedeemScript: %+v", err)
+			}
+
+			ltc, teardown := newLockScriptTestContext(t, consensusConfig, "TestTimeLock", redeemScript)
+			defer teardown(false)
+
+			timeLockUTXO := func() *libhtnwallet.ScriptHashUTXO {
+				return &libhtnwallet.ScriptHashUTXO{
+					UTXO:              &libhtnwallet.UTXO{DerivationPath: path},
+					ExtendedPublicKey: publicKey,
+					RedeemScript:      redeemScript,
+				}
+			}
+
+			if ltc.spend(mnemonic, timeLockUTXO(), lockTime, ecdsa) {
+				t.Fatalf("The time locked output was unexpectedly spent before its lock time")
+			}
+
+			ltc.extendChainToDAAScore(lockTime)
+			if ltc.spend(mnemonic, timeLockUTXO(), lockTime-1, ecdsa) {
+				t.Fatalf("The time locked output was unexpectedly spent by a transaction with a lower lock time")
+			}
+			if !ltc.spend(mnemonic, timeLockUTXO(), lockTime, ecdsa) {
+				t.Fatalf("The time locked output wasn't spent after its lock time")
+			}
+		})
+	})
+}
+
+func TestVault(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		forSchnorrAndECDSA(t, func(t *testing.T, ecdsa bool) {
+			ownerMnemonic, ownerPublicKey := createMnemonicAndPublicKey(t, consensusConfig)
+			recoveryMnemonic, recoveryPublicKey := createMnemonicAndPublicKey(t, consensusConfig)
+
+			const ownerPath = "m/2/0"
+			const recoveryPath = "m/3/0"
+			const delay = 10
+			redeemScript, err := libhtnwallet.VaultRedeemScript(ownerPublicKey, ownerPath, recoveryPublicKey,
+				recoveryPath, delay, ecdsa)
+			if err != nil {
+				t.Fatalf("VaultRedeemScript: %+v", err)
+			}
+
+			ownerUTXO := func() *libhtnwallet.ScriptHashUTXO {
+				return &libhtnwallet.ScriptHashUTXO{
+					UTXO:                  &libhtnwallet.UTXO{DerivationPath: ownerPath},
+					ExtendedPublicKey:     ownerPublicKey,
+					RedeemScript:          redeemScript,
+					RedeemScriptArguments: libhtnwallet.VaultRedeemScriptArguments(false),
+					Sequence:              delay,
+				}
+			}
+			recoveryUTXO := func() *libhtnwallet.ScriptHashUTXO {
+				return &libhtnwallet.ScriptHashUTXO{
+					UTXO:                  &libhtnwallet.UTXO{DerivationPath: recoveryPath},
+					ExtendedPublicKey:     recoveryPublicKey,
+					RedeemScript:          redeemScript,
+					RedeemScriptArguments: libhtnwallet.VaultRedeemScriptArguments(true),
+				}
+			}
+
+			t.Run("owner", func(t *testing.T) {
+				ltc, teardown := newLockScriptTestContext(t, consensusConfig, "TestVaultOwner", redeemScript)
+				defer teardown(false)
+
+				if ltc.spend(ownerMnemonic, ownerUTXO(), 0, ecdsa) {
+					t.Fatalf("The vault output was unexpectedly spent by the owner before its delay")
+				}
+
+				ltc.extendChainToDAAScore(ltc.tipDAAScore() + delay)
+				if !ltc.spend(ownerMnemonic, ownerUTXO(), 0, ecdsa) {
+					t.Fatalf("The vault output wasn't spent by the owner after its delay")
+				}
+			})
+
+			t.Run("recovery", func(t *testing.T) {
+				ltc, teardown := newLockScriptTestContext(t, consensusConfig, "TestVaultRecovery", redeemScript)
+				defer teardown(false)
+
+				if !ltc.spend(recoveryMnemonic, recoveryUTXO(), 0, ecdsa) {
+					t.Fatalf("The vault output wasn't spent by the recovery key before its delay")
+				}
+			})
+		})
+	})
+}
@@ -46,41 +46,11 @@ func multiSigRedeemScript(extendedPublicKeys []string, minimumSignatures uint32,
 	scriptBuilder := txscript.NewScriptBuilder()
 	scriptBuilder.AddInt64(int64(minimumSignatures))
 	for _, key := range extendedPublicKeys {
-		extendedKey, err := bip32.DeserializeExtendedKey(key)
+		serializedPublicKey, err := serializedPublicKey(key, path, ecdsa)
 		if err != nil {
 			return nil, err
 		}
 
-		derivedKey, err := extendedKey.DeriveFromPath(path)
-		if err != nil {
-			return nil, err
-		}
-
-		publicKey, err := derivedKey.PublicKey()
-		if err != nil {
-			return nil, err
-		}
-
-		var serializedPublicKey []byte
-		if ecdsa {
-			serializedECDSAPublicKey, err := publicKey.Serialize()
-			if err != nil {
-				return nil, err
-			}
-			serializedPublicKey = serializedECDSAPublicKey[:]
-		} else {
-			schnorrPublicKey, err := publicKey.ToSchnorr()
-			if err != nil {
-				return nil, err
-			}
-
-			serializedSchnorrPublicKey, err := schnorrPublicKey.Serialize()
-			if err != nil {
-				return nil, err
-			}
-			serializedPublicKey = serializedSchnorrPublicKey[:]
-		}
-
 		scriptBuilder.AddData(serializedPublicKey)
 	}
 	scriptBuilder.AddInt64(int64(len(extendedPublicKeys)))
@@ -132,17 +102,9 @@ func createUnsignedTransaction(
 		}
 	}
 
-	outputs := make([]*externalapi.DomainTransactionOutput, len(payments))
-	for i, payment := range payments {
-		scriptPublicKey, err := txscript.PayToAddrScript(payment.Address)
-		if err != nil {
-			return nil, err
-		}
-
-		outputs[i] = &externalapi.DomainTransactionOutput{
-			Value:           payment.Amount,
-			ScriptPublicKey: scriptPublicKey,
-		}
+	outputs, err := paymentOutputs(payments)
+	if err != nil {
+		return nil, err
 	}
 
 	domainTransaction := &externalapi.DomainTransaction{
@@ -162,8 +124,32 @@ func createUnsignedTransaction(
 
 }
 
+func paymentOutputs(payments []*Payment) ([]*externalapi.DomainTransactionOutput, error) {
+	outputs := make([]*externalapi.DomainTransactionOutput, len(payments))
+	for i, payment := range payments {
+		scriptPublicKey, err := txscript.PayToAddrScript(payment.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		outputs[i] = &externalapi.DomainTransactionOutput{
+			Value:           payment.Amount,
+			ScriptPublicKey: scriptPublicKey,
+		}
+	}
+	return outputs, nil
+}
+
 // IsTransactionFullySigned returns whether the transaction is fully signed and ready to broadcast.
 func IsTransactionFullySigned(partiallySignedTransactionBytes []byte) (bool, error) {
+	if serialization.IsPSTX(partiallySignedTransactionBytes) {
+		pstx, err := serialization.DeserializePSTX(partiallySignedTransactionBytes)
+		if err != nil {
+			return false, err
+		}
+		return isPSTXFullySigned(pstx), nil
+	}
+
 	partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(partiallySignedTransactionBytes)
 	if err != nil {
 		return false, err
@@ -188,8 +174,16 @@ func isTransactionFullySigned(partiallySignedTransaction *serialization.Partiall
 }
 
 // ExtractTransaction extracts a domain transaction from partially signed transaction after all of the
-// relevant parties have signed it.
+// relevant parties have signed it. The transaction may also be a PSTX container.
 func ExtractTransaction(partiallySignedTransactionBytes []byte, ecdsa bool) (*externalapi.DomainTransaction, error) {
+	if serialization.IsPSTX(partiallySignedTransactionBytes) {
+		pstx, err := serialization.DeserializePSTX(partiallySignedTransactionBytes)
+		if err != nil {
+			return nil, err
+		}
+		return extractPSTXTransaction(pstx)
+	}
+
 	partiallySignedTransaction, err := serialization.DeserializePartiallySignedTransaction(partiallySignedTransactionBytes)
 	if err != nil {
 		return nil, err
@@ -204,51 +198,54 @@ func ExtractTransactionDeserialized(partiallySignedTransaction *serialization.Pa
 	*externalapi.DomainTransaction, error) {
 
 	for i, input := range partiallySignedTransaction.PartiallySignedInputs {
-		isMultisig := len(input.PubKeySignaturePairs) > 1
-		scriptBuilder := txscript.NewScriptBuilder()
-		if isMultisig {
-			signatureCount := 0
-			for _, pair := range input.PubKeySignaturePairs {
-				if pair.Signature != nil {
-					scriptBuilder.AddData(pair.Signature)
-					signatureCount++
-				}
-			}
-			if uint32(signatureCount) < input.MinimumSignatures {
-				return nil, errors.Errorf("missing %d signatures", input.MinimumSignatures-uint32(signatureCount))
-			}
+		sigScript, err := signatureScript(input, ecdsa)
+		if err != nil {
+			return nil, err
+		}
+		partiallySignedTransaction.Tx.Inputs[i].SignatureScript = sigScript
+	}
+	return partiallySignedTransaction.Tx, nil
+}
 
-			redeemScript, err := partiallySignedInputMultisigRedeemScript(input, ecdsa)
-			if err != nil {
-				return nil, err
-			}
+// signatureScript builds the signature script of a partially signed input that has all of its required signatures
+func signatureScript(input *serialization.PartiallySignedInput, ecdsa bool) ([]byte, error) {
+	if input.RedeemScript != nil {
+		return scriptHashSignatureScript(input)
+	}
 
-			scriptBuilder.AddData(redeemScript)
-			sigScript, err := scriptBuilder.Script()
-			if err != nil {
-				return nil, err
+	isMultisig := len(input.PubKeySignaturePairs) > 1
+	if isMultisig {
+		scriptBuilder := txscript.NewScriptBuilder()
+		signatureCount := 0
+		for _, pair := range input.PubKeySignaturePairs {
+			if pair.Signature != nil {
+				scriptBuilder.AddData(pair.Signature)
+				signatureCount++
 			}
+		}
+		if uint32(signatureCount) < input.MinimumSignatures {
+			return nil, errors.Errorf("missing %d signatures", input.MinimumSignatures-uint32(signatureCount))
+		}
 
-			partiallySignedTransaction.Tx.Inputs[i].SignatureScript = sigScript
-		} else {
-			if len(input.PubKeySignaturePairs) > 1 {
-				return nil, errors.Errorf("Cannot sign on P2PK when len(input.PubKeySignaturePairs) > 1")
-			}
+		redeemScript, err := partiallySignedInputMultisigRedeemScript(input, ecdsa)
+		if err != nil {
+			return nil, err
+		}
 
-			if input.PubKeySignaturePairs[0].Signature == nil {
-				return nil, errors.Errorf("missing signature")
-			}
+		return scriptBuilder.AddData(redeemScript).Script()
+	}
 
-			sigScript, err := txscript.NewScriptBuilder().
-				AddData(input.PubKeySignaturePairs[0].Signature).
-				Script()
-			if err != nil {
-				return nil, err
-			}
-			partiallySignedTransaction.Tx.Inputs[i].SignatureScript = sigScript
-		}
+	if len(input.PubKeySignaturePairs) == 0 {
+		return nil, errors.Errorf("the input has no public keys")
 	}
-	return partiallySignedTransaction.Tx, nil
+
+	if input.PubKeySignaturePairs[0].Signature == nil {
+		return nil, errors.Errorf("missing signature")
+	}
+
+	return txscript.NewScriptBuilder().
+		AddData(input.PubKeySignaturePairs[0].Signature).
+		Script()
 }
 
 func partiallySignedInputMultisigRedeemScript(input *serialization.PartiallySignedInput, ecdsa bool) ([]byte, error) {
@@ -28,6 +28,42 @@ func main() {
 		err = showAddresses(config.(*showAddressesConfig))
 	case newAddressSubCmd:
 		err = newAddress(config.(*newAddressConfig))
+	case historySubCmd:
+		err = history(config.(*historyConfig))
+	case labelTransactionSubCmd:
+		err = labelTransaction(config.(*labelTransactionConfig))
+	case signMessageSubCmd:
+		err = signMessage(config.(*signMessageConfig))
+	case verifyMessageSubCmd:
+		err = verifyMessage(config.(*verifyMessageConfig))
+	case newTimeLockAddressSubCmd:
+		err = newTimeLockAddress(config.(*newTimeLockAddressConfig))
+	case newVaultAddressSubCmd:
+		err = newVaultAddress(config.(*newVaultAddressConfig))
+	case showLockedOutputsSubCmd:
+		err = showLockedOutputs(config.(*showLockedOutputsConfig))
+	case spendLockedOutputsSubCmd:
+		err = spendLockedOutputs(config.(*spendLockedOutputsConfig))
+	case htlcInitiateSubCmd:
+		err = htlcInitiate(config.(*htlcInitiateConfig))
+	case htlcRedeemSubCmd:
+		err = htlcRedeem(config.(*htlcRedeemConfig))
+	case htlcRefundSubCmd:
+		err = htlcRefund(config.(*htlcRefundConfig))
+	case htlcAuditSubCmd:
+		err = htlcAudit(config.(*htlcAuditConfig))
+	case listUTXOsSubCmd:
+		err = listUTXOs(config.(*listUTXOsConfig))
+	case lockUTXOsSubCmd:
+		err = lockUTXOs(config.(*lockUTXOsConfig))
+	case unlockUTXOsSubCmd:
+		err = unlockUTXOs(config.(*unlockUTXOsConfig))
+	case pstxCombineSubCmd:
+		err = pstxCombine(config.(*pstxCombineConfig))
+	case pstxFinalizeSubCmd:
+		err = pstxFinalize(config.(*pstxFinalizeConfig))
+	case pstxInspectSubCmd:
+		err = pstxInspect(config.(*pstxInspectConfig))
 	case dumpUnencryptedDataSubCmd:
 		err = dumpUnencryptedData(config.(*dumpUnencryptedDataConfig))
 	case startDaemonSubCmd:
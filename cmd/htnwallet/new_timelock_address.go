@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/client"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
+	"github.com/pkg/errors"
+)
+
+func newTimeLockAddress(conf *newTimeLockAddressConfig) error {
+	lockTime, err := parseLockTime(conf.LockDAAScore, conf.LockTime)
+	if err != nil {
+		return err
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.NewTimeLockAddress(ctx, &pb.NewTimeLockAddressRequest{LockTime: lockTime})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New time lock address:\n%s\n", response.Address)
+	fmt.Printf("Redeem script:\n%s\n", response.RedeemScript)
+	fmt.Println("The redeem script was saved in the keys file. Funds sent to the address can't be spent without it, " +
+		"so make sure to back up the keys file again.")
+	return nil
+}
+
+// parseLockTime returns the lock time given by either '--lock-daa-score' or '--lock-time'
+func parseLockTime(lockDAAScore uint64, lockTime string) (uint64, error) {
+	if lockDAAScore >= constants.LockTimeThreshold {
+		return 0, errors.Errorf("'--lock-daa-score' must be lower than %d", uint64(constants.LockTimeThreshold))
+	}
+	if lockTime == "" {
+		return lockDAAScore, nil
+	}
+
+	lockTimestamp, err := time.Parse(time.RFC3339, lockTime)
+	if err != nil {
+		return 0, errors.Wrapf(err, "'--lock-time' could not be parsed")
+	}
+	if lockTimestamp.UnixMilli() < constants.LockTimeThreshold {
+		return 0, errors.Errorf("'--lock-time' must be later than %s",
+			time.UnixMilli(constants.LockTimeThreshold).UTC().Format(time.RFC3339))
+	}
+	return uint64(lockTimestamp.UnixMilli()), nil
+}
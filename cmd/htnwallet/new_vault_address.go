@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/client"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+)
+
+func newVaultAddress(conf *newVaultAddressConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.NewVaultAddress(ctx, &pb.NewVaultAddressRequest{
+		Delay:                     conf.Delay,
+		RecoveryExtendedPublicKey: conf.RecoveryExtendedPublicKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("New vault address:\n%s\n", response.Address)
+	fmt.Printf("Redeem script:\n%s\n", response.RedeemScript)
+	fmt.Println("The redeem script was saved in the keys file. Funds sent to the address can't be spent without it, " +
+		"so make sure to back up the keys file again.")
+	return nil
+}
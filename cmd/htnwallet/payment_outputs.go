@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/utils"
+	"github.com/pkg/errors"
+)
+
+// paymentOutputJSON is an entry of a JSON outputs file. The amount is in HTN,
+// and may be given either as a number or as a string.
+type paymentOutputJSON struct {
+	Address string      `json:"address"`
+	Amount  json.Number `json:"amount"`
+}
+
+func validatePaymentFlags(toAddress, sendAmount string, isSendAll bool, outputs []string, outputsFile string) error {
+	if len(outputs) > 0 || outputsFile != "" {
+		if toAddress != "" || sendAmount != "" || isSendAll {
+			return errors.New("'--output' and '--outputs-file' cannot be combined with '--to-address', " +
+				"'--send-amount' or '--send-all'")
+		}
+		return nil
+	}
+
+	if toAddress == "" {
+		return errors.New("either '--to-address' or '--output'/'--outputs-file' must be specified")
+	}
+	if (!isSendAll && sendAmount == "") ||
+		(isSendAll && sendAmount != "") {
+
+		return errors.New("exactly one of '--send-amount' or '--send-all' must be specified")
+	}
+	return nil
+}
+
+// parsePaymentOutputs collects the outputs given with --output, as <address>=<amount>,
+// followed by the ones in the outputs file, if any.
+func parsePaymentOutputs(outputs []string, outputsFile string) ([]*pb.PaymentOutput, error) {
+	paymentOutputs := make([]*pb.PaymentOutput, 0, len(outputs))
+	for _, output := range outputs {
+		separatorIndex := strings.LastIndex(output, "=")
+		if separatorIndex == -1 {
+			return nil, errors.Errorf("invalid output '%s': expected <address>=<amount>", output)
+		}
+		paymentOutput, err := newPaymentOutput(output[:separatorIndex], output[separatorIndex+1:])
+		if err != nil {
+			return nil, err
+		}
+		paymentOutputs = append(paymentOutputs, paymentOutput)
+	}
+
+	if outputsFile == "" {
+		return paymentOutputs, nil
+	}
+
+	file, err := os.Open(outputsFile)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var fileOutputs []*pb.PaymentOutput
+	if strings.EqualFold(filepath.Ext(outputsFile), ".json") {
+		fileOutputs, err = readPaymentOutputsJSON(file)
+	} else {
+		fileOutputs, err = readPaymentOutputsCSV(file)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading outputs file %s", outputsFile)
+	}
+	if len(fileOutputs) == 0 {
+		return nil, errors.Errorf("outputs file %s has no outputs", outputsFile)
+	}
+
+	return append(paymentOutputs, fileOutputs...), nil
+}
+
+func newPaymentOutput(address, amount string) (*pb.PaymentOutput, error) {
+	address = strings.TrimSpace(address)
+	amount = strings.TrimSpace(amount)
+	if address == "" {
+		return nil, errors.Errorf("missing address for the output of %s HTN", amount)
+	}
+	amountSompi, err := utils.KasToSompi(amount)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid amount '%s' for %s", amount, address)
+	}
+	return &pb.PaymentOutput{Address: address, Amount: amountSompi}, nil
+}
+
+// readPaymentOutputsCSV reads lines of address,amount. A header line starting
+// with "address" and lines starting with # are skipped.
+func readPaymentOutputsCSV(reader io.Reader) ([]*pb.PaymentOutput, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = 2
+	csvReader.TrimLeadingSpace = true
+
+	var paymentOutputs []*pb.PaymentOutput
+	for isFirstRecord := true; ; isFirstRecord = false {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if isFirstRecord && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+
+		paymentOutput, err := newPaymentOutput(record[0], record[1])
+		if err != nil {
+			return nil, err
+		}
+		paymentOutputs = append(paymentOutputs, paymentOutput)
+	}
+	return paymentOutputs, nil
+}
+
+func readPaymentOutputsJSON(reader io.Reader) ([]*pb.PaymentOutput, error) {
+	var entries []*paymentOutputJSON
+	err := json.NewDecoder(reader).Decode(&entries)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentOutputs := make([]*pb.PaymentOutput, len(entries))
+	for i, entry := range entries {
+		paymentOutputs[i], err = newPaymentOutput(entry.Address, entry.Amount.String())
+		if err != nil {
+			return nil, err
+		}
+	}
+	return paymentOutputs, nil
+}
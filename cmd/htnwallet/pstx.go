@@ -0,0 +1,270 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/keys"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet/serialization"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/utils"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+	"github.com/pkg/errors"
+)
+
+func pstxCombine(conf *pstxCombineConfig) error {
+	if len(conf.Transactions) == 0 && len(conf.TransactionFiles) == 0 {
+		return errors.Errorf("At least one --transaction or --transaction-file is required")
+	}
+
+	copiesHex := conf.Transactions
+	for _, transactionFile := range conf.TransactionFiles {
+		transactionHex, err := readTransactionsHex("", transactionFile)
+		if err != nil {
+			return err
+		}
+		copiesHex = append(copiesHex, transactionHex)
+	}
+
+	copies := make([][][]byte, len(copiesHex))
+	for i, copyHex := range copiesHex {
+		var err error
+		copies[i], err = decodeTransactionsFromHex(copyHex)
+		if err != nil {
+			return err
+		}
+		if len(copies[i]) != len(copies[0]) {
+			return errors.Errorf("Copy #%d has %d transactions, but copy #1 has %d", i+1, len(copies[i]), len(copies[0]))
+		}
+	}
+
+	ecdsa, err := pstxKeysFileECDSA(conf.NetParams(), conf.KeysFile, copies...)
+	if err != nil {
+		return err
+	}
+
+	combinedTransactions := make([][]byte, len(copies[0]))
+	for i := range combinedTransactions {
+		transactionCopies := make([][]byte, len(copies))
+		for j, transactions := range copies {
+			transactionCopies[j] = transactions[i]
+		}
+
+		combinedTransactions[i], err = libhtnwallet.CombinePSTX(transactionCopies, ecdsa)
+		if err != nil {
+			return errors.Wrapf(err, "Transaction #%d", i+1)
+		}
+	}
+
+	fmt.Println(encodeTransactionsToHex(combinedTransactions))
+	return nil
+}
+
+func pstxFinalize(conf *pstxFinalizeConfig) error {
+	transactionsHex, err := readTransactionsHex(conf.Transaction, conf.TransactionFile)
+	if err != nil {
+		return err
+	}
+
+	transactions, err := decodeTransactionsFromHex(transactionsHex)
+	if err != nil {
+		return err
+	}
+
+	ecdsa, err := pstxKeysFileECDSA(conf.NetParams(), conf.KeysFile, transactions)
+	if err != nil {
+		return err
+	}
+
+	finalizedTransactions := make([][]byte, len(transactions))
+	for i, transaction := range transactions {
+		finalizedTransactions[i], err = libhtnwallet.FinalizePSTX(transaction, ecdsa)
+		if err != nil {
+			return errors.Wrapf(err, "Transaction #%d", i+1)
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "The transaction is finalized and ready to broadcast")
+	fmt.Println(encodeTransactionsToHex(finalizedTransactions))
+	return nil
+}
+
+func pstxInspect(conf *pstxInspectConfig) error {
+	transactionsHex, err := readTransactionsHex(conf.Transaction, conf.TransactionFile)
+	if err != nil {
+		return err
+	}
+
+	transactions, err := decodeTransactionsFromHex(transactionsHex)
+	if err != nil {
+		return err
+	}
+
+	ecdsa, err := pstxKeysFileECDSA(conf.NetParams(), conf.KeysFile, transactions)
+	if err != nil {
+		return err
+	}
+
+	for i, transaction := range transactions {
+		pstx, err := libhtnwallet.ToPSTX(transaction, ecdsa)
+		if err != nil {
+			return errors.Wrapf(err, "Transaction #%d", i+1)
+		}
+
+		format := "wallet's own format"
+		if serialization.IsPSTX(transaction) {
+			format = fmt.Sprintf("PSTX version %d", serialization.PSTXVersion)
+		}
+		err = printPSTX(conf.NetParams(), i+1, format, pstx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printPSTX(params *dagconfig.Params, number int, format string, pstx *serialization.PSTX) error {
+	tx := pstx.Global.UnsignedTx
+	keyType := "Schnorr"
+	if pstx.Global.ECDSA {
+		keyType = "ECDSA"
+	}
+
+	fmt.Printf("Transaction #%d ID: \t%s\n", number, consensushashing.TransactionID(tx))
+	fmt.Printf("Format:\t\t\t%s\n", format)
+	fmt.Printf("Key type:\t\t%s\n", keyType)
+	fmt.Printf("Version:\t\t%d\n", tx.Version)
+	fmt.Printf("Lock time:\t\t%d\n", tx.LockTime)
+	printProprietary("", pstx.Global.Proprietary)
+	fmt.Println()
+
+	allInputSompi := uint64(0)
+	missingSignatures := 0
+	for index, input := range pstx.Inputs {
+		outpoint := tx.Inputs[index].PreviousOutpoint
+		fmt.Printf("Input %d: \tOutpoint: %s:%d \tAmount: %s HTN\n", index, outpoint.TransactionID, outpoint.Index,
+			utils.FormatHTN(input.PrevOutput.Value))
+		allInputSompi += input.PrevOutput.Value
+
+		signatureCount := 0
+		for _, key := range input.Keys {
+			if key.Signature != nil {
+				signatureCount++
+			}
+		}
+		status := fmt.Sprintf("%d of %d required signatures", signatureCount, input.MinimumSignatures)
+		if input.FinalSignatureScript != nil {
+			status = "finalized"
+		} else if !libhtnwallet.IsPSTXInputFullySigned(input) {
+			missingSignatures += int(input.MinimumSignatures) - signatureCount
+		}
+		fmt.Printf("\tSighash type: %d \tStatus: %s\n", input.SigHashType, status)
+
+		printPSTXKeys(input.Keys, true)
+		if input.RedeemScript != nil {
+			fmt.Printf("\tRedeem script: %x\n", input.RedeemScript)
+		}
+		printProprietary("\t", input.Proprietary)
+	}
+	fmt.Println()
+
+	allOutputSompi := uint64(0)
+	for index, output := range tx.Outputs {
+		scriptPublicKeyType, address, err := txscript.ExtractScriptPubKeyAddress(output.ScriptPublicKey, params)
+		if err != nil {
+			return err
+		}
+
+		recipient := fmt.Sprintf("<%s transaction script public key: %s>", scriptPublicKeyType,
+			hex.EncodeToString(output.ScriptPublicKey.Script))
+		if address != nil {
+			recipient = address.EncodeAddress()
+		}
+		fmt.Printf("Output %d: \tRecipient: %s \tAmount: %s HTN\n", index, recipient, utils.FormatHTN(output.Value))
+		allOutputSompi += output.Value
+
+		pstxOutput := pstx.Outputs[index]
+		printPSTXKeys(pstxOutput.Keys, false)
+		if pstxOutput.RedeemScript != nil {
+			fmt.Printf("\tRedeem script: %x\n", pstxOutput.RedeemScript)
+		}
+		printProprietary("\t", pstxOutput.Proprietary)
+	}
+	fmt.Println()
+
+	fmt.Printf("Fee:\t%d Sompi\n", allInputSompi-allOutputSompi)
+	if missingSignatures > 0 {
+		fmt.Printf("Missing signatures:\t%d\n\n", missingSignatures)
+	} else {
+		fmt.Printf("The transaction is fully signed\n\n")
+	}
+	return nil
+}
+
+func printPSTXKeys(keys []*serialization.PSTXKey, showSignatures bool) {
+	for _, key := range keys {
+		signed := ""
+		if showSignatures {
+			signed = "\tnot signed"
+			if key.Signature != nil {
+				signed = "\tsigned"
+			}
+		}
+		fmt.Printf("\tKey: %x \tPath: %s%s\n", key.PublicKey, key.DerivationPath, signed)
+	}
+}
+
+func printProprietary(indent string, proprietary map[string][]byte) {
+	proprietaryKeys := make([]string, 0, len(proprietary))
+	for key := range proprietary {
+		proprietaryKeys = append(proprietaryKeys, key)
+	}
+	sort.Strings(proprietaryKeys)
+
+	for _, key := range proprietaryKeys {
+		fmt.Printf("%sProprietary %s: %x\n", indent, key, proprietary[key])
+	}
+}
+
+// pstxKeysFileECDSA returns the key type of the wallet if any of the given transactions is in the wallet's own
+// format, which doesn't record it. The keys file isn't read if all of the transactions are PSTXs.
+func pstxKeysFileECDSA(params *dagconfig.Params, keysFilePath string, transactionLists ...[][]byte) (bool, error) {
+	for _, transactions := range transactionLists {
+		for _, transaction := range transactions {
+			if serialization.IsPSTX(transaction) {
+				continue
+			}
+
+			keysFile, err := keys.ReadKeysFile(params, keysFilePath)
+			if err != nil {
+				return false, err
+			}
+			return keysFile.ECDSA, nil
+		}
+	}
+	return false, nil
+}
+
+func readTransactionsHex(transaction string, transactionFile string) (string, error) {
+	if transaction == "" && transactionFile == "" {
+		return "", errors.Errorf("Either --transaction or --transaction-file is required")
+	}
+	if transaction != "" && transactionFile != "" {
+		return "", errors.Errorf("Both --transaction and --transaction-file cannot be passed at the same time")
+	}
+
+	if transactionFile == "" {
+		return transaction, nil
+	}
+
+	transactionHexBytes, err := os.ReadFile(transactionFile)
+	if err != nil {
+		return "", errors.Wrapf(err, "Could not read hex from %s", transactionFile)
+	}
+	return strings.TrimSpace(string(transactionHexBytes)), nil
+}
@@ -24,6 +24,10 @@ func send(conf *sendConfig) error {
 		return err
 	}
 
+	if keysFile.IsWatchOnly() {
+		return keys.ErrWatchOnly
+	}
+
 	if len(keysFile.ExtendedPublicKeys) > len(keysFile.EncryptedMnemonics) {
 		return errors.Errorf("Cannot use 'send' command for multisig wallet without all of the keys")
 	}
@@ -38,23 +42,39 @@ func send(conf *sendConfig) error {
 	defer cancel()
 
 	var sendAmountSompi uint64
-	if !conf.IsSendAll {
+	if !conf.IsSendAll && conf.SendAmount != "" {
 		sendAmountSompi, err = utils.KasToSompi(conf.SendAmount)
 		if err != nil {
 			return err
 		}
 	}
+
+	outputs, err := parsePaymentOutputs(conf.Outputs, conf.OutputsFile)
+	if err != nil {
+		return err
+	}
+
+	outpoints, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
 retry:
 	for attempt := 0; attempt <= maxRetries; attempt++ {
 		createUnsignedTransactionsResponse, err :=
 			daemonClient.CreateUnsignedTransactions(ctx, &pb.CreateUnsignedTransactionsRequest{
 				From:                     conf.FromAddresses,
+				Outpoints:                outpoints,
 				Address:                  conf.ToAddress,
 				Amount:                   sendAmountSompi,
+				Outputs:                  outputs,
 				IsSendAll:                conf.IsSendAll,
 				UseExistingChangeAddress: conf.UseExistingChangeAddress,
 			})
 		if err != nil {
+			// Explicitly given outputs never change, so there's no point in waiting for them
+			if len(outpoints) > 0 {
+				return err
+			}
 			if strings.Contains(err.Error(), "Insufficient funds for send") {
 				fmt.Printf("Waiting for spendable UTXO.\n")
 				attempt = attempt - 1
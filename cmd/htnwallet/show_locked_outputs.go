@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/client"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/utils"
+)
+
+func showLockedOutputs(conf *showLockedOutputsConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.GetLockedOutputs(ctx, &pb.GetLockedOutputsRequest{})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Outputs) == 0 {
+		fmt.Println("The time lock and vault addresses of the wallet have no outputs")
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "Address\tType\tOutpoint\tAmount\tUnlocks at\tSpendable")
+	for _, output := range response.Outputs {
+		unlocksAt := fmt.Sprintf("DAA score %d", output.UnlockDaaScore)
+		if output.UnlockTimestamp != 0 {
+			unlocksAt = time.UnixMilli(output.UnlockTimestamp).UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s:%d\t%s\t%s\t%t\n", output.Address, output.Type, output.Outpoint.TransactionId,
+			output.Outpoint.Index, utils.FormatHTN(output.Amount), unlocksAt, output.IsSpendable)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/client"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/keys"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+)
+
+func spendLockedOutputs(conf *spendLockedOutputsConfig) error {
+	keysFile, err := readSpendingKeysFile(conf.NetParams(), conf.KeysFile)
+	if err != nil {
+		return err
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.CreateUnsignedLockedOutputsTransaction(ctx,
+		&pb.CreateUnsignedLockedOutputsTransactionRequest{
+			Address:    conf.Address,
+			ToAddress:  conf.ToAddress,
+			IsRecovery: conf.IsRecovery,
+		})
+	if err != nil {
+		return err
+	}
+
+	txID, err := signAndBroadcast(conf.NetParams(), keysFile, conf.Password, daemonClient, response.UnsignedTransaction)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Broadcasted Transaction ID: ")
+	fmt.Printf("\t%s\n", txID)
+	return nil
+}
+
+// signAndBroadcast signs the given unsigned transaction with the keys of the given keys file, asking
+// for its password if it's empty, and broadcasts it. Returns the ID of the broadcasted transaction.
+func signAndBroadcast(params *dagconfig.Params, keysFile *keys.File, password string,
+	daemonClient pb.HtnwalletdClient, unsignedTransaction []byte) (string, error) {
+
+	if len(password) == 0 {
+		password = keys.GetPassword("Password:")
+	}
+	mnemonics, err := keysFile.DecryptMnemonics(password)
+	if err != nil {
+		if strings.Contains(err.Error(), "message authentication failed") {
+			fmt.Fprintf(os.Stderr, "Password decryption failed. Sometimes this is a result of not "+
+				"specifying the keys file of the wallet whose keys may spend the outputs.\n")
+		}
+		return "", err
+	}
+
+	signedTransaction, err := libhtnwallet.Sign(params, mnemonics, unsignedTransaction, keysFile.ECDSA)
+	if err != nil {
+		return "", err
+	}
+
+	// Since we waited for user input when getting the password, which could take unbound amount of time -
+	// create a new context for broadcast, to reset the timeout.
+	broadcastCtx, broadcastCancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer broadcastCancel()
+
+	broadcastResponse, err := daemonClient.Broadcast(broadcastCtx,
+		&pb.BroadcastRequest{Transactions: [][]byte{signedTransaction}})
+	if err != nil {
+		return "", err
+	}
+	return broadcastResponse.TxIDs[0], nil
+}
@@ -20,6 +20,12 @@ func FomatHSAT(amount uint64) string {
 	return res
 }
 
+// FormatHTN takes the amount of sompis as uint64, and returns the exact amount of HTN
+// with 8 decimal places and no padding, as used in exports
+func FormatHTN(amount uint64) string {
+	return fmt.Sprintf("%d.%08d", amount/constants.SompiPerHoosat, amount%constants.SompiPerHoosat)
+}
+
 // KasToSompi takes in a string representation of the Kas value to convert to Sompi
 func KasToSompi(amount string) (uint64, error) {
 	err := validateHSATAmountFormat(amount)
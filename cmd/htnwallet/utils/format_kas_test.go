@@ -88,3 +88,23 @@ func TestValidateAmountFormat(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatHTN(t *testing.T) {
+	testCases := []struct {
+		amount   uint64
+		expected string
+	}{
+		{amount: 0, expected: "0.00000000"},
+		{amount: 1, expected: "0.00000001"},
+		{amount: 100000000, expected: "1.00000000"},
+		{amount: 3318414897320, expected: "33184.14897320"},
+		{amount: 18446744073709551615, expected: "184467440737.09551615"},
+	}
+
+	for _, testCase := range testCases {
+		formatted := FormatHTN(testCase.amount)
+		if formatted != testCase.expected {
+			t.Errorf("Expected %d to be formatted as %s. Got: %s", testCase.amount, testCase.expected, formatted)
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/client"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/daemon/pb"
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/utils"
+	"github.com/pkg/errors"
+)
+
+func listUTXOs(conf *listUTXOsConfig) error {
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	response, err := daemonClient.ListUTXOs(ctx, &pb.ListUTXOsRequest{From: conf.FromAddresses})
+	if err != nil {
+		return err
+	}
+
+	if len(response.Utxos) == 0 {
+		fmt.Println("The wallet has no unspent outputs")
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "Outpoint\tAddress\tAmount\tAge\tFlags")
+	for _, utxo := range response.Utxos {
+		var flags []string
+		if utxo.IsLocked {
+			flags = append(flags, "locked")
+		}
+		if utxo.IsPending {
+			flags = append(flags, "pending")
+		}
+		if !utxo.IsSpendable {
+			flags = append(flags, "immature")
+		}
+		if utxo.IsCoinbase {
+			flags = append(flags, "coinbase")
+		}
+		fmt.Fprintf(writer, "%s:%d\t%s\t%s\t%d\t%s\n", utxo.Outpoint.TransactionId, utxo.Outpoint.Index, utxo.Address,
+			utils.FormatHTN(utxo.Amount), utxo.Age, strings.Join(flags, ","))
+	}
+	return writer.Flush()
+}
+
+func lockUTXOs(conf *lockUTXOsConfig) error {
+	outpoints, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.LockUTXOs(ctx, &pb.LockUTXOsRequest{Outpoints: outpoints})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Locked %d output(s)\n", len(outpoints))
+	return nil
+}
+
+func unlockUTXOs(conf *unlockUTXOsConfig) error {
+	outpoints, err := parseOutpoints(conf.UTXOs)
+	if err != nil {
+		return err
+	}
+
+	daemonClient, tearDown, err := client.Connect(conf.DaemonAddress)
+	if err != nil {
+		return err
+	}
+	defer tearDown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), daemonTimeout)
+	defer cancel()
+
+	_, err = daemonClient.UnlockUTXOs(ctx, &pb.UnlockUTXOsRequest{Outpoints: outpoints})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Unlocked %d output(s)\n", len(outpoints))
+	return nil
+}
+
+// parseOutpoints parses outpoints given as <transaction ID>:<index>. The transaction IDs are validated by the daemon.
+func parseOutpoints(outpointStrings []string) ([]*pb.Outpoint, error) {
+	outpoints := make([]*pb.Outpoint, len(outpointStrings))
+	for i, outpointString := range outpointStrings {
+		separatorIndex := strings.LastIndex(outpointString, ":")
+		if separatorIndex == -1 {
+			return nil, errors.Errorf("Outpoint %s is not in the form <transaction ID>:<index>", outpointString)
+		}
+
+		index, err := strconv.ParseUint(outpointString[separatorIndex+1:], 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid index in outpoint %s", outpointString)
+		}
+
+		outpoints[i] = &pb.Outpoint{
+			TransactionId: outpointString[:separatorIndex],
+			Index:         uint32(index),
+		}
+	}
+	return outpoints, nil
+}
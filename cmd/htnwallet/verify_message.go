@@ -0,0 +1,33 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/Hoosat-Oy/HTND/cmd/htnwallet/libhtnwallet"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/pkg/errors"
+)
+
+func verifyMessage(conf *verifyMessageConfig) error {
+	address, err := util.DecodeAddress(conf.Address, conf.NetParams().Prefix)
+	if err != nil {
+		return err
+	}
+
+	signature, err := hex.DecodeString(conf.Signature)
+	if err != nil {
+		return errors.Wrap(err, "The signature must be encoded in hex")
+	}
+
+	isValid, err := libhtnwallet.VerifyMessage(address, []byte(conf.Message), signature)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		return errors.Errorf("The signature is not valid for address %s", conf.Address)
+	}
+
+	fmt.Println("The signature is valid")
+	return nil
+}
@@ -24,6 +24,7 @@ type consensus struct {
 	genesisHash  *externalapi.DomainHash
 
 	expectedDAAWindowDurationInMilliseconds int64
+	maxMergeDepth                           uint64
 
 	blockProcessor        model.BlockProcessor
 	blockBuilder          model.BlockBuilder
@@ -358,7 +359,7 @@ func (s *consensus) ValidateTransactionAndPopulateWithConsensusData(transaction
 		return err
 	}
 	return s.transactionValidator.ValidateTransactionInContextAndPopulateFee(
-		stagingArea, transaction, model.VirtualBlockHash, daaScore)
+		stagingArea, transaction, model.VirtualBlockHash, daaScore, false)
 }
 
 func (s *consensus) GetBlock(blockHash *externalapi.DomainHash) (*externalapi.DomainBlock, bool, error) {
@@ -653,6 +654,32 @@ func (s *consensus) GetVirtualUTXOs(expectedVirtualParents []*externalapi.Domain
 	return virtualUTXOs, nil
 }
 
+// GetVirtualUTXOEntries returns the virtual UTXO entries of the given outpoints, in the same order.
+// The entry of an outpoint that isn't in the virtual UTXO set is nil
+func (s *consensus) GetVirtualUTXOEntries(outpoints []*externalapi.DomainOutpoint) ([]externalapi.UTXOEntry, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	utxoEntries := make([]externalapi.UTXOEntry, len(outpoints))
+	for i, outpoint := range outpoints {
+		hasUTXOEntry, err := s.consensusStateStore.HasUTXOByOutpoint(s.databaseContext, stagingArea, outpoint)
+		if err != nil {
+			return nil, err
+		}
+		if !hasUTXOEntry {
+			continue
+		}
+		utxoEntry, _, err := s.consensusStateStore.UTXOByOutpoint(s.databaseContext, stagingArea, outpoint)
+		if err != nil {
+			return nil, err
+		}
+		utxoEntries[i] = utxoEntry
+	}
+	return utxoEntries, nil
+}
+
 func (s *consensus) PruningPoint() (*externalapi.DomainHash, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
@@ -1155,6 +1182,49 @@ func (s *consensus) IsChainBlock(blockHash *externalapi.DomainHash) (bool, error
 	return s.dagTopologyManagers[0].IsInSelectedParentChainOf(stagingArea, blockHash, virtualGHOSTDAGData.SelectedParent())
 }
 
+// GetCoinbaseSplit returns how the coinbase transaction of the given chain block divides the rewards of its merge set
+func (s *consensus) GetCoinbaseSplit(blockHash *externalapi.DomainHash) (*externalapi.CoinbaseSplit, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	err := s.validateBlockHashExists(stagingArea, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	virtualGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, model.VirtualBlockHash, false)
+	if err != nil {
+		return nil, err
+	}
+	isChainBlock, err := s.dagTopologyManagers[0].IsInSelectedParentChainOf(stagingArea, blockHash,
+		virtualGHOSTDAGData.SelectedParent())
+	if err != nil {
+		return nil, err
+	}
+	if !isChainBlock {
+		return nil, errors.Errorf("block %s is not in the selected parent chain", blockHash)
+	}
+
+	return s.coinbaseManager.CoinbaseSplit(stagingArea, blockHash)
+}
+
+// GetEmissionInfo returns the emission schedule as seen from the virtual's DAA score, along with the
+// block subsidies at the given DAA scores
+func (s *consensus) GetEmissionInfo(daaScores []uint64) (*externalapi.EmissionInfo, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	virtualDAAScore, err := s.daaBlocksStore.DAAScore(s.databaseContext, stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	return s.coinbaseManager.EmissionInfo(virtualDAAScore, daaScores), nil
+}
+
 func (s *consensus) VirtualMergeDepthRoot() (*externalapi.DomainHash, error) {
 	s.lock.Lock()
 	defer s.lock.Unlock()
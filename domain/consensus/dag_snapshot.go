@@ -0,0 +1,277 @@
+package consensus
+
+import (
+	"math"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/hashset"
+	"github.com/Hoosat-Oy/HTND/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// maxDAGSnapshotChainBlocks is the maximum number of selected chain blocks that a single GetDAGSnapshot call
+// visits, so that a single page doesn't hold the consensus lock for too long
+const maxDAGSnapshotChainBlocks = 10_000
+
+// GetDAGSnapshot returns the blocks whose blue score, or DAA score if useDAAScore is set, is between lowScore
+// and highScore inclusive. The DAG is traversed through the merge sets of the selected chain blocks, from the
+// virtual down to the pruning point, so every block is classified relative to the chain block that merged it.
+// A page starts at startChainBlockHash, or if it's nil, at the highest chain block that may merge blocks within
+// the window. A page ends once it contains maxBlocks blocks or more, or once it visited maxDAGSnapshotChainBlocks
+// chain blocks, after which the returned snapshot points at the chain block the next page starts from
+func (s *consensus) GetDAGSnapshot(lowScore uint64, highScore uint64, useDAAScore bool,
+	startChainBlockHash *externalapi.DomainHash, maxBlocks int) (*externalapi.DAGSnapshot, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if lowScore > highScore {
+		return nil, errors.Errorf("low score %d is greater than high score %d", lowScore, highScore)
+	}
+
+	stagingArea := model.NewStagingArea()
+	snapshotBuilder := &dagSnapshotBuilder{
+		consensus:   s,
+		stagingArea: stagingArea,
+		lowScore:    lowScore,
+		highScore:   highScore,
+		useDAAScore: useDAAScore,
+		snapshot:    &externalapi.DAGSnapshot{},
+	}
+
+	virtualGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, model.VirtualBlockHash, false)
+	if err != nil {
+		return nil, err
+	}
+	virtualSelectedParent := virtualGHOSTDAGData.SelectedParent()
+
+	chainBlockHash := startChainBlockHash
+	if chainBlockHash != nil {
+		err := s.validateBlockHashExists(stagingArea, chainBlockHash)
+		if err != nil {
+			return nil, err
+		}
+		isChainBlock, err := s.dagTopologyManagers[0].IsInSelectedParentChainOf(stagingArea, chainBlockHash,
+			virtualSelectedParent)
+		if err != nil {
+			return nil, err
+		}
+		if !isChainBlock {
+			return nil, errors.Errorf("block %s is not in the selected chain", chainBlockHash)
+		}
+	} else {
+		chainBlockHash, err = s.highestChainBlockMergingScore(stagingArea, snapshotBuilder, virtualSelectedParent)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if chainBlockHash == nil {
+		err := snapshotBuilder.addMergeSet(nil, virtualGHOSTDAGData)
+		if err != nil {
+			return nil, err
+		}
+
+		// The virtual doesn't merge the tips that exceed its parent limit
+		tips, err := s.consensusStateStore.Tips(stagingArea, s.databaseContext)
+		if err != nil {
+			return nil, err
+		}
+		virtualSelectedParentAnticone, err := s.dagTraversalManager.AnticoneFromBlocks(stagingArea, tips,
+			virtualSelectedParent, 0)
+		if err != nil {
+			return nil, err
+		}
+		virtualMergeSet := hashset.NewFromSlice(virtualGHOSTDAGData.MergeSetBlues()...)
+		for _, redHash := range virtualGHOSTDAGData.MergeSetReds() {
+			virtualMergeSet.Add(redHash)
+		}
+		for _, blockHash := range virtualSelectedParentAnticone {
+			if virtualMergeSet.Contains(blockHash) {
+				continue
+			}
+			err := snapshotBuilder.addBlock(blockHash, nil, false, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+		chainBlockHash = virtualSelectedParent
+	}
+
+	pruningPoint, err := s.pruningStore.PruningPoint(s.databaseContext, stagingArea)
+	if err != nil {
+		return nil, err
+	}
+	for visitedChainBlocks := 0; len(snapshotBuilder.snapshot.Blocks) < maxBlocks; visitedChainBlocks++ {
+		if visitedChainBlocks == maxDAGSnapshotChainBlocks {
+			break
+		}
+		// The merge set of the pruning point is not guaranteed to be available
+		if chainBlockHash.Equal(pruningPoint) {
+			return snapshotBuilder.snapshot, nil
+		}
+		chainBlockScore, err := snapshotBuilder.score(chainBlockHash)
+		if err != nil {
+			return nil, err
+		}
+		// Every block in the merge set of a chain block has a lower score than the chain block itself
+		if chainBlockScore < lowScore {
+			return snapshotBuilder.snapshot, nil
+		}
+
+		chainBlockGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, chainBlockHash, false)
+		if err != nil {
+			return nil, err
+		}
+		err = snapshotBuilder.addMergeSet(chainBlockHash, chainBlockGHOSTDAGData)
+		if err != nil {
+			return nil, err
+		}
+		chainBlockHash = chainBlockGHOSTDAGData.SelectedParent()
+	}
+
+	snapshotBuilder.snapshot.NextChainBlockHash = chainBlockHash
+	return snapshotBuilder.snapshot, nil
+}
+
+// highestChainBlockMergingScore returns the highest selected chain block that may merge blocks whose score is at
+// most the high score of the snapshot. Blocks are only merged by chain blocks that are at most the merge depth
+// above them, so it's the lowest chain block whose score is above the high score by more than the merge depth.
+// The selected chain is searched through the headers selected chain, which shares its blocks below the virtual
+// selected parent. Returns nil if the traversal should start at the virtual
+func (s *consensus) highestChainBlockMergingScore(stagingArea *model.StagingArea, snapshotBuilder *dagSnapshotBuilder,
+	virtualSelectedParent *externalapi.DomainHash) (*externalapi.DomainHash, error) {
+
+	if snapshotBuilder.highScore > math.MaxUint64-s.maxMergeDepth {
+		return nil, nil
+	}
+	thresholdScore := snapshotBuilder.highScore + s.maxMergeDepth
+
+	highIndex, err := s.headersSelectedChainStore.GetIndexByHash(s.databaseContext, stagingArea, virtualSelectedParent)
+	if database.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	pruningPoint, err := s.pruningStore.PruningPoint(s.databaseContext, stagingArea)
+	if err != nil {
+		return nil, err
+	}
+	lowIndex, err := s.headersSelectedChainStore.GetIndexByHash(s.databaseContext, stagingArea, pruningPoint)
+	if database.IsNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Scores grow along the selected chain, so the lowest chain block above the threshold score is found
+	// by a binary search
+	for lowIndex < highIndex {
+		middleIndex := lowIndex + (highIndex-lowIndex)/2
+		middleHash, err := s.headersSelectedChainStore.GetHashByIndex(s.databaseContext, stagingArea, middleIndex)
+		if err != nil {
+			return nil, err
+		}
+		middleScore, err := snapshotBuilder.score(middleHash)
+		if err != nil {
+			return nil, err
+		}
+		if middleScore > thresholdScore {
+			highIndex = middleIndex
+		} else {
+			lowIndex = middleIndex + 1
+		}
+	}
+	chainBlockHash, err := s.headersSelectedChainStore.GetHashByIndex(s.databaseContext, stagingArea, highIndex)
+	if err != nil {
+		return nil, err
+	}
+	if chainBlockHash.Equal(virtualSelectedParent) {
+		return nil, nil
+	}
+	return chainBlockHash, nil
+}
+
+type dagSnapshotBuilder struct {
+	consensus   *consensus
+	stagingArea *model.StagingArea
+	lowScore    uint64
+	highScore   uint64
+	useDAAScore bool
+	snapshot    *externalapi.DAGSnapshot
+}
+
+// addMergeSet adds the merge set of the given chain block, or of the virtual if it's nil, to the snapshot.
+// The selected parent of the merging block is the only block in the merge set that is a chain block
+func (b *dagSnapshotBuilder) addMergeSet(mergingChainBlockHash *externalapi.DomainHash,
+	ghostdagData *externalapi.BlockGHOSTDAGData) error {
+
+	for _, blueHash := range ghostdagData.MergeSetBlues() {
+		err := b.addBlock(blueHash, mergingChainBlockHash, true, blueHash.Equal(ghostdagData.SelectedParent()))
+		if err != nil {
+			return err
+		}
+	}
+	for _, redHash := range ghostdagData.MergeSetReds() {
+		err := b.addBlock(redHash, mergingChainBlockHash, false, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addBlock adds the given block to the snapshot if its score is within the snapshot's window
+func (b *dagSnapshotBuilder) addBlock(blockHash *externalapi.DomainHash, mergingChainBlockHash *externalapi.DomainHash,
+	isBlue bool, isChainBlock bool) error {
+
+	s := b.consensus
+	header, err := s.blockHeaderStore.BlockHeader(s.databaseContext, b.stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	ghostdagData, err := s.ghostdagDataStores[0].Get(s.databaseContext, b.stagingArea, blockHash, false)
+	if err != nil {
+		return err
+	}
+	score := ghostdagData.BlueScore()
+	if b.useDAAScore {
+		score = header.DAAScore()
+	}
+	if score < b.lowScore || score > b.highScore {
+		return nil
+	}
+
+	blockStatus, err := s.blockStatusStore.Get(s.databaseContext, b.stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	b.snapshot.Blocks = append(b.snapshot.Blocks, &externalapi.DAGSnapshotBlock{
+		Hash:                  blockHash,
+		ParentHashes:          header.DirectParents(),
+		SelectedParent:        ghostdagData.SelectedParent(),
+		MergeSetBlues:         ghostdagData.MergeSetBlues(),
+		MergeSetReds:          ghostdagData.MergeSetReds(),
+		BlueScore:             ghostdagData.BlueScore(),
+		DAAScore:              header.DAAScore(),
+		BlockStatus:           blockStatus,
+		IsChainBlock:          isChainBlock,
+		MergingChainBlockHash: mergingChainBlockHash,
+		IsBlue:                isBlue,
+	})
+	return nil
+}
+
+func (b *dagSnapshotBuilder) score(blockHash *externalapi.DomainHash) (uint64, error) {
+	s := b.consensus
+	if b.useDAAScore {
+		return s.daaBlocksStore.DAAScore(s.databaseContext, b.stagingArea, blockHash)
+	}
+	ghostdagData, err := s.ghostdagDataStores[0].Get(s.databaseContext, b.stagingArea, blockHash, false)
+	if err != nil {
+		return 0, err
+	}
+	return ghostdagData.BlueScore(), nil
+}
@@ -0,0 +1,214 @@
+package consensus_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+)
+
+func TestGetDAGSnapshot(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestGetDAGSnapshot")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		addBlock := func(parentHashes ...*externalapi.DomainHash) *externalapi.DomainHash {
+			blockHash, _, err := tc.AddBlock(parentHashes, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			return blockHash
+		}
+
+		// Build the following DAG, in which A5 is the virtual selected parent and X is merged only by the virtual:
+		// genesis <- A1 <- A2 <- A3 <- A4 <- A5
+		//            A1 <- B1 <- B2 <------- A5
+		//                  A2 <- X
+		genesisHash := consensusConfig.GenesisHash
+		a1Hash := addBlock(genesisHash)
+		a2Hash := addBlock(a1Hash)
+		a3Hash := addBlock(a2Hash)
+		a4Hash := addBlock(a3Hash)
+		b1Hash := addBlock(a1Hash)
+		b2Hash := addBlock(b1Hash)
+		a5Hash := addBlock(a4Hash, b2Hash)
+		xHash := addBlock(a2Hash)
+
+		expectedMergingChainBlocks := map[externalapi.DomainHash]*externalapi.DomainHash{
+			*genesisHash: a1Hash,
+			*a1Hash:      a2Hash,
+			*a2Hash:      a3Hash,
+			*a3Hash:      a4Hash,
+			*a4Hash:      a5Hash,
+			*b1Hash:      a5Hash,
+			*b2Hash:      a5Hash,
+			*a5Hash:      nil,
+			*xHash:       nil,
+		}
+		chainBlocks := map[externalapi.DomainHash]struct{}{
+			*genesisHash: {}, *a1Hash: {}, *a2Hash: {}, *a3Hash: {}, *a4Hash: {}, *a5Hash: {},
+		}
+
+		collectSnapshot := func(lowScore uint64, highScore uint64, useDAAScore bool,
+			pageSize int) map[externalapi.DomainHash]*externalapi.DAGSnapshotBlock {
+
+			blocks := make(map[externalapi.DomainHash]*externalapi.DAGSnapshotBlock)
+			var startChainBlockHash *externalapi.DomainHash
+			for {
+				snapshot, err := tc.GetDAGSnapshot(lowScore, highScore, useDAAScore, startChainBlockHash, pageSize)
+				if err != nil {
+					t.Fatalf("GetDAGSnapshot: %+v", err)
+				}
+				for _, block := range snapshot.Blocks {
+					if _, ok := blocks[*block.Hash]; ok {
+						t.Fatalf("block %s appears more than once in the snapshot", block.Hash)
+					}
+					blocks[*block.Hash] = block
+				}
+				if snapshot.NextChainBlockHash == nil {
+					return blocks
+				}
+				startChainBlockHash = snapshot.NextChainBlockHash
+			}
+		}
+
+		for _, pageSize := range []int{1000, 2, 1} {
+			blocks := collectSnapshot(0, math.MaxUint64, false, pageSize)
+			if len(blocks) != len(expectedMergingChainBlocks) {
+				t.Fatalf("page size %d: expected %d blocks in the snapshot, got %d",
+					pageSize, len(expectedMergingChainBlocks), len(blocks))
+			}
+			for blockHash, expectedMergingChainBlock := range expectedMergingChainBlocks {
+				block, ok := blocks[blockHash]
+				if !ok {
+					t.Fatalf("page size %d: block %s is missing from the snapshot", pageSize, blockHash)
+				}
+				if expectedMergingChainBlock == nil && block.MergingChainBlockHash != nil ||
+					expectedMergingChainBlock != nil && !expectedMergingChainBlock.Equal(block.MergingChainBlockHash) {
+
+					t.Fatalf("page size %d: expected block %s to be merged by %s, got %s",
+						pageSize, blockHash, expectedMergingChainBlock, block.MergingChainBlockHash)
+				}
+				_, isChainBlock := chainBlocks[blockHash]
+				if block.IsChainBlock != isChainBlock {
+					t.Fatalf("page size %d: expected IsChainBlock of block %s to be %t", pageSize, blockHash, isChainBlock)
+				}
+				if !block.IsBlue {
+					t.Fatalf("page size %d: expected block %s to be blue", pageSize, blockHash)
+				}
+
+				blockInfo, err := tc.GetBlockInfo(&blockHash)
+				if err != nil {
+					t.Fatalf("GetBlockInfo: %+v", err)
+				}
+				if block.BlueScore != blockInfo.BlueScore || !block.SelectedParent.Equal(blockInfo.SelectedParent) ||
+					!externalapi.HashesEqual(block.MergeSetBlues, blockInfo.MergeSetBlues) ||
+					block.BlockStatus != blockInfo.BlockStatus {
+
+					t.Fatalf("page size %d: the snapshot of block %s doesn't match its block info", pageSize, blockHash)
+				}
+			}
+		}
+
+		// Only the blocks within the score window are returned
+		for _, useDAAScore := range []bool{false, true} {
+			const lowScore, highScore = 2, 3
+			blocks := collectSnapshot(lowScore, highScore, useDAAScore, 1)
+			for blockHash := range expectedMergingChainBlocks {
+				header, err := tc.GetBlockHeader(&blockHash)
+				if err != nil {
+					t.Fatalf("GetBlockHeader: %+v", err)
+				}
+				score := header.BlueScore()
+				if useDAAScore {
+					score = header.DAAScore()
+				}
+				_, isInSnapshot := blocks[blockHash]
+				isInWindow := score >= lowScore && score <= highScore
+				if isInSnapshot != isInWindow {
+					t.Fatalf("useDAAScore %t: expected block %s with score %d to be in the snapshot: %t",
+						useDAAScore, blockHash, score, isInWindow)
+				}
+			}
+		}
+
+		_, err = tc.GetDAGSnapshot(0, math.MaxUint64, false, b1Hash, 1000)
+		if err == nil {
+			t.Fatalf("GetDAGSnapshot unexpectedly succeeded for a page cursor that isn't a chain block")
+		}
+		_, err = tc.GetDAGSnapshot(3, 2, false, nil, 1000)
+		if err == nil {
+			t.Fatalf("GetDAGSnapshot unexpectedly succeeded for a low score that is greater than the high score")
+		}
+	})
+}
+
+func TestGetDAGSnapshotStartsBelowVirtual(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.MergeDepth = []uint64{5}
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestGetDAGSnapshotStartsBelowVirtual")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		addBlock := func(parentHashes ...*externalapi.DomainHash) *externalapi.DomainHash {
+			blockHash, _, err := tc.AddBlock(parentHashes, nil, nil)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			return blockHash
+		}
+
+		// Build a selected chain of 20 blocks on top of genesis, in which C8 merges S, a child of C5:
+		// genesis <- C1 <- ... <- C5 <- C6 <- C7 <- C8 <- ... <- C20
+		//                         C5 <- S <------------ C8
+		chainHashes := []*externalapi.DomainHash{consensusConfig.GenesisHash}
+		var sHash *externalapi.DomainHash
+		for i := 1; i <= 20; i++ {
+			parentHashes := []*externalapi.DomainHash{chainHashes[i-1]}
+			if i == 6 {
+				sHash = addBlock(chainHashes[5])
+			}
+			if i == 8 {
+				parentHashes = append(parentHashes, sHash)
+			}
+			chainHashes = append(chainHashes, addBlock(parentHashes...))
+		}
+
+		// The blocks with a blue score of 5 or 6 are C5, C6 and S. The page starts far below the
+		// virtual, but still above C8, which merges S
+		snapshot, err := tc.GetDAGSnapshot(5, 6, false, nil, 1000)
+		if err != nil {
+			t.Fatalf("GetDAGSnapshot: %+v", err)
+		}
+		if snapshot.NextChainBlockHash != nil {
+			t.Fatalf("expected the snapshot to fit in a single page")
+		}
+		expectedBlocks := map[externalapi.DomainHash]*externalapi.DomainHash{
+			*chainHashes[5]: chainHashes[6],
+			*chainHashes[6]: chainHashes[7],
+			*sHash:          chainHashes[8],
+		}
+		if len(snapshot.Blocks) != len(expectedBlocks) {
+			t.Fatalf("expected %d blocks in the snapshot, got %d", len(expectedBlocks), len(snapshot.Blocks))
+		}
+		for _, block := range snapshot.Blocks {
+			expectedMergingChainBlock, ok := expectedBlocks[*block.Hash]
+			if !ok {
+				t.Fatalf("unexpected block %s with blue score %d in the snapshot", block.Hash, block.BlueScore)
+			}
+			if !expectedMergingChainBlock.Equal(block.MergingChainBlockHash) {
+				t.Fatalf("expected block %s to be merged by %s, got %s",
+					block.Hash, expectedMergingChainBlock, block.MergingChainBlockHash)
+			}
+		}
+	})
+}
@@ -14,13 +14,13 @@ func DomainOutpointToDbOutpoint(domainOutpoint *externalapi.DomainOutpoint) *DbO
 
 // DbOutpointToDomainOutpoint converts DbOutpoint to DomainOutpoint
 func DbOutpointToDomainOutpoint(dbOutpoint *DbOutpoint) (*externalapi.DomainOutpoint, error) {
-	domainTransactionID, err := DbTransactionIDToDomainTransactionID(dbOutpoint.TransactionID)
+	domainTransactionID, err := DbTransactionIDToDomainTransactionID(dbOutpoint.GetTransactionID())
 	if err != nil {
 		return nil, err
 	}
 
 	return &externalapi.DomainOutpoint{
 		TransactionID: *domainTransactionID,
-		Index:         dbOutpoint.Index,
+		Index:         dbOutpoint.GetIndex(),
 	}, nil
 }
@@ -7,7 +7,7 @@ import (
 
 // DbTransactionIDToDomainTransactionID converts DbTransactionId to DomainTransactionID
 func DbTransactionIDToDomainTransactionID(dbTransactionID *DbTransactionId) (*externalapi.DomainTransactionID, error) {
-	return transactionid.FromBytes(dbTransactionID.TransactionId)
+	return transactionid.FromBytes(dbTransactionID.GetTransactionId())
 }
 
 // DomainTransactionIDToDbTransactionID converts DomainTransactionID to DbTransactionId
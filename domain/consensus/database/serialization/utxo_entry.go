@@ -15,10 +15,10 @@ func ScriptPublicKeyToDBScriptPublicKey(scriptPublicKey *externalapi.ScriptPubli
 
 // DBScriptPublicKeyToScriptPublicKey convert DbScriptPublicKey ro ScriptPublicKey
 func DBScriptPublicKeyToScriptPublicKey(dbScriptPublicKey *DbScriptPublicKey) (*externalapi.ScriptPublicKey, error) {
-	if dbScriptPublicKey.Version > math.MaxUint16 {
+	if dbScriptPublicKey.GetVersion() > math.MaxUint16 {
 		return nil, errors.Errorf("The version on ScriptPublicKey is bigger then uint16.")
 	}
-	return &externalapi.ScriptPublicKey{Script: dbScriptPublicKey.Script, Version: uint16(dbScriptPublicKey.Version)}, nil
+	return &externalapi.ScriptPublicKey{Script: dbScriptPublicKey.GetScript(), Version: uint16(dbScriptPublicKey.GetVersion())}, nil
 }
 
 // UTXOEntryToDBUTXOEntry converts UTXOEntry to DbUtxoEntry
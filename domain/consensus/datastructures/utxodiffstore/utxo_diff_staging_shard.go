@@ -9,16 +9,19 @@ type utxoDiffStagingShard struct {
 	store              *utxoDiffStore
 	utxoDiffToAdd      map[externalapi.DomainHash]externalapi.UTXODiff
 	utxoDiffChildToAdd map[externalapi.DomainHash]*externalapi.DomainHash
-	toDelete           map[externalapi.DomainHash]struct{}
+	// utxoDiffChildToClear holds the blocks whose utxoDiff child is removed while their utxoDiff is kept
+	utxoDiffChildToClear map[externalapi.DomainHash]struct{}
+	toDelete             map[externalapi.DomainHash]struct{}
 }
 
 func (uds *utxoDiffStore) stagingShard(stagingArea *model.StagingArea) *utxoDiffStagingShard {
 	return stagingArea.GetOrCreateShard(uds.shardID, func() model.StagingShard {
 		return &utxoDiffStagingShard{
-			store:              uds,
-			utxoDiffToAdd:      make(map[externalapi.DomainHash]externalapi.UTXODiff),
-			utxoDiffChildToAdd: make(map[externalapi.DomainHash]*externalapi.DomainHash),
-			toDelete:           make(map[externalapi.DomainHash]struct{}),
+			store:                uds,
+			utxoDiffToAdd:        make(map[externalapi.DomainHash]externalapi.UTXODiff),
+			utxoDiffChildToAdd:   make(map[externalapi.DomainHash]*externalapi.DomainHash),
+			utxoDiffChildToClear: make(map[externalapi.DomainHash]struct{}),
+			toDelete:             make(map[externalapi.DomainHash]struct{}),
 		}
 	}).(*utxoDiffStagingShard)
 }
@@ -36,6 +39,14 @@ func (udss *utxoDiffStagingShard) Commit(dbTx model.DBTransaction) error {
 		udss.store.utxoDiffCache.Add(&hash, utxoDiff)
 	}
 
+	for hash := range udss.utxoDiffChildToClear {
+		err := dbTx.Delete(udss.store.utxoDiffChildHashAsKey(&hash))
+		if err != nil {
+			return err
+		}
+		udss.store.utxoDiffChildCache.Remove(&hash)
+	}
+
 	for hash, utxoDiffChild := range udss.utxoDiffChildToAdd {
 		if utxoDiffChild == nil {
 			continue
@@ -70,5 +81,6 @@ func (udss *utxoDiffStagingShard) Commit(dbTx model.DBTransaction) error {
 }
 
 func (udss *utxoDiffStagingShard) isStaged() bool {
-	return len(udss.utxoDiffToAdd) != 0 || len(udss.utxoDiffChildToAdd) != 0 || len(udss.toDelete) != 0
+	return len(udss.utxoDiffToAdd) != 0 || len(udss.utxoDiffChildToAdd) != 0 || len(udss.utxoDiffChildToClear) != 0 ||
+		len(udss.toDelete) != 0
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/lrucache"
+	"github.com/Hoosat-Oy/HTND/infrastructure/db/database"
 	"github.com/Hoosat-Oy/HTND/util/staging"
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
@@ -43,9 +44,18 @@ func (uds *utxoDiffStore) Stage(stagingArea *model.StagingArea, blockHash *exter
 
 	if utxoDiffChild != nil {
 		stagingShard.utxoDiffChildToAdd[*blockHash] = utxoDiffChild
+		delete(stagingShard.utxoDiffChildToClear, *blockHash)
 	}
 }
 
+// ClearUTXODiffChild stages the removal of the utxoDiff child of the given blockHash, while keeping its utxoDiff
+func (uds *utxoDiffStore) ClearUTXODiffChild(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) {
+	stagingShard := uds.stagingShard(stagingArea)
+
+	delete(stagingShard.utxoDiffChildToAdd, *blockHash)
+	stagingShard.utxoDiffChildToClear[*blockHash] = struct{}{}
+}
+
 func (uds *utxoDiffStore) IsStaged(stagingArea *model.StagingArea) bool {
 	return uds.stagingShard(stagingArea).isStaged()
 }
@@ -90,6 +100,9 @@ func (uds *utxoDiffStore) UTXODiff(dbContext model.DBReader, stagingArea *model.
 func (uds *utxoDiffStore) UTXODiffChild(dbContext model.DBReader, stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) (*externalapi.DomainHash, error) {
 	stagingShard := uds.stagingShard(stagingArea)
 
+	if _, ok := stagingShard.utxoDiffChildToClear[*blockHash]; ok {
+		return nil, errors.Wrapf(database.ErrNotFound, "block %s has no UTXO diff child", blockHash)
+	}
 	utxoDiffChild, ok := stagingShard.utxoDiffChildToAdd[*blockHash]
 	if ok && utxoDiffChild != nil {
 		return utxoDiffChild, nil
@@ -109,13 +122,16 @@ func (uds *utxoDiffStore) UTXODiffChild(dbContext model.DBReader, stagingArea *m
 		return nil, err
 	}
 	uds.utxoDiffChildCache.Add(blockHash, utxoDiffChildDeserialized)
-	return utxoDiffChild, nil
+	return utxoDiffChildDeserialized, nil
 }
 
 // HasUTXODiffChild returns true if the given blockHash has a UTXODiffChild
 func (uds *utxoDiffStore) HasUTXODiffChild(dbContext model.DBReader, stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) (bool, error) {
 	stagingShard := uds.stagingShard(stagingArea)
 
+	if _, ok := stagingShard.utxoDiffChildToClear[*blockHash]; ok {
+		return false, nil
+	}
 	utxoDiff, ok := stagingShard.utxoDiffChildToAdd[*blockHash]
 	if ok && utxoDiff != nil {
 		return true, nil
@@ -137,8 +153,10 @@ func (uds *utxoDiffStore) Delete(stagingArea *model.StagingArea, blockHash *exte
 	if uds.isBlockHashStaged(stagingShard, blockHash) {
 		delete(stagingShard.utxoDiffToAdd, *blockHash)
 		delete(stagingShard.utxoDiffChildToAdd, *blockHash)
+		delete(stagingShard.utxoDiffChildToClear, *blockHash)
 		return
 	}
+	delete(stagingShard.utxoDiffChildToClear, *blockHash)
 	stagingShard.toDelete[*blockHash] = struct{}{}
 }
 
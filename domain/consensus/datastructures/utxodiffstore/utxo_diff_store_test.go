@@ -74,3 +74,62 @@ func TestUTXODiffStoreRoundTripChildAndDelete(t *testing.T) {
 		t.Fatalf("expected not-found after delete, got %v", err)
 	}
 }
+
+func TestUTXODiffStoreClearChild(t *testing.T) {
+	dbManager, prefixBucket, teardown := testutils.NewTestDB(t)
+	defer teardown()
+
+	store := New(prefixBucket, 10, false)
+
+	blockHash := testutils.Hash(1)
+	childHash := testutils.Hash(2)
+	diff := utxo.NewUTXODiff()
+
+	stagingArea := model.NewStagingArea()
+	store.Stage(stagingArea, blockHash, diff, childHash)
+	testutils.Commit(t, dbManager, stagingArea)
+
+	// A store without a cache has to read the child from the database
+	uncachedStore := New(prefixBucket, 10, false)
+	gotChild, err := uncachedStore.UTXODiffChild(dbManager, model.NewStagingArea(), blockHash)
+	if err != nil {
+		t.Fatalf("UTXODiffChild: %v", err)
+	}
+	if !gotChild.Equal(childHash) {
+		t.Fatalf("unexpected child hash %s", gotChild)
+	}
+
+	// Staging a nil child leaves the existing one alone
+	stagingArea = model.NewStagingArea()
+	store.Stage(stagingArea, blockHash, diff, nil)
+	testutils.Commit(t, dbManager, stagingArea)
+	hasChild, err := New(prefixBucket, 10, false).HasUTXODiffChild(dbManager, model.NewStagingArea(), blockHash)
+	if err != nil {
+		t.Fatalf("HasUTXODiffChild: %v", err)
+	}
+	if !hasChild {
+		t.Fatalf("expected staging a nil child to keep the existing one")
+	}
+
+	// ClearUTXODiffChild removes it
+	stagingArea = model.NewStagingArea()
+	store.ClearUTXODiffChild(stagingArea, blockHash)
+	hasChild, err = store.HasUTXODiffChild(dbManager, stagingArea, blockHash)
+	if err != nil {
+		t.Fatalf("HasUTXODiffChild: %v", err)
+	}
+	if hasChild {
+		t.Fatalf("expected HasUTXODiffChild to be false for a staged cleared child")
+	}
+	testutils.Commit(t, dbManager, stagingArea)
+
+	for _, s := range []model.UTXODiffStore{store, New(prefixBucket, 10, false)} {
+		hasChild, err := s.HasUTXODiffChild(dbManager, model.NewStagingArea(), blockHash)
+		if err != nil {
+			t.Fatalf("HasUTXODiffChild: %v", err)
+		}
+		if hasChild {
+			t.Fatalf("expected HasUTXODiffChild to be false after clearing the child")
+		}
+	}
+}
@@ -13,6 +13,8 @@ import (
 	parentssanager "github.com/Hoosat-Oy/HTND/domain/consensus/processes/parentsmanager"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/processes/pruningproofmanager"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/Hoosat-Oy/HTND/util"
 	"github.com/Hoosat-Oy/HTND/util/staging"
 	"github.com/pkg/errors"
 
@@ -230,10 +232,14 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		config.MaxCoinbasePayloadLength,
 		config.MergeSetSizeLimit,
 		config.CoinbasePayloadScriptPublicKeyMaxLength,
+		config.AssumeValidBlockHash,
 		dbManager,
 		pastMedianTimeManager,
 		ghostdagDataStore,
 		daaBlocksStore,
+		dagTopologyManager,
+		blockStatusStore,
+		headersSelectedTipStore,
 		txMassCalculator)
 	difficultyManager := f.difficultyConstructor(
 		dbManager,
@@ -249,6 +255,14 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		config.TargetTimePerBlock,
 		config.GenesisHash,
 		config.GenesisBlock.Header.Bits())
+	devFeeAddress, err := util.DecodeAddress(config.DevFeeAddress, config.Prefix)
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "invalid dev-fee address %s", config.DevFeeAddress)
+	}
+	devFeeScriptPublicKey, err := txscript.PayToAddrScript(devFeeAddress)
+	if err != nil {
+		return nil, false, err
+	}
 	coinbaseManager := coinbasemanager.New(
 		dbManager,
 		config.SubsidyGenesisReward,
@@ -259,6 +273,10 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		config.DeflationaryPhaseBaseSubsidy,
 		config.DeflationaryPhaseCurveFactor,
 		config.TargetTimePerBlock,
+		devFeeScriptPublicKey,
+		config.DevFeePercentage,
+		config.DevFeeActivationDAAScore,
+		config.POWScores,
 
 		dagTraversalManager,
 		ghostdagDataStore,
@@ -366,6 +384,8 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		config.TargetTimePerBlock,
 		config.POWScores,
 		config.MaxBlockLevel,
+		devFeeScriptPublicKey,
+		config.DevFeeMinPercentage,
 
 		dbManager,
 		difficultyManager,
@@ -497,6 +517,7 @@ func (f *factory) NewConsensus(config *Config, db infrastructuredatabase.Databas
 		genesisHash:  config.GenesisHash,
 
 		expectedDAAWindowDurationInMilliseconds: config.TargetTimePerBlock[constants.GetBlockVersion()-1].Milliseconds() * int64(config.DifficultyAdjustmentWindowSize[constants.GetBlockVersion()-1]),
+		maxMergeDepth:                           maxMergeDepth(config.MergeDepth),
 
 		blockProcessor:        blockProcessor,
 		blockBuilder:          blockBuilder,
@@ -758,3 +779,14 @@ func (f *factory) dagProcesses(config *Config,
 
 	return dagTopologyManagers, ghostdagManagers, dagTraversalManagers
 }
+
+// maxMergeDepth returns the highest merge depth of all the block versions
+func maxMergeDepth(mergeDepths []uint64) uint64 {
+	maxMergeDepth := uint64(0)
+	for _, mergeDepth := range mergeDepths {
+		if mergeDepth > maxMergeDepth {
+			maxMergeDepth = mergeDepth
+		}
+	}
+	return maxMergeDepth
+}
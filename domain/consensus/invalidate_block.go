@@ -0,0 +1,137 @@
+package consensus
+
+import (
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/hashset"
+	"github.com/Hoosat-Oy/HTND/util/staging"
+	"github.com/pkg/errors"
+)
+
+// InvalidateBlock marks the given block and all the blocks in its future as invalid, and
+// reorganizes the virtual away from them. The invalid status is persisted, so the decision
+// survives restarts until ReconsiderBlock is called
+func (s *consensus) InvalidateBlock(blockHash *externalapi.DomainHash) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+	err := s.validateBlockHashExists(stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.consensusStateManager.InvalidateBlock(stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+
+	return s.updateVirtualAfterTipsChange(stagingArea)
+}
+
+// ReconsiderBlock removes the invalid status that was set by InvalidateBlock from the given block,
+// from the invalidated blocks in its past and from the future of all of them, and resolves the
+// virtual again
+func (s *consensus) ReconsiderBlock(blockHash *externalapi.DomainHash) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+	exists, err := s.blockStatusStore.Exists(s.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.Errorf("block %s does not exist", blockHash)
+	}
+
+	reconsideredBlocks, err := s.consensusStateManager.ReconsiderBlock(stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+
+	// Reconsidered blocks that have no reconsidered children are the candidates for the headers selected tip
+	reconsideredBlocksSet := hashset.NewFromSlice(reconsideredBlocks...)
+	for _, reconsideredBlock := range reconsideredBlocks {
+		children, err := s.dagTopologyManagers[0].Children(stagingArea, reconsideredBlock)
+		if err != nil {
+			return err
+		}
+		hasReconsideredChildren := false
+		for _, child := range children {
+			if reconsideredBlocksSet.Contains(child) {
+				hasReconsideredChildren = true
+				break
+			}
+		}
+		if hasReconsideredChildren {
+			continue
+		}
+		err = s.headerTipsManager.AddHeaderTip(stagingArea, reconsideredBlock)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.updateVirtualAfterTipsChange(stagingArea)
+}
+
+// updateVirtualAfterTipsChange moves the virtual to the DAG tips that were staged by InvalidateBlock or
+// ReconsiderBlock and commits it together with them, so that a crash can't leave the block statuses, the
+// virtual and the headers selected chain out of sync. The virtual is then resolved completely. It must be
+// called with the consensus lock held
+func (s *consensus) updateVirtualAfterTipsChange(stagingArea *model.StagingArea) error {
+	virtualChangeSet, isCompletelyResolved, err := s.consensusStateManager.UpdateVirtualAfterTipsChange(stagingArea)
+	if err != nil {
+		return err
+	}
+	err = s.stageHeadersSelectedTipAfterTipsChange(stagingArea)
+	if err != nil {
+		return err
+	}
+	err = staging.CommitAllChanges(s.databaseContext, stagingArea)
+	if err != nil {
+		return err
+	}
+	s.virtualNotUpdated = !isCompletelyResolved
+
+	err = s.sendVirtualChangedEvent(virtualChangeSet, true)
+	if err != nil {
+		return err
+	}
+
+	for !isCompletelyResolved {
+		_, isCompletelyResolved, err = s.resolveVirtualChunkNoLock(virtualResolveChunk)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stageHeadersSelectedTipAfterTipsChange moves the headers selected tip back to the virtual selected
+// parent if it was invalidated
+func (s *consensus) stageHeadersSelectedTipAfterTipsChange(stagingArea *model.StagingArea) error {
+	headersSelectedTip, err := s.headersSelectedTipStore.HeadersSelectedTip(s.databaseContext, stagingArea)
+	if err != nil {
+		return err
+	}
+	headersSelectedTipStatus, err := s.blockStatusStore.Get(s.databaseContext, stagingArea, headersSelectedTip)
+	if err != nil {
+		return err
+	}
+	if headersSelectedTipStatus != externalapi.StatusInvalid {
+		return nil
+	}
+	virtualGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, model.VirtualBlockHash, false)
+	if err != nil {
+		return err
+	}
+	virtualSelectedParent := virtualGHOSTDAGData.SelectedParent()
+	chainChanges, err := s.dagTraversalManager.CalculateChainPath(stagingArea, headersSelectedTip, virtualSelectedParent)
+	if err != nil {
+		return err
+	}
+	s.headersSelectedTipStore.Stage(stagingArea, virtualSelectedParent)
+	return s.headersSelectedChainStore.Stage(s.databaseContext, stagingArea, chainChanges)
+}
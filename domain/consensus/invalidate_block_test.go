@@ -0,0 +1,155 @@
+package consensus_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/ruleerrors"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionhelper"
+)
+
+func TestInvalidateAndReconsiderBlock(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestInvalidateAndReconsiderBlock")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		addBlock := func(parentHashes []*externalapi.DomainHash, transactions ...*externalapi.DomainTransaction) *externalapi.DomainHash {
+			blockHash, _, err := tc.AddBlock(parentHashes, nil, transactions)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			return blockHash
+		}
+		checkVirtualSelectedParent := func(expected *externalapi.DomainHash) {
+			virtualSelectedParent, err := tc.GetVirtualSelectedParent()
+			if err != nil {
+				t.Fatalf("GetVirtualSelectedParent: %+v", err)
+			}
+			if !virtualSelectedParent.Equal(expected) {
+				t.Fatalf("expected virtual selected parent %s, got %s", expected, virtualSelectedParent)
+			}
+		}
+		checkStatus := func(blockHash *externalapi.DomainHash, expected externalapi.BlockStatus) {
+			blockInfo, err := tc.GetBlockInfo(blockHash)
+			if err != nil {
+				t.Fatalf("GetBlockInfo: %+v", err)
+			}
+			if blockInfo.BlockStatus != expected {
+				t.Fatalf("expected block %s to have status %s, got %s", blockHash, expected, blockInfo.BlockStatus)
+			}
+		}
+		virtualUTXOs := func() map[externalapi.DomainOutpoint]struct{} {
+			virtualInfo, err := tc.GetVirtualInfo()
+			if err != nil {
+				t.Fatalf("GetVirtualInfo: %+v", err)
+			}
+			pairs, err := tc.GetVirtualUTXOs(virtualInfo.ParentHashes, nil, 1000)
+			if err != nil {
+				t.Fatalf("GetVirtualUTXOs: %+v", err)
+			}
+			outpoints := make(map[externalapi.DomainOutpoint]struct{}, len(pairs))
+			for _, pair := range pairs {
+				outpoints[*pair.Outpoint] = struct{}{}
+			}
+			return outpoints
+		}
+
+		// Build the following DAG, in which A4 is the virtual selected parent:
+		// genesis <- A1 <- A2 <- A3 <- A4
+		//            A1 <- B1
+		// A3 spends the coinbase of A2
+		genesisHash := consensusConfig.GenesisHash
+		a1Hash := addBlock([]*externalapi.DomainHash{genesisHash})
+		a2Hash := addBlock([]*externalapi.DomainHash{a1Hash})
+		a2, _, err := tc.GetBlock(a2Hash)
+		if err != nil {
+			t.Fatalf("GetBlock: %+v", err)
+		}
+		transaction, err := testutils.CreateTransaction(a2.Transactions[transactionhelper.CoinbaseTransactionIndex], 1)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %+v", err)
+		}
+		a3Hash := addBlock([]*externalapi.DomainHash{a2Hash}, transaction)
+		a4Hash := addBlock([]*externalapi.DomainHash{a3Hash})
+		b1Hash := addBlock([]*externalapi.DomainHash{a1Hash})
+		checkVirtualSelectedParent(a4Hash)
+		utxosBeforeInvalidation := virtualUTXOs()
+
+		// A5 is built on top of A4 now, and is only received while A2 is invalidated
+		a5, _, err := tc.BuildBlockWithParents([]*externalapi.DomainHash{a4Hash}, nil, nil)
+		if err != nil {
+			t.Fatalf("BuildBlockWithParents: %+v", err)
+		}
+		a5Hash := consensushashing.BlockHash(a5)
+
+		err = tc.InvalidateBlock(a2Hash)
+		if err != nil {
+			t.Fatalf("InvalidateBlock: %+v", err)
+		}
+		for _, blockHash := range []*externalapi.DomainHash{a2Hash, a3Hash, a4Hash} {
+			checkStatus(blockHash, externalapi.StatusInvalid)
+		}
+		checkVirtualSelectedParent(b1Hash)
+
+		// Blocks that point at an invalidated block are rejected without being stored as invalid,
+		// while the rest of the DAG keeps growing
+		err = tc.ValidateAndInsertBlock(a5, true, true)
+		if !errors.Is(err, ruleerrors.ErrInvalidatedAncestorBlock) {
+			t.Fatalf("expected ErrInvalidatedAncestorBlock when adding a child of an invalidated block, got %+v", err)
+		}
+		a5Info, err := tc.GetBlockInfo(a5Hash)
+		if err != nil {
+			t.Fatalf("GetBlockInfo: %+v", err)
+		}
+		if a5Info.Exists {
+			t.Fatalf("block %s that was rejected because of an invalidated ancestor was stored with status %s",
+				a5Hash, a5Info.BlockStatus)
+		}
+		b2Hash := addBlock([]*externalapi.DomainHash{b1Hash})
+		checkVirtualSelectedParent(b2Hash)
+
+		// Reconsidering A3 reconsiders its invalidated ancestor A2 and its future as well
+		err = tc.ReconsiderBlock(a3Hash)
+		if err != nil {
+			t.Fatalf("ReconsiderBlock: %+v", err)
+		}
+		for _, blockHash := range []*externalapi.DomainHash{a2Hash, a3Hash, a4Hash} {
+			checkStatus(blockHash, externalapi.StatusUTXOValid)
+		}
+		checkVirtualSelectedParent(a4Hash)
+
+		// The selected chain is the same as before the invalidation, so none of the UTXOs are lost
+		utxosAfterReconsideration := virtualUTXOs()
+		for outpoint := range utxosBeforeInvalidation {
+			if _, ok := utxosAfterReconsideration[outpoint]; !ok {
+				t.Fatalf("outpoint %s is missing from the virtual UTXO set after the reconsideration", outpoint)
+			}
+		}
+
+		// The block that was rejected while A2 was invalidated is accepted once it's received again
+		err = tc.ValidateAndInsertBlock(a5, true, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertBlock: %+v", err)
+		}
+		checkStatus(a5Hash, externalapi.StatusUTXOValid)
+		checkVirtualSelectedParent(a5Hash)
+
+		err = tc.ReconsiderBlock(a3Hash)
+		if err == nil {
+			t.Fatalf("ReconsiderBlock unexpectedly succeeded for a block that isn't invalid")
+		}
+		err = tc.InvalidateBlock(genesisHash)
+		if err == nil {
+			t.Fatalf("InvalidateBlock unexpectedly succeeded for the genesis block")
+		}
+	})
+}
@@ -36,3 +36,45 @@ func (dcd *DomainCoinbaseData) Equal(other *DomainCoinbaseData) bool {
 
 	return dcd.ScriptPublicKey.Equal(other.ScriptPublicKey)
 }
+
+// CoinbaseReward is the part of a coinbase transaction that pays the reward of a
+// merged blue block, or the combined reward of all the merged red blocks
+type CoinbaseReward struct {
+	// BlockHash is the merged blue block the reward is for. It's nil for the red blocks' reward
+	BlockHash       *DomainHash
+	ScriptPublicKey *ScriptPublicKey
+	MinerReward     uint64
+	DevFee          uint64
+}
+
+// CoinbaseSplit describes how the coinbase transaction of a chain block divides the
+// rewards of its merge set between the miners and the dev fee
+type CoinbaseSplit struct {
+	BlockVersion   uint16
+	IsDevFeeActive bool
+	BlueRewards    []*CoinbaseReward
+	// RedReward is nil if the merged red blocks have no reward
+	RedReward      *CoinbaseReward
+	RedBlockHashes []*DomainHash
+}
+
+// BlockSubsidy is the subsidy of the blocks with a given DAA score
+type BlockSubsidy struct {
+	DAAScore     uint64
+	BlockVersion uint16
+	Subsidy      uint64
+	// EmittedSompi is the projected amount of sompi created by the subsidies of all the DAA scores before DAAScore
+	EmittedSompi uint64
+}
+
+// EmissionInfo describes the emission schedule as seen from the virtual's DAA score
+type EmissionInfo struct {
+	VirtualDAAScore uint64
+	CurrentSubsidy  *BlockSubsidy
+	// NextReduction is the first DAA score after the virtual's with a lower subsidy. It's nil if the subsidy is never reduced again
+	NextReduction *BlockSubsidy
+	// Subsidies are the subsidies at the DAA scores that were asked for
+	Subsidies []*BlockSubsidy
+	// ProjectedTotalSupply is the amount of sompi created by the subsidies of all DAA scores, once the subsidy runs out
+	ProjectedTotalSupply uint64
+}
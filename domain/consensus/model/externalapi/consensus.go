@@ -29,6 +29,7 @@ type Consensus interface {
 	GetMissingBlockBodyHashes(highHash *DomainHash) ([]*DomainHash, error)
 	GetPruningPointUTXOs(expectedPruningPointHash *DomainHash, fromOutpoint *DomainOutpoint, limit int) ([]*OutpointAndUTXOEntryPair, error)
 	GetVirtualUTXOs(expectedVirtualParents []*DomainHash, fromOutpoint *DomainOutpoint, limit int) ([]*OutpointAndUTXOEntryPair, error)
+	GetVirtualUTXOEntries(outpoints []*DomainOutpoint) ([]UTXOEntry, error)
 	PruningPoint() (*DomainHash, error)
 	PruningPointHeaders() ([]BlockHeader, error)
 	PruningPointAndItsAnticone() ([]*DomainHash, error)
@@ -57,7 +58,15 @@ type Consensus interface {
 	TrustedBlockAssociatedGHOSTDAGDataBlockHashes(blockHash *DomainHash) ([]*DomainHash, error)
 	TrustedGHOSTDAGData(blockHash *DomainHash) (*BlockGHOSTDAGData, error)
 	IsChainBlock(blockHash *DomainHash) (bool, error)
+	GetCoinbaseSplit(blockHash *DomainHash) (*CoinbaseSplit, error)
+	GetEmissionInfo(daaScores []uint64) (*EmissionInfo, error)
 	VirtualMergeDepthRoot() (*DomainHash, error)
 	IsNearlySynced() (bool, error)
 	GetBlockByTransactionID(transactionID *DomainTransactionID) (*DomainBlock, error)
+	GetTransactionInclusionProof(transactionID *DomainTransactionID, includingBlockHash *DomainHash,
+		targetChainBlockHash *DomainHash, maxChainPathLength uint64) (*TransactionInclusionProof, error)
+	InvalidateBlock(blockHash *DomainHash) error
+	ReconsiderBlock(blockHash *DomainHash) error
+	GetDAGSnapshot(lowScore uint64, highScore uint64, useDAAScore bool, startChainBlockHash *DomainHash,
+		maxBlocks int) (*DAGSnapshot, error)
 }
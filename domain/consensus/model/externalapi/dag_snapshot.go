@@ -0,0 +1,30 @@
+package externalapi
+
+// DAGSnapshotBlock describes a block in a DAG snapshot along with its GHOSTDAG classification
+type DAGSnapshotBlock struct {
+	Hash           *DomainHash
+	ParentHashes   []*DomainHash
+	SelectedParent *DomainHash
+	MergeSetBlues  []*DomainHash
+	MergeSetReds   []*DomainHash
+	BlueScore      uint64
+	DAAScore       uint64
+	BlockStatus    BlockStatus
+	IsChainBlock   bool
+
+	// MergingChainBlockHash is the selected chain block whose merge set contains the block, and
+	// IsBlue tells whether the block is blue in that merge set. MergingChainBlockHash is nil for the
+	// blocks that no selected chain block merged yet, in which case IsBlue refers to the merge set
+	// of the virtual
+	MergingChainBlockHash *DomainHash
+	IsBlue                bool
+}
+
+// DAGSnapshot is a page of the blocks within a score window of the DAG
+type DAGSnapshot struct {
+	Blocks []*DAGSnapshotBlock
+
+	// NextChainBlockHash is the selected chain block to start the next page from,
+	// or nil if this is the last page
+	NextChainBlockHash *DomainHash
+}
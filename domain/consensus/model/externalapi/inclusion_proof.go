@@ -0,0 +1,41 @@
+package externalapi
+
+// MerkleBranch proves that a hash is a leaf of a merkle tree. Hashes are the
+// siblings of the leaf and of its ancestors, ordered from the leaf up to the root,
+// and Index is the position of the leaf in the tree, which tells for every level
+// whether the sibling is on the left or on the right
+type MerkleBranch struct {
+	Index  uint64
+	Hashes []*DomainHash
+}
+
+// TransactionInclusionProof proves that a transaction was included in a block and
+// accepted by a selected chain block, and links both to a given selected chain block
+// through block headers only
+type TransactionInclusionProof struct {
+	// IncludingBlockHeader is the header of a block that contains the transaction, and
+	// HashMerkleBranch proves that the transaction hash is a leaf of its hash merkle root
+	IncludingBlockHeader BlockHeader
+	HashMerkleBranch     *MerkleBranch
+
+	// MergeSetPath are the headers of the blocks that link the including block to the
+	// accepting block. Every block in the path, starting with the including block, is a
+	// direct parent of the next one, and the last one is a direct parent of the accepting block
+	MergeSetPath []BlockHeader
+
+	// AcceptingBlockHeader is the header of the selected chain block that accepted the
+	// transaction, and AcceptedIDMerkleBranch proves that the transaction ID is a leaf of
+	// its accepted ID merkle root
+	AcceptingBlockHeader   BlockHeader
+	AcceptedIDMerkleBranch *MerkleBranch
+
+	// ChainPath are the headers of the selected chain blocks that follow the accepting block,
+	// up to and including the target block. Every block in the path has the previous one, starting
+	// with the accepting block, as a direct parent
+	ChainPath []BlockHeader
+
+	// ChainPathOtherParents are, for every block in ChainPath, the headers of its direct parents
+	// other than the previous block in the path. They prove that the previous block has the highest
+	// blue work among the parents, and so is the selected parent of the block
+	ChainPathOtherParents [][]BlockHeader
+}
@@ -6,6 +6,7 @@ import "github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
 type UTXODiffStore interface {
 	Store
 	Stage(stagingArea *StagingArea, blockHash *externalapi.DomainHash, utxoDiff externalapi.UTXODiff, utxoDiffChild *externalapi.DomainHash)
+	ClearUTXODiffChild(stagingArea *StagingArea, blockHash *externalapi.DomainHash)
 	IsStaged(stagingArea *StagingArea) bool
 	UTXODiff(dbContext DBReader, stagingArea *StagingArea, blockHash *externalapi.DomainHash) (externalapi.UTXODiff, error)
 	UTXODiffChild(dbContext DBReader, stagingArea *StagingArea, blockHash *externalapi.DomainHash) (*externalapi.DomainHash, error)
@@ -9,6 +9,9 @@ type CoinbaseManager interface {
 		coinbaseData *externalapi.DomainCoinbaseData) (expectedTransaction *externalapi.DomainTransaction, hasRedReward bool, err error)
 	ExpectedCoinbaseTransactionWithAcceptanceData(stagingArea *StagingArea, blockHash *externalapi.DomainHash,
 		coinbaseData *externalapi.DomainCoinbaseData, acceptanceData externalapi.AcceptanceData) (expectedTransaction *externalapi.DomainTransaction, hasRedReward bool, err error)
+	CoinbaseSplit(stagingArea *StagingArea, blockHash *externalapi.DomainHash) (*externalapi.CoinbaseSplit, error)
+	IsDevFeeActive(blockVersion uint16, daaScore uint64) bool
 	CalcBlockSubsidy(stagingArea *StagingArea, blockHash *externalapi.DomainHash, blockVersion uint16) (uint64, error)
+	EmissionInfo(virtualDAAScore uint64, daaScores []uint64) *externalapi.EmissionInfo
 	ExtractCoinbaseDataBlueScoreAndSubsidy(coinbaseTx *externalapi.DomainTransaction) (blueScore uint64, coinbaseData *externalapi.DomainCoinbaseData, subsidy uint64, err error)
 }
@@ -15,4 +15,7 @@ type ConsensusStateManager interface {
 	ReverseUTXODiffs(tipHash *externalapi.DomainHash, reversalData *UTXODiffReversalData) error
 	ResolveVirtual(maxBlocksToResolve uint64) (*externalapi.VirtualChangeSet, bool, error)
 	ValidateUTXODiffChildChains() error
+	InvalidateBlock(stagingArea *StagingArea, blockHash *externalapi.DomainHash) ([]*externalapi.DomainHash, error)
+	ReconsiderBlock(stagingArea *StagingArea, blockHash *externalapi.DomainHash) ([]*externalapi.DomainHash, error)
+	UpdateVirtualAfterTipsChange(stagingArea *StagingArea) (*externalapi.VirtualChangeSet, bool, error)
 }
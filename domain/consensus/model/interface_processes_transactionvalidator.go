@@ -11,6 +11,8 @@ type TransactionValidator interface {
 	ValidateTransactionInContextIgnoringUTXO(stagingArea *StagingArea, tx *externalapi.DomainTransaction,
 		povBlockHash *externalapi.DomainHash, povBlockPastMedianTime int64, povDAAScore uint64) error
 	ValidateTransactionInContextAndPopulateFee(stagingArea *StagingArea,
-		tx *externalapi.DomainTransaction, povBlockHash *externalapi.DomainHash, povDAAScore uint64) error
+		tx *externalapi.DomainTransaction, povBlockHash *externalapi.DomainHash, povDAAScore uint64,
+		isAssumedValid bool) error
+	IsInPastOfAssumeValidBlock(stagingArea *StagingArea, povBlockHash *externalapi.DomainHash) (bool, error)
 	PopulateMass(transaction *externalapi.DomainTransaction)
 }
@@ -186,7 +186,7 @@ func (bb *blockBuilder) validateTransaction(
 		return err
 	}
 
-	return bb.transactionValidator.ValidateTransactionInContextAndPopulateFee(stagingArea, transaction, model.VirtualBlockHash, virtualDAAScore)
+	return bb.transactionValidator.ValidateTransactionInContextAndPopulateFee(stagingArea, transaction, model.VirtualBlockHash, virtualDAAScore, false)
 }
 
 func (bb *blockBuilder) newBlockCoinbaseTransaction(stagingArea *model.StagingArea,
@@ -81,9 +81,13 @@ func (bp *blockProcessor) validateBlock(stagingArea *model.StagingArea, block *e
 			// transactions that fits the merkle root.
 			// ErrPrunedBlock - ErrPrunedBlock is an error that rejects a block body and
 			// not the block as a whole, so we shouldn't mark it as invalid.
+			// ErrInvalidatedAncestorBlock - the ancestor was invalidated by the node
+			// operator and may be reconsidered, after which the block should be
+			// accepted when it's received again.
 			if !errors.As(err, &ruleerrors.ErrMissingParents{}) &&
 				!errors.Is(err, ruleerrors.ErrBadMerkleRoot) &&
-				!errors.Is(err, ruleerrors.ErrPrunedBlock) {
+				!errors.Is(err, ruleerrors.ErrPrunedBlock) &&
+				!errors.Is(err, ruleerrors.ErrInvalidatedAncestorBlock) {
 				// Use a new stagingArea so we save only the block status
 				stagingArea := model.NewStagingArea()
 				hash := consensushashing.BlockHash(block)
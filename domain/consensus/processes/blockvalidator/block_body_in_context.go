@@ -6,11 +6,8 @@ import (
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/ruleerrors"
-	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionhelper"
-	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/virtual"
-	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
 	"github.com/Hoosat-Oy/HTND/infrastructure/logger"
 	"github.com/pkg/errors"
 )
@@ -186,20 +183,15 @@ func (v *blockValidator) checkCoinbaseSubsidy(stagingArea *model.StagingArea, bl
 	return subsidy, nil
 }
 
-func IsDevFeeOutput(reward uint64, block *externalapi.DomainBlock, output *externalapi.DomainTransactionOutput) bool {
-	_, address, err := txscript.ExtractScriptPubKeyAddress(output.ScriptPublicKey, &dagconfig.MainnetParams)
-	if err != nil {
-		return false
-	}
-	devFeeAddressInBlock := address.EncodeAddress()
-	isDevFeeAddressEqual := devFeeAddressInBlock == constants.DevFeeAddress
-	devFeeMinQuantity := uint64(float64(constants.DevFeeMin) / 100 * float64(reward))
-	isValueEqual := output.Value >= devFeeMinQuantity
-	return isDevFeeAddressEqual && isValueEqual
+// isDevFeeOutput returns whether output pays the network's dev fee out of reward
+func (v *blockValidator) isDevFeeOutput(reward uint64, output *externalapi.DomainTransactionOutput) bool {
+	devFeeMinQuantity := uint64(float64(v.devFeeMinPercentage) / 100 * float64(reward))
+	return output.ScriptPublicKey.Equal(v.devFeeScriptPublicKey) && output.Value >= devFeeMinQuantity
 }
 
 func (v *blockValidator) checkDevFee(stagingArea *model.StagingArea, block *externalapi.DomainBlock, reward uint64) error {
-	if block.Header.Version() < 2 || block.Transactions[0].Version == 0 {
+	if !v.coinbaseManager.IsDevFeeActive(block.Header.Version(), block.Header.DAAScore()) ||
+		block.Transactions[0].Version == 0 {
 		return nil
 	}
 	// Check for nodeFee in block outputs
@@ -215,7 +207,7 @@ func (v *blockValidator) checkDevFee(stagingArea *model.StagingArea, block *exte
 	hasDevFee := false
 	for _, transaction := range block.Transactions {
 		for _, output := range transaction.Outputs {
-			if IsDevFeeOutput(reward, block, output) {
+			if v.isDevFeeOutput(reward, output) {
 				hasDevFee = true
 				break
 			}
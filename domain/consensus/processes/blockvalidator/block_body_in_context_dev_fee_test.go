@@ -0,0 +1,63 @@
+package blockvalidator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/processes/coinbasemanager"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/ruleerrors"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/blockheader"
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+)
+
+func TestCheckDevFeeActivation(t *testing.T) {
+	const devFeeActivationDAAScore = 1000
+	const reward = 1000
+	devFeeScriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0}
+	minerScriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{4, 5, 6}, Version: 0}
+	params := dagconfig.MainnetParams
+	validator := &blockValidator{
+		devFeeScriptPublicKey: devFeeScriptPublicKey,
+		devFeeMinPercentage:   params.DevFeeMinPercentage,
+		coinbaseManager: coinbasemanager.New(nil, 0, 0, 0, &externalapi.DomainHash{}, 0, 0, 0,
+			params.TargetTimePerBlock, devFeeScriptPublicKey, params.DevFeePercentage, devFeeActivationDAAScore,
+			params.POWScores, nil, nil, nil, nil, nil, nil, nil),
+	}
+
+	blockWithOutputs := func(daaScore uint64, outputs ...*externalapi.DomainTransactionOutput) *externalapi.DomainBlock {
+		header := blockheader.NewImmutableBlockHeader(5, nil, &externalapi.DomainHash{}, &externalapi.DomainHash{},
+			&externalapi.DomainHash{}, 0, 0, 0, daaScore, 0, big.NewInt(0), &externalapi.DomainHash{})
+		// The dev fee isn't checked for coinbase transactions of version 0
+		coinbaseTransaction := &externalapi.DomainTransaction{
+			Version: 1,
+			Outputs: outputs,
+		}
+		return &externalapi.DomainBlock{Header: header, Transactions: []*externalapi.DomainTransaction{coinbaseTransaction}}
+	}
+	minerOnlyOutputs := []*externalapi.DomainTransactionOutput{
+		{Value: reward, ScriptPublicKey: minerScriptPublicKey},
+	}
+	devFee := params.DevFeePercentage * reward / 100
+	outputsWithDevFee := []*externalapi.DomainTransactionOutput{
+		{Value: reward - devFee, ScriptPublicKey: minerScriptPublicKey},
+		{Value: devFee, ScriptPublicKey: devFeeScriptPublicKey},
+	}
+
+	// Below the activation DAA score, blocks don't have to pay the dev fee
+	err := validator.checkDevFee(nil, blockWithOutputs(devFeeActivationDAAScore-1, minerOnlyOutputs...), reward)
+	if err != nil {
+		t.Fatalf("checkDevFee unexpectedly failed below the activation DAA score: %+v", err)
+	}
+
+	// From the activation DAA score, blocks have to pay the dev fee
+	err = validator.checkDevFee(nil, blockWithOutputs(devFeeActivationDAAScore, minerOnlyOutputs...), reward)
+	if !errors.Is(err, ruleerrors.ErrDevFeeNotIncluded) {
+		t.Fatalf("expected ErrDevFeeNotIncluded from the activation DAA score, got %+v", err)
+	}
+	err = validator.checkDevFee(nil, blockWithOutputs(devFeeActivationDAAScore, outputsWithDevFee...), reward)
+	if err != nil {
+		t.Fatalf("checkDevFee unexpectedly failed for a block that pays the dev fee: %+v", err)
+	}
+}
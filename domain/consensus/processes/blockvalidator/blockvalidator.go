@@ -26,6 +26,8 @@ type blockValidator struct {
 	targetTimePerBlock          []time.Duration
 	POWScores                   []uint64
 	maxBlockLevel               int
+	devFeeScriptPublicKey       *externalapi.ScriptPublicKey
+	devFeeMinPercentage         uint64
 
 	databaseContext       model.DBReader
 	difficultyManager     model.DifficultyManager
@@ -66,6 +68,8 @@ func New(powMax *big.Int,
 	targetTimePerBlock []time.Duration,
 	POWScores []uint64,
 	maxBlockLevel int,
+	devFeeScriptPublicKey *externalapi.ScriptPublicKey,
+	devFeeMinPercentage uint64,
 
 	databaseContext model.DBReader,
 
@@ -106,6 +110,8 @@ func New(powMax *big.Int,
 		maxBlockParents:            maxBlockParents,
 		POWScores:                  POWScores,
 		maxBlockLevel:              maxBlockLevel,
+		devFeeScriptPublicKey:      devFeeScriptPublicKey,
+		devFeeMinPercentage:        devFeeMinPercentage,
 
 		timestampDeviationTolerance: timestampDeviationTolerance,
 		targetTimePerBlock:          targetTimePerBlock,
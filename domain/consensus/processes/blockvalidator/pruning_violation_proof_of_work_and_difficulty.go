@@ -218,6 +218,17 @@ func (v *blockValidator) checkParentHeadersExist(stagingArea *model.StagingArea,
 			missingParentHashes = append(missingParentHashes, parent)
 			continue
 		}
+
+		// Parents that were invalidated by the node operator are still in the DAG,
+		// so their invalid status has to be checked separately
+		parentStatus, err := v.blockStatusStore.Get(v.databaseContext, stagingArea, parent)
+		if err != nil {
+			if !database.IsNotFoundError(err) {
+				return err
+			}
+		} else if parentStatus == externalapi.StatusInvalid {
+			return errors.Wrapf(ruleerrors.ErrInvalidatedAncestorBlock, "parent %s was invalidated", parent)
+		}
 	}
 
 	if len(missingParentHashes) > 0 {
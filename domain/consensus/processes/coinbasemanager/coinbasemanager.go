@@ -10,12 +10,17 @@ import (
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/hashset"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/subnetworks"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionhelper"
-	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
 	"github.com/Hoosat-Oy/HTND/infrastructure/db/database"
-	"github.com/Hoosat-Oy/HTND/util"
 	"github.com/pkg/errors"
 )
 
+const (
+	secondsPerYear = 31557600
+
+	// nocturneHFDAAScore is the DAA score of the hard fork that moved the network to 5 BPS
+	nocturneHFDAAScore = 43334184
+)
+
 type coinbaseManager struct {
 	subsidyGenesisReward                    uint64
 	preDeflationaryPhaseBaseSubsidy         uint64
@@ -25,6 +30,10 @@ type coinbaseManager struct {
 	deflationaryPhaseBaseSubsidy            uint64
 	deflationaryPhaseCurveFactor            float64
 	targetTimePerBlock                      []time.Duration
+	devFeeScriptPublicKey                   *externalapi.ScriptPublicKey
+	devFeePercentage                        uint64
+	devFeeActivationDAAScore                uint64
+	powScores                               []uint64
 
 	databaseContext     model.DBReader
 	dagTraversalManager model.DAGTraversalManager
@@ -56,66 +65,18 @@ func (c *coinbaseManager) ExpectedCoinbaseTransaction(stagingArea *model.Staging
 
 func (c *coinbaseManager) ExpectedCoinbaseTransactionInternal(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash, coinbaseData *externalapi.DomainCoinbaseData, acceptanceData externalapi.AcceptanceData) (expectedTransaction *externalapi.DomainTransaction, hasRedReward bool, err error) {
 
-	ghostdagData, err := c.ghostdagDataStore.Get(c.databaseContext, stagingArea, blockHash, true)
-	// If there's ghostdag data with trusted data we prefer it because we need the original merge set non-pruned merge set.
-	if database.IsNotFoundError(err) {
-		ghostdagData, err = c.ghostdagDataStore.Get(c.databaseContext, stagingArea, blockHash, false)
-		if err != nil {
-			return nil, false, err
-		}
-	}
-
-	daaAddedBlocksSet, err := c.daaAddedBlocksSet(stagingArea, blockHash)
+	split, err := c.coinbaseSplit(stagingArea, blockHash, coinbaseData, acceptanceData, constants.GetBlockVersion())
 	if err != nil {
 		return nil, false, err
 	}
 
-	txOuts := make([]*externalapi.DomainTransactionOutput, 0, len(ghostdagData.MergeSetBlues()))
-	acceptanceDataMap := acceptanceDataFromArrayToMap(acceptanceData)
-	if constants.GetBlockVersion() == 1 {
-		for _, blue := range ghostdagData.MergeSetBlues() {
-			txOut, hasReward, err := c.coinbaseOutputForBlueBlockV1(stagingArea, blue, acceptanceDataMap[*blue], daaAddedBlocksSet)
-			if err != nil {
-				return nil, false, err
-			}
-
-			if hasReward {
-				txOuts = append(txOuts, txOut)
-			}
-		}
-
-		txOut, hasRedReward, err := c.coinbaseOutputForRewardFromRedBlocksV1(
-			stagingArea, ghostdagData, acceptanceData, daaAddedBlocksSet, coinbaseData)
-		if err != nil {
-			return nil, false, err
-		}
-
-		if hasRedReward {
-			txOuts = append(txOuts, txOut)
-		}
-	} else if constants.GetBlockVersion() >= 2 {
-		for _, blue := range ghostdagData.MergeSetBlues() {
-			txOut, devTx, hasReward, err := c.coinbaseOutputForBlueBlockV2(stagingArea, blue, acceptanceDataMap[*blue], daaAddedBlocksSet)
-			if err != nil {
-				return nil, false, err
-			}
-
-			if hasReward {
-				txOuts = append(txOuts, txOut)
-				txOuts = append(txOuts, devTx)
-			}
-		}
-
-		txOut, devTx, hasRedReward, err := c.coinbaseOutputForRewardFromRedBlocksV2(
-			stagingArea, ghostdagData, acceptanceData, daaAddedBlocksSet, coinbaseData)
-		if err != nil {
-			return nil, false, err
-		}
-
-		if hasRedReward {
-			txOuts = append(txOuts, txOut)
-			txOuts = append(txOuts, devTx)
-		}
+	txOuts := make([]*externalapi.DomainTransactionOutput, 0, 2*len(split.BlueRewards)+2)
+	for _, blueReward := range split.BlueRewards {
+		txOuts = append(txOuts, c.rewardOutputs(blueReward, split.IsDevFeeActive)...)
+	}
+	hasRedReward = split.RedReward != nil
+	if hasRedReward {
+		txOuts = append(txOuts, c.rewardOutputs(split.RedReward, split.IsDevFeeActive)...)
 	}
 
 	subsidy, err := c.CalcBlockSubsidy(stagingArea, blockHash, constants.GetBlockVersion())
@@ -123,6 +84,11 @@ func (c *coinbaseManager) ExpectedCoinbaseTransactionInternal(stagingArea *model
 		return nil, false, err
 	}
 
+	ghostdagData, err := c.ghostdagData(stagingArea, blockHash)
+	if err != nil {
+		return nil, false, err
+	}
+
 	payload, err := c.serializeCoinbasePayload(ghostdagData.BlueScore(), coinbaseData, subsidy)
 	if err != nil {
 		return nil, false, err
@@ -140,158 +106,178 @@ func (c *coinbaseManager) ExpectedCoinbaseTransactionInternal(stagingArea *model
 	return domainTransaction, hasRedReward, nil
 }
 
-func (c *coinbaseManager) daaAddedBlocksSet(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) (
-	hashset.HashSet, error) {
+// CoinbaseSplit returns how the coinbase transaction of the given chain block divides the rewards of
+// its merge set between the miners and the dev fee.
+func (c *coinbaseManager) CoinbaseSplit(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) (
+	*externalapi.CoinbaseSplit, error) {
 
-	daaAddedBlocks, err := c.daaBlocksStore.DAAAddedBlocks(c.databaseContext, stagingArea, blockHash)
+	acceptanceData, err := c.acceptanceDataStore.Get(c.databaseContext, stagingArea, blockHash)
 	if err != nil {
 		return nil, err
 	}
 
-	return hashset.NewFromSlice(daaAddedBlocks...), nil
-}
-
-// coinbaseOutputForBlueBlock calculates the output that should go into the coinbase transaction of blueBlock
-// If blueBlock gets no fee - returns nil for txOut
-func (c *coinbaseManager) coinbaseOutputForBlueBlockV2(stagingArea *model.StagingArea,
-	blueBlock *externalapi.DomainHash, blockAcceptanceData *externalapi.BlockAcceptanceData,
-	mergingBlockDAAAddedBlocksSet hashset.HashSet) (*externalapi.DomainTransactionOutput, *externalapi.DomainTransactionOutput, bool, error) {
-
-	blockReward, err := c.calcMergedBlockReward(stagingArea, blueBlock, blockAcceptanceData, mergingBlockDAAAddedBlocksSet)
+	block, err := c.blockStore.Block(c.databaseContext, stagingArea, blockHash)
 	if err != nil {
-		return nil, nil, false, err
+		return nil, err
 	}
 
-	devFeeDecodedAddress, err := util.DecodeAddress(constants.DevFeeAddress, util.Bech32PrefixHoosat)
+	_, coinbaseData, _, err := c.ExtractCoinbaseDataBlueScoreAndSubsidy(block.Transactions[transactionhelper.CoinbaseTransactionIndex])
 	if err != nil {
-		return nil, nil, false, err
-	}
-	devFeeScriptPublicKey, err := txscript.PayToAddrScript(devFeeDecodedAddress)
-	if err != nil {
-		return nil, nil, false, err
-	}
-	devFeeQuantity := uint64(float64(constants.DevFee) / 100 * float64(blockReward))
-	blockReward -= devFeeQuantity
-	if blockReward <= 0 {
-		return nil, nil, false, nil
+		return nil, err
 	}
 
-	// the ScriptPublicKey for the coinbase is parsed from the coinbase payload
-	_, coinbaseData, _, err := c.ExtractCoinbaseDataBlueScoreAndSubsidy(blockAcceptanceData.TransactionAcceptanceData[0].Transaction)
-	if err != nil {
-		return nil, nil, false, err
-	}
+	return c.coinbaseSplit(stagingArea, blockHash, coinbaseData, acceptanceData, block.Header.Version())
+}
 
-	txOut := &externalapi.DomainTransactionOutput{
-		Value:           blockReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
-	}
+func (c *coinbaseManager) ghostdagData(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) (
+	*externalapi.BlockGHOSTDAGData, error) {
 
-	devTx := &externalapi.DomainTransactionOutput{
-		Value:           devFeeQuantity,
-		ScriptPublicKey: devFeeScriptPublicKey,
+	ghostdagData, err := c.ghostdagDataStore.Get(c.databaseContext, stagingArea, blockHash, true)
+	// If there's ghostdag data with trusted data we prefer it because we need the original merge set non-pruned merge set.
+	if database.IsNotFoundError(err) {
+		return c.ghostdagDataStore.Get(c.databaseContext, stagingArea, blockHash, false)
 	}
-
-	return txOut, devTx, true, nil
+	return ghostdagData, err
 }
 
-func (c *coinbaseManager) coinbaseOutputForBlueBlockV1(stagingArea *model.StagingArea,
-	blueBlock *externalapi.DomainHash, blockAcceptanceData *externalapi.BlockAcceptanceData,
-	mergingBlockDAAAddedBlocksSet hashset.HashSet) (*externalapi.DomainTransactionOutput, bool, error) {
+// coinbaseSplit calculates the rewards that the coinbase transaction of blockHash pays for its merged blocks.
+// Blue blocks get no reward if they're not in the DAA window of blockHash, and red blocks' rewards go to the
+// miner of blockHash.
+func (c *coinbaseManager) coinbaseSplit(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash,
+	coinbaseData *externalapi.DomainCoinbaseData, acceptanceData externalapi.AcceptanceData, blockVersion uint16) (
+	*externalapi.CoinbaseSplit, error) {
 
-	blockReward, err := c.calcMergedBlockReward(stagingArea, blueBlock, blockAcceptanceData, mergingBlockDAAAddedBlocksSet)
+	ghostdagData, err := c.ghostdagData(stagingArea, blockHash)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
-	if blockReward <= 0 {
-		return nil, false, nil
+	daaAddedBlocksSet, err := c.daaAddedBlocksSet(stagingArea, blockHash)
+	if err != nil {
+		return nil, err
 	}
 
-	// the ScriptPublicKey for the coinbase is parsed from the coinbase payload
-	_, coinbaseData, _, err := c.ExtractCoinbaseDataBlueScoreAndSubsidy(blockAcceptanceData.TransactionAcceptanceData[0].Transaction)
+	isDevFeeActive, err := c.isDevFeeActive(stagingArea, blockHash, blockVersion)
 	if err != nil {
-		return nil, false, err
+		return nil, err
 	}
 
-	txOut := &externalapi.DomainTransactionOutput{
-		Value:           blockReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
+	split := &externalapi.CoinbaseSplit{
+		BlockVersion:   blockVersion,
+		IsDevFeeActive: isDevFeeActive,
+		BlueRewards:    make([]*externalapi.CoinbaseReward, 0, len(ghostdagData.MergeSetBlues())),
 	}
+	acceptanceDataMap := acceptanceDataFromArrayToMap(acceptanceData)
+	for _, blue := range ghostdagData.MergeSetBlues() {
+		reward, err := c.calcMergedBlockReward(stagingArea, blue, acceptanceDataMap[*blue], daaAddedBlocksSet)
+		if err != nil {
+			return nil, err
+		}
 
-	return txOut, true, nil
-}
+		minerReward, devFee := c.splitReward(reward, isDevFeeActive)
+		if minerReward == 0 {
+			continue
+		}
 
-func (c *coinbaseManager) coinbaseOutputForRewardFromRedBlocksV2(stagingArea *model.StagingArea,
-	ghostdagData *externalapi.BlockGHOSTDAGData, acceptanceData externalapi.AcceptanceData, daaAddedBlocksSet hashset.HashSet,
-	coinbaseData *externalapi.DomainCoinbaseData) (*externalapi.DomainTransactionOutput, *externalapi.DomainTransactionOutput, bool, error) {
+		// the ScriptPublicKey for the coinbase is parsed from the coinbase payload
+		_, blueCoinbaseData, _, err := c.ExtractCoinbaseDataBlueScoreAndSubsidy(
+			acceptanceDataMap[*blue].TransactionAcceptanceData[0].Transaction)
+		if err != nil {
+			return nil, err
+		}
 
-	acceptanceDataMap := acceptanceDataFromArrayToMap(acceptanceData)
-	totalReward := uint64(0)
+		split.BlueRewards = append(split.BlueRewards, &externalapi.CoinbaseReward{
+			BlockHash:       blue,
+			ScriptPublicKey: blueCoinbaseData.ScriptPublicKey,
+			MinerReward:     minerReward,
+			DevFee:          devFee,
+		})
+	}
+
+	totalRedReward := uint64(0)
 	for _, red := range ghostdagData.MergeSetReds() {
 		if acceptanceDataMap[*red] == nil {
 			continue
 		}
 		reward, err := c.calcMergedBlockReward(stagingArea, red, acceptanceDataMap[*red], daaAddedBlocksSet)
 		if err != nil {
-			return nil, nil, false, err
+			return nil, err
 		}
-		totalReward += reward
+		totalRedReward += reward
+		split.RedBlockHashes = append(split.RedBlockHashes, red)
 	}
 
-	devFeeDecodedAddress, err := util.DecodeAddress(constants.DevFeeAddress, util.Bech32PrefixHoosat)
-	if err != nil {
-		return nil, nil, false, err
+	minerReward, devFee := c.splitReward(totalRedReward, isDevFeeActive)
+	if minerReward > 0 {
+		split.RedReward = &externalapi.CoinbaseReward{
+			ScriptPublicKey: coinbaseData.ScriptPublicKey,
+			MinerReward:     minerReward,
+			DevFee:          devFee,
+		}
+	}
+
+	return split, nil
+}
+
+// isDevFeeActive returns whether the coinbase transaction of blockHash pays a dev fee
+func (c *coinbaseManager) isDevFeeActive(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash,
+	blockVersion uint16) (bool, error) {
+
+	if blockVersion < 2 || c.devFeeActivationDAAScore == 0 {
+		return c.IsDevFeeActive(blockVersion, 0), nil
 	}
-	devFeeScriptPublicKey, err := txscript.PayToAddrScript(devFeeDecodedAddress)
+
+	daaScore, err := c.daaBlocksStore.DAAScore(c.databaseContext, stagingArea, blockHash)
 	if err != nil {
-		return nil, nil, false, err
+		return false, err
 	}
-	devFeeQuantity := uint64(float64(constants.DevFee) / 100 * float64(totalReward))
-	totalReward -= devFeeQuantity
-	if totalReward <= 0 {
-		return nil, nil, false, nil
+	return c.IsDevFeeActive(blockVersion, daaScore), nil
+}
+
+// IsDevFeeActive returns whether the coinbase transaction of a block with the given version and DAA score
+// pays a dev fee. The dev fee started with block version 2, and is paid from the network's dev-fee
+// activation DAA score.
+func (c *coinbaseManager) IsDevFeeActive(blockVersion uint16, daaScore uint64) bool {
+	return blockVersion >= 2 && daaScore >= c.devFeeActivationDAAScore
+}
+
+// splitReward divides reward between the miner and the dev fee
+func (c *coinbaseManager) splitReward(reward uint64, isDevFeeActive bool) (minerReward uint64, devFee uint64) {
+	if !isDevFeeActive {
+		return reward, 0
 	}
+	devFee = uint64(float64(c.devFeePercentage) / 100 * float64(reward))
+	return reward - devFee, devFee
+}
+
+// rewardOutputs returns the coinbase outputs that pay the given reward
+func (c *coinbaseManager) rewardOutputs(reward *externalapi.CoinbaseReward,
+	isDevFeeActive bool) []*externalapi.DomainTransactionOutput {
 
 	txOut := &externalapi.DomainTransactionOutput{
-		Value:           totalReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
+		Value:           reward.MinerReward,
+		ScriptPublicKey: reward.ScriptPublicKey,
+	}
+	if !isDevFeeActive {
+		return []*externalapi.DomainTransactionOutput{txOut}
 	}
 
 	devTx := &externalapi.DomainTransactionOutput{
-		Value:           devFeeQuantity,
-		ScriptPublicKey: devFeeScriptPublicKey,
+		Value:           reward.DevFee,
+		ScriptPublicKey: c.devFeeScriptPublicKey,
 	}
-
-	return txOut, devTx, true, nil
+	return []*externalapi.DomainTransactionOutput{txOut, devTx}
 }
 
-func (c *coinbaseManager) coinbaseOutputForRewardFromRedBlocksV1(stagingArea *model.StagingArea,
-	ghostdagData *externalapi.BlockGHOSTDAGData, acceptanceData externalapi.AcceptanceData, daaAddedBlocksSet hashset.HashSet,
-	coinbaseData *externalapi.DomainCoinbaseData) (*externalapi.DomainTransactionOutput, bool, error) {
-
-	acceptanceDataMap := acceptanceDataFromArrayToMap(acceptanceData)
-	totalReward := uint64(0)
-	for _, red := range ghostdagData.MergeSetReds() {
-		if acceptanceDataMap[*red] == nil {
-			continue
-		}
-		reward, err := c.calcMergedBlockReward(stagingArea, red, acceptanceDataMap[*red], daaAddedBlocksSet)
-		if err != nil {
-			return nil, false, err
-		}
-		totalReward += reward
-	}
-	if totalReward <= 0 {
-		return nil, false, nil
-	}
+func (c *coinbaseManager) daaAddedBlocksSet(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash) (
+	hashset.HashSet, error) {
 
-	txOut := &externalapi.DomainTransactionOutput{
-		Value:           totalReward,
-		ScriptPublicKey: coinbaseData.ScriptPublicKey,
+	daaAddedBlocks, err := c.daaBlocksStore.DAAAddedBlocks(c.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return nil, err
 	}
 
-	return txOut, true, nil
+	return hashset.NewFromSlice(daaAddedBlocks...), nil
 }
 
 func acceptanceDataFromArrayToMap(acceptanceData externalapi.AcceptanceData) map[externalapi.DomainHash]*externalapi.BlockAcceptanceData {
@@ -323,28 +309,36 @@ func (c *coinbaseManager) CalcBlockSubsidy(stagingArea *model.StagingArea, block
 }
 
 func (c *coinbaseManager) calcDeflationaryPeriodBlockSubsidy(blockDaaScore uint64, blockVersion uint16) uint64 {
+	yearsSinceDeflationStarted, _ := c.deflationaryYear(blockDaaScore, blockVersion)
+
+	// Return the pre-calculated value from subsidy-per-month table
+	return c.getDeflationaryPeriodBlockSubsidyFromTable(yearsSinceDeflationStarted, blockVersion)
+}
+
+// deflationaryYear returns the number of years since the deflationary phase started at the
+// given DAA score, along with the number of DAA scores left until the year ends.
+func (c *coinbaseManager) deflationaryYear(blockDaaScore uint64, blockVersion uint16) (year uint64, daaScoresLeftInYear uint64) {
 	// We define a year as 365.25 days and a month as 365.25 / 12 = 30.4375
 	// secondsPerMonth = 30.4375 * 24 * 60 * 60 = 2629800
 	// blocksPerYear = 2629800 * 12 / 0.20s (5BPS) = 157788000
-	var blocksPerYear = uint64(31557600 / c.targetTimePerBlock[blockVersion-1].Seconds())
+	var blocksPerYear = uint64(secondsPerYear / c.targetTimePerBlock[blockVersion-1].Seconds())
 	// var blocksPerYear = uint64(31557600)
 	// Note that this calculation implicitly assumes that block per second = 1 (by assuming daa score diff is in second units).
 	var yearsSinceDeflationStarted uint64
 	// First year on 1 BPS
-	if blockDaaScore >= 31557600 {
+	if blockDaaScore >= secondsPerYear {
 		yearsSinceDeflationStarted = 1
-		blockDaaScore -= 31557600
+		blockDaaScore -= secondsPerYear
 	}
 	// Second year partly on 1 BPS, lets bloat the blockDaaScore calculation for those blocks to 5 BPS
-	nocturneHfScore := uint64(43334184 - 31557600)
+	nocturneHfScore := uint64(nocturneHFDAAScore - secondsPerYear)
 	if blockDaaScore >= nocturneHfScore {
 		blockDaaScore += nocturneHfScore * 4
 	}
 
-	yearsSinceDeflationStarted += (blockDaaScore - c.deflationaryPhaseDaaScore) / blocksPerYear
-
-	// Return the pre-calculated value from subsidy-per-month table
-	return c.getDeflationaryPeriodBlockSubsidyFromTable(yearsSinceDeflationStarted, blockVersion)
+	daaScoresSinceDeflationStarted := blockDaaScore - c.deflationaryPhaseDaaScore
+	yearsSinceDeflationStarted += daaScoresSinceDeflationStarted / blocksPerYear
+	return yearsSinceDeflationStarted, blocksPerYear - daaScoresSinceDeflationStarted%blocksPerYear
 }
 
 func (c *coinbaseManager) getDeflationaryPeriodBlockSubsidyFromTable(year uint64, blockVersion uint16) uint64 {
@@ -417,6 +411,10 @@ func New(
 	deflationaryPhaseBaseSubsidy uint64,
 	defaultdeflationaryPhaseCurveFactor float64,
 	targetTimePerBlock []time.Duration,
+	devFeeScriptPublicKey *externalapi.ScriptPublicKey,
+	devFeePercentage uint64,
+	devFeeActivationDAAScore uint64,
+	powScores []uint64,
 	dagTraversalManager model.DAGTraversalManager,
 	ghostdagDataStore model.GHOSTDAGDataStore,
 	acceptanceDataStore model.AcceptanceDataStore,
@@ -436,6 +434,10 @@ func New(
 		deflationaryPhaseBaseSubsidy:            deflationaryPhaseBaseSubsidy,
 		deflationaryPhaseCurveFactor:            defaultdeflationaryPhaseCurveFactor,
 		targetTimePerBlock:                      targetTimePerBlock,
+		devFeeScriptPublicKey:                   devFeeScriptPublicKey,
+		devFeePercentage:                        devFeePercentage,
+		devFeeActivationDAAScore:                devFeeActivationDAAScore,
+		powScores:                               powScores,
 
 		dagTraversalManager: dagTraversalManager,
 		ghostdagDataStore:   ghostdagDataStore,
@@ -26,6 +26,10 @@ func TestCalcDeflationaryPeriodBlockSubsidy(t *testing.T) {
 		deflationaryPhaseCurveFactor,
 		dagconfig.MainnetParams.TargetTimePerBlock,
 		nil,
+		dagconfig.MainnetParams.DevFeePercentage,
+		dagconfig.MainnetParams.DevFeeActivationDAAScore,
+		dagconfig.MainnetParams.POWScores,
+		nil,
 		nil,
 		nil,
 		nil,
@@ -112,6 +116,10 @@ func TestBuildSubsidyTable(t *testing.T) {
 		deflationaryPhaseCurveFactor,
 		dagconfig.MainnetParams.TargetTimePerBlock,
 		nil,
+		dagconfig.MainnetParams.DevFeePercentage,
+		dagconfig.MainnetParams.DevFeeActivationDAAScore,
+		dagconfig.MainnetParams.POWScores,
+		nil,
 		nil,
 		nil,
 		nil,
@@ -141,3 +149,164 @@ func TestBuildSubsidyTable(t *testing.T) {
 	len := len(subsidyTable)
 	t.Logf("Length: %d", len)
 }
+
+func TestEmissionInfo(t *testing.T) {
+	params := &dagconfig.MainnetParams
+	coinbaseManagerInterface := New(
+		nil,
+		params.SubsidyGenesisReward,
+		params.PreDeflationaryPhaseBaseSubsidy,
+		params.CoinbasePayloadScriptPublicKeyMaxLength,
+		params.GenesisHash,
+		params.DeflationaryPhaseDaaScore,
+		params.DeflationaryPhaseBaseSubsidy,
+		params.DeflationaryPhaseCurveFactor,
+		params.TargetTimePerBlock,
+		nil,
+		params.DevFeePercentage,
+		params.DevFeeActivationDAAScore,
+		params.POWScores,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil)
+	coinbaseManagerInstance := coinbaseManagerInterface.(*coinbaseManager)
+
+	// Every period of the schedule must agree with the subsidy calculation on both of its ends
+	schedule := coinbaseManagerInstance.subsidySchedule()
+	for i, period := range schedule {
+		if subsidy := coinbaseManagerInstance.subsidyAtDAAScore(period.startDAAScore); subsidy != period.subsidy {
+			t.Fatalf("period #%d starts at DAA score %d with subsidy %d, but the subsidy there is %d",
+				i, period.startDAAScore, period.subsidy, subsidy)
+		}
+		if i == 0 {
+			continue
+		}
+		previousPeriod := schedule[i-1]
+		if subsidy := coinbaseManagerInstance.subsidyAtDAAScore(period.startDAAScore - 1); subsidy != previousPeriod.subsidy {
+			t.Fatalf("period #%d ends at DAA score %d with subsidy %d, but the subsidy there is %d",
+				i-1, period.startDAAScore-1, previousPeriod.subsidy, subsidy)
+		}
+	}
+	lastPeriod := schedule[len(schedule)-1]
+	if lastPeriod.subsidy != 0 {
+		t.Fatalf("the subsidy of the last period is %d and not 0", lastPeriod.subsidy)
+	}
+
+	emissionInfo := coinbaseManagerInstance.EmissionInfo(params.POWScores[3], []uint64{0, lastPeriod.startDAAScore * 2})
+	if emissionInfo.CurrentSubsidy.BlockVersion != 5 {
+		t.Fatalf("expected block version 5 at the last POW score, got %d", emissionInfo.CurrentSubsidy.BlockVersion)
+	}
+	if emissionInfo.NextReduction == nil || emissionInfo.NextReduction.DAAScore <= params.POWScores[3] ||
+		emissionInfo.NextReduction.Subsidy >= emissionInfo.CurrentSubsidy.Subsidy {
+
+		t.Fatalf("unexpected next reduction %+v", emissionInfo.NextReduction)
+	}
+	if emissionInfo.Subsidies[0].Subsidy != params.PreDeflationaryPhaseBaseSubsidy || emissionInfo.Subsidies[0].EmittedSompi != 0 {
+		t.Fatalf("unexpected subsidy at DAA score 0: %+v", emissionInfo.Subsidies[0])
+	}
+	if emissionInfo.Subsidies[1].Subsidy != 0 || emissionInfo.Subsidies[1].EmittedSompi != emissionInfo.ProjectedTotalSupply {
+		t.Fatalf("unexpected subsidy after the subsidy runs out: %+v", emissionInfo.Subsidies[1])
+	}
+	if emissionInfo.ProjectedTotalSupply > constants.MaxSompi {
+		t.Fatalf("the projected total supply %d is above the maximum supply %d", emissionInfo.ProjectedTotalSupply, constants.MaxSompi)
+	}
+}
+
+func TestIsDevFeeActive(t *testing.T) {
+	tests := []struct {
+		name                     string
+		devFeeActivationDAAScore uint64
+		blockVersion             uint16
+		daaScore                 uint64
+		expectedIsDevFeeActive   bool
+	}{
+		{
+			name:                     "block version 1",
+			devFeeActivationDAAScore: 0,
+			blockVersion:             1,
+			daaScore:                 1000,
+			expectedIsDevFeeActive:   false,
+		},
+		{
+			name:                     "no activation DAA score",
+			devFeeActivationDAAScore: 0,
+			blockVersion:             2,
+			daaScore:                 0,
+			expectedIsDevFeeActive:   true,
+		},
+		{
+			name:                     "below the activation DAA score",
+			devFeeActivationDAAScore: 1000,
+			blockVersion:             5,
+			daaScore:                 999,
+			expectedIsDevFeeActive:   false,
+		},
+		{
+			name:                     "at the activation DAA score",
+			devFeeActivationDAAScore: 1000,
+			blockVersion:             5,
+			daaScore:                 1000,
+			expectedIsDevFeeActive:   true,
+		},
+		{
+			name:                     "block version 1 above the activation DAA score",
+			devFeeActivationDAAScore: 1000,
+			blockVersion:             1,
+			daaScore:                 2000,
+			expectedIsDevFeeActive:   false,
+		},
+	}
+
+	for _, test := range tests {
+		coinbaseManagerInstance := &coinbaseManager{devFeeActivationDAAScore: test.devFeeActivationDAAScore}
+		isDevFeeActive := coinbaseManagerInstance.IsDevFeeActive(test.blockVersion, test.daaScore)
+		if isDevFeeActive != test.expectedIsDevFeeActive {
+			t.Errorf("%s: expected IsDevFeeActive to be %t, got %t", test.name, test.expectedIsDevFeeActive, isDevFeeActive)
+		}
+	}
+}
+
+func TestSplitReward(t *testing.T) {
+	devFeeScriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{1, 2, 3}, Version: 0}
+	minerScriptPublicKey := &externalapi.ScriptPublicKey{Script: []byte{4, 5, 6}, Version: 0}
+	coinbaseManagerInstance := &coinbaseManager{
+		devFeeScriptPublicKey: devFeeScriptPublicKey,
+		devFeePercentage:      5,
+	}
+
+	minerReward, devFee := coinbaseManagerInstance.splitReward(1000, true)
+	if minerReward != 950 || devFee != 50 {
+		t.Fatalf("expected a split of 950 and 50 when the dev fee is active, got %d and %d", minerReward, devFee)
+	}
+	outputs := coinbaseManagerInstance.rewardOutputs(&externalapi.CoinbaseReward{
+		ScriptPublicKey: minerScriptPublicKey,
+		MinerReward:     minerReward,
+		DevFee:          devFee,
+	}, true)
+	if len(outputs) != 2 {
+		t.Fatalf("expected 2 outputs when the dev fee is active, got %d", len(outputs))
+	}
+	if outputs[0].Value != 950 || !outputs[0].ScriptPublicKey.Equal(minerScriptPublicKey) {
+		t.Fatalf("unexpected miner output %+v", outputs[0])
+	}
+	if outputs[1].Value != 50 || !outputs[1].ScriptPublicKey.Equal(devFeeScriptPublicKey) {
+		t.Fatalf("unexpected dev fee output %+v", outputs[1])
+	}
+
+	minerReward, devFee = coinbaseManagerInstance.splitReward(1000, false)
+	if minerReward != 1000 || devFee != 0 {
+		t.Fatalf("expected a split of 1000 and 0 when the dev fee isn't active, got %d and %d", minerReward, devFee)
+	}
+	outputs = coinbaseManagerInstance.rewardOutputs(&externalapi.CoinbaseReward{
+		ScriptPublicKey: minerScriptPublicKey,
+		MinerReward:     minerReward,
+		DevFee:          devFee,
+	}, false)
+	if len(outputs) != 1 || outputs[0].Value != 1000 {
+		t.Fatalf("expected a single output of 1000 when the dev fee isn't active, got %+v", outputs)
+	}
+}
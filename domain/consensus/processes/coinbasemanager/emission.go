@@ -0,0 +1,139 @@
+package coinbasemanager
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+)
+
+// subsidyPeriod is a range of DAA scores, lasting until the start of the next period, during which
+// the block subsidy doesn't change
+type subsidyPeriod struct {
+	startDAAScore uint64
+	subsidy       uint64
+	// emittedSompi is the amount of sompi created by the subsidies of all the DAA scores before startDAAScore
+	emittedSompi uint64
+}
+
+// EmissionInfo returns the emission schedule as seen from the given virtual DAA score, along with the
+// block subsidies at the given DAA scores
+func (c *coinbaseManager) EmissionInfo(virtualDAAScore uint64, daaScores []uint64) *externalapi.EmissionInfo {
+	schedule := c.subsidySchedule()
+
+	currentPeriodIndex := subsidyPeriodIndex(schedule, virtualDAAScore)
+	emissionInfo := &externalapi.EmissionInfo{
+		VirtualDAAScore: virtualDAAScore,
+		CurrentSubsidy:  c.blockSubsidy(schedule, virtualDAAScore),
+		Subsidies:       make([]*externalapi.BlockSubsidy, len(daaScores)),
+	}
+	for _, period := range schedule[currentPeriodIndex+1:] {
+		if period.subsidy < schedule[currentPeriodIndex].subsidy {
+			emissionInfo.NextReduction = c.blockSubsidy(schedule, period.startDAAScore)
+			break
+		}
+	}
+	for i, daaScore := range daaScores {
+		emissionInfo.Subsidies[i] = c.blockSubsidy(schedule, daaScore)
+	}
+
+	// The subsidy of the last period is 0, so nothing is emitted after it starts
+	emissionInfo.ProjectedTotalSupply = schedule[len(schedule)-1].emittedSompi
+
+	return emissionInfo
+}
+
+// subsidySchedule returns the periods of the emission schedule, ordered by their DAA scores.
+// The first period starts at DAA score 0, and the last one never ends. The special reward of
+// the genesis block is not a part of the schedule.
+func (c *coinbaseManager) subsidySchedule() []*subsidyPeriod {
+	schedule := []*subsidyPeriod{{startDAAScore: 0, subsidy: c.subsidyAtDAAScore(0)}}
+	for daaScore := uint64(0); ; {
+		nextDAAScore, ok := c.nextPossibleSubsidyChange(daaScore)
+		if !ok {
+			return schedule
+		}
+		daaScore = nextDAAScore
+
+		lastPeriod := schedule[len(schedule)-1]
+		subsidy := c.subsidyAtDAAScore(daaScore)
+		if subsidy == lastPeriod.subsidy {
+			continue
+		}
+		schedule = append(schedule, &subsidyPeriod{
+			startDAAScore: daaScore,
+			subsidy:       subsidy,
+			emittedSompi:  lastPeriod.emittedSompi + (daaScore-lastPeriod.startDAAScore)*lastPeriod.subsidy,
+		})
+	}
+}
+
+// nextPossibleSubsidyChange returns the first DAA score after the given one at which the subsidy may
+// change. It returns false if the subsidy never changes after the given DAA score.
+func (c *coinbaseManager) nextPossibleSubsidyChange(daaScore uint64) (uint64, bool) {
+	next := uint64(math.MaxUint64)
+	found := false
+	consider := func(candidate uint64) {
+		if candidate > daaScore && candidate <= next {
+			next = candidate
+			found = true
+		}
+	}
+
+	// The DAA scores at which the way deflationaryYear counts years changes
+	consider(c.deflationaryPhaseDaaScore)
+	consider(nocturneHFDAAScore - secondsPerYear)
+	consider(secondsPerYear)
+	consider(secondsPerYear + c.deflationaryPhaseDaaScore)
+	consider(nocturneHFDAAScore)
+	for _, powScore := range c.powScores {
+		consider(powScore)
+	}
+
+	if daaScore >= c.deflationaryPhaseDaaScore {
+		year, daaScoresLeftInYear := c.deflationaryYear(daaScore, c.blockVersionAtDAAScore(daaScore))
+		isLastYearInTable := year >= uint64(len(subsidyByDeflationaryYearTable)-1)
+		if !isLastYearInTable && daaScoresLeftInYear <= math.MaxUint64-daaScore {
+			consider(daaScore + daaScoresLeftInYear)
+		}
+	}
+
+	return next, found
+}
+
+// subsidyAtDAAScore returns the subsidy of a non-genesis block with the given DAA score
+func (c *coinbaseManager) subsidyAtDAAScore(daaScore uint64) uint64 {
+	if daaScore < c.deflationaryPhaseDaaScore {
+		return c.preDeflationaryPhaseBaseSubsidy
+	}
+	return c.calcDeflationaryPeriodBlockSubsidy(daaScore, c.blockVersionAtDAAScore(daaScore))
+}
+
+// blockVersionAtDAAScore returns the version of the blocks with the given DAA score, the same way the
+// block builder sets it
+func (c *coinbaseManager) blockVersionAtDAAScore(daaScore uint64) uint16 {
+	var blockVersion uint16 = 1
+	for _, powScore := range c.powScores {
+		if daaScore >= powScore {
+			blockVersion++
+		}
+	}
+	return blockVersion
+}
+
+func (c *coinbaseManager) blockSubsidy(schedule []*subsidyPeriod, daaScore uint64) *externalapi.BlockSubsidy {
+	period := schedule[subsidyPeriodIndex(schedule, daaScore)]
+	return &externalapi.BlockSubsidy{
+		DAAScore:     daaScore,
+		BlockVersion: c.blockVersionAtDAAScore(daaScore),
+		Subsidy:      period.subsidy,
+		EmittedSompi: period.emittedSompi + (daaScore-period.startDAAScore)*period.subsidy,
+	}
+}
+
+// subsidyPeriodIndex returns the index of the period the given DAA score is in
+func subsidyPeriodIndex(schedule []*subsidyPeriod, daaScore uint64) int {
+	return sort.Search(len(schedule), func(i int) bool {
+		return schedule[i].startDAAScore > daaScore
+	}) - 1
+}
@@ -187,6 +187,11 @@ func (csm *consensusStateManager) applyMergeSetBlocks(stagingArea *model.Staging
 	}
 	log.Tracef("The past median time for block %s is: %d", blockHash, selectedParentMedianTime)
 
+	isAssumedValid, err := csm.transactionValidator.IsInPastOfAssumeValidBlock(stagingArea, blockHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	multiblockAcceptanceData := make(externalapi.AcceptanceData, len(mergeSetBlocks))
 	accumulatedUTXODiff := selectedParentPastUTXODiff.CloneMutable()
 	accumulatedMass := uint64(0)
@@ -204,7 +209,7 @@ func (csm *consensusStateManager) applyMergeSetBlocks(stagingArea *model.Staging
 			var isAccepted bool
 
 			isAccepted, accumulatedMass, err = csm.maybeAcceptTransaction(stagingArea, transaction, blockHash,
-				isSelectedParent, accumulatedUTXODiff, accumulatedMass, selectedParentMedianTime, daaScore)
+				isSelectedParent, accumulatedUTXODiff, accumulatedMass, selectedParentMedianTime, daaScore, isAssumedValid)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -233,7 +238,7 @@ func (csm *consensusStateManager) applyMergeSetBlocks(stagingArea *model.Staging
 func (csm *consensusStateManager) maybeAcceptTransaction(stagingArea *model.StagingArea,
 	transaction *externalapi.DomainTransaction, blockHash *externalapi.DomainHash, isSelectedParent bool,
 	accumulatedUTXODiff externalapi.MutableUTXODiff, accumulatedMassBefore uint64, selectedParentPastMedianTime int64,
-	blockDAAScore uint64) (isAccepted bool, accumulatedMassAfter uint64, err error) {
+	blockDAAScore uint64, isAssumedValid bool) (isAccepted bool, accumulatedMassAfter uint64, err error) {
 
 	transactionID := consensushashing.TransactionID(transaction)
 	log.Tracef("maybeAcceptTransaction start for transaction %s in block %s", transactionID, blockHash)
@@ -261,7 +266,7 @@ func (csm *consensusStateManager) maybeAcceptTransaction(stagingArea *model.Stag
 	} else {
 		log.Tracef("Validating transaction %s in block %s", transactionID, blockHash)
 		err = csm.transactionValidator.ValidateTransactionInContextAndPopulateFee(
-			stagingArea, transaction, blockHash, blockDAAScore)
+			stagingArea, transaction, blockHash, blockDAAScore, isAssumedValid)
 		if err != nil {
 			if !errors.As(err, &(ruleerrors.RuleError{})) {
 				return false, 0, err
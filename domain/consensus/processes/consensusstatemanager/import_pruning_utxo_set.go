@@ -97,6 +97,10 @@ func (csm *consensusStateManager) importPruningPointUTXOSet(stagingArea *model.S
 	log.Tracef("The past median time of pruning block %s is %d",
 		newPruningPoint, newPruningPointSelectedParentMedianTime)
 
+	isAssumedValid, err := csm.transactionValidator.IsInPastOfAssumeValidBlock(stagingArea, newPruningPoint)
+	if err != nil {
+		return err
+	}
 	for i, transaction := range newPruningPointBlock.Transactions {
 		transactionID := consensushashing.TransactionID(transaction)
 		log.Tracef("Validating transaction %s in pruning block %s against "+
@@ -107,7 +111,7 @@ func (csm *consensusStateManager) importPruningPointUTXOSet(stagingArea *model.S
 		}
 		log.Tracef("Validating transaction %s and populating it with mass and fee", transactionID)
 		err = csm.transactionValidator.ValidateTransactionInContextAndPopulateFee(
-			stagingArea, transaction, newPruningPoint, newPruningPointBlock.Header.DAAScore())
+			stagingArea, transaction, newPruningPoint, newPruningPointBlock.Header.DAAScore(), isAssumedValid)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,388 @@
+package consensusstatemanager
+
+import (
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/hashset"
+	"github.com/Hoosat-Oy/HTND/infrastructure/logger"
+	"github.com/pkg/errors"
+)
+
+// InvalidateBlock marks the given block and every block in its future as invalid, and removes them
+// from the DAG tips. The virtual isn't updated: UpdateVirtualAfterTipsChange should be called with
+// the same staging area. Returns the blocks that were invalidated
+func (csm *consensusStateManager) InvalidateBlock(stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash) ([]*externalapi.DomainHash, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "csm.InvalidateBlock")
+	defer onEnd()
+
+	if blockHash.Equal(csm.genesisHash) {
+		return nil, errors.Errorf("the genesis block cannot be invalidated")
+	}
+
+	// Reorganizing below the finality point (or the pruning point, which is always below it)
+	// is not possible, so blocks in their past can't be invalidated
+	finalityPoint, err := csm.finalityManager.VirtualFinalityPoint(stagingArea)
+	if err != nil {
+		return nil, err
+	}
+	pruningPoint, err := csm.pruningStore.PruningPoint(csm.databaseContext, stagingArea)
+	if err != nil {
+		return nil, err
+	}
+	for _, point := range []*externalapi.DomainHash{finalityPoint, pruningPoint} {
+		isInPastOfPoint, err := csm.dagTopologyManager.IsAncestorOf(stagingArea, blockHash, point)
+		if err != nil {
+			return nil, err
+		}
+		if isInPastOfPoint {
+			return nil, errors.Errorf("block %s cannot be invalidated because it's not in the future "+
+				"of the finality point %s", blockHash, point)
+		}
+	}
+
+	invalidatedBlocks, err := csm.blockAndItsFuture(stagingArea, blockHash, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, invalidatedBlock := range invalidatedBlocks.ToSlice() {
+		csm.blockStatusStore.Stage(stagingArea, invalidatedBlock, externalapi.StatusInvalid)
+	}
+
+	// The parents of the invalidated blocks become tips if all of their children were invalidated
+	tips, err := csm.consensusStateStore.Tips(stagingArea, csm.databaseContext)
+	if err != nil {
+		return nil, err
+	}
+	newTips := hashset.New()
+	for _, tip := range tips {
+		if !invalidatedBlocks.Contains(tip) {
+			newTips.Add(tip)
+		}
+	}
+	for _, invalidatedBlock := range invalidatedBlocks.ToSlice() {
+		parents, err := csm.dagTopologyManager.Parents(stagingArea, invalidatedBlock)
+		if err != nil {
+			return nil, err
+		}
+		for _, parent := range parents {
+			if parent.Equal(model.VirtualGenesisBlockHash) || invalidatedBlocks.Contains(parent) || newTips.Contains(parent) {
+				continue
+			}
+			hasBody, err := csm.blockStore.HasBlock(csm.databaseContext, stagingArea, parent)
+			if err != nil {
+				return nil, err
+			}
+			if !hasBody {
+				continue
+			}
+			hasValidChildren, err := csm.hasChildWithBodyOutside(stagingArea, parent, invalidatedBlocks)
+			if err != nil {
+				return nil, err
+			}
+			if !hasValidChildren {
+				newTips.Add(parent)
+			}
+		}
+	}
+	csm.consensusStateStore.StageTips(stagingArea, newTips.ToSlice())
+
+	log.Infof("Invalidated block %s and %d blocks in its future", blockHash, invalidatedBlocks.Length()-1)
+	return invalidatedBlocks.ToSlice(), nil
+}
+
+// ReconsiderBlock removes the invalid status that was set by InvalidateBlock from the given block, from
+// the invalidated blocks in its past, and from the future of all of them. The reconsidered blocks are
+// returned to the DAG tips and their UTXO state is verified again by the next virtual resolution.
+// Returns the blocks that were reconsidered
+func (csm *consensusStateManager) ReconsiderBlock(stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash) ([]*externalapi.DomainHash, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "csm.ReconsiderBlock")
+	defer onEnd()
+
+	status, err := csm.blockStatusStore.Get(csm.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if status != externalapi.StatusInvalid {
+		return nil, errors.Errorf("block %s is not invalid", blockHash)
+	}
+	// Blocks that failed validation are never added to the DAG, so only blocks
+	// that were invalidated by InvalidateBlock have relations
+	isInDAG, err := csm.blockRelationStore.Has(csm.databaseContext, stagingArea, blockHash)
+	if err != nil {
+		return nil, err
+	}
+	if !isInDAG {
+		return nil, errors.Errorf("block %s failed validation and cannot be reconsidered", blockHash)
+	}
+
+	// Collect the invalidated blocks in the past of the given block, since the given block
+	// can't be valid as long as any of them is invalid
+	invalidatedAncestors := hashset.New()
+	invalidatedAncestors.Add(blockHash)
+	queue := []*externalapi.DomainHash{blockHash}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		parents, err := csm.dagTopologyManager.Parents(stagingArea, current)
+		if err != nil {
+			return nil, err
+		}
+		for _, parent := range parents {
+			if parent.Equal(model.VirtualGenesisBlockHash) || invalidatedAncestors.Contains(parent) {
+				continue
+			}
+			parentStatus, err := csm.blockStatusStore.Get(csm.databaseContext, stagingArea, parent)
+			if err != nil {
+				return nil, err
+			}
+			if parentStatus == externalapi.StatusInvalid {
+				invalidatedAncestors.Add(parent)
+				queue = append(queue, parent)
+			}
+		}
+	}
+
+	reconsideredBlocks := hashset.New()
+	for _, invalidatedAncestor := range invalidatedAncestors.ToSlice() {
+		_, err := csm.blockAndItsFuture(stagingArea, invalidatedAncestor, reconsideredBlocks)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A reconsidered block that becomes the virtual selected parent again mustn't keep the UTXO diff child
+	// it got when it was invalidated. So each reconsidered block that has a diff child is made a root of
+	// the UTXO diff paths in advance, with a diff from the virtual, which keeps the past UTXO of every
+	// block whose diff path goes through it unchanged
+	rootedUTXODiffs := make(map[externalapi.DomainHash]externalapi.UTXODiff)
+	for _, reconsideredBlock := range reconsideredBlocks.ToSlice() {
+		hasUTXODiffChild, err := csm.utxoDiffStore.HasUTXODiffChild(csm.databaseContext, stagingArea, reconsideredBlock)
+		if err != nil {
+			return nil, err
+		}
+		if !hasUTXODiffChild {
+			continue
+		}
+		pastUTXO, err := csm.restorePastUTXO(stagingArea, reconsideredBlock)
+		if err != nil {
+			return nil, err
+		}
+		rootedUTXODiffs[*reconsideredBlock] = pastUTXO
+	}
+	for blockHash, pastUTXO := range rootedUTXODiffs {
+		blockHash := blockHash
+		csm.stageDiff(stagingArea, &blockHash, pastUTXO, nil)
+		csm.utxoDiffStore.ClearUTXODiffChild(stagingArea, &blockHash)
+	}
+
+	tips, err := csm.consensusStateStore.Tips(stagingArea, csm.databaseContext)
+	if err != nil {
+		return nil, err
+	}
+	newTips := hashset.New()
+	for _, tip := range tips {
+		newTips.Add(tip)
+	}
+	for _, reconsideredBlock := range reconsideredBlocks.ToSlice() {
+		hasBody, err := csm.blockStore.HasBlock(csm.databaseContext, stagingArea, reconsideredBlock)
+		if err != nil {
+			return nil, err
+		}
+		if !hasBody {
+			csm.blockStatusStore.Stage(stagingArea, reconsideredBlock, externalapi.StatusHeaderOnly)
+			continue
+		}
+		csm.blockStatusStore.Stage(stagingArea, reconsideredBlock, externalapi.StatusUTXOPendingVerification)
+
+		hasChildrenWithBody, err := csm.hasChildWithBodyOutside(stagingArea, reconsideredBlock, hashset.New())
+		if err != nil {
+			return nil, err
+		}
+		if !hasChildrenWithBody {
+			newTips.Add(reconsideredBlock)
+		}
+		parents, err := csm.dagTopologyManager.Parents(stagingArea, reconsideredBlock)
+		if err != nil {
+			return nil, err
+		}
+		for _, parent := range parents {
+			newTips.Remove(parent)
+		}
+	}
+	csm.consensusStateStore.StageTips(stagingArea, newTips.ToSlice())
+
+	log.Infof("Reconsidered %d blocks", reconsideredBlocks.Length())
+	return reconsideredBlocks.ToSlice(), nil
+}
+
+// blockAndItsFuture adds the given block and all the blocks in its future into the given set,
+// or into a new set if it's nil, and returns the set
+func (csm *consensusStateManager) blockAndItsFuture(stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash, blocks hashset.HashSet) (hashset.HashSet, error) {
+
+	if blocks == nil {
+		blocks = hashset.New()
+	}
+	if blocks.Contains(blockHash) {
+		return blocks, nil
+	}
+	blocks.Add(blockHash)
+	queue := []*externalapi.DomainHash{blockHash}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		children, err := csm.dagTopologyManager.Children(stagingArea, current)
+		if err != nil {
+			return nil, err
+		}
+		for _, child := range children {
+			if child.Equal(model.VirtualBlockHash) || blocks.Contains(child) {
+				continue
+			}
+			blocks.Add(child)
+			queue = append(queue, child)
+		}
+	}
+	return blocks, nil
+}
+
+// hasChildWithBodyOutside returns whether the given block has a child with a body that isn't in the given set
+func (csm *consensusStateManager) hasChildWithBodyOutside(stagingArea *model.StagingArea,
+	blockHash *externalapi.DomainHash, excluded hashset.HashSet) (bool, error) {
+
+	children, err := csm.dagTopologyManager.Children(stagingArea, blockHash)
+	if err != nil {
+		return false, err
+	}
+	for _, child := range children {
+		if child.Equal(model.VirtualBlockHash) || excluded.Contains(child) {
+			continue
+		}
+		hasBody, err := csm.blockStore.HasBlock(csm.databaseContext, stagingArea, child)
+		if err != nil {
+			return false, err
+		}
+		if hasBody {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// UpdateVirtualAfterTipsChange updates the virtual after InvalidateBlock or ReconsiderBlock staged a change
+// of the DAG tips in the given staging area, so that all of them are committed together. If the best tip is
+// already UTXO-verified the virtual is completely resolved to it. Otherwise, the virtual is only moved off
+// invalidated blocks, and ResolveVirtual should be called until it's completely resolved
+func (csm *consensusStateManager) UpdateVirtualAfterTipsChange(stagingArea *model.StagingArea) (
+	*externalapi.VirtualChangeSet, bool, error) {
+
+	onEnd := logger.LogAndMeasureExecutionTime(log, "csm.UpdateVirtualAfterTipsChange")
+	defer onEnd()
+
+	previousVirtualSelectedParent, err := csm.virtualSelectedParent(stagingArea)
+	if err != nil {
+		return nil, false, err
+	}
+	pendingTip, pendingTipStatus, err := csm.findNextPendingTip(stagingArea)
+	if err != nil {
+		return nil, false, err
+	}
+	if pendingTip == nil {
+		return nil, false, errors.Errorf("none of the DAG tips are valid")
+	}
+
+	var newVirtualSelectedParent *externalapi.DomainHash
+	isCompletelyResolved := pendingTipStatus == externalapi.StatusUTXOValid
+	if isCompletelyResolved {
+		newVirtualSelectedParent = pendingTip
+	} else {
+		previousVirtualSelectedParentStatus, err := csm.blockStatusStore.Get(
+			csm.databaseContext, stagingArea, previousVirtualSelectedParent)
+		if err != nil {
+			return nil, false, err
+		}
+		if previousVirtualSelectedParentStatus != externalapi.StatusInvalid {
+			return nil, false, nil
+		}
+
+		// ResolveVirtual compares the pending tip with the virtual selected parent, so
+		// the latter is first moved to the highest chain block that wasn't invalidated
+		newVirtualSelectedParent = previousVirtualSelectedParent
+		for {
+			status, err := csm.blockStatusStore.Get(csm.databaseContext, stagingArea, newVirtualSelectedParent)
+			if err != nil {
+				return nil, false, err
+			}
+			if status != externalapi.StatusInvalid {
+				break
+			}
+			ghostdagData, err := csm.ghostdagDataStore.Get(csm.databaseContext, stagingArea, newVirtualSelectedParent, false)
+			if err != nil {
+				return nil, false, err
+			}
+			newVirtualSelectedParent = ghostdagData.SelectedParent()
+		}
+	}
+
+	if !newVirtualSelectedParent.Equal(previousVirtualSelectedParent) {
+		// Make the new virtual selected parent the root of the UTXO diff paths,
+		// the same way resolveSingleBlockStatus does for a new selected tip
+		previousVirtualSelectedParentUTXOSet, err := csm.restorePastUTXO(stagingArea, previousVirtualSelectedParent)
+		if err != nil {
+			return nil, false, err
+		}
+		newVirtualSelectedParentUTXOSet, err := csm.restorePastUTXO(stagingArea, newVirtualSelectedParent)
+		if err != nil {
+			return nil, false, err
+		}
+		updatedPreviousVirtualSelectedParentUTXOSet, err :=
+			newVirtualSelectedParentUTXOSet.DiffFrom(previousVirtualSelectedParentUTXOSet)
+		if err != nil {
+			return nil, false, err
+		}
+		csm.stageDiff(stagingArea, previousVirtualSelectedParent,
+			updatedPreviousVirtualSelectedParentUTXOSet, newVirtualSelectedParent)
+		csm.stageDiff(stagingArea, newVirtualSelectedParent, newVirtualSelectedParentUTXOSet, nil)
+		// The new virtual selected parent was an ancestor of the previous one, so it still has
+		// the diff child that pointed towards it
+		csm.utxoDiffStore.ClearUTXODiffChild(stagingArea, newVirtualSelectedParent)
+	}
+
+	virtualParents := []*externalapi.DomainHash{newVirtualSelectedParent}
+	if isCompletelyResolved {
+		lowerTips, err := csm.getGHOSTDAGLowerTips(stagingArea, newVirtualSelectedParent)
+		if err != nil {
+			return nil, false, err
+		}
+		virtualParents, err = csm.pickVirtualParents(stagingArea, lowerTips)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	virtualUTXODiff, err := csm.updateVirtualWithParents(stagingArea, virtualParents)
+	if err != nil {
+		return nil, false, err
+	}
+	selectedParentChainChanges, err := csm.dagTraversalManager.
+		CalculateChainPath(stagingArea, previousVirtualSelectedParent, newVirtualSelectedParent)
+	if err != nil {
+		return nil, false, err
+	}
+	virtualParentsOutcome, err := csm.dagTopologyManager.Parents(stagingArea, model.VirtualBlockHash)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &externalapi.VirtualChangeSet{
+		VirtualSelectedParentChainChanges: selectedParentChainChanges,
+		VirtualUTXODiff:                   virtualUTXODiff,
+		VirtualParents:                    virtualParentsOutcome,
+	}, isCompletelyResolved, nil
+}
@@ -186,7 +186,7 @@ func (csm *consensusStateManager) selectVirtualSelectedParent(stagingArea *model
 			if err != nil {
 				return 0, err
 			}
-			if childStatus == externalapi.StatusHeaderOnly {
+			if childStatus == externalapi.StatusHeaderOnly || childStatus == externalapi.StatusInvalid {
 				continue
 			}
 			count++
@@ -72,6 +72,11 @@ func (csm *consensusStateManager) validateBlockTransactionsAgainstPastUTXO(stagi
 	}
 	log.Tracef("The past median time of %s is %d", blockHash, selectedParentMedianTime)
 
+	isAssumedValid, err := csm.transactionValidator.IsInPastOfAssumeValidBlock(stagingArea, blockHash)
+	if err != nil {
+		return err
+	}
+
 	var wg sync.WaitGroup
 	var mu sync.Mutex
 	var stagingMu sync.Mutex
@@ -113,7 +118,7 @@ func (csm *consensusStateManager) validateBlockTransactionsAgainstPastUTXO(stagi
 
 			// Validate transaction
 			err = csm.transactionValidator.ValidateTransactionInContextAndPopulateFee(
-				stagingArea, tx, blockHash, block.Header.DAAScore())
+				stagingArea, tx, blockHash, block.Header.DAAScore(), isAssumedValid)
 			if err != nil {
 				mu.Lock()
 				if firstErr == nil {
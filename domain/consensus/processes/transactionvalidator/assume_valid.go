@@ -0,0 +1,51 @@
+package transactionvalidator
+
+import (
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+)
+
+// IsInPastOfAssumeValidBlock returns whether the scripts of the transactions accepted by the given
+// block may be assumed valid. That's the case only if the given block is the assume-valid block or
+// in its past, and the assume-valid block is in the selected chain of the headers selected tip.
+// Until the node learns of the assume-valid block, or if it's on a chain the node doesn't follow,
+// all scripts are verified. It's the same for all the transactions validated from the point of view
+// of the given block, so callers compute it once per block and pass it to
+// ValidateTransactionInContextAndPopulateFee
+func (v *transactionValidator) IsInPastOfAssumeValidBlock(stagingArea *model.StagingArea,
+	povBlockHash *externalapi.DomainHash) (bool, error) {
+
+	if v.assumeValidBlockHash == nil || povBlockHash.Equal(model.VirtualBlockHash) {
+		return false, nil
+	}
+
+	exists, err := v.blockStatusStore.Exists(v.databaseContext, stagingArea, v.assumeValidBlockHash)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, nil
+	}
+	status, err := v.blockStatusStore.Get(v.databaseContext, stagingArea, v.assumeValidBlockHash)
+	if err != nil {
+		return false, err
+	}
+	if status == externalapi.StatusInvalid {
+		return false, nil
+	}
+
+	headersSelectedTip, err := v.headersSelectedTipStore.HeadersSelectedTip(v.databaseContext, stagingArea)
+	if err != nil {
+		return false, err
+	}
+	isInHeadersSelectedChain, err := v.dagTopologyManager.IsInSelectedParentChainOf(stagingArea,
+		v.assumeValidBlockHash, headersSelectedTip)
+	if err != nil {
+		return false, err
+	}
+	if !isInHeadersSelectedChain {
+		return false, nil
+	}
+
+	return v.dagTopologyManager.IsAncestorOf(stagingArea, povBlockHash, v.assumeValidBlockHash)
+}
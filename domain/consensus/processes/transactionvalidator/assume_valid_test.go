@@ -0,0 +1,117 @@
+package transactionvalidator_test
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionhelper"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+)
+
+func TestAssumeValid(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, tearDown, err := factory.NewTestConsensus(consensusConfig, "TestAssumeValid")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer tearDown(false)
+
+		addBlock := func(parentHashes []*externalapi.DomainHash, transactions ...*externalapi.DomainTransaction) *externalapi.DomainHash {
+			blockHash, _, err := tc.AddBlock(parentHashes, nil, transactions)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			return blockHash
+		}
+
+		// Build genesis <- A1 <- A2 <- A3, where A3 contains a transaction with an invalid
+		// signature script, which disqualifies it from the selected chain
+		a1Hash := addBlock([]*externalapi.DomainHash{consensusConfig.GenesisHash})
+		a2Hash := addBlock([]*externalapi.DomainHash{a1Hash})
+		a2, _, err := tc.GetBlock(a2Hash)
+		if err != nil {
+			t.Fatalf("GetBlock: %+v", err)
+		}
+		invalidTransaction, err := testutils.CreateTransaction(a2.Transactions[transactionhelper.CoinbaseTransactionIndex], 1)
+		if err != nil {
+			t.Fatalf("CreateTransaction: %+v", err)
+		}
+		invalidTransaction.Inputs[0].SignatureScript, err = txscript.PayToScriptHashSignatureScript([]byte{txscript.OpFalse}, nil)
+		if err != nil {
+			t.Fatalf("PayToScriptHashSignatureScript: %+v", err)
+		}
+		a3Hash := addBlock([]*externalapi.DomainHash{a2Hash}, invalidTransaction)
+		blocks := make([]*externalapi.DomainBlock, 0, 3)
+		for _, blockHash := range []*externalapi.DomainHash{a1Hash, a2Hash, a3Hash} {
+			block, _, err := tc.GetBlock(blockHash)
+			if err != nil {
+				t.Fatalf("GetBlock: %+v", err)
+			}
+			blocks = append(blocks, block)
+		}
+
+		unknownBlockHash := externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{0xff})
+		tests := []struct {
+			name                 string
+			assumeValidBlockHash *externalapi.DomainHash
+			expectedStatus       externalapi.BlockStatus
+		}{
+			{
+				name:                 "no assume-valid block",
+				assumeValidBlockHash: nil,
+				expectedStatus:       externalapi.StatusDisqualifiedFromChain,
+			},
+			{
+				name:                 "unknown assume-valid block",
+				assumeValidBlockHash: unknownBlockHash,
+				expectedStatus:       externalapi.StatusDisqualifiedFromChain,
+			},
+			{
+				name:                 "assume-valid block that contains the invalid transaction",
+				assumeValidBlockHash: a3Hash,
+				expectedStatus:       externalapi.StatusUTXOValid,
+			},
+			{
+				name:                 "assume-valid block in the past of the invalid transaction",
+				assumeValidBlockHash: a2Hash,
+				expectedStatus:       externalapi.StatusDisqualifiedFromChain,
+			},
+		}
+		for _, test := range tests {
+			assumeValidConfig := *consensusConfig
+			assumeValidConfig.AssumeValidBlockHash = test.assumeValidBlockHash
+			syncee, tearDownSyncee, err := factory.NewTestConsensus(&assumeValidConfig, "TestAssumeValidSyncee")
+			if err != nil {
+				t.Fatalf("%s: Error setting up consensus: %+v", test.name, err)
+			}
+
+			// Sync the headers first and the bodies afterwards, the same way IBD does
+			for _, block := range blocks {
+				err := syncee.ValidateAndInsertBlock(&externalapi.DomainBlock{Header: block.Header}, false, false)
+				if err != nil {
+					t.Fatalf("%s: ValidateAndInsertBlock: %+v", test.name, err)
+				}
+			}
+			for _, block := range blocks {
+				err := syncee.ValidateAndInsertBlock(block, true, false)
+				if err != nil {
+					t.Fatalf("%s: ValidateAndInsertBlock: %+v", test.name, err)
+				}
+			}
+
+			status, err := syncee.BlockStatusStore().Get(syncee.DatabaseContext(), model.NewStagingArea(), a3Hash)
+			if err != nil {
+				t.Fatalf("%s: BlockStatusStore.Get: %+v", test.name, err)
+			}
+			if status != test.expectedStatus {
+				t.Fatalf("%s: expected block A3 to have status %s, got %s", test.name, test.expectedStatus, status)
+			}
+			tearDownSyncee(false)
+		}
+	})
+}
@@ -2,6 +2,7 @@ package transactionvalidator
 
 import (
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
 	"github.com/Hoosat-Oy/HTND/util/txmass"
 )
@@ -20,6 +21,10 @@ type transactionValidator struct {
 	maxCoinbasePayloadLength                uint64
 	MergeSetSizeLimit                       uint64
 	coinbasePayloadScriptPublicKeyMaxLength uint8
+	assumeValidBlockHash                    *externalapi.DomainHash
+	dagTopologyManager                      model.DAGTopologyManager
+	blockStatusStore                        model.BlockStatusStore
+	headersSelectedTipStore                 model.HeaderSelectedTipStore
 	sigCache                                *txscript.SigCache
 	sigCacheECDSA                           *txscript.SigCacheECDSA
 	txMassCalculator                        *txmass.Calculator
@@ -31,10 +36,14 @@ func New(blockCoinbaseMaturity uint64,
 	maxCoinbasePayloadLength uint64,
 	MergeSetSizeLimit uint64,
 	coinbasePayloadScriptPublicKeyMaxLength uint8,
+	assumeValidBlockHash *externalapi.DomainHash,
 	databaseContext model.DBReader,
 	pastMedianTimeManager model.PastMedianTimeManager,
 	ghostdagDataStore model.GHOSTDAGDataStore,
 	daaBlocksStore model.DAABlocksStore,
+	dagTopologyManager model.DAGTopologyManager,
+	blockStatusStore model.BlockStatusStore,
+	headersSelectedTipStore model.HeaderSelectedTipStore,
 	txMassCalculator *txmass.Calculator) model.TransactionValidator {
 
 	return &transactionValidator{
@@ -43,10 +52,14 @@ func New(blockCoinbaseMaturity uint64,
 		maxCoinbasePayloadLength:                maxCoinbasePayloadLength,
 		MergeSetSizeLimit:                       MergeSetSizeLimit,
 		coinbasePayloadScriptPublicKeyMaxLength: coinbasePayloadScriptPublicKeyMaxLength,
+		assumeValidBlockHash:                    assumeValidBlockHash,
 		databaseContext:                         databaseContext,
 		pastMedianTimeManager:                   pastMedianTimeManager,
 		ghostdagDataStore:                       ghostdagDataStore,
 		daaBlocksStore:                          daaBlocksStore,
+		dagTopologyManager:                      dagTopologyManager,
+		blockStatusStore:                        blockStatusStore,
+		headersSelectedTipStore:                 headersSelectedTipStore,
 		sigCache:                                txscript.NewSigCache(sigCacheSize),
 		sigCacheECDSA:                           txscript.NewSigCacheECDSA(sigCacheSize),
 		txMassCalculator:                        txMassCalculator,
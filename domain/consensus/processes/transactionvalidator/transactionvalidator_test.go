@@ -265,7 +265,7 @@ func TestValidateTransactionInContextAndPopulateFee(t *testing.T) {
 		}
 
 		for _, test := range tests {
-			err := tc.TransactionValidator().ValidateTransactionInContextAndPopulateFee(stagingArea, test.tx, test.povBlockHash, daaScore)
+			err := tc.TransactionValidator().ValidateTransactionInContextAndPopulateFee(stagingArea, test.tx, test.povBlockHash, daaScore, false)
 
 			if test.isValid {
 				if err != nil {
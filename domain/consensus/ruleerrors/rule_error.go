@@ -163,6 +163,10 @@ var (
 	// already failed validation.
 	ErrInvalidAncestorBlock = newRuleError("ErrInvalidAncestorBlock")
 
+	// ErrInvalidatedAncestorBlock indicates that an ancestor of this block was
+	// invalidated by the node operator, and may still be reconsidered.
+	ErrInvalidatedAncestorBlock = newRuleError("ErrInvalidatedAncestorBlock")
+
 	// ErrTransactionsNotSorted indicates that transactions in block are not
 	// sorted by subnetwork
 	ErrTransactionsNotSorted = newRuleError("ErrTransactionsNotSorted")
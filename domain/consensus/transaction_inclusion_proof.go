@@ -0,0 +1,291 @@
+package consensus
+
+import (
+	"sort"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/merkle"
+	"github.com/Hoosat-Oy/HTND/infrastructure/db/database"
+	"github.com/pkg/errors"
+)
+
+// GetTransactionInclusionProof builds a proof that the transaction with the given ID, which is contained in
+// the given block, was accepted by the selected chain. The proof is linked to the given selected chain block,
+// or to the accepting block itself if targetChainBlockHash is nil. Building the proof fails if linking it to
+// the target block takes more than maxChainPathLength chain block headers
+func (s *consensus) GetTransactionInclusionProof(transactionID *externalapi.DomainTransactionID,
+	includingBlockHash *externalapi.DomainHash, targetChainBlockHash *externalapi.DomainHash,
+	maxChainPathLength uint64) (*externalapi.TransactionInclusionProof, error) {
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	stagingArea := model.NewStagingArea()
+
+	err := s.validateBlockHashExists(stagingArea, includingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	includingBlock, err := s.blockStore.Block(s.databaseContext, stagingArea, includingBlockHash)
+	if database.IsNotFoundError(err) {
+		return nil, errors.Errorf("the body of block %s is not available", includingBlockHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	transactionIndex := -1
+	for i, transaction := range includingBlock.Transactions {
+		if consensushashing.TransactionID(transaction).Equal(transactionID) {
+			transactionIndex = i
+			break
+		}
+	}
+	if transactionIndex == -1 {
+		return nil, errors.Errorf("transaction %s is not in block %s", transactionID, includingBlockHash)
+	}
+	hashMerkleBranch, err := merkle.CalculateHashMerkleBranch(includingBlock.Transactions, transactionIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptingBlockHash, chainPathHashes, err := s.acceptingChainBlock(stagingArea, includingBlockHash,
+		targetChainBlockHash, maxChainPathLength)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptingBlockHeader, err := s.blockHeaderStore.BlockHeader(s.databaseContext, stagingArea, acceptingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	acceptanceData, err := s.acceptanceDataStore.Get(s.databaseContext, stagingArea, acceptingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	acceptedIDMerkleBranch, err := acceptedIDMerkleBranch(acceptanceData, transactionID,
+		acceptingBlockHeader.AcceptedIDMerkleRoot())
+	if err != nil {
+		return nil, errors.Wrapf(err, "transaction %s wasn't accepted by chain block %s", transactionID, acceptingBlockHash)
+	}
+
+	mergeSetPathHashes, err := s.mergeSetPath(stagingArea, includingBlockHash, acceptingBlockHash)
+	if err != nil {
+		return nil, err
+	}
+	mergeSetPath, err := s.blockHeaderStore.BlockHeaders(s.databaseContext, stagingArea, mergeSetPathHashes)
+	if err != nil {
+		return nil, err
+	}
+	chainPath, err := s.blockHeaderStore.BlockHeaders(s.databaseContext, stagingArea, chainPathHashes)
+	if err != nil {
+		return nil, err
+	}
+	chainPathOtherParents, err := s.chainPathOtherParents(stagingArea, chainPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &externalapi.TransactionInclusionProof{
+		IncludingBlockHeader:   includingBlock.Header,
+		HashMerkleBranch:       hashMerkleBranch,
+		MergeSetPath:           mergeSetPath,
+		AcceptingBlockHeader:   acceptingBlockHeader,
+		AcceptedIDMerkleBranch: acceptedIDMerkleBranch,
+		ChainPath:              chainPath,
+		ChainPathOtherParents:  chainPathOtherParents,
+	}, nil
+}
+
+// chainPathOtherParents returns, for every header in the given chain path, the headers of its direct
+// parents other than its selected parent
+func (s *consensus) chainPathOtherParents(stagingArea *model.StagingArea, chainPath []externalapi.BlockHeader) (
+	[][]externalapi.BlockHeader, error) {
+
+	chainPathOtherParents := make([][]externalapi.BlockHeader, len(chainPath))
+	for i, header := range chainPath {
+		ghostdagData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea,
+			consensushashing.HeaderHash(header), false)
+		if err != nil {
+			return nil, err
+		}
+		var otherParentHashes []*externalapi.DomainHash
+		for _, parent := range header.DirectParents() {
+			if !parent.Equal(ghostdagData.SelectedParent()) {
+				otherParentHashes = append(otherParentHashes, parent)
+			}
+		}
+		chainPathOtherParents[i], err = s.blockHeaderStore.BlockHeaders(s.databaseContext, stagingArea, otherParentHashes)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return chainPathOtherParents, nil
+}
+
+// acceptingChainBlock walks down the selected chain from the target block, or from the virtual selected parent if
+// there's no target block, to the chain block whose merge set contains the given block. It returns that block
+// along with the chain blocks that follow it up to the target block, ordered from the lowest one
+func (s *consensus) acceptingChainBlock(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash,
+	targetChainBlockHash *externalapi.DomainHash, maxChainPathLength uint64) (
+	*externalapi.DomainHash, []*externalapi.DomainHash, error) {
+
+	virtualGHOSTDAGData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, model.VirtualBlockHash, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	chainTip := virtualGHOSTDAGData.SelectedParent()
+	if targetChainBlockHash != nil {
+		err := s.validateBlockHashExists(stagingArea, targetChainBlockHash)
+		if err != nil {
+			return nil, nil, err
+		}
+		isChainBlock, err := s.dagTopologyManagers[0].IsInSelectedParentChainOf(stagingArea, targetChainBlockHash, chainTip)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isChainBlock {
+			return nil, nil, errors.Errorf("block %s is not in the selected parent chain", targetChainBlockHash)
+		}
+		chainTip = targetChainBlockHash
+	}
+
+	isInPast, err := s.dagTopologyManagers[0].IsAncestorOf(stagingArea, blockHash, chainTip)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !isInPast || blockHash.Equal(chainTip) {
+		return nil, nil, errors.Errorf("block %s is not in the past of chain block %s, so its transactions "+
+			"weren't accepted by it yet", blockHash, chainTip)
+	}
+
+	var chainPathHashes []*externalapi.DomainHash
+	acceptingBlockHash := chainTip
+	for {
+		ghostdagData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, acceptingBlockHash, false)
+		if err != nil {
+			return nil, nil, err
+		}
+		selectedParent := ghostdagData.SelectedParent()
+		if selectedParent.Equal(blockHash) {
+			break
+		}
+		isInSelectedParentPast, err := s.dagTopologyManagers[0].IsAncestorOf(stagingArea, blockHash, selectedParent)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !isInSelectedParentPast {
+			break
+		}
+
+		if targetChainBlockHash != nil {
+			if uint64(len(chainPathHashes)) == maxChainPathLength {
+				return nil, nil, errors.Errorf("chain block %s is more than %d chain blocks away from the chain "+
+					"block that accepted block %s", targetChainBlockHash, maxChainPathLength, blockHash)
+			}
+			chainPathHashes = append(chainPathHashes, acceptingBlockHash)
+		}
+		acceptingBlockHash = selectedParent
+	}
+
+	for i, j := 0, len(chainPathHashes)-1; i < j; i, j = i+1, j-1 {
+		chainPathHashes[i], chainPathHashes[j] = chainPathHashes[j], chainPathHashes[i]
+	}
+	return acceptingBlockHash, chainPathHashes, nil
+}
+
+// mergeSetPath returns a path of direct parent links from the given block up to the chain block whose merge set
+// contains it. The returned blocks are the ones between the two, ordered from the child of the given block. The
+// path never leaves the merge set, so its length is bounded by the merge set size limit
+func (s *consensus) mergeSetPath(stagingArea *model.StagingArea, blockHash *externalapi.DomainHash,
+	acceptingBlockHash *externalapi.DomainHash) ([]*externalapi.DomainHash, error) {
+
+	ghostdagData, err := s.ghostdagDataStores[0].Get(s.databaseContext, stagingArea, acceptingBlockHash, false)
+	if err != nil {
+		return nil, err
+	}
+	mergeSet := make(map[externalapi.DomainHash]struct{})
+	for _, mergeSetBlockHash := range append(ghostdagData.MergeSetBlues(), ghostdagData.MergeSetReds()...) {
+		mergeSet[*mergeSetBlockHash] = struct{}{}
+	}
+
+	// Search breadth first from the accepting block down to the given block, while
+	// remembering the child that every block was reached from
+	reachedFrom := make(map[externalapi.DomainHash]*externalapi.DomainHash)
+	queue := []*externalapi.DomainHash{acceptingBlockHash}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		parents, err := s.dagTopologyManagers[0].Parents(stagingArea, current)
+		if err != nil {
+			return nil, err
+		}
+		for _, parent := range parents {
+			if _, ok := mergeSet[*parent]; !ok {
+				continue
+			}
+			if _, ok := reachedFrom[*parent]; ok {
+				continue
+			}
+			reachedFrom[*parent] = current
+
+			if parent.Equal(blockHash) {
+				var path []*externalapi.DomainHash
+				for hash := current; !hash.Equal(acceptingBlockHash); hash = reachedFrom[*hash] {
+					path = append(path, hash)
+				}
+				return path, nil
+			}
+			queue = append(queue, parent)
+		}
+	}
+
+	return nil, errors.Errorf("block %s is not in the merge set of block %s", blockHash, acceptingBlockHash)
+}
+
+// acceptedIDMerkleBranch builds the merkle branch of the given transaction ID to the accepted ID merkle root of
+// the block that the given acceptance data belongs to. Depending on the block version, the accepted ID merkle
+// root commits to the accepted transactions either in their acceptance order or sorted by their IDs, so the
+// branch is built for the order that matches the given root
+func acceptedIDMerkleBranch(acceptanceData externalapi.AcceptanceData, transactionID *externalapi.DomainTransactionID,
+	acceptedIDMerkleRoot *externalapi.DomainHash) (*externalapi.MerkleBranch, error) {
+
+	var acceptedTransactions []*externalapi.DomainTransaction
+	for _, blockAcceptanceData := range acceptanceData {
+		for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+			if transactionAcceptanceData.IsAccepted {
+				acceptedTransactions = append(acceptedTransactions, transactionAcceptanceData.Transaction)
+			}
+		}
+	}
+	sortedAcceptedTransactions := make([]*externalapi.DomainTransaction, len(acceptedTransactions))
+	copy(sortedAcceptedTransactions, acceptedTransactions)
+	sort.Slice(sortedAcceptedTransactions, func(i, j int) bool {
+		return consensushashing.TransactionID(sortedAcceptedTransactions[i]).Less(
+			consensushashing.TransactionID(sortedAcceptedTransactions[j]))
+	})
+
+	for _, transactions := range [][]*externalapi.DomainTransaction{acceptedTransactions, sortedAcceptedTransactions} {
+		transactionIndex := -1
+		for i, transaction := range transactions {
+			if consensushashing.TransactionID(transaction).Equal(transactionID) {
+				transactionIndex = i
+				break
+			}
+		}
+		if transactionIndex == -1 {
+			return nil, errors.Errorf("transaction %s is not among the accepted transactions", transactionID)
+		}
+
+		branch, err := merkle.CalculateIDMerkleBranch(transactions, transactionIndex)
+		if err != nil {
+			return nil, err
+		}
+		if merkle.MerkleBranchRoot((*externalapi.DomainHash)(transactionID), branch).Equal(acceptedIDMerkleRoot) {
+			return branch, nil
+		}
+	}
+	return nil, errors.Errorf("the accepted transactions don't match the accepted ID merkle root %s", acceptedIDMerkleRoot)
+}
@@ -0,0 +1,218 @@
+package consensus_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/inclusionproof"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/merkle"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionhelper"
+)
+
+func TestTransactionInclusionProof(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestTransactionInclusionProof")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		addBlock := func(parentHashes []*externalapi.DomainHash, transactions ...*externalapi.DomainTransaction) *externalapi.DomainHash {
+			blockHash, _, err := tc.AddBlock(parentHashes, nil, transactions)
+			if err != nil {
+				t.Fatalf("AddBlock: %+v", err)
+			}
+			return blockHash
+		}
+		spendCoinbaseOf := func(blockHash *externalapi.DomainHash) *externalapi.DomainTransaction {
+			block, _, err := tc.GetBlock(blockHash)
+			if err != nil {
+				t.Fatalf("GetBlock: %+v", err)
+			}
+			transaction, err := testutils.CreateTransaction(block.Transactions[transactionhelper.CoinbaseTransactionIndex], 1)
+			if err != nil {
+				t.Fatalf("CreateTransaction: %+v", err)
+			}
+			return transaction
+		}
+
+		// Build the following DAG, in which C selects A6 as its selected parent and merges B1 and B2:
+		// genesis <- A1 <- A2 <- A3 <- A4 <- A5 <- A6 <- C <- D <- E
+		//                         A3 <- B1 <- B2 <------- C
+		// B1 contains transaction1, A5 contains transaction2 and E contains transaction3
+		genesisHash := consensusConfig.GenesisHash
+		a1Hash := addBlock([]*externalapi.DomainHash{genesisHash})
+		a2Hash := addBlock([]*externalapi.DomainHash{a1Hash})
+		a3Hash := addBlock([]*externalapi.DomainHash{a2Hash})
+		transaction1 := spendCoinbaseOf(a3Hash)
+		b1Hash := addBlock([]*externalapi.DomainHash{a3Hash}, transaction1)
+		b2Hash := addBlock([]*externalapi.DomainHash{b1Hash})
+		a4Hash := addBlock([]*externalapi.DomainHash{a3Hash})
+		transaction2 := spendCoinbaseOf(a4Hash)
+		a5Hash := addBlock([]*externalapi.DomainHash{a4Hash}, transaction2)
+		a6Hash := addBlock([]*externalapi.DomainHash{a5Hash})
+		cHash := addBlock([]*externalapi.DomainHash{a6Hash, b2Hash})
+		dHash := addBlock([]*externalapi.DomainHash{cHash})
+		transaction3 := spendCoinbaseOf(a5Hash)
+		eHash := addBlock([]*externalapi.DomainHash{dHash}, transaction3)
+
+		tests := []struct {
+			name                     string
+			transaction              *externalapi.DomainTransaction
+			otherTransaction         *externalapi.DomainTransaction
+			includingBlockHash       *externalapi.DomainHash
+			targetChainBlockHash     *externalapi.DomainHash
+			expectedAcceptingBlock   *externalapi.DomainHash
+			expectedMergeSetPathSize int
+			expectedChainPathSize    int
+		}{
+			{
+				name:                     "merged block linked to a later chain block",
+				transaction:              transaction1,
+				otherTransaction:         transaction2,
+				includingBlockHash:       b1Hash,
+				targetChainBlockHash:     eHash,
+				expectedAcceptingBlock:   cHash,
+				expectedMergeSetPathSize: 1,
+				expectedChainPathSize:    2,
+			},
+			{
+				name:                     "merged block linked to the accepting block",
+				transaction:              transaction1,
+				otherTransaction:         transaction2,
+				includingBlockHash:       b1Hash,
+				targetChainBlockHash:     nil,
+				expectedAcceptingBlock:   cHash,
+				expectedMergeSetPathSize: 1,
+				expectedChainPathSize:    0,
+			},
+			{
+				name:                     "selected parent",
+				transaction:              transaction2,
+				otherTransaction:         transaction1,
+				includingBlockHash:       a5Hash,
+				targetChainBlockHash:     dHash,
+				expectedAcceptingBlock:   a6Hash,
+				expectedMergeSetPathSize: 0,
+				expectedChainPathSize:    2,
+			},
+		}
+		for _, test := range tests {
+			transactionID := consensushashing.TransactionID(test.transaction)
+			proof, err := tc.GetTransactionInclusionProof(transactionID, test.includingBlockHash, test.targetChainBlockHash, 10)
+			if err != nil {
+				t.Fatalf("%s: GetTransactionInclusionProof: %+v", test.name, err)
+			}
+
+			acceptingBlockHash := consensushashing.HeaderHash(proof.AcceptingBlockHeader)
+			if !acceptingBlockHash.Equal(test.expectedAcceptingBlock) {
+				t.Fatalf("%s: expected accepting block %s, got %s", test.name, test.expectedAcceptingBlock, acceptingBlockHash)
+			}
+			if len(proof.MergeSetPath) != test.expectedMergeSetPathSize {
+				t.Fatalf("%s: expected a merge set path of %d blocks, got %d", test.name,
+					test.expectedMergeSetPathSize, len(proof.MergeSetPath))
+			}
+			if len(proof.ChainPath) != test.expectedChainPathSize {
+				t.Fatalf("%s: expected a chain path of %d blocks, got %d", test.name,
+					test.expectedChainPathSize, len(proof.ChainPath))
+			}
+
+			targetChainBlockHash := test.targetChainBlockHash
+			if targetChainBlockHash == nil {
+				targetChainBlockHash = test.expectedAcceptingBlock
+			}
+			err = inclusionproof.Verify(test.transaction, proof, targetChainBlockHash)
+			if err != nil {
+				t.Fatalf("%s: Verify: %+v", test.name, err)
+			}
+
+			err = inclusionproof.Verify(test.transaction, proof, genesisHash)
+			if !errors.Is(err, inclusionproof.ErrInvalidProof) {
+				t.Fatalf("%s: expected ErrInvalidProof for a wrong target block, got %+v", test.name, err)
+			}
+			err = inclusionproof.Verify(test.otherTransaction, proof, targetChainBlockHash)
+			if !errors.Is(err, inclusionproof.ErrInvalidProof) {
+				t.Fatalf("%s: expected ErrInvalidProof for a different transaction, got %+v", test.name, err)
+			}
+		}
+
+		// B2 accepted transaction1 as well, but it isn't a chain block. A proof that links it to C as if it
+		// was C's selected parent is rejected, since C's other parent A6 has a higher blue work
+		transaction1ID := consensushashing.TransactionID(transaction1)
+		proof, err := tc.GetTransactionInclusionProof(transaction1ID, b1Hash, eHash, 10)
+		if err != nil {
+			t.Fatalf("GetTransactionInclusionProof: %+v", err)
+		}
+		getHeader := func(blockHash *externalapi.DomainHash) externalapi.BlockHeader {
+			header, err := tc.GetBlockHeader(blockHash)
+			if err != nil {
+				t.Fatalf("GetBlockHeader: %+v", err)
+			}
+			return header
+		}
+		b2Header := getHeader(b2Hash)
+		b2AcceptanceData, err := tc.GetBlockAcceptanceData(b2Hash)
+		if err != nil {
+			t.Fatalf("GetBlockAcceptanceData: %+v", err)
+		}
+		var b2AcceptedTransactions []*externalapi.DomainTransaction
+		for _, blockAcceptanceData := range b2AcceptanceData {
+			for _, transactionAcceptanceData := range blockAcceptanceData.TransactionAcceptanceData {
+				if transactionAcceptanceData.IsAccepted {
+					b2AcceptedTransactions = append(b2AcceptedTransactions, transactionAcceptanceData.Transaction)
+				}
+			}
+		}
+		sort.Slice(b2AcceptedTransactions, func(i, j int) bool {
+			return consensushashing.TransactionID(b2AcceptedTransactions[i]).Less(
+				consensushashing.TransactionID(b2AcceptedTransactions[j]))
+		})
+		var b2AcceptedIDMerkleBranch *externalapi.MerkleBranch
+		for i, transaction := range b2AcceptedTransactions {
+			if !consensushashing.TransactionID(transaction).Equal(transaction1ID) {
+				continue
+			}
+			b2AcceptedIDMerkleBranch, err = merkle.CalculateIDMerkleBranch(b2AcceptedTransactions, i)
+			if err != nil {
+				t.Fatalf("CalculateIDMerkleBranch: %+v", err)
+			}
+		}
+		if b2AcceptedIDMerkleBranch == nil || !merkle.MerkleBranchRoot((*externalapi.DomainHash)(transaction1ID),
+			b2AcceptedIDMerkleBranch).Equal(b2Header.AcceptedIDMerkleRoot()) {
+			t.Fatalf("transaction1 isn't committed to by the accepted ID merkle root of B2")
+		}
+		proof.MergeSetPath = nil
+		proof.AcceptingBlockHeader = b2Header
+		proof.AcceptedIDMerkleBranch = b2AcceptedIDMerkleBranch
+		proof.ChainPath = []externalapi.BlockHeader{getHeader(cHash), getHeader(dHash), getHeader(eHash)}
+		proof.ChainPathOtherParents = [][]externalapi.BlockHeader{{getHeader(a6Hash)}, {}, {}}
+		err = inclusionproof.Verify(transaction1, proof, eHash)
+		if !errors.Is(err, inclusionproof.ErrInvalidProof) {
+			t.Fatalf("expected ErrInvalidProof for a non-chain accepting block, got %+v", err)
+		}
+		proof.ChainPathOtherParents = [][]externalapi.BlockHeader{{}, {}, {}}
+		err = inclusionproof.Verify(transaction1, proof, eHash)
+		if !errors.Is(err, inclusionproof.ErrInvalidProof) {
+			t.Fatalf("expected ErrInvalidProof for a chain path without the other parents, got %+v", err)
+		}
+
+		// The transactions of the selected tip aren't accepted by any chain block yet
+		_, err = tc.GetTransactionInclusionProof(consensushashing.TransactionID(transaction3), eHash, nil, 10)
+		if err == nil {
+			t.Fatalf("GetTransactionInclusionProof unexpectedly succeeded for a transaction that wasn't accepted")
+		}
+
+		// A target block that's too far away from the accepting block is rejected
+		_, err = tc.GetTransactionInclusionProof(consensushashing.TransactionID(transaction2), a5Hash, eHash, 2)
+		if err == nil {
+			t.Fatalf("GetTransactionInclusionProof unexpectedly succeeded for a chain path that's too long")
+		}
+	})
+}
@@ -42,10 +42,6 @@ var BannedAddresses = []string{
 }
 
 const (
-	DevFee        = 5
-	DevFeeMin     = 1
-	DevFeeAddress = "hoosat:qp4ad2eh72xc8dtjjyz4llxzq9utn6k26uyl644xxw70wskdfl85zsqj9k4vz"
-
 	// MaxTransactionVersion is the current latest supported transaction version.
 	MaxTransactionVersion uint16 = 0
 
@@ -0,0 +1,471 @@
+package dagscenario
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/testapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/ruleerrors"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/blockheader"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/transactionhelper"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/txscript"
+	"github.com/pkg/errors"
+)
+
+// Mismatch describes a statement of a scenario whose outcome differs from the one the scenario expects
+type Mismatch struct {
+	Line      int
+	Statement string
+	Expected  string
+	Actual    string
+	Details   string
+}
+
+func (m *Mismatch) String() string {
+	result := fmt.Sprintf("line %d (%s): expected %s, got %s", m.Line, m.Statement, m.Expected, m.Actual)
+	if m.Details != "" {
+		result += " (" + m.Details + ")"
+	}
+	return result
+}
+
+// wrongUTXOCommitment is the UTXO commitment of the blocks that are declared as utxoinvalid
+var wrongUTXOCommitment = externalapi.NewDomainHashFromByteArray(&[externalapi.DomainHashSize]byte{0xff})
+
+type runner struct {
+	tc           testapi.TestConsensus
+	blocks       map[string]*externalapi.DomainHash
+	blockNames   map[externalapi.DomainHash]string
+	transactions map[string]*externalapi.DomainTransaction
+
+	// outputsBeforeLastBlock holds whether the outputs referenced by the utxodiff assertions that follow
+	// the last block statement were in the virtual UTXO set before that block was added
+	outputsBeforeLastBlock map[string]bool
+
+	mismatches []*Mismatch
+}
+
+// Run plays the scenario against the given test consensus and returns the statements whose outcome
+// differs from the expected one. A block that's rejected although the scenario doesn't expect it to be
+// is reported as a mismatch as well, and ends the run, since the statements that follow might depend
+// on it. An error is returned only if the scenario couldn't be played
+func (s *Scenario) Run(tc testapi.TestConsensus) ([]*Mismatch, error) {
+	genesisHash := tc.DAGParams().GenesisHash
+	r := &runner{
+		tc:           tc,
+		blocks:       map[string]*externalapi.DomainHash{GenesisName: genesisHash},
+		blockNames:   map[externalapi.DomainHash]string{*genesisHash: GenesisName},
+		transactions: map[string]*externalapi.DomainTransaction{},
+	}
+
+	for i, statement := range s.statements {
+		switch statement.kind {
+		case statementKindBlock:
+			isRejectedUnexpectedly, err := r.runBlock(statement, s.statements[i+1:])
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d", statement.line)
+			}
+			if isRejectedUnexpectedly {
+				return r.mismatches, nil
+			}
+		case statementKindTransaction:
+			err := r.runTransaction(statement.transaction)
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d", statement.line)
+			}
+		case statementKindAssertion:
+			err := r.runAssertion(statement)
+			if err != nil {
+				return nil, errors.Wrapf(err, "line %d", statement.line)
+			}
+		}
+	}
+	return r.mismatches, nil
+}
+
+// RunTest parses the given scenario, runs it against the given test consensus, and fails
+// the test with every mismatch that was found
+func RunTest(t *testing.T, tc testapi.TestConsensus, text string) {
+	t.Helper()
+
+	scenario, err := ParseString(text)
+	if err != nil {
+		t.Fatalf("ParseString: %+v", err)
+	}
+	mismatches, err := scenario.Run(tc)
+	if err != nil {
+		t.Fatalf("Run: %+v", err)
+	}
+	for _, mismatch := range mismatches {
+		t.Errorf("%s", mismatch)
+	}
+	if len(mismatches) > 0 {
+		t.FailNow()
+	}
+}
+
+func (r *runner) addMismatch(statement *statement, expected string, actual string, details string) {
+	r.mismatches = append(r.mismatches, &Mismatch{
+		Line:      statement.line,
+		Statement: statement.text,
+		Expected:  expected,
+		Actual:    actual,
+		Details:   details,
+	})
+}
+
+func (r *runner) runBlock(statement *statement, followingStatements []*statement) (isRejectedUnexpectedly bool, err error) {
+	block := statement.block
+
+	err = r.recordOutputsBeforeBlock(followingStatements)
+	if err != nil {
+		return false, err
+	}
+
+	parentHashes := make([]*externalapi.DomainHash, len(block.parents))
+	for i, parent := range block.parents {
+		parentHash, ok := r.blocks[parent]
+		if !ok {
+			return false, errors.Errorf("block %s was rejected", parent)
+		}
+		parentHashes[i] = parentHash
+	}
+	transactions := make([]*externalapi.DomainTransaction, len(block.transactions))
+	for i, transaction := range block.transactions {
+		transactions[i] = r.transactions[transaction]
+	}
+
+	blockHash, insertErr := r.buildAndInsertBlock(block, parentHashes, transactions)
+	if block.expectedRuleError == "" {
+		if insertErr != nil {
+			r.addMismatch(statement, "the block to be added", "error", insertErr.Error())
+			return true, nil
+		}
+	} else if !isRuleError(insertErr, block.expectedRuleError) {
+		actual := "no error"
+		details := ""
+		if insertErr != nil {
+			actual = "error"
+			details = insertErr.Error()
+		}
+		r.addMismatch(statement, block.expectedRuleError, actual, details)
+	}
+
+	if blockHash != nil {
+		r.blocks[block.name] = blockHash
+		r.blockNames[*blockHash] = block.name
+	}
+	return false, nil
+}
+
+// buildAndInsertBlock returns the hash of the block if it was added to the DAG, along with
+// the error that prevented it from being added otherwise
+func (r *runner) buildAndInsertBlock(block *blockStatement, parentHashes []*externalapi.DomainHash,
+	transactions []*externalapi.DomainTransaction) (*externalapi.DomainHash, error) {
+
+	domainBlock, _, err := r.tc.BuildBlockWithParents(parentHashes, nil, transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.timeKind != timeKindNone {
+		header := domainBlock.Header.ToMutable()
+		if block.timeKind == timeKindAbsolute {
+			header.SetTimeInMilliseconds(block.time)
+		} else {
+			header.SetTimeInMilliseconds(header.TimeInMilliseconds() + block.time)
+		}
+		domainBlock.Header = header.ToImmutable()
+	}
+	if block.isUTXOInvalid {
+		header := domainBlock.Header
+		domainBlock.Header = blockheader.NewImmutableBlockHeader(
+			header.Version(),
+			header.Parents(),
+			header.HashMerkleRoot(),
+			header.AcceptedIDMerkleRoot(),
+			wrongUTXOCommitment,
+			header.TimeInMilliseconds(),
+			header.Bits(),
+			header.Nonce(),
+			header.DAAScore(),
+			header.BlueScore(),
+			header.BlueWork(),
+			header.PruningPoint(),
+		)
+	}
+
+	err = r.tc.ValidateAndInsertBlock(domainBlock, true, true)
+	if err != nil {
+		return nil, err
+	}
+	return consensushashing.BlockHash(domainBlock), nil
+}
+
+// isRuleError returns whether err is the rule error with the given name, e.g. ErrTimeTooOld
+func isRuleError(err error, name string) bool {
+	var ruleError ruleerrors.RuleError
+	if !errors.As(err, &ruleError) {
+		return false
+	}
+	message := ruleError.Error()
+	return message == name || strings.HasPrefix(message, name+": ")
+}
+
+// recordOutputsBeforeBlock records whether each output that's referenced by the utxodiff assertions
+// that follow the current block statement is in the virtual UTXO set
+func (r *runner) recordOutputsBeforeBlock(followingStatements []*statement) error {
+	r.outputsBeforeLastBlock = map[string]bool{}
+	for _, statement := range followingStatements {
+		if statement.kind == statementKindBlock {
+			break
+		}
+		if statement.kind != statementKindAssertion || statement.assertion.kind != assertionKindUTXODiff {
+			continue
+		}
+		for _, names := range [][]string{statement.assertion.present, statement.assertion.absent} {
+			for _, name := range names {
+				isInVirtualUTXOSet, err := r.isInVirtualUTXOSet(name)
+				if err != nil {
+					return err
+				}
+				r.outputsBeforeLastBlock[name] = isInVirtualUTXOSet
+			}
+		}
+	}
+	return nil
+}
+
+func (r *runner) runTransaction(transaction *transactionStatement) error {
+	var transactionToSpend *externalapi.DomainTransaction
+	if blockHash, ok := r.blocks[transaction.spends]; ok {
+		block, _, err := r.tc.GetBlock(blockHash)
+		if err != nil {
+			return err
+		}
+		transactionToSpend = block.Transactions[transactionhelper.CoinbaseTransactionIndex]
+	} else if spentTransaction, ok := r.transactions[transaction.spends]; ok {
+		transactionToSpend = spentTransaction
+	} else {
+		return errors.Errorf("block %s was rejected", transaction.spends)
+	}
+	if len(transactionToSpend.Outputs) == 0 {
+		return errors.Errorf("%s has no outputs to spend", transaction.spends)
+	}
+
+	domainTransaction, err := testutils.CreateTransaction(transactionToSpend, transaction.fee)
+	if err != nil {
+		return err
+	}
+	if transaction.isInvalidScript {
+		domainTransaction.Inputs[0].SignatureScript, err = txscript.PayToScriptHashSignatureScript([]byte{txscript.OpFalse}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	r.transactions[transaction.name] = domainTransaction
+	return nil
+}
+
+func (r *runner) runAssertion(statement *statement) error {
+	assertion := statement.assertion
+	switch assertion.kind {
+	case assertionKindBlues, assertionKindReds:
+		blockHash, err := r.blockHash(assertion.block)
+		if err != nil {
+			return err
+		}
+		ghostdagData, err := r.tc.GHOSTDAGDataStore().Get(r.tc.DatabaseContext(), model.NewStagingArea(), blockHash, false)
+		if err != nil {
+			return err
+		}
+		mergeSet := ghostdagData.MergeSetBlues()
+		if assertion.kind == assertionKindReds {
+			mergeSet = ghostdagData.MergeSetReds()
+		}
+		r.compareSets(statement, assertion.expected, r.names(mergeSet))
+	case assertionKindSelectedParent:
+		blockHash, err := r.blockHash(assertion.block)
+		if err != nil {
+			return err
+		}
+		ghostdagData, err := r.tc.GHOSTDAGDataStore().Get(r.tc.DatabaseContext(), model.NewStagingArea(), blockHash, false)
+		if err != nil {
+			return err
+		}
+		actual := r.name(ghostdagData.SelectedParent())
+		if actual != assertion.expected[0] {
+			r.addMismatch(statement, assertion.expected[0], actual, "")
+		}
+	case assertionKindChain:
+		selectedChainPath, err := r.tc.GetVirtualSelectedParentChainFromBlock(r.blocks[GenesisName])
+		if err != nil {
+			return err
+		}
+		actual := r.names(selectedChainPath.Added)
+		if strings.Join(actual, " ") != strings.Join(assertion.expected, " ") {
+			r.addMismatch(statement, formatNames(assertion.expected), formatNames(actual), "")
+		}
+	case assertionKindTips:
+		tips, err := r.tc.Tips()
+		if err != nil {
+			return err
+		}
+		r.compareSets(statement, assertion.expected, r.names(tips))
+	case assertionKindStatus:
+		blockHash, err := r.blockHash(assertion.block)
+		if err != nil {
+			return err
+		}
+		blockInfo, err := r.tc.GetBlockInfo(blockHash)
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(blockInfo.BlockStatus.String(), assertion.expected[0]) {
+			r.addMismatch(statement, assertion.expected[0], blockInfo.BlockStatus.String(), "")
+		}
+	case assertionKindUTXO, assertionKindUTXODiff:
+		return r.runUTXOAssertion(statement)
+	}
+	return nil
+}
+
+func (r *runner) runUTXOAssertion(statement *statement) error {
+	assertion := statement.assertion
+	var wrongOutputs []string
+	for _, expectation := range []struct {
+		names    []string
+		expected bool
+		prefix   string
+	}{
+		{names: assertion.present, expected: true, prefix: "+"},
+		{names: assertion.absent, expected: false, prefix: "-"},
+	} {
+		for _, name := range expectation.names {
+			isInVirtualUTXOSet, err := r.isInVirtualUTXOSet(name)
+			if err != nil {
+				return err
+			}
+			matches := isInVirtualUTXOSet == expectation.expected
+			if assertion.kind == assertionKindUTXODiff {
+				wasInVirtualUTXOSet := r.outputsBeforeLastBlock[name]
+				if expectation.expected {
+					matches = isInVirtualUTXOSet && !wasInVirtualUTXOSet
+				} else {
+					matches = !isInVirtualUTXOSet && wasInVirtualUTXOSet
+				}
+			}
+			if !matches {
+				wrongOutputs = append(wrongOutputs, expectation.prefix+name)
+			}
+		}
+	}
+	if len(wrongOutputs) > 0 {
+		expected := "all outputs to be in the virtual UTXO set as marked"
+		if assertion.kind == assertionKindUTXODiff {
+			expected = "all outputs to be added or removed as marked"
+		}
+		r.addMismatch(statement, expected, "mismatching outputs", strings.Join(wrongOutputs, " "))
+	}
+	return nil
+}
+
+// isInVirtualUTXOSet returns whether the output referenced by the given name is in the virtual UTXO set.
+// Outputs of blocks and transactions the scenario hasn't reached yet are never in the virtual UTXO set
+func (r *runner) isInVirtualUTXOSet(name string) (bool, error) {
+	var transactionID *externalapi.DomainTransactionID
+	if blockHash, ok := r.blocks[name]; ok {
+		block, _, err := r.tc.GetBlock(blockHash)
+		if err != nil {
+			return false, err
+		}
+		transactionID = consensushashing.TransactionID(block.Transactions[transactionhelper.CoinbaseTransactionIndex])
+	} else if transaction, ok := r.transactions[name]; ok {
+		transactionID = consensushashing.TransactionID(transaction)
+	} else {
+		return false, nil
+	}
+
+	utxoEntries, err := r.tc.GetVirtualUTXOEntries([]*externalapi.DomainOutpoint{{TransactionID: *transactionID, Index: 0}})
+	if err != nil {
+		return false, err
+	}
+	return utxoEntries[0] != nil, nil
+}
+
+func (r *runner) blockHash(name string) (*externalapi.DomainHash, error) {
+	blockHash, ok := r.blocks[name]
+	if !ok {
+		return nil, errors.Errorf("block %s was rejected", name)
+	}
+	return blockHash, nil
+}
+
+func (r *runner) name(blockHash *externalapi.DomainHash) string {
+	name, ok := r.blockNames[*blockHash]
+	if !ok {
+		return blockHash.String()
+	}
+	return name
+}
+
+func (r *runner) names(blockHashes []*externalapi.DomainHash) []string {
+	names := make([]string, len(blockHashes))
+	for i, blockHash := range blockHashes {
+		names[i] = r.name(blockHash)
+	}
+	return names
+}
+
+func (r *runner) compareSets(statement *statement, expected []string, actual []string) {
+	expectedSet := make(map[string]struct{}, len(expected))
+	for _, name := range expected {
+		expectedSet[name] = struct{}{}
+	}
+	actualSet := make(map[string]struct{}, len(actual))
+	for _, name := range actual {
+		actualSet[name] = struct{}{}
+	}
+
+	var missing, unexpected []string
+	for name := range expectedSet {
+		if _, ok := actualSet[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	for name := range actualSet {
+		if _, ok := expectedSet[name]; !ok {
+			unexpected = append(unexpected, name)
+		}
+	}
+	if len(missing) == 0 && len(unexpected) == 0 {
+		return
+	}
+
+	sort.Strings(missing)
+	sort.Strings(unexpected)
+	var details []string
+	if len(missing) > 0 {
+		details = append(details, "missing "+strings.Join(missing, " "))
+	}
+	if len(unexpected) > 0 {
+		details = append(details, "unexpected "+strings.Join(unexpected, " "))
+	}
+	r.addMismatch(statement, formatNames(sortedCopy(expected)), formatNames(sortedCopy(actual)), strings.Join(details, ", "))
+}
+
+func sortedCopy(names []string) []string {
+	sorted := append([]string(nil), names...)
+	sort.Strings(sorted)
+	return sorted
+}
+
+func formatNames(names []string) string {
+	return "[" + strings.Join(names, " ") + "]"
+}
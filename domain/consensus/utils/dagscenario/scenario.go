@@ -0,0 +1,382 @@
+// Package dagscenario implements a small text format for describing DAG scenarios in consensus tests,
+// along with a runner that plays them against a testapi.TestConsensus.
+//
+// A scenario consists of one statement per line. Empty lines and anything following a '#' are ignored.
+// The genesis block is always named "genesis". The following statements are supported:
+//
+//	block <name> parents=<block>[,<block>...] [txs=<tx>[,<tx>...]] [time=<ms>|+<ms>|-<ms>] [utxoinvalid] [reject=<rule error>]
+//	tx <name> spends=<block or tx> [fee=<sompi>] [invalidscript]
+//	assert blues <block> = [<block>...]
+//	assert reds <block> = [<block>...]
+//	assert selectedparent <block> = <block>
+//	assert chain = [<block>...]
+//	assert tips = [<block>...]
+//	assert status <block> = <status>
+//	assert utxo [+<block or tx>...] [-<block or tx>...]
+//	assert utxodiff [+<block or tx>...] [-<block or tx>...]
+//
+// A tx statement creates a transaction that spends the first output of the coinbase transaction of the
+// given block, or the first output of the given transaction. The transaction is included in every block
+// that lists it in its txs. An absolute time sets the timestamp of the block, while a relative time is
+// added to the timestamp chosen by the block builder. A block that declares reject is expected to be
+// rejected with the given rule error, for example ErrTimeTooOld. A utxoinvalid block commits to a wrong
+// UTXO set, so it passes every validation that doesn't involve the UTXO set.
+//
+// blues and reds refer to the blues and reds of the block's mergeset, where the selected parent is one
+// of the blues. chain lists the virtual selected parent chain, from the block after genesis up to the
+// virtual selected parent. utxo asserts which outputs are in the virtual UTXO set, and utxodiff asserts
+// which outputs were added to (+) or removed from (-) the virtual UTXO set by the last block statement.
+// An output is referenced by the name of its block, meaning the first output of the block's coinbase
+// transaction, or by the name of its transaction, meaning the transaction's first output.
+package dagscenario
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GenesisName is the name by which scenarios refer to the genesis block
+const GenesisName = "genesis"
+
+type statementKind int
+
+const (
+	statementKindBlock statementKind = iota
+	statementKindTransaction
+	statementKindAssertion
+)
+
+type assertionKind string
+
+const (
+	assertionKindBlues          assertionKind = "blues"
+	assertionKindReds           assertionKind = "reds"
+	assertionKindSelectedParent assertionKind = "selectedparent"
+	assertionKindChain          assertionKind = "chain"
+	assertionKindTips           assertionKind = "tips"
+	assertionKindStatus         assertionKind = "status"
+	assertionKindUTXO           assertionKind = "utxo"
+	assertionKindUTXODiff       assertionKind = "utxodiff"
+)
+
+type timeKind int
+
+const (
+	timeKindNone timeKind = iota
+	timeKindAbsolute
+	timeKindRelative
+)
+
+type blockStatement struct {
+	name              string
+	parents           []string
+	transactions      []string
+	timeKind          timeKind
+	time              int64
+	isUTXOInvalid     bool
+	expectedRuleError string
+}
+
+type transactionStatement struct {
+	name            string
+	spends          string
+	fee             uint64
+	isInvalidScript bool
+}
+
+type assertionStatement struct {
+	kind     assertionKind
+	block    string
+	expected []string
+	present  []string
+	absent   []string
+}
+
+type statement struct {
+	line        int
+	text        string
+	kind        statementKind
+	block       *blockStatement
+	transaction *transactionStatement
+	assertion   *assertionStatement
+}
+
+// Scenario is a parsed DAG scenario
+type Scenario struct {
+	statements []*statement
+}
+
+type nameKind int
+
+const (
+	nameKindBlock nameKind = iota
+	nameKindTransaction
+)
+
+type parser struct {
+	names map[string]nameKind
+}
+
+// ParseString parses the given scenario text
+func ParseString(text string) (*Scenario, error) {
+	return Parse(strings.NewReader(text))
+}
+
+// Parse parses a scenario from the given reader. Every name must be declared by a block or
+// tx statement before it's referenced
+func Parse(r io.Reader) (*Scenario, error) {
+	p := &parser{
+		names: map[string]nameKind{GenesisName: nameKindBlock},
+	}
+	scenario := &Scenario{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if commentIndex := strings.IndexByte(text, '#'); commentIndex >= 0 {
+			text = text[:commentIndex]
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+		parsedStatement, err := p.parseStatement(text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "line %d", line)
+		}
+		parsedStatement.line = line
+		parsedStatement.text = text
+		scenario.statements = append(scenario.statements, parsedStatement)
+	}
+	err := scanner.Err()
+	if err != nil {
+		return nil, err
+	}
+	return scenario, nil
+}
+
+func (p *parser) parseStatement(text string) (*statement, error) {
+	fields := strings.Fields(text)
+	switch fields[0] {
+	case "block":
+		block, err := p.parseBlock(fields[1:])
+		if err != nil {
+			return nil, err
+		}
+		return &statement{kind: statementKindBlock, block: block}, nil
+	case "tx":
+		transaction, err := p.parseTransaction(fields[1:])
+		if err != nil {
+			return nil, err
+		}
+		return &statement{kind: statementKindTransaction, transaction: transaction}, nil
+	case "assert":
+		assertion, err := p.parseAssertion(fields[1:])
+		if err != nil {
+			return nil, err
+		}
+		return &statement{kind: statementKindAssertion, assertion: assertion}, nil
+	default:
+		return nil, errors.Errorf("unknown statement %s", fields[0])
+	}
+}
+
+func (p *parser) parseBlock(fields []string) (*blockStatement, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("block name is missing")
+	}
+	block := &blockStatement{name: fields[0]}
+	for _, field := range fields[1:] {
+		key, value, hasValue := strings.Cut(field, "=")
+		switch {
+		case key == "parents" && hasValue:
+			parents, err := p.parseNames(value, nameKindBlock)
+			if err != nil {
+				return nil, err
+			}
+			block.parents = parents
+		case key == "txs" && hasValue:
+			transactions, err := p.parseNames(value, nameKindTransaction)
+			if err != nil {
+				return nil, err
+			}
+			block.transactions = transactions
+		case key == "time" && hasValue:
+			block.timeKind = timeKindAbsolute
+			if strings.HasPrefix(value, "+") || strings.HasPrefix(value, "-") {
+				block.timeKind = timeKindRelative
+			}
+			time, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid time %s", value)
+			}
+			block.time = time
+		case key == "reject" && hasValue:
+			block.expectedRuleError = value
+		case key == "utxoinvalid" && !hasValue:
+			block.isUTXOInvalid = true
+		default:
+			return nil, errors.Errorf("unknown block attribute %s", field)
+		}
+	}
+	if len(block.parents) == 0 {
+		return nil, errors.Errorf("block %s has no parents", block.name)
+	}
+	err := p.declare(block.name, nameKindBlock)
+	if err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (p *parser) parseTransaction(fields []string) (*transactionStatement, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("transaction name is missing")
+	}
+	transaction := &transactionStatement{name: fields[0], fee: 1}
+	for _, field := range fields[1:] {
+		key, value, hasValue := strings.Cut(field, "=")
+		switch {
+		case key == "spends" && hasValue:
+			_, err := p.resolve(value)
+			if err != nil {
+				return nil, err
+			}
+			transaction.spends = value
+		case key == "fee" && hasValue:
+			fee, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid fee %s", value)
+			}
+			transaction.fee = fee
+		case key == "invalidscript" && !hasValue:
+			transaction.isInvalidScript = true
+		default:
+			return nil, errors.Errorf("unknown tx attribute %s", field)
+		}
+	}
+	if transaction.spends == "" {
+		return nil, errors.Errorf("transaction %s doesn't spend anything", transaction.name)
+	}
+	err := p.declare(transaction.name, nameKindTransaction)
+	if err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+func (p *parser) parseAssertion(fields []string) (*assertionStatement, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("assertion kind is missing")
+	}
+	assertion := &assertionStatement{kind: assertionKind(fields[0])}
+	fields = fields[1:]
+	switch assertion.kind {
+	case assertionKindBlues, assertionKindReds, assertionKindSelectedParent, assertionKindStatus:
+		if len(fields) < 2 || fields[1] != "=" {
+			return nil, errors.Errorf("expected: assert %s <block> = ...", assertion.kind)
+		}
+		err := p.expect(fields[0], nameKindBlock)
+		if err != nil {
+			return nil, err
+		}
+		assertion.block = fields[0]
+		assertion.expected = fields[2:]
+		switch assertion.kind {
+		case assertionKindStatus:
+			if len(assertion.expected) != 1 {
+				return nil, errors.New("expected exactly one status")
+			}
+			return assertion, nil
+		case assertionKindSelectedParent:
+			if len(assertion.expected) != 1 {
+				return nil, errors.New("expected exactly one selected parent")
+			}
+		}
+		for _, name := range assertion.expected {
+			err := p.expect(name, nameKindBlock)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case assertionKindChain, assertionKindTips:
+		if len(fields) < 1 || fields[0] != "=" {
+			return nil, errors.Errorf("expected: assert %s = ...", assertion.kind)
+		}
+		assertion.expected = fields[1:]
+		for _, name := range assertion.expected {
+			err := p.expect(name, nameKindBlock)
+			if err != nil {
+				return nil, err
+			}
+		}
+	case assertionKindUTXO, assertionKindUTXODiff:
+		if len(fields) == 0 {
+			return nil, errors.Errorf("assert %s requires at least one output", assertion.kind)
+		}
+		for _, field := range fields {
+			if len(field) < 2 || (field[0] != '+' && field[0] != '-') {
+				return nil, errors.Errorf("expected +<name> or -<name> but got %s", field)
+			}
+			name := field[1:]
+			_, err := p.resolve(name)
+			if err != nil {
+				return nil, err
+			}
+			if field[0] == '+' {
+				assertion.present = append(assertion.present, name)
+			} else {
+				assertion.absent = append(assertion.absent, name)
+			}
+		}
+	default:
+		return nil, errors.Errorf("unknown assertion %s", assertion.kind)
+	}
+	return assertion, nil
+}
+
+func (p *parser) parseNames(value string, kind nameKind) ([]string, error) {
+	names := strings.Split(value, ",")
+	for _, name := range names {
+		err := p.expect(name, kind)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
+
+func (p *parser) declare(name string, kind nameKind) error {
+	if _, ok := p.names[name]; ok {
+		return errors.Errorf("%s is already declared", name)
+	}
+	p.names[name] = kind
+	return nil
+}
+
+func (p *parser) resolve(name string) (nameKind, error) {
+	kind, ok := p.names[name]
+	if !ok {
+		return 0, errors.Errorf("%s is not declared", name)
+	}
+	return kind, nil
+}
+
+func (p *parser) expect(name string, expectedKind nameKind) error {
+	kind, err := p.resolve(name)
+	if err != nil {
+		return err
+	}
+	if kind != expectedKind {
+		if expectedKind == nameKindBlock {
+			return errors.Errorf("%s is not a block", name)
+		}
+		return errors.Errorf("%s is not a transaction", name)
+	}
+	return nil
+}
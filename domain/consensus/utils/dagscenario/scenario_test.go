@@ -0,0 +1,119 @@
+package dagscenario_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/constants"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/dagscenario"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/testutils"
+)
+
+func TestScenarios(t *testing.T) {
+	paths, err := filepath.Glob("testdata/*.dag")
+	if err != nil {
+		t.Fatalf("Glob: %+v", err)
+	}
+	if len(paths) == 0 {
+		t.Fatalf("no scenarios were found")
+	}
+
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		consensusConfig.K[constants.GetBlockVersion()-1] = 1
+		factory := consensus.NewFactory()
+		for _, path := range paths {
+			t.Run(filepath.Base(path), func(t *testing.T) {
+				text, err := os.ReadFile(path)
+				if err != nil {
+					t.Fatalf("ReadFile: %+v", err)
+				}
+				tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestScenarios")
+				if err != nil {
+					t.Fatalf("Error setting up consensus: %+v", err)
+				}
+				defer teardown(false)
+
+				dagscenario.RunTest(t, tc, string(text))
+			})
+		}
+	})
+}
+
+func TestScenarioMismatches(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestScenarioMismatches")
+		if err != nil {
+			t.Fatalf("Error setting up consensus: %+v", err)
+		}
+		defer teardown(false)
+
+		scenario, err := dagscenario.ParseString(`
+block A1 parents=genesis
+block A2 parents=A1
+assert chain = A1 A2
+assert tips = A1
+block A3 parents=A2 reject=ErrTimeTooOld
+assert status A3 = invalid
+block A4 parents=A3 time=0
+assert tips = A4`)
+		if err != nil {
+			t.Fatalf("ParseString: %+v", err)
+		}
+		mismatches, err := scenario.Run(tc)
+		if err != nil {
+			t.Fatalf("Run: %+v", err)
+		}
+
+		// The run ends at the unexpectedly rejected A4, so the last assertion isn't reached
+		expectedMismatches := []dagscenario.Mismatch{
+			{Line: 5, Statement: "assert tips = A1", Expected: "[A1]", Actual: "[A2]", Details: "missing A1, unexpected A2"},
+			{Line: 6, Statement: "block A3 parents=A2 reject=ErrTimeTooOld", Expected: "ErrTimeTooOld", Actual: "no error"},
+			{Line: 7, Statement: "assert status A3 = invalid", Expected: "invalid", Actual: "Valid"},
+			{Line: 8, Statement: "block A4 parents=A3 time=0", Expected: "the block to be added", Actual: "error",
+				Details: "block timestamp of 0"},
+		}
+		if len(mismatches) != len(expectedMismatches) {
+			t.Fatalf("expected %d mismatches, got %d: %s", len(expectedMismatches), len(mismatches), mismatches)
+		}
+		for i, mismatch := range mismatches {
+			expected := expectedMismatches[i]
+			if mismatch.Line != expected.Line || mismatch.Statement != expected.Statement ||
+				mismatch.Expected != expected.Expected || mismatch.Actual != expected.Actual ||
+				!strings.HasPrefix(mismatch.Details, expected.Details) {
+
+				t.Fatalf("expected mismatch %d to be %+v, got %+v", i, expected, *mismatch)
+			}
+		}
+	})
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		scenario string
+	}{
+		{name: "unknown statement", scenario: "mine A1"},
+		{name: "block without parents", scenario: "block A1"},
+		{name: "undeclared parent", scenario: "block A1 parents=A0"},
+		{name: "duplicate name", scenario: "block A1 parents=genesis\nblock A1 parents=genesis"},
+		{name: "transaction as a parent", scenario: "block A1 parents=genesis\ntx T1 spends=A1\nblock A2 parents=T1"},
+		{name: "block as a transaction", scenario: "block A1 parents=genesis\nblock A2 parents=A1 txs=A1"},
+		{name: "invalid time", scenario: "block A1 parents=genesis time=now"},
+		{name: "transaction that spends nothing", scenario: "tx T1"},
+		{name: "unknown assertion", scenario: "assert dag = genesis"},
+		{name: "assertion without equals sign", scenario: "assert chain genesis"},
+		{name: "two selected parents", scenario: "block A1 parents=genesis\nassert selectedparent A1 = genesis A1"},
+		{name: "output without sign", scenario: "block A1 parents=genesis\nassert utxo A1"},
+	}
+	for _, test := range tests {
+		_, err := dagscenario.ParseString(test.scenario)
+		if err == nil {
+			t.Errorf("%s: expected a parse error", test.name)
+		}
+	}
+}
@@ -16,6 +16,7 @@ const (
 	blockDomain                   = "BlockHash"
 	heavyHashDomain               = "HeavyHash"
 	merkleBranchDomain            = "MerkleBranchHash"
+	personalMessageSigningDomain  = "PersonalMessageSigningHash"
 )
 
 // transactionSigningECDSADomainHash is a hashed version of transcationSigningECDSADomain that is used
@@ -148,3 +149,11 @@ func NewMerkleBranchHashWriter() HashWriter {
 // 	}
 // 	return HashWriter{blake}
 // }
+
+// NewPersonalMessageSigningHashWriter Returns a new HashWriter used for signing on an arbitrary message
+func NewPersonalMessageSigningHashWriter() HashWriter {
+	var fixedSizeKey [32]byte
+	copy(fixedSizeKey[:], personalMessageSigningDomain)
+	blake := blake3.New(32, fixedSizeKey[:])
+	return HashWriter{blake}
+}
@@ -0,0 +1,149 @@
+package inclusionproof
+
+import (
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/merkle"
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidProof is returned by Verify when a transaction inclusion proof doesn't prove
+// that the transaction was accepted
+var ErrInvalidProof = errors.New("invalid transaction inclusion proof")
+
+// Verify verifies that the given transaction was included in a block and accepted by the selected
+// chain, according to the given inclusion proof. Every step of the proof is linked by hashes, and
+// every block in the chain path is proven to select the block before it as its selected parent, so
+// the only thing that has to be trusted is that the given target block is a selected chain block,
+// which light clients can learn from any source they trust
+func Verify(transaction *externalapi.DomainTransaction, proof *externalapi.TransactionInclusionProof,
+	targetChainBlockHash *externalapi.DomainHash) error {
+
+	if proof == nil || proof.IncludingBlockHeader == nil || proof.HashMerkleBranch == nil ||
+		proof.AcceptingBlockHeader == nil || proof.AcceptedIDMerkleBranch == nil {
+		return errors.Wrapf(ErrInvalidProof, "the proof is incomplete")
+	}
+
+	transactionHash := consensushashing.TransactionHash(transaction)
+	if !merkle.MerkleBranchRoot(transactionHash, proof.HashMerkleBranch).Equal(proof.IncludingBlockHeader.HashMerkleRoot()) {
+		return errors.Wrapf(ErrInvalidProof, "the hash merkle branch doesn't lead from transaction hash %s "+
+			"to the hash merkle root of the including block", transactionHash)
+	}
+
+	mergeSetPath := make([]externalapi.BlockHeader, 0, len(proof.MergeSetPath)+1)
+	mergeSetPath = append(mergeSetPath, proof.MergeSetPath...)
+	mergeSetPath = append(mergeSetPath, proof.AcceptingBlockHeader)
+	linkedBlockHash, err := verifyParentLinks(consensushashing.HeaderHash(proof.IncludingBlockHeader), mergeSetPath)
+	if err != nil {
+		return errors.Wrapf(err, "the including block isn't linked to the accepting block")
+	}
+
+	transactionID := consensushashing.TransactionID(transaction)
+	acceptedIDMerkleRoot := merkle.MerkleBranchRoot((*externalapi.DomainHash)(transactionID), proof.AcceptedIDMerkleBranch)
+	if !acceptedIDMerkleRoot.Equal(proof.AcceptingBlockHeader.AcceptedIDMerkleRoot()) {
+		return errors.Wrapf(ErrInvalidProof, "the accepted ID merkle branch doesn't lead from transaction ID %s "+
+			"to the accepted ID merkle root of the accepting block", transactionID)
+	}
+
+	linkedBlockHash, err = verifySelectedParentLinks(linkedBlockHash, proof.AcceptingBlockHeader,
+		proof.ChainPath, proof.ChainPathOtherParents)
+	if err != nil {
+		return errors.Wrapf(err, "the accepting block isn't linked to the target block")
+	}
+	if !linkedBlockHash.Equal(targetChainBlockHash) {
+		return errors.Wrapf(ErrInvalidProof, "the proof is linked to block %s instead of the target block %s",
+			linkedBlockHash, targetChainBlockHash)
+	}
+	return nil
+}
+
+// verifyParentLinks verifies that every header has the block before it, starting with the block
+// with the given hash, as a direct parent. It returns the hash of the last header
+func verifyParentLinks(blockHash *externalapi.DomainHash, headers []externalapi.BlockHeader) (*externalapi.DomainHash, error) {
+	for _, header := range headers {
+		if header == nil {
+			return nil, errors.Wrapf(ErrInvalidProof, "the proof contains a missing header")
+		}
+		isDirectParent := false
+		for _, parent := range header.DirectParents() {
+			if parent.Equal(blockHash) {
+				isDirectParent = true
+				break
+			}
+		}
+		headerHash := consensushashing.HeaderHash(header)
+		if !isDirectParent {
+			return nil, errors.Wrapf(ErrInvalidProof, "block %s is not a direct parent of block %s", blockHash, headerHash)
+		}
+		blockHash = headerHash
+	}
+	return blockHash, nil
+}
+
+// verifySelectedParentLinks verifies that every header has the block before it, starting with the given
+// block, as its selected parent. The selected parent is the direct parent with the highest blue work, with
+// ties broken by the higher hash, so the headers of all the other direct parents have to be given along
+// with every header. It returns the hash of the last header
+func verifySelectedParentLinks(blockHash *externalapi.DomainHash, blockHeader externalapi.BlockHeader,
+	headers []externalapi.BlockHeader, otherParents [][]externalapi.BlockHeader) (*externalapi.DomainHash, error) {
+
+	if len(otherParents) != len(headers) {
+		return nil, errors.Wrapf(ErrInvalidProof, "the proof contains the other parents of %d chain path "+
+			"blocks instead of %d", len(otherParents), len(headers))
+	}
+	for i, header := range headers {
+		if header == nil {
+			return nil, errors.Wrapf(ErrInvalidProof, "the proof contains a missing header")
+		}
+		headerHash := consensushashing.HeaderHash(header)
+
+		otherParentHeaders := make(map[externalapi.DomainHash]externalapi.BlockHeader, len(otherParents[i]))
+		for _, otherParentHeader := range otherParents[i] {
+			if otherParentHeader == nil {
+				return nil, errors.Wrapf(ErrInvalidProof, "the proof contains a missing header")
+			}
+			otherParentHeaders[*consensushashing.HeaderHash(otherParentHeader)] = otherParentHeader
+		}
+
+		isDirectParent := false
+		for _, parent := range header.DirectParents() {
+			if parent.Equal(blockHash) {
+				isDirectParent = true
+				continue
+			}
+			otherParentHeader, ok := otherParentHeaders[*parent]
+			if !ok {
+				return nil, errors.Wrapf(ErrInvalidProof, "the header of parent %s of block %s is missing",
+					parent, headerHash)
+			}
+			delete(otherParentHeaders, *parent)
+			if !isSelectedOver(blockHash, blockHeader, parent, otherParentHeader) {
+				return nil, errors.Wrapf(ErrInvalidProof, "block %s is not the selected parent of block %s, "+
+					"since its parent %s has a higher blue work", blockHash, headerHash, parent)
+			}
+		}
+		if !isDirectParent {
+			return nil, errors.Wrapf(ErrInvalidProof, "block %s is not a direct parent of block %s", blockHash, headerHash)
+		}
+		if len(otherParentHeaders) > 0 {
+			return nil, errors.Wrapf(ErrInvalidProof, "the proof contains headers that aren't parents of block %s",
+				headerHash)
+		}
+		blockHash, blockHeader = headerHash, header
+	}
+	return blockHash, nil
+}
+
+// isSelectedOver returns whether block A would be chosen as a selected parent over block B
+func isSelectedOver(blockHashA *externalapi.DomainHash, blockHeaderA externalapi.BlockHeader,
+	blockHashB *externalapi.DomainHash, blockHeaderB externalapi.BlockHeader) bool {
+
+	switch blockHeaderA.BlueWork().Cmp(blockHeaderB.BlueWork()) {
+	case 1:
+		return true
+	case -1:
+		return false
+	default:
+		return blockHashB.Less(blockHashA)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
 	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/hashes"
+	"github.com/pkg/errors"
 )
 
 // nextPowerOfTwo returns the next highest power of two from a given number if
@@ -96,3 +97,70 @@ func merkleRoot(hashes []*externalapi.DomainHash) *externalapi.DomainHash {
 
 	return merkles[len(merkles)-1]
 }
+
+// CalculateHashMerkleBranch returns the merkle branch that proves that the hash of the transaction
+// at the given index is a leaf of the merkle tree calculated by CalculateHashMerkleRoot
+func CalculateHashMerkleBranch(transactions []*externalapi.DomainTransaction, index int) (*externalapi.MerkleBranch, error) {
+	txHashes := make([]*externalapi.DomainHash, len(transactions))
+	for i, tx := range transactions {
+		txHashes[i] = consensushashing.TransactionHash(tx)
+	}
+	return merkleBranch(txHashes, index)
+}
+
+// CalculateIDMerkleBranch returns the merkle branch that proves that the ID of the transaction
+// at the given index is a leaf of the merkle tree calculated by CalculateIDMerkleRoot
+func CalculateIDMerkleBranch(transactions []*externalapi.DomainTransaction, index int) (*externalapi.MerkleBranch, error) {
+	txIDs := make([]*externalapi.DomainHash, len(transactions))
+	for i, tx := range transactions {
+		txIDs[i] = (*externalapi.DomainHash)(consensushashing.TransactionID(tx))
+	}
+	return merkleBranch(txIDs, index)
+}
+
+// merkleBranch collects the siblings of the path from the leaf at the given index to
+// the root of the merkle tree built by `merkleRoot`.
+func merkleBranch(hashes []*externalapi.DomainHash, index int) (*externalapi.MerkleBranch, error) {
+	if index < 0 || index >= len(hashes) {
+		return nil, errors.Errorf("index %d is out of range for a merkle tree with %d leaves", index, len(hashes))
+	}
+
+	branch := &externalapi.MerkleBranch{Index: uint64(index)}
+	level := hashes
+	for position := index; len(level) > 1; position /= 2 {
+		// A missing right sibling is treated as zeros, the same way `merkleRoot` does
+		siblingPosition := position ^ 1
+		sibling := &externalapi.DomainHash{}
+		if siblingPosition < len(level) {
+			sibling = level[siblingPosition]
+		}
+		branch.Hashes = append(branch.Hashes, sibling)
+
+		nextLevel := make([]*externalapi.DomainHash, (len(level)+1)/2)
+		for i := range nextLevel {
+			right := &externalapi.DomainHash{}
+			if 2*i+1 < len(level) {
+				right = level[2*i+1]
+			}
+			nextLevel[i] = hashMerkleBranches(level[2*i], right)
+		}
+		level = nextLevel
+	}
+	return branch, nil
+}
+
+// MerkleBranchRoot returns the root of the merkle tree that the given leaf is a part of,
+// according to the given merkle branch
+func MerkleBranchRoot(leaf *externalapi.DomainHash, branch *externalapi.MerkleBranch) *externalapi.DomainHash {
+	current := leaf
+	position := branch.Index
+	for _, sibling := range branch.Hashes {
+		if position&1 == 0 {
+			current = hashMerkleBranches(current, sibling)
+		} else {
+			current = hashMerkleBranches(sibling, current)
+		}
+		position >>= 1
+	}
+	return current
+}
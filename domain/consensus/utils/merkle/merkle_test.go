@@ -0,0 +1,58 @@
+package merkle
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/consensushashing"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/subnetworks"
+)
+
+func TestMerkleBranch(t *testing.T) {
+	for numTransactions := 1; numTransactions <= 17; numTransactions++ {
+		transactions := make([]*externalapi.DomainTransaction, numTransactions)
+		for i := range transactions {
+			transactions[i] = &externalapi.DomainTransaction{
+				SubnetworkID: subnetworks.SubnetworkIDNative,
+				LockTime:     uint64(i),
+			}
+		}
+		hashMerkleRoot := CalculateHashMerkleRoot(transactions)
+		idMerkleRoot := CalculateIDMerkleRoot(transactions)
+
+		for i, transaction := range transactions {
+			hashBranch, err := CalculateHashMerkleBranch(transactions, i)
+			if err != nil {
+				t.Fatalf("CalculateHashMerkleBranch: %+v", err)
+			}
+			if !MerkleBranchRoot(consensushashing.TransactionHash(transaction), hashBranch).Equal(hashMerkleRoot) {
+				t.Fatalf("the hash merkle branch of transaction %d out of %d doesn't lead to the hash merkle root",
+					i, numTransactions)
+			}
+
+			idBranch, err := CalculateIDMerkleBranch(transactions, i)
+			if err != nil {
+				t.Fatalf("CalculateIDMerkleBranch: %+v", err)
+			}
+			transactionID := (*externalapi.DomainHash)(consensushashing.TransactionID(transaction))
+			if !MerkleBranchRoot(transactionID, idBranch).Equal(idMerkleRoot) {
+				t.Fatalf("the ID merkle branch of transaction %d out of %d doesn't lead to the ID merkle root",
+					i, numTransactions)
+			}
+
+			// A branch must not prove the position of any other transaction
+			if numTransactions > 1 {
+				otherTransaction := transactions[(i+1)%numTransactions]
+				if MerkleBranchRoot(consensushashing.TransactionHash(otherTransaction), hashBranch).Equal(hashMerkleRoot) {
+					t.Fatalf("the hash merkle branch of transaction %d out of %d proves another transaction",
+						i, numTransactions)
+				}
+			}
+		}
+	}
+
+	_, err := CalculateHashMerkleBranch([]*externalapi.DomainTransaction{{SubnetworkID: subnetworks.SubnetworkIDNative}}, 1)
+	if err == nil {
+		t.Fatalf("CalculateHashMerkleBranch unexpectedly succeeded for an out of range index")
+	}
+}
@@ -5,6 +5,7 @@
 package txscript
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
@@ -24,6 +25,7 @@ const (
 	PubKeyTy                         // Pay to pubkey.
 	PubKeyECDSATy                    // Pay to pubkey ECDSA.
 	ScriptHashTy                     // Pay to script hash.
+	HTLCTy                           // Hash time-locked contract.
 )
 
 // Script public key versions for address types.
@@ -40,6 +42,7 @@ var scriptClassToName = []string{
 	PubKeyTy:      "pubkey",
 	PubKeyECDSATy: "pubkeyecdsa",
 	ScriptHashTy:  "scripthash",
+	HTLCTy:        "htlc",
 }
 
 // String implements the Stringer interface by returning the name of
@@ -79,6 +82,8 @@ func typeOfScript(pops []parsedOpcode) ScriptClass {
 		return PubKeyECDSATy
 	case isScriptHash(pops):
 		return ScriptHashTy
+	case isHTLC(pops):
+		return HTLCTy
 	}
 	return NonStandardTy
 }
@@ -355,6 +360,11 @@ func ExtractScriptPubKeyAddress(scriptPubKey *externalapi.ScriptPublicKey, dagPa
 		}
 		return scriptClass, addr, nil
 
+	case HTLCTy:
+		// A hash time-locked contract is spent by either of two keys, so it
+		// has no single address.
+		return scriptClass, nil, nil
+
 	case NonStandardTy:
 		// Don't attempt to extract addresses or required signatures for
 		// nonstandard transactions.
@@ -444,3 +454,148 @@ func ExtractAtomicSwapDataPushes(version uint16, scriptPubKey []byte) (*AtomicSw
 	}
 	return pushes, nil
 }
+
+// HTLCSecretSize is the size of the secrets that hash time-locked contracts are redeemed with
+const HTLCSecretSize = 32
+
+// HTLCScriptData houses the data pushes of a hash time-locked contract (HTLC) script.
+type HTLCScriptData struct {
+	// RecipientPublicKey may spend the contract with the secret whose SHA256 hash is SecretHash
+	RecipientPublicKey []byte
+	// RefundPublicKey may spend the contract once LockTime is reached
+	RefundPublicKey []byte
+	SecretHash      [32]byte
+	// LockTime is a DAA score if it's below constants.LockTimeThreshold, and a UNIX timestamp
+	// in milliseconds otherwise
+	LockTime uint64
+	// IsECDSA is whether both public keys are ECDSA public keys rather than Schnorr public keys
+	IsECDSA bool
+}
+
+// PayToHTLCScript creates a hash time-locked contract script of the form:
+//
+//	OP_IF
+//	  OP_SIZE <HTLCSecretSize> OP_EQUALVERIFY OP_SHA256 <secret hash> OP_EQUALVERIFY <recipient public key>
+//	OP_ELSE
+//	  <lock time> OP_CHECKLOCKTIMEVERIFY <refund public key>
+//	OP_ENDIF
+//	OP_CHECKSIG (OP_CHECKSIGECDSA for ECDSA public keys)
+//
+// The secret size is fixed so that a secret that redeems the contract redeems its counterparts on
+// other chains as well.
+func PayToHTLCScript(data *HTLCScriptData) ([]byte, error) {
+	publicKeySize := 32
+	checkSigOpcode := byte(OpCheckSig)
+	if data.IsECDSA {
+		publicKeySize = 33
+		checkSigOpcode = OpCheckSigECDSA
+	}
+	if len(data.RecipientPublicKey) != publicKeySize || len(data.RefundPublicKey) != publicKeySize {
+		return nil, errors.Errorf("HTLC public keys must be %d bytes long", publicKeySize)
+	}
+	if data.LockTime == 0 {
+		return nil, errors.New("HTLC lock time must be greater than 0")
+	}
+
+	return NewScriptBuilder().
+		AddOp(OpIf).
+		AddOp(OpSize).AddInt64(HTLCSecretSize).AddOp(OpEqualVerify).
+		AddOp(OpSHA256).AddData(data.SecretHash[:]).AddOp(OpEqualVerify).
+		AddData(data.RecipientPublicKey).
+		AddOp(OpElse).
+		AddLockTimeNumber(data.LockTime).AddOp(OpCheckLockTimeVerify).
+		AddData(data.RefundPublicKey).
+		AddOp(OpEndIf).
+		AddOp(checkSigOpcode).
+		Script()
+}
+
+// isHTLC returns true if the script passed is a hash time-locked contract script
+// as created by PayToHTLCScript, false otherwise.
+func isHTLC(pops []parsedOpcode) bool {
+	return extractHTLCScriptData(pops) != nil
+}
+
+// ExtractHTLCScriptData returns the data pushes of a hash time-locked contract script.
+// If the script is not a hash time-locked contract, ExtractHTLCScriptData returns
+// (nil, nil). Non-nil errors are returned for unparsable scripts.
+func ExtractHTLCScriptData(script []byte) (*HTLCScriptData, error) {
+	pops, err := ParseScript(script)
+	if err != nil {
+		return nil, err
+	}
+	return extractHTLCScriptData(pops), nil
+}
+
+func extractHTLCScriptData(pops []parsedOpcode) *HTLCScriptData {
+	if len(pops) != 14 {
+		return nil
+	}
+
+	isECDSA := pops[13].opcode.value == OpCheckSigECDSA
+	publicKeyOpcode := byte(OpData32)
+	if isECDSA {
+		publicKeyOpcode = OpData33
+	}
+	isHTLC := pops[0].opcode.value == OpIf &&
+		pops[1].opcode.value == OpSize &&
+		pops[2].opcode.value == OpData1 && pops[2].data[0] == HTLCSecretSize &&
+		pops[3].opcode.value == OpEqualVerify &&
+		pops[4].opcode.value == OpSHA256 &&
+		pops[5].opcode.value == OpData32 &&
+		pops[6].opcode.value == OpEqualVerify &&
+		pops[7].opcode.value == publicKeyOpcode &&
+		pops[8].opcode.value == OpElse &&
+		canonicalPush(pops[9]) &&
+		pops[10].opcode.value == OpCheckLockTimeVerify &&
+		pops[11].opcode.value == publicKeyOpcode &&
+		pops[12].opcode.value == OpEndIf &&
+		(pops[13].opcode.value == OpCheckSig || isECDSA)
+	if !isHTLC {
+		return nil
+	}
+
+	lockTime, ok := lockTimeFromPush(pops[9])
+	if !ok || lockTime == 0 {
+		return nil
+	}
+
+	data := &HTLCScriptData{
+		RecipientPublicKey: pops[7].data,
+		RefundPublicKey:    pops[11].data,
+		LockTime:           lockTime,
+		IsECDSA:            isECDSA,
+	}
+	copy(data.SecretHash[:], pops[5].data)
+	return data
+}
+
+// lockTimeFromPush returns the lock time that the given push, as created by
+// ScriptBuilder.AddLockTimeNumber, pushes to the stack
+func lockTimeFromPush(pop parsedOpcode) (uint64, bool) {
+	switch {
+	case isSmallInt(pop.opcode):
+		return uint64(asSmallInt(pop.opcode)), true
+	case pop.opcode.value == Op1Negate:
+		return 0x81, true
+	case pop.opcode.value >= OpData1 && pop.opcode.value <= OpData8:
+		lockTimeBytes := make([]byte, 8)
+		copy(lockTimeBytes, pop.data)
+		return binary.LittleEndian.Uint64(lockTimeBytes), true
+	}
+	return 0, false
+}
+
+// HTLCRedeemSignatureScript creates the signature script that spends a hash time-locked contract
+// with the recipient key and the given secret. A contract that is paid to through
+// pay-to-script-hash also needs its script appended with PayToScriptHashSignatureScript.
+func HTLCRedeemSignatureScript(signature []byte, secret []byte) ([]byte, error) {
+	return NewScriptBuilder().AddData(signature).AddData(secret).AddOp(OpTrue).Script()
+}
+
+// HTLCRefundSignatureScript creates the signature script that spends a hash time-locked contract
+// with the refund key. A contract that is paid to through pay-to-script-hash also needs its
+// script appended with PayToScriptHashSignatureScript.
+func HTLCRefundSignatureScript(signature []byte) ([]byte, error) {
+	return NewScriptBuilder().AddData(signature).AddOp(OpFalse).Script()
+}
@@ -6,6 +6,7 @@ package txscript
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"reflect"
 	"testing"
 
@@ -443,6 +444,56 @@ var scriptClassTests = []struct {
 		class: ScriptHashTy,
 	},
 
+	{
+		name: "htlc",
+		script: "IF SIZE DATA_1 0x20 EQUALVERIFY SHA256 DATA_32 0x1111111111111111111111111111111111111111111111111111111111111111 EQUALVERIFY " +
+			"DATA_32 0x2222222222222222222222222222222222222222222222222222222222222222 ELSE DATA_2 0x1027 CHECKLOCKTIMEVERIFY " +
+			"DATA_32 0x3333333333333333333333333333333333333333333333333333333333333333 ENDIF CHECKSIG",
+		class: HTLCTy,
+	},
+	{
+		name: "htlc ecdsa",
+		script: "IF SIZE DATA_1 0x20 EQUALVERIFY SHA256 DATA_32 0x1111111111111111111111111111111111111111111111111111111111111111 EQUALVERIFY " +
+			"DATA_33 0x022222222222222222222222222222222222222222222222222222222222222222 ELSE DATA_2 0x1027 CHECKLOCKTIMEVERIFY " +
+			"DATA_33 0x033333333333333333333333333333333333333333333333333333333333333333 ENDIF CHECKSIGECDSA",
+		class: HTLCTy,
+	},
+	{
+		name: "htlc with a small lock time",
+		script: "IF SIZE DATA_1 0x20 EQUALVERIFY SHA256 DATA_32 0x1111111111111111111111111111111111111111111111111111111111111111 EQUALVERIFY " +
+			"DATA_32 0x2222222222222222222222222222222222222222222222222222222222222222 ELSE 5 CHECKLOCKTIMEVERIFY " +
+			"DATA_32 0x3333333333333333333333333333333333333333333333333333333333333333 ENDIF CHECKSIG",
+		class: HTLCTy,
+	},
+	{
+		// Schnorr public keys with an ECDSA signature check
+		name: "htlc mixed keys",
+		script: "IF SIZE DATA_1 0x20 EQUALVERIFY SHA256 DATA_32 0x1111111111111111111111111111111111111111111111111111111111111111 EQUALVERIFY " +
+			"DATA_32 0x2222222222222222222222222222222222222222222222222222222222222222 ELSE DATA_2 0x1027 CHECKLOCKTIMEVERIFY " +
+			"DATA_32 0x3333333333333333333333333333333333333333333333333333333333333333 ENDIF CHECKSIGECDSA",
+		class: NonStandardTy,
+	},
+	{
+		name: "htlc wrong secret size",
+		script: "IF SIZE DATA_1 0x21 EQUALVERIFY SHA256 DATA_32 0x1111111111111111111111111111111111111111111111111111111111111111 EQUALVERIFY " +
+			"DATA_32 0x2222222222222222222222222222222222222222222222222222222222222222 ELSE DATA_2 0x1027 CHECKLOCKTIMEVERIFY " +
+			"DATA_32 0x3333333333333333333333333333333333333333333333333333333333333333 ENDIF CHECKSIG",
+		class: NonStandardTy,
+	},
+	{
+		name: "htlc zero lock time",
+		script: "IF SIZE DATA_1 0x20 EQUALVERIFY SHA256 DATA_32 0x1111111111111111111111111111111111111111111111111111111111111111 EQUALVERIFY " +
+			"DATA_32 0x2222222222222222222222222222222222222222222222222222222222222222 ELSE 0 CHECKLOCKTIMEVERIFY " +
+			"DATA_32 0x3333333333333333333333333333333333333333333333333333333333333333 ENDIF CHECKSIG",
+		class: NonStandardTy,
+	},
+	{
+		name: "htlc with an extra opcode",
+		script: "IF SIZE DATA_1 0x20 EQUALVERIFY SHA256 DATA_32 0x1111111111111111111111111111111111111111111111111111111111111111 EQUALVERIFY " +
+			"DATA_32 0x2222222222222222222222222222222222222222222222222222222222222222 ELSE DATA_2 0x1027 CHECKLOCKTIMEVERIFY " +
+			"DATA_32 0x3333333333333333333333333333333333333333333333333333333333333333 ENDIF CHECKSIG NOP",
+		class: NonStandardTy,
+	},
 	{
 		// Nulldata. It is standard in Bitcoin but not in Hoosat
 		name:   "nulldata",
@@ -552,6 +603,11 @@ func TestStringifyClass(t *testing.T) {
 			class:    ScriptHashTy,
 			stringed: "scripthash",
 		},
+		{
+			name:     "htlc",
+			class:    HTLCTy,
+			stringed: "htlc",
+		},
 		{
 			name:     "broken",
 			class:    ScriptClass(255),
@@ -567,3 +623,103 @@ func TestStringifyClass(t *testing.T) {
 		}
 	}
 }
+
+// TestPayToHTLCScript ensures that hash time-locked contract scripts created by
+// PayToHTLCScript are recognized, and that ExtractHTLCScriptData returns the
+// data they were created with.
+func TestPayToHTLCScript(t *testing.T) {
+	t.Parallel()
+
+	schnorrPublicKey := bytes.Repeat([]byte{0x22}, 32)
+	ecdsaPublicKey := append([]byte{0x02}, bytes.Repeat([]byte{0x22}, 32)...)
+	tests := []struct {
+		name string
+		data *HTLCScriptData
+	}{
+		{
+			name: "schnorr with a DAA score",
+			data: &HTLCScriptData{
+				RecipientPublicKey: schnorrPublicKey,
+				RefundPublicKey:    schnorrPublicKey,
+				SecretHash:         sha256.Sum256([]byte("secret")),
+				LockTime:           10_000,
+			},
+		},
+		{
+			name: "schnorr with a small DAA score",
+			data: &HTLCScriptData{
+				RecipientPublicKey: schnorrPublicKey,
+				RefundPublicKey:    schnorrPublicKey,
+				LockTime:           16,
+			},
+		},
+		{
+			name: "ecdsa with a timestamp",
+			data: &HTLCScriptData{
+				RecipientPublicKey: ecdsaPublicKey,
+				RefundPublicKey:    ecdsaPublicKey,
+				SecretHash:         sha256.Sum256([]byte("secret")),
+				LockTime:           1_800_000_000_000,
+				IsECDSA:            true,
+			},
+		},
+		{
+			name: "lock time that is pushed as OP_1NEGATE",
+			data: &HTLCScriptData{
+				RecipientPublicKey: schnorrPublicKey,
+				RefundPublicKey:    schnorrPublicKey,
+				LockTime:           0x81,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		script, err := PayToHTLCScript(test.data)
+		if err != nil {
+			t.Fatalf("%s: PayToHTLCScript: %s", test.name, err)
+		}
+
+		if class := GetScriptClass(script); class != HTLCTy {
+			t.Errorf("%s: expected class %s, got %s", test.name, HTLCTy, class)
+		}
+
+		data, err := ExtractHTLCScriptData(script)
+		if err != nil {
+			t.Fatalf("%s: ExtractHTLCScriptData: %s", test.name, err)
+		}
+		if !reflect.DeepEqual(data, test.data) {
+			t.Errorf("%s: expected data %+v, got %+v", test.name, test.data, data)
+		}
+
+		class, address, err := ExtractScriptPubKeyAddress(&externalapi.ScriptPublicKey{Script: script},
+			&dagconfig.MainnetParams)
+		if err != nil || class != HTLCTy || address != nil {
+			t.Errorf("%s: expected ExtractScriptPubKeyAddress to return class %s and no address, "+
+				"got %s, %v, %v", test.name, HTLCTy, class, address, err)
+		}
+	}
+
+	_, err := PayToHTLCScript(&HTLCScriptData{
+		RecipientPublicKey: schnorrPublicKey,
+		RefundPublicKey:    schnorrPublicKey,
+		IsECDSA:            true,
+		LockTime:           1,
+	})
+	if err == nil {
+		t.Errorf("PayToHTLCScript unexpectedly succeeded with Schnorr public keys for an ECDSA contract")
+	}
+
+	_, err = PayToHTLCScript(&HTLCScriptData{
+		RecipientPublicKey: schnorrPublicKey,
+		RefundPublicKey:    schnorrPublicKey,
+	})
+	if err == nil {
+		t.Errorf("PayToHTLCScript unexpectedly succeeded with a lock time of 0")
+	}
+
+	data, err := ExtractHTLCScriptData(mustParseShortForm("DATA_32 "+
+		"0x2222222222222222222222222222222222222222222222222222222222222222 CHECKSIG", 0))
+	if err != nil || data != nil {
+		t.Errorf("ExtractHTLCScriptData unexpectedly returned %+v, %v for a pay-to-pubkey script", data, err)
+	}
+}
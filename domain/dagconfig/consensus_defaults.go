@@ -83,4 +83,19 @@ const (
 	defaultDeflationaryPhaseDaaScore = 360
 
 	defaultMergeDepth = 360
+
+	// defaultDevFeePercentage is the percentage of every block reward that is paid to the network's
+	// dev-fee address, starting from block version 2.
+	defaultDevFeePercentage = 5
+	// defaultDevFeeMinPercentage is the lowest dev-fee percentage a coinbase output to the dev-fee
+	// address may have and still be recognized as a dev fee.
+	defaultDevFeeMinPercentage = 1
+)
+
+// The dev-fee addresses of the default networks. They all share the same public key, so that they pay to the
+// same script public key, and differ only in their network prefix.
+const (
+	mainnetDevFeeAddress = "hoosat:qp4ad2eh72xc8dtjjyz4llxzq9utn6k26uyl644xxw70wskdfl85zsqj9k4vz"
+	testnetDevFeeAddress = "hoosattest:qp4ad2eh72xc8dtjjyz4llxzq9utn6k26uyl644xxw70wskdfl85zmf23x39z"
+	simnetDevFeeAddress  = "hoosatsim:qp4ad2eh72xc8dtjjyz4llxzq9utn6k26uyl644xxw70wskdfl85zpm6uwkud"
 )
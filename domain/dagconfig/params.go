@@ -194,6 +194,26 @@ type Params struct {
 	MergeDepth []uint64
 
 	POWScores []uint64
+
+	// DevFeeAddress is the address that the dev-fee part of every block reward is paid to.
+	// It must be encoded with the network's Prefix.
+	DevFeeAddress string
+
+	// DevFeePercentage is the percentage of every block reward that is paid to DevFeeAddress
+	DevFeePercentage uint64
+
+	// DevFeeMinPercentage is the lowest percentage of a block reward that an output
+	// to DevFeeAddress may pay and still be considered a dev fee
+	DevFeeMinPercentage uint64
+
+	// DevFeeActivationDAAScore is the DAA score from which coinbase transactions of
+	// version 2 blocks and above pay the dev fee
+	DevFeeActivationDAAScore uint64
+
+	// AssumeValidBlockHash is a selected chain block whose past is assumed to have valid
+	// transaction scripts and signatures. They aren't verified for the chain blocks in its
+	// past, while every other check still is. A nil hash verifies all of them
+	AssumeValidBlockHash *externalapi.DomainHash
 }
 
 // NormalizeRPCServerAddress returns addr with the current network default
@@ -261,6 +281,23 @@ func (p *Params) PruningDepth() uint64 {
 	return 2*p.FinalityDepth()*p.pruningMultiplierForCurrentVersion() + 4*p.MergeSetSizeLimit*k + 2*k + 2
 }
 
+// ValidateDevFee returns an error if the dev-fee parameters are inconsistent with each
+// other or with the network
+func (p *Params) ValidateDevFee() error {
+	_, err := util.DecodeAddress(p.DevFeeAddress, p.Prefix)
+	if err != nil {
+		return errors.Wrapf(err, "invalid dev-fee address %s for network %s", p.DevFeeAddress, p.Name)
+	}
+	if p.DevFeePercentage > 100 {
+		return errors.Errorf("dev-fee percentage %d of network %s is over 100", p.DevFeePercentage, p.Name)
+	}
+	if p.DevFeeMinPercentage > p.DevFeePercentage {
+		return errors.Errorf("minimum dev-fee percentage %d of network %s is over its dev-fee percentage %d",
+			p.DevFeeMinPercentage, p.Name, p.DevFeePercentage)
+	}
+	return nil
+}
+
 // MainnetParams defines the network parameters for the main Hoosat network.
 var MainnetParams = Params{
 	K:           []externalapi.KType{defaultGHOSTDAGK, defaultGHOSTDAGK, defaultGHOSTDAGK, defaultGHOSTDAGK, 40},
@@ -333,6 +370,10 @@ var MainnetParams = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	DevFeeAddress:                           mainnetDevFeeAddress,
+	DevFeePercentage:                        defaultDevFeePercentage,
+	DevFeeMinPercentage:                     defaultDevFeeMinPercentage,
+	DevFeeActivationDAAScore:                0,
 	DisallowDirectBlocksOnTopOfGenesis:      true,
 
 	// This is technically 255, but we clamped it at 256 - block level of mainnet genesis
@@ -409,6 +450,10 @@ var TestnetParams = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	DevFeeAddress:                           testnetDevFeeAddress,
+	DevFeePercentage:                        defaultDevFeePercentage,
+	DevFeeMinPercentage:                     defaultDevFeeMinPercentage,
+	DevFeeActivationDAAScore:                0,
 	DisallowDirectBlocksOnTopOfGenesis:      true,
 
 	// This is technically 255, but we clamped it at 256 - block level of mainnet genesis
@@ -483,6 +528,10 @@ var TestnetParamsB5 = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	DevFeeAddress:                           testnetDevFeeAddress,
+	DevFeePercentage:                        defaultDevFeePercentage,
+	DevFeeMinPercentage:                     defaultDevFeeMinPercentage,
+	DevFeeActivationDAAScore:                0,
 
 	MaxBlockLevel: 225,
 	MergeDepth:    []uint64{defaultMergeDepth, defaultMergeDepth, defaultMergeDepth, 3600, 3600},
@@ -554,6 +603,10 @@ var TestnetParamsB10 = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	DevFeeAddress:                           testnetDevFeeAddress,
+	DevFeePercentage:                        defaultDevFeePercentage,
+	DevFeeMinPercentage:                     defaultDevFeeMinPercentage,
+	DevFeeActivationDAAScore:                0,
 
 	MaxBlockLevel: 250,
 	MergeDepth:    []uint64{defaultMergeDepth, defaultMergeDepth, defaultMergeDepth, 3600, 3600},
@@ -624,6 +677,10 @@ var SimnetParams = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	DevFeeAddress:                           simnetDevFeeAddress,
+	DevFeePercentage:                        defaultDevFeePercentage,
+	DevFeeMinPercentage:                     defaultDevFeeMinPercentage,
+	DevFeeActivationDAAScore:                0,
 
 	MaxBlockLevel: 250,
 	MergeDepth:    []uint64{defaultMergeDepth, defaultMergeDepth, defaultMergeDepth, defaultMergeDepth, defaultMergeDepth},
@@ -689,6 +746,10 @@ var DevnetParams = Params{
 	CoinbasePayloadScriptPublicKeyMaxLength: defaultCoinbasePayloadScriptPublicKeyMaxLength,
 	PruningProofM:                           defaultPruningProofM,
 	DeflationaryPhaseDaaScore:               defaultDeflationaryPhaseDaaScore,
+	DevFeeAddress:                           mainnetDevFeeAddress,
+	DevFeePercentage:                        defaultDevFeePercentage,
+	DevFeeMinPercentage:                     defaultDevFeeMinPercentage,
+	DevFeeActivationDAAScore:                0,
 	DisallowDirectBlocksOnTopOfGenesis:      true,
 
 	// This is technically 255, but we clamped it at 256 - block level of mainnet genesis
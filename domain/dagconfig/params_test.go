@@ -90,6 +90,40 @@ func TestSkipProofOfWork(t *testing.T) {
 	}
 }
 
+// TestValidateDevFee ensures all of the hard coded network params have valid dev-fee parameters,
+// and that invalid ones are rejected.
+func TestValidateDevFee(t *testing.T) {
+	allParams := []Params{
+		MainnetParams,
+		TestnetParams,
+		TestnetParamsB5,
+		TestnetParamsB10,
+		SimnetParams,
+		DevnetParams,
+	}
+
+	for _, params := range allParams {
+		err := params.ValidateDevFee()
+		if err != nil {
+			t.Errorf("invalid dev-fee params for %s: %s", params.Name, err)
+		}
+	}
+
+	wrongPrefix := TestnetParams
+	wrongPrefix.DevFeeAddress = MainnetParams.DevFeeAddress
+	overHundred := MainnetParams
+	overHundred.DevFeePercentage = 101
+	minOverPercentage := MainnetParams
+	minOverPercentage.DevFeeMinPercentage = MainnetParams.DevFeePercentage + 1
+	for _, params := range []Params{wrongPrefix, overHundred, minOverPercentage} {
+		err := params.ValidateDevFee()
+		if err == nil {
+			t.Errorf("expected invalid dev-fee params to be rejected: %s %d%% (min %d%%)", params.DevFeeAddress,
+				params.DevFeePercentage, params.DevFeeMinPercentage)
+		}
+	}
+}
+
 // calculateK estimates the k value for GHOSTDAG based on blocks per second (bps).
 // It uses a heuristic that scales k with bps, adjusts for network latency, and ensures
 // security against a target hashrate attack (e.g., 47.5%).
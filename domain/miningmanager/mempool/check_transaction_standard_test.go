@@ -218,6 +218,14 @@ func TestCheckTransactionStandardInIsolation(t *testing.T) {
 		Value:           100000000, // 1 HTN
 		ScriptPublicKey: dummyScriptPublicKey,
 	}
+	htlcScript, err := txscript.PayToHTLCScript(&txscript.HTLCScriptData{
+		RecipientPublicKey: addrHash[:],
+		RefundPublicKey:    addrHash[:],
+		LockTime:           300000,
+	})
+	if err != nil {
+		t.Fatalf("PayToHTLCScript: unexpected error: %v", err)
+	}
 
 	tests := []struct {
 		name       string
@@ -261,6 +269,15 @@ func TestCheckTransactionStandardInIsolation(t *testing.T) {
 			isStandard: false,
 			code:       RejectNonstandard,
 		},
+		{
+			name: "Hash time-locked contract output",
+			tx: &externalapi.DomainTransaction{Version: 0, Inputs: []*externalapi.DomainTransactionInput{&dummyTxIn}, Outputs: []*externalapi.DomainTransactionOutput{{
+				Value:           100000000,
+				ScriptPublicKey: &externalapi.ScriptPublicKey{Script: htlcScript, Version: 0},
+			}}},
+			height:     300000,
+			isStandard: true,
+		},
 		{
 			name: "Valid but non standard public key script",
 			tx: &externalapi.DomainTransaction{Version: 0, Inputs: []*externalapi.DomainTransactionInput{&dummyTxIn}, Outputs: []*externalapi.DomainTransactionOutput{{
@@ -64,7 +64,7 @@ func (rtl *compoundTxRateLimiter) extractSenderAddresses(transaction *externalap
 		if input.UTXOEntry != nil && input.UTXOEntry.ScriptPublicKey() != nil {
 			_, extractedAddress, err := txscript.ExtractScriptPubKeyAddress(
 				input.UTXOEntry.ScriptPublicKey(), rtl.config.DAGParams)
-			if err != nil {
+			if err != nil || extractedAddress == nil {
 				continue
 			}
 			addresses[extractedAddress.EncodeAddress()] = true
@@ -52,6 +52,15 @@ func (mp *mempool) ValidateAndInsertTransaction(transaction *externalapi.DomainT
 	return mp.validateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
 }
 
+func (mp *mempool) TestTransactionAccept(transaction *externalapi.DomainTransaction) (
+	validatedTransaction *externalapi.DomainTransaction, err error) {
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	return mp.testTransactionAccept(transaction)
+}
+
 func (mp *mempool) GetTransaction(transactionID *externalapi.DomainTransactionID,
 	includeTransactionPool bool,
 	includeOrphanPool bool) (
@@ -77,6 +86,19 @@ func (mp *mempool) GetTransaction(transactionID *externalapi.DomainTransactionID
 	return transaction, isOrphan, transactionfound
 }
 
+func (mp *mempool) GetTransactionSpendingOutpoint(outpoint *externalapi.DomainOutpoint) (
+	transactionID *externalapi.DomainTransactionID, found bool) {
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	spendingTransaction, found := mp.mempoolUTXOSet.transactionByPreviousOutpoint[*outpoint]
+	if !found {
+		return nil, false
+	}
+	return spendingTransaction.TransactionID(), true
+}
+
 func (mp *mempool) GetTransactionsByAddresses(includeTransactionPool bool, includeOrphanPool bool) (
 	sendingInTransactionPool map[string]*externalapi.DomainTransaction,
 	receivingInTransactionPool map[string]*externalapi.DomainTransaction,
@@ -94,13 +116,13 @@ func (mp *mempool) GetTransactionsByAddresses(includeTransactionPool bool, inclu
 	}
 
 	if includeOrphanPool {
-		sendingInTransactionPool, receivingInOrphanPool, err = mp.orphansPool.getOrphanTransactionsByAddresses()
+		sendingInOrphanPool, receivingInOrphanPool, err = mp.orphansPool.getOrphanTransactionsByAddresses()
 		if err != nil {
 			return nil, nil, nil, nil, err
 		}
 	}
 
-	return sendingInTransactionPool, receivingInTransactionPool, sendingInTransactionPool, receivingInOrphanPool, nil
+	return sendingInTransactionPool, receivingInTransactionPool, sendingInOrphanPool, receivingInOrphanPool, nil
 }
 
 func (mp *mempool) AllTransactions(includeTransactionPool bool, includeOrphanPool bool) (
@@ -121,6 +143,25 @@ func (mp *mempool) AllTransactions(includeTransactionPool bool, includeOrphanPoo
 	return transactionPoolTransactions, orphanPoolTransactions
 }
 
+func (mp *mempool) FilterTransactions(filter func(transactionID *externalapi.DomainTransactionID) bool,
+	includeTransactionPool bool, includeOrphanPool bool) (
+	transactionPoolTransactions []*externalapi.DomainTransaction,
+	orphanPoolTransactions []*externalapi.DomainTransaction) {
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	if includeTransactionPool {
+		transactionPoolTransactions = mp.transactionsPool.filterTransactions(filter)
+	}
+
+	if includeOrphanPool {
+		orphanPoolTransactions = mp.orphansPool.filterOrphanTransactions(filter)
+	}
+
+	return transactionPoolTransactions, orphanPoolTransactions
+}
+
 func (mp *mempool) TransactionCount(includeTransactionPool bool, includeOrphanPool bool) int {
 	mp.mtx.RLock()
 	defer mp.mtx.RUnlock()
@@ -374,6 +374,16 @@ func (op *orphansPool) getAllOrphanTransactions() []*externalapi.DomainTransacti
 	return allOrphanTransactions
 }
 
+func (op *orphansPool) filterOrphanTransactions(filter func(transactionID *externalapi.DomainTransactionID) bool) []*externalapi.DomainTransaction {
+	var orphanTransactions []*externalapi.DomainTransaction
+	for transactionID, mempoolTransaction := range op.allOrphans {
+		if filter(&transactionID) {
+			orphanTransactions = append(orphanTransactions, mempoolTransaction.Transaction().Clone()) //these pointers leave the mempool, hence we clone.
+		}
+	}
+	return orphanTransactions
+}
+
 func (op *orphansPool) orphanTransactionCount() int {
 	return len(op.allOrphans)
 }
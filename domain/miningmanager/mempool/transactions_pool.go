@@ -247,6 +247,16 @@ func (tp *transactionsPool) getAllTransactions() []*externalapi.DomainTransactio
 	return allTransactions
 }
 
+func (tp *transactionsPool) filterTransactions(filter func(transactionID *externalapi.DomainTransactionID) bool) []*externalapi.DomainTransaction {
+	var transactions []*externalapi.DomainTransaction
+	for transactionID, mempoolTransaction := range tp.allTransactions {
+		if filter(&transactionID) {
+			transactions = append(transactions, mempoolTransaction.Transaction().Clone()) //this pointer leaves the mempool, hence we clone.
+		}
+	}
+	return transactions
+}
+
 func (tp *transactionsPool) transactionCount() int {
 	return len(tp.allTransactions)
 }
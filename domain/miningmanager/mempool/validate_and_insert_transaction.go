@@ -69,3 +69,35 @@ func (mp *mempool) validateAndInsertTransaction(transaction *externalapi.DomainT
 
 	return acceptedTransactions, nil
 }
+
+// testTransactionAccept runs the validations of validateAndInsertTransaction over a copy of the given
+// transaction, without inserting it into the mempool or recording it for rate limiting. The copy is
+// returned populated with its mass, fee and UTXO entries, as far as validation got before failing.
+func (mp *mempool) testTransactionAccept(transaction *externalapi.DomainTransaction) (
+	validatedTransaction *externalapi.DomainTransaction, err error) {
+
+	validatedTransaction = transaction.Clone()
+	mp.consensusReference.Consensus().PopulateMass(validatedTransaction)
+
+	err = mp.validateTransactionPreUTXOEntry(validatedTransaction)
+	if err != nil {
+		return validatedTransaction, err
+	}
+
+	_, missingOutpoints, err := mp.fillInputsAndGetMissingParents(validatedTransaction)
+	if err != nil {
+		return validatedTransaction, err
+	}
+	if len(missingOutpoints) > 0 {
+		str := fmt.Sprintf("Transaction %s is an orphan, missing outpoints: %v",
+			consensushashing.TransactionID(validatedTransaction), missingOutpoints)
+		return validatedTransaction, transactionRuleError(RejectBadOrphan, str)
+	}
+
+	err = mp.validateTransactionInContext(validatedTransaction)
+	if err != nil {
+		return validatedTransaction, err
+	}
+
+	return validatedTransaction, nil
+}
@@ -41,7 +41,7 @@ func (wfm *walletFreezingManager) extractAddressesFromTransaction(transaction *e
 		if input.UTXOEntry != nil && input.UTXOEntry.ScriptPublicKey() != nil {
 			_, extractedAddress, err := txscript.ExtractScriptPubKeyAddress(
 				input.UTXOEntry.ScriptPublicKey(), wfm.config.DAGParams)
-			if err != nil {
+			if err != nil || extractedAddress == nil {
 				continue
 			}
 			addresses[extractedAddress.EncodeAddress()] = true
@@ -53,7 +53,7 @@ func (wfm *walletFreezingManager) extractAddressesFromTransaction(transaction *e
 		if output.ScriptPublicKey != nil {
 			_, extractedAddress, err := txscript.ExtractScriptPubKeyAddress(
 				output.ScriptPublicKey, wfm.config.DAGParams)
-			if err != nil {
+			if err != nil || extractedAddress == nil {
 				continue
 			}
 			addresses[extractedAddress.EncodeAddress()] = true
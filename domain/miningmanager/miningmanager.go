@@ -19,6 +19,8 @@ type MiningManager interface {
 		transactionPoolTransaction *externalapi.DomainTransaction,
 		isOrphan bool,
 		found bool)
+	GetTransactionSpendingOutpoint(outpoint *externalapi.DomainOutpoint) (
+		transactionID *externalapi.DomainTransactionID, found bool)
 	GetTransactionsByAddresses(includeTransactionPool bool, includeOrphanPool bool) (
 		sendingInTransactionPool map[string]*externalapi.DomainTransaction,
 		receivingInTransactionPool map[string]*externalapi.DomainTransaction,
@@ -28,10 +30,16 @@ type MiningManager interface {
 	AllTransactions(includeTransactionPool bool, includeOrphanPool bool) (
 		transactionPoolTransactions []*externalapi.DomainTransaction,
 		orphanPoolTransactions []*externalapi.DomainTransaction)
+	FilterTransactions(filter func(transactionID *externalapi.DomainTransactionID) bool,
+		includeTransactionPool bool, includeOrphanPool bool) (
+		transactionPoolTransactions []*externalapi.DomainTransaction,
+		orphanPoolTransactions []*externalapi.DomainTransaction)
 	TransactionCount(includeTransactionPool bool, includeOrphanPool bool) int
 	HandleNewBlockTransactions(txs []*externalapi.DomainTransaction) ([]*externalapi.DomainTransaction, error)
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	TestTransactionAccept(transaction *externalapi.DomainTransaction) (
+		validatedTransaction *externalapi.DomainTransaction, err error)
 	RevalidateHighPriorityTransactions() (validTransactions []*externalapi.DomainTransaction, err error)
 }
 
@@ -118,6 +126,14 @@ func (mm *miningManager) ValidateAndInsertTransaction(transaction *externalapi.D
 	return mm.mempool.ValidateAndInsertTransaction(transaction, isHighPriority, allowOrphan)
 }
 
+// TestTransactionAccept validates the given transaction as ValidateAndInsertTransaction
+// would, without inserting it into the mempool
+func (mm *miningManager) TestTransactionAccept(transaction *externalapi.DomainTransaction) (
+	validatedTransaction *externalapi.DomainTransaction, err error) {
+
+	return mm.mempool.TestTransactionAccept(transaction)
+}
+
 func (mm *miningManager) GetTransaction(
 	transactionID *externalapi.DomainTransactionID,
 	includeTransactionPool bool,
@@ -129,6 +145,14 @@ func (mm *miningManager) GetTransaction(
 	return mm.mempool.GetTransaction(transactionID, includeTransactionPool, includeOrphanPool)
 }
 
+// GetTransactionSpendingOutpoint returns the ID of the transaction pool transaction that spends
+// the given outpoint, if there is one
+func (mm *miningManager) GetTransactionSpendingOutpoint(outpoint *externalapi.DomainOutpoint) (
+	transactionID *externalapi.DomainTransactionID, found bool) {
+
+	return mm.mempool.GetTransactionSpendingOutpoint(outpoint)
+}
+
 func (mm *miningManager) AllTransactions(includeTransactionPool bool, includeOrphanPool bool) (
 	transactionPoolTransactions []*externalapi.DomainTransaction,
 	orphanPoolTransactions []*externalapi.DomainTransaction) {
@@ -136,6 +160,14 @@ func (mm *miningManager) AllTransactions(includeTransactionPool bool, includeOrp
 	return mm.mempool.AllTransactions(includeTransactionPool, includeOrphanPool)
 }
 
+func (mm *miningManager) FilterTransactions(filter func(transactionID *externalapi.DomainTransactionID) bool,
+	includeTransactionPool bool, includeOrphanPool bool) (
+	transactionPoolTransactions []*externalapi.DomainTransaction,
+	orphanPoolTransactions []*externalapi.DomainTransaction) {
+
+	return mm.mempool.FilterTransactions(filter, includeTransactionPool, includeOrphanPool)
+}
+
 func (mm *miningManager) GetTransactionsByAddresses(includeTransactionPool bool, includeOrphanPool bool) (
 	sendingInTransactionPool map[string]*externalapi.DomainTransaction,
 	receivingInTransactionPool map[string]*externalapi.DomainTransaction,
@@ -63,6 +63,18 @@ func TestValidateAndInsertTransaction(t *testing.T) {
 			}
 		}
 
+		// Including the orphan pool must not hide the transactions of the transaction pool
+		sendingInTransactionPool, _, sendingInOrphanPool, _, err := miningManager.GetTransactionsByAddresses(true, true)
+		if err != nil {
+			t.Fatalf("GetTransactionsByAddresses: %v", err)
+		}
+		if len(sendingInTransactionPool) == 0 {
+			t.Fatalf("Expected sending transactions in the transaction pool")
+		}
+		if len(sendingInOrphanPool) != 0 {
+			t.Fatalf("Expected no sending transactions in the orphan pool, got %d", len(sendingInOrphanPool))
+		}
+
 		// The parent's transaction was inserted by consensus(AddBlock), and we want to verify that
 		// the transaction is not considered an orphan and inserted into the mempool.
 		transactionNotAnOrphan, err := createChildAndParentTxsAndAddParentToConsensus(tc)
@@ -173,6 +185,122 @@ func TestDoubleSpendInMempool(t *testing.T) {
 	})
 }
 
+// TestTestTransactionAccept verifies that TestTransactionAccept reports whether the mempool
+// would accept a transaction, without inserting it into the mempool.
+func TestTestTransactionAccept(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestTestTransactionAccept")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		transaction, err := createChildAndParentTxsAndAddParentToConsensus(tc)
+		if err != nil {
+			t.Fatalf("Error creating transaction: %+v", err)
+		}
+
+		validatedTransaction, err := miningManager.TestTransactionAccept(transaction)
+		if err != nil {
+			t.Fatalf("TestTransactionAccept: %v", err)
+		}
+		if validatedTransaction.Mass == 0 || validatedTransaction.Fee == 0 {
+			t.Fatalf("Expected the validated transaction to have a mass and a fee, got mass %d and fee %d",
+				validatedTransaction.Mass, validatedTransaction.Fee)
+		}
+		if transaction.Inputs[0].UTXOEntry != nil {
+			t.Fatalf("TestTransactionAccept populated the given transaction")
+		}
+		if miningManager.TransactionCount(true, true) != 0 {
+			t.Fatalf("TestTransactionAccept inserted the transaction into the mempool")
+		}
+
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		_, err = miningManager.TestTransactionAccept(transaction)
+		if err == nil || !strings.Contains(err.Error(), "is already in the mempool") {
+			t.Fatalf("TestTransactionAccept: %v", err)
+		}
+
+		doubleSpendingTransaction := transaction.Clone()
+		doubleSpendingTransaction.ID = nil
+		doubleSpendingTransaction.Outputs[0].Value--
+		_, err = miningManager.TestTransactionAccept(doubleSpendingTransaction)
+		if err == nil || !strings.Contains(err.Error(), "already spent by transaction") {
+			t.Fatalf("TestTransactionAccept: %v", err)
+		}
+		if miningManager.TransactionCount(true, true) != 1 {
+			t.Fatalf("Expected a single transaction in the mempool, got %d", miningManager.TransactionCount(true, true))
+		}
+	})
+}
+
+// TestGetTransactionSpendingOutpoint verifies that the virtual UTXO entry of an outpoint and the
+// mempool transaction spending it can be looked up by the outpoint alone.
+func TestGetTransactionSpendingOutpoint(t *testing.T) {
+	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
+		consensusConfig.BlockCoinbaseMaturity = 0
+		factory := consensus.NewFactory()
+		tc, teardown, err := factory.NewTestConsensus(consensusConfig, "TestGetTransactionSpendingOutpoint")
+		if err != nil {
+			t.Fatalf("Error setting up TestConsensus: %+v", err)
+		}
+		defer teardown(false)
+
+		miningFactory := miningmanager.NewFactory()
+		tcAsConsensus := tc.(externalapi.Consensus)
+		tcAsConsensusPointer := &tcAsConsensus
+		consensusReference := consensusreference.NewConsensusReference(&tcAsConsensusPointer)
+		miningManager := miningFactory.NewMiningManager(consensusReference, &consensusConfig.Params, mempool.DefaultConfig(&consensusConfig.Params))
+		transaction, err := createChildAndParentTxsAndAddParentToConsensus(tc)
+		if err != nil {
+			t.Fatalf("Error creating transaction: %+v", err)
+		}
+
+		spentOutpoint := &transaction.Inputs[0].PreviousOutpoint
+		missingOutpoint := &externalapi.DomainOutpoint{TransactionID: spentOutpoint.TransactionID, Index: 1000}
+		utxoEntries, err := tc.GetVirtualUTXOEntries([]*externalapi.DomainOutpoint{spentOutpoint, missingOutpoint})
+		if err != nil {
+			t.Fatalf("GetVirtualUTXOEntries: %+v", err)
+		}
+		if len(utxoEntries) != 2 {
+			t.Fatalf("Expected 2 UTXO entries, got %d", len(utxoEntries))
+		}
+		if utxoEntries[0] == nil {
+			t.Fatalf("Expected the outpoint %s to be in the virtual UTXO set", spentOutpoint)
+		}
+		if utxoEntries[1] != nil {
+			t.Fatalf("Unexpectedly found the outpoint %s in the virtual UTXO set", missingOutpoint)
+		}
+
+		_, found := miningManager.GetTransactionSpendingOutpoint(spentOutpoint)
+		if found {
+			t.Fatalf("Found a spending transaction before inserting one into the mempool")
+		}
+		_, err = miningManager.ValidateAndInsertTransaction(transaction, false, true)
+		if err != nil {
+			t.Fatalf("ValidateAndInsertTransaction: %v", err)
+		}
+		spendingTransactionID, found := miningManager.GetTransactionSpendingOutpoint(spentOutpoint)
+		if !found {
+			t.Fatalf("Expected the outpoint %s to be spent in the mempool", spentOutpoint)
+		}
+		if !spendingTransactionID.Equal(consensushashing.TransactionID(transaction)) {
+			t.Fatalf("Expected the outpoint to be spent by %s, got %s",
+				consensushashing.TransactionID(transaction), spendingTransactionID)
+		}
+	})
+}
+
 // TestHandleNewBlockTransactions verifies that all the transactions in the block were successfully removed from the mempool.
 func TestHandleNewBlockTransactions(t *testing.T) {
 	testutils.ForAllNets(t, true, func(t *testing.T, consensusConfig *consensus.Config) {
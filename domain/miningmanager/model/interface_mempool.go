@@ -12,6 +12,8 @@ type Mempool interface {
 	BlockCandidateTransactions() []*externalapi.DomainTransaction
 	ValidateAndInsertTransaction(transaction *externalapi.DomainTransaction, isHighPriority bool, allowOrphan bool) (
 		acceptedTransactions []*externalapi.DomainTransaction, err error)
+	TestTransactionAccept(transaction *externalapi.DomainTransaction) (
+		validatedTransaction *externalapi.DomainTransaction, err error)
 	RemoveInvalidTransactions(err *ruleerrors.ErrInvalidTransactionsInNewBlock) error
 	GetTransaction(
 		transactionID *externalapi.DomainTransactionID,
@@ -21,6 +23,8 @@ type Mempool interface {
 		transactionPoolTransaction *externalapi.DomainTransaction,
 		isOrphan bool,
 		found bool)
+	GetTransactionSpendingOutpoint(outpoint *externalapi.DomainOutpoint) (
+		transactionID *externalapi.DomainTransactionID, found bool)
 	GetTransactionsByAddresses(
 		includeTransactionPool bool,
 		includeOrphanPool bool) (
@@ -35,6 +39,13 @@ type Mempool interface {
 	) (
 		transactionPoolTransactions []*externalapi.DomainTransaction,
 		orphanPoolTransactions []*externalapi.DomainTransaction)
+	FilterTransactions(
+		filter func(transactionID *externalapi.DomainTransactionID) bool,
+		includeTransactionPool bool,
+		includeOrphanPool bool,
+	) (
+		transactionPoolTransactions []*externalapi.DomainTransaction,
+		orphanPoolTransactions []*externalapi.DomainTransaction)
 	TransactionCount(
 		includeTransactionPool bool,
 		includeOrphanPool bool) int
@@ -19,6 +19,7 @@ import (
 	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
 	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
 	"github.com/Hoosat-Oy/HTND/infrastructure/logger"
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/id"
 	"github.com/Hoosat-Oy/HTND/util"
 	"github.com/Hoosat-Oy/HTND/util/network"
 	"github.com/Hoosat-Oy/HTND/version"
@@ -43,6 +44,7 @@ const (
 	DefaultMaxRPCClients         = 500
 	defaultMaxRPCWebsockets      = 250
 	defaultMaxRPCConcurrentReqs  = 10000
+	defaultRPCRateBurst          = 100
 	defaultBlockMaxMass          = 10_000_000
 	blockMaxMassMin              = 1000
 	blockMaxMassMax              = 10_000_000
@@ -54,6 +56,7 @@ const (
 	sampleConfigFilename    = "sample-htnd.conf"
 	defaultMaxUTXOCacheSize = 5_000_000_000
 	defaultProtocolVersion  = 7
+	defaultP2PKeyFilename   = "p2p.key"
 )
 
 var (
@@ -83,6 +86,8 @@ type Flags struct {
 	LogDir                          string        `long:"logdir" description:"Directory to log output."`
 	AddPeers                        []string      `short:"a" long:"addpeer" description:"Add a peer to connect with at startup"`
 	ConnectPeers                    []string      `long:"connect" description:"Connect only to the specified peers at startup"`
+	P2PKeyFile                      string        `long:"p2pkeyfile" description:"File holding the static key this node authenticates with in the encrypted P2P transport. It is created if it doesn't exist (default: p2p.key in the network's data directory)"`
+	RejectUnencryptedP2P            bool          `long:"reject-unencrypted-p2p" description:"Only make and accept P2P connections over the encrypted transport. By default, peers that don't support it are connected unencrypted, and a warning is logged"`
 	DisableListen                   bool          `long:"nolisten" description:"Disable listening for incoming connections -- NOTE: Listening is automatically disabled if the --connect or --proxy options are used without also specifying listen interfaces via --listen"`
 	Listeners                       []string      `long:"listen" description:"Add an interface/port to listen for connections (default all interfaces port: 42421, testnet: 42423)"`
 	TargetOutboundPeers             int           `long:"outpeers" description:"Target number of outbound peers"`
@@ -99,6 +104,12 @@ type Flags struct {
 	RPCMaxConcurrentReqs            int           `long:"rpcmaxconcurrentreqs" description:"Max number of concurrent RPC requests that may be processed concurrently"`
 	DisableRPC                      bool          `long:"norpc" description:"Disable built-in RPC server"`
 	SafeRPC                         bool          `long:"saferpc" description:"Disable RPC commands which affect the state of the node"`
+	RPCAuth                         []string      `long:"rpcauth" description:"Add an RPC credential in the form <token>:<group>. Clients present the token as a bearer token in the 'authorization' gRPC metadata. Groups: {read-only, mining, wallet, admin}"`
+	RPCListenGroups                 []string      `long:"rpclistengroup" description:"Assign a permission group to clients without a credential that connect through an RPC listener, in the form <interface:port>=<group>"`
+	RPCDefaultGroup                 string        `long:"rpcdefaultgroup" description:"Permission group of RPC clients without a credential on listeners without an assigned group {read-only, mining, wallet, admin}"`
+	RPCRateLimit                    float64       `long:"rpcratelimit" description:"Max sustained number of RPC requests per second per client. 0 disables rate limiting"`
+	RPCRateBurst                    int           `long:"rpcrateburst" description:"Max number of RPC requests a client may burst above --rpcratelimit"`
+	RPCMaxClientConcurrentReqs      int           `long:"rpcmaxclientconcurrentreqs" description:"Max number of RPC requests a single client may have processed concurrently. 0 means no limit"`
 	DisableDNSSeed                  bool          `long:"nodnsseed" description:"Disable DNS seeding for peers"`
 	DNSSeed                         string        `long:"dnsseed" description:"Override DNS seeds with specified hostname (Only 1 hostname allowed)"`
 	GRPCSeed                        string        `long:"grpcseed" description:"Hostname of gRPC server for seeding peers"`
@@ -123,6 +134,7 @@ type Flags struct {
 	ResetDatabase                   bool          `long:"reset-db" description:"Reset database before starting node. It's needed when switching between subnetworks."`
 	MaxUTXOCacheSize                uint64        `long:"maxutxocachesize" description:"Max size of loaded UTXO into ram from the disk in bytes"`
 	UTXOIndex                       bool          `long:"utxoindex" description:"Enable the UTXO index"`
+	AssumeValid                     string        `long:"assumevalid" description:"Hash of a selected chain block whose past is assumed to have valid transaction scripts and signatures, which aren't verified during IBD. Use 0 to verify all of them. Defaults to the assume-valid block of the active network"`
 	IsArchivalNode                  bool          `long:"archival" description:"Run as an archival node: don't delete old block data when moving the pruning point. (Warning: heavy disk usage)'"`
 	DeletionDepth                   uint64        `long:"deletion-depth" hidden:"true" description:"The depth at which pruning deletes blocks, multiplies pruning depth. Defaults to 0, which uses the configured pruning depth. (Warning: Setting a custom depth may significantly increase disk usage.)"`
 	AllowSubmitBlockWhenNotSynced   bool          `long:"allow-submit-block-when-not-synced" hidden:"true" description:"Allow the node to accept blocks from RPC while not synced (this flag is mainly used for testing)"`
@@ -152,6 +164,19 @@ type Config struct {
 	MinRelayTxFee util.Amount
 	Whitelists    []*net.IPNet
 	SubnetworkID  *externalapi.DomainSubnetworkID // nil in full nodes
+
+	// AssumeValidBlockHash is the block whose past is assumed to have valid
+	// transaction scripts. nil if all of them are verified
+	AssumeValidBlockHash *externalapi.DomainHash
+
+	// RPCCredentials maps RPC authentication tokens to their permission group
+	RPCCredentials map[string]RPCPermissionGroup
+	// RPCListenerGroups maps normalized RPC listener addresses to the permission
+	// group of clients without a credential that connect through them
+	RPCListenerGroups map[string]RPCPermissionGroup
+	// PinnedPeerPublicKeys maps the normalized addresses of --addpeer and --connect
+	// peers to the node public key they must authenticate with
+	PinnedPeerPublicKeys map[string]*id.NodePublicKey
 }
 
 // ServiceOptions defines the configuration options for the daemon as a service on
@@ -194,6 +219,8 @@ func defaultFlags() *Flags {
 		RPCMaxClients:                  DefaultMaxRPCClients,
 		RPCMaxWebsockets:               defaultMaxRPCWebsockets,
 		RPCMaxConcurrentReqs:           defaultMaxRPCConcurrentReqs,
+		RPCDefaultGroup:                string(RPCPermissionGroupAdmin),
+		RPCRateBurst:                   defaultRPCRateBurst,
 		AppDir:                         defaultDataDir,
 		RPCKey:                         defaultRPCKeyFile,
 		RPCCert:                        defaultRPCCertFile,
@@ -470,6 +497,24 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	if cfg.RPCMaxClientConcurrentReqs < 0 {
+		str := "%s: The rpcmaxclientconcurrentreqs option may " +
+			"not be less than 0 -- parsed [%d]"
+		err := errors.Errorf(str, funcName, cfg.RPCMaxClientConcurrentReqs)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	if cfg.RPCRateLimit < 0 || (cfg.RPCRateLimit > 0 && cfg.RPCRateBurst < 1) {
+		str := "%s: The rpcratelimit option may not be negative and rpcrateburst " +
+			"must be at least 1 when rate limiting is enabled -- parsed [%f, %d]"
+		err := errors.Errorf(str, funcName, cfg.RPCRateLimit, cfg.RPCRateBurst)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Validate the the minrelaytxfee.
 	cfg.MinRelayTxFee, err = util.NewAmount(cfg.Flags.MinRelayTxFee)
 	if err != nil {
@@ -530,6 +575,14 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	err = cfg.resolveRPCPermissions()
+	if err != nil {
+		err := errors.Errorf("%s: %s", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
 	// Disallow --addpeer and --connect used together
 	if len(cfg.AddPeers) > 0 && len(cfg.ConnectPeers) > 0 {
 		str := "%s: --addpeer and --connect can not be used together"
@@ -539,6 +592,29 @@ func LoadConfig() (*Config, error) {
 		return nil, err
 	}
 
+	err = cfg.resolvePinnedPeerPublicKeys()
+	if err != nil {
+		err := errors.Errorf("%s: %s", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		fmt.Fprintln(os.Stderr, usageMessage)
+		return nil, err
+	}
+
+	// Resolve the assume-valid block, which defaults to the one of the active network
+	cfg.AssumeValidBlockHash = cfg.NetParams().AssumeValidBlockHash
+	if cfg.AssumeValid == "0" {
+		cfg.AssumeValidBlockHash = nil
+	} else if cfg.AssumeValid != "" {
+		cfg.AssumeValidBlockHash, err = externalapi.NewDomainHashFromString(cfg.AssumeValid)
+		if err != nil {
+			str := "%s: the specified assumevalid block hash is invalid: %s"
+			err := errors.Errorf(str, funcName, err)
+			fmt.Fprintln(os.Stderr, err)
+			fmt.Fprintln(os.Stderr, usageMessage)
+			return nil, err
+		}
+	}
+
 	// Add default port to all added peer addresses if needed and remove
 	// duplicate addresses.
 	cfg.AddPeers, err = network.NormalizeAddresses(cfg.AddPeers,
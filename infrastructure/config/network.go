@@ -52,6 +52,10 @@ type overrideDAGParamsConfig struct {
 	DisableDifficultyAdjustment             *bool              `json:"disableDifficultyAdjustment"`
 	SkipProofOfWork                         *bool              `json:"skipProofOfWork"`
 	HardForkOmitGenesisFromParentsDAAScore  *uint64            `json:"hardForkOmitGenesisFromParentsDaaScore"`
+	DevFeeAddress                           *string            `json:"devFeeAddress"`
+	DevFeePercentage                        *uint64            `json:"devFeePercentage"`
+	DevFeeMinPercentage                     *uint64            `json:"devFeeMinPercentage"`
+	DevFeeActivationDAAScore                *uint64            `json:"devFeeActivationDaaScore"`
 }
 
 // ResolveNetwork parses the network command line argument and sets NetParams accordingly.
@@ -223,5 +227,21 @@ func (networkFlags *NetworkFlags) overrideDAGParams() error {
 		networkFlags.ActiveNetParams.SkipProofOfWork = *config.SkipProofOfWork
 	}
 
-	return nil
+	if config.DevFeeAddress != nil {
+		networkFlags.ActiveNetParams.DevFeeAddress = *config.DevFeeAddress
+	}
+
+	if config.DevFeePercentage != nil {
+		networkFlags.ActiveNetParams.DevFeePercentage = *config.DevFeePercentage
+	}
+
+	if config.DevFeeMinPercentage != nil {
+		networkFlags.ActiveNetParams.DevFeeMinPercentage = *config.DevFeeMinPercentage
+	}
+
+	if config.DevFeeActivationDAAScore != nil {
+		networkFlags.ActiveNetParams.DevFeeActivationDAAScore = *config.DevFeeActivationDAAScore
+	}
+
+	return networkFlags.ActiveNetParams.ValidateDevFee()
 }
@@ -0,0 +1,48 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/id"
+	"github.com/Hoosat-Oy/HTND/util/network"
+	"github.com/pkg/errors"
+)
+
+// resolvePinnedPeerPublicKeys strips the node public keys from --addpeer and --connect
+// peers given in the form <nodepublickey>@<address>, and records them in
+// cfg.PinnedPeerPublicKeys by the peers' normalized addresses. It also defaults
+// the P2P key file to the network's data directory, so that the node keeps its
+// public key across restarts.
+func (cfg *Config) resolvePinnedPeerPublicKeys() error {
+	if cfg.P2PKeyFile == "" {
+		cfg.P2PKeyFile = filepath.Join(cfg.AppDir, defaultP2PKeyFilename)
+	} else {
+		cfg.P2PKeyFile = cleanAndExpandPath(cfg.P2PKeyFile)
+	}
+
+	cfg.PinnedPeerPublicKeys = make(map[string]*id.NodePublicKey)
+	for _, peers := range [][]string{cfg.AddPeers, cfg.ConnectPeers} {
+		for i, peer := range peers {
+			separatorIndex := strings.Index(peer, "@")
+			if separatorIndex == -1 {
+				continue
+			}
+			publicKey, err := id.NodePublicKeyFromString(peer[:separatorIndex])
+			if err != nil {
+				return errors.Wrapf(err, "invalid pinned peer '%s'", peer)
+			}
+			address, err := network.NormalizeAddress(peer[separatorIndex+1:], cfg.NetParams().DefaultPort)
+			if err != nil {
+				return errors.Wrapf(err, "invalid pinned peer '%s'", peer)
+			}
+			if pinnedPublicKey, ok := cfg.PinnedPeerPublicKeys[address]; ok && !pinnedPublicKey.IsEqual(publicKey) {
+				return errors.Errorf("peer %s is pinned to more than one node public key", address)
+			}
+			cfg.PinnedPeerPublicKeys[address] = publicKey
+			peers[i] = address
+		}
+	}
+
+	return nil
+}
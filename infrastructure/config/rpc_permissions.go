@@ -0,0 +1,99 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/Hoosat-Oy/HTND/util/network"
+	"github.com/pkg/errors"
+)
+
+// RPCPermissionGroup is a named set of RPC commands that a client is allowed to call
+type RPCPermissionGroup string
+
+// The RPC permission groups, from the most to the least restrictive
+const (
+	// RPCPermissionGroupReadOnly allows querying the node without affecting it
+	RPCPermissionGroupReadOnly RPCPermissionGroup = "read-only"
+
+	// RPCPermissionGroupMining allows read-only access plus requesting block templates and submitting blocks
+	RPCPermissionGroupMining RPCPermissionGroup = "mining"
+
+	// RPCPermissionGroupWallet allows read-only access plus address queries and submitting transactions
+	RPCPermissionGroupWallet RPCPermissionGroup = "wallet"
+
+	// RPCPermissionGroupAdmin allows every RPC command
+	RPCPermissionGroupAdmin RPCPermissionGroup = "admin"
+)
+
+var rpcPermissionGroups = []RPCPermissionGroup{
+	RPCPermissionGroupReadOnly,
+	RPCPermissionGroupMining,
+	RPCPermissionGroupWallet,
+	RPCPermissionGroupAdmin,
+}
+
+// ParseRPCPermissionGroup returns the RPC permission group with the given name
+func ParseRPCPermissionGroup(name string) (RPCPermissionGroup, error) {
+	for _, group := range rpcPermissionGroups {
+		if string(group) == name {
+			return group, nil
+		}
+	}
+	return "", errors.Errorf("unknown RPC permission group '%s'", name)
+}
+
+// resolveRPCPermissions parses and validates the --rpcauth, --rpclistengroup and
+// --rpcdefaultgroup options. It expects cfg.RPCListeners to be normalized already.
+func (cfg *Config) resolveRPCPermissions() error {
+	_, err := ParseRPCPermissionGroup(cfg.RPCDefaultGroup)
+	if err != nil {
+		return errors.Wrapf(err, "invalid rpcdefaultgroup")
+	}
+
+	cfg.RPCCredentials = make(map[string]RPCPermissionGroup, len(cfg.RPCAuth))
+	for _, auth := range cfg.RPCAuth {
+		separatorIndex := strings.LastIndex(auth, ":")
+		if separatorIndex <= 0 {
+			return errors.Errorf("rpcauth must be in the form <token>:<group>")
+		}
+		token, groupName := auth[:separatorIndex], auth[separatorIndex+1:]
+		group, err := ParseRPCPermissionGroup(groupName)
+		if err != nil {
+			return errors.Wrapf(err, "invalid rpcauth")
+		}
+		if _, ok := cfg.RPCCredentials[token]; ok {
+			return errors.Errorf("rpcauth token is specified more than once")
+		}
+		cfg.RPCCredentials[token] = group
+	}
+
+	cfg.RPCListenerGroups = make(map[string]RPCPermissionGroup, len(cfg.RPCListenGroups))
+	for _, listenGroup := range cfg.RPCListenGroups {
+		separatorIndex := strings.LastIndex(listenGroup, "=")
+		if separatorIndex <= 0 {
+			return errors.Errorf("rpclistengroup '%s' must be in the form <interface:port>=<group>", listenGroup)
+		}
+		group, err := ParseRPCPermissionGroup(listenGroup[separatorIndex+1:])
+		if err != nil {
+			return errors.Wrapf(err, "invalid rpclistengroup '%s'", listenGroup)
+		}
+		listeners, err := network.NormalizeAddresses([]string{listenGroup[:separatorIndex]}, cfg.NetParams().RPCPort)
+		if err != nil {
+			return err
+		}
+		listener := listeners[0]
+		isKnownListener := false
+		for _, rpcListener := range cfg.RPCListeners {
+			if rpcListener == listener {
+				isKnownListener = true
+				break
+			}
+		}
+		if !isKnownListener {
+			return errors.Errorf("rpclistengroup '%s' refers to %s, which is not an RPC listener", listenGroup, listener)
+		}
+		cfg.RPCListenerGroups[listener] = group
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package id
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"lukechampine.com/blake3"
+)
+
+// NodePublicKeyLength is the length in bytes of a NodePublicKey
+const NodePublicKeyLength = ed25519.PublicKeySize
+
+// NodeKey is the static key a node authenticates itself with in the encrypted P2P transport.
+// The node's ID is derived from its public key, so that peers can't claim another node's ID.
+type NodeKey struct {
+	privateKey ed25519.PrivateKey
+}
+
+// NodePublicKey is the public part of a NodeKey
+type NodePublicKey [NodePublicKeyLength]byte
+
+// GenerateNodeKey generates a new random NodeKey
+func GenerateNodeKey() (*NodeKey, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &NodeKey{privateKey: privateKey}, nil
+}
+
+// LoadOrCreateNodeKey loads the NodeKey stored in the given file. If the file
+// doesn't exist, a new NodeKey is generated and stored in it.
+func LoadOrCreateNodeKey(path string) (*NodeKey, error) {
+	serializedSeed, err := os.ReadFile(path)
+	if err == nil {
+		seed, err := hex.DecodeString(strings.TrimSpace(string(serializedSeed)))
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, errors.Errorf("node key file %s is malformed", path)
+		}
+		return &NodeKey{privateKey: ed25519.NewKeyFromSeed(seed)}, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "error reading node key file %s", path)
+	}
+
+	nodeKey, err := GenerateNodeKey()
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating the directory of node key file %s", path)
+	}
+	err = os.WriteFile(path, []byte(hex.EncodeToString(nodeKey.privateKey.Seed())), 0600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error writing node key file %s", path)
+	}
+	return nodeKey, nil
+}
+
+// PublicKey returns the public key of the NodeKey
+func (k *NodeKey) PublicKey() *NodePublicKey {
+	publicKey := new(NodePublicKey)
+	copy(publicKey[:], k.privateKey.Public().(ed25519.PublicKey))
+	return publicKey
+}
+
+// Sign signs the given message with the NodeKey
+func (k *NodeKey) Sign(message []byte) []byte {
+	return ed25519.Sign(k.privateKey, message)
+}
+
+// ID returns the ID of the node that owns the NodeKey
+func (k *NodeKey) ID() *ID {
+	return k.PublicKey().ID()
+}
+
+// NodePublicKeyFromString parses a hex encoded NodePublicKey
+func NodePublicKeyFromString(publicKeyString string) (*NodePublicKey, error) {
+	serializedPublicKey, err := hex.DecodeString(publicKeyString)
+	if err != nil {
+		return nil, errors.Wrapf(err, "node public key '%s' is not hex encoded", publicKeyString)
+	}
+	return NodePublicKeyFromBytes(serializedPublicKey)
+}
+
+// NodePublicKeyFromBytes returns the NodePublicKey serialized in the given byte slice
+func NodePublicKeyFromBytes(serializedPublicKey []byte) (*NodePublicKey, error) {
+	if len(serializedPublicKey) != NodePublicKeyLength {
+		return nil, errors.Errorf("node public key must be %d bytes long, but got %d",
+			NodePublicKeyLength, len(serializedPublicKey))
+	}
+	publicKey := new(NodePublicKey)
+	copy(publicKey[:], serializedPublicKey)
+	return publicKey, nil
+}
+
+// Verify returns whether signature is a valid signature of message by the owner of the public key
+func (publicKey *NodePublicKey) Verify(message []byte, signature []byte) bool {
+	return ed25519.Verify(publicKey[:], message, signature)
+}
+
+// ID returns the ID of the node that owns the public key
+func (publicKey *NodePublicKey) ID() *ID {
+	hash := blake3.Sum256(publicKey[:])
+	id := new(ID)
+	copy(id.bytes[:], hash[:IDLength])
+	return id
+}
+
+// IsEqual returns whether publicKey equals to other
+func (publicKey *NodePublicKey) IsEqual(other *NodePublicKey) bool {
+	return *publicKey == *other
+}
+
+func (publicKey *NodePublicKey) String() string {
+	return hex.EncodeToString(publicKey[:])
+}
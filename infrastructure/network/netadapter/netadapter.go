@@ -40,11 +40,13 @@ type NetAdapter struct {
 // NewNetAdapter creates and starts a new NetAdapter on the
 // given listeningPort
 func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
-	netAdapterID, err := id.GenerateID()
+	nodeKey, err := loadNodeKey(cfg)
 	if err != nil {
 		return nil, err
 	}
-	p2pServer, err := grpcserver.NewP2PServer(cfg.Listeners)
+	log.Infof("P2P node public key: %s", nodeKey.PublicKey())
+
+	p2pServer, err := grpcserver.NewP2PServer(cfg.Listeners, nodeKey, !cfg.RejectUnencryptedP2P, cfg.PinnedPeerPublicKeys)
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +56,7 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	}
 	adapter := NetAdapter{
 		cfg:       cfg,
-		id:        netAdapterID,
+		id:        nodeKey.ID(),
 		p2pServer: p2pServer,
 		rpcServer: rpcServer,
 
@@ -67,6 +69,16 @@ func NewNetAdapter(cfg *config.Config) (*NetAdapter, error) {
 	return &adapter, nil
 }
 
+// loadNodeKey returns the node key stored in the configured P2P key file, or
+// a new ephemeral node key if no key file is configured. loadConfig always
+// configures one, so the latter only happens with hand-built configs
+func loadNodeKey(cfg *config.Config) (*id.NodeKey, error) {
+	if cfg.P2PKeyFile == "" {
+		return id.GenerateNodeKey()
+	}
+	return id.LoadOrCreateNodeKey(cfg.P2PKeyFile)
+}
+
 // Start begins the operation of the NetAdapter
 func (na *NetAdapter) Start() error {
 	if na.p2pRouterInitializer == nil {
@@ -70,11 +70,33 @@ func (c *NetConnection) SetID(peerID *id.ID) {
 	c.id = peerID
 }
 
+// PeerPublicKey returns the node public key the peer authenticated with in the
+// encrypted P2P transport, or nil if the connection is unencrypted
+func (c *NetConnection) PeerPublicKey() *id.NodePublicKey {
+	return c.connection.PeerPublicKey()
+}
+
 // Address returns the address associated with this connection
 func (c *NetConnection) Address() string {
 	return c.connection.Address().String()
 }
 
+// LocalAddress returns the local address an inbound connection was accepted on,
+// or an empty string if it is unknown
+func (c *NetConnection) LocalAddress() string {
+	localAddress := c.connection.LocalAddress()
+	if localAddress == nil {
+		return ""
+	}
+	return localAddress.String()
+}
+
+// AuthToken returns the authentication token presented by the remote side
+// of the connection, or an empty string if it presented none
+func (c *NetConnection) AuthToken() string {
+	return c.connection.AuthToken()
+}
+
 // IsOutbound returns whether the connection is outbound
 func (c *NetConnection) IsOutbound() bool {
 	return c.connection.IsOutbound()
@@ -5,6 +5,7 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/id"
 	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
 	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/pkg/errors"
@@ -18,6 +19,9 @@ import (
 type gRPCConnection struct {
 	server                   *gRPCServer
 	address                  *net.TCPAddr
+	localAddress             net.Addr
+	authToken                string
+	peerPublicKey            *id.NodePublicKey
 	stream                   grpcStream
 	router                   *router.Router
 	lowLevelClientConnection *grpc.ClientConn
@@ -132,6 +136,24 @@ func (c *gRPCConnection) Address() *net.TCPAddr {
 	return c.address
 }
 
+// LocalAddress returns the local address the connection was accepted on.
+// It is nil for outbound connections
+func (c *gRPCConnection) LocalAddress() net.Addr {
+	return c.localAddress
+}
+
+// AuthToken returns the authentication token that the remote side presented
+// when it opened the connection, or an empty string if it presented none
+func (c *gRPCConnection) AuthToken() string {
+	return c.authToken
+}
+
+// PeerPublicKey returns the node public key the remote node authenticated with
+// in the encrypted P2P transport, or nil if the connection is not encrypted
+func (c *gRPCConnection) PeerPublicKey() *id.NodePublicKey {
+	return c.peerPublicKey
+}
+
 func (c *gRPCConnection) receive() (*protowire.HoosatdMessage, error) {
 	// We use RLock here and in send() because they can work
 	// in parallel. closeSend(), however, must not have either
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,9 +12,16 @@ import (
 	"github.com/Hoosat-Oy/HTND/util/panics"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
 
+// AuthorizationMetadataKey is the gRPC metadata key in which clients
+// present their authentication token, in the form "Bearer <token>"
+const AuthorizationMetadataKey = "authorization"
+
+const authorizationBearerPrefix = "Bearer "
+
 type gRPCServer struct {
 	onConnectedHandler server.OnConnectedHandler
 	listeningAddresses []string
@@ -26,10 +34,13 @@ type gRPCServer struct {
 }
 
 // newGRPCServer creates a gRPC server
-func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string) *gRPCServer {
+func newGRPCServer(listeningAddresses []string, maxMessageSize int, maxInboundConnections int, name string,
+	serverOptions ...grpc.ServerOption) *gRPCServer {
+
 	log.Debugf("Created new %s GRPC server with maxMessageSize %d and maxInboundConnections %d", name, maxMessageSize, maxInboundConnections)
+	serverOptions = append(serverOptions, grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize))
 	return &gRPCServer{
-		server:                     grpc.NewServer(grpc.MaxRecvMsgSize(maxMessageSize), grpc.MaxSendMsgSize(maxMessageSize)),
+		server:                     grpc.NewServer(serverOptions...),
 		listeningAddresses:         listeningAddresses,
 		name:                       name,
 		maxInboundConnections:      maxInboundConnections,
@@ -111,6 +122,9 @@ func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStr
 	}
 
 	connection := newConnection(s, tcpAddress, stream, nil)
+	connection.localAddress = peerInfo.LocalAddr
+	connection.authToken = authTokenFromContext(ctx)
+	connection.peerPublicKey = peerPublicKeyFromAuthInfo(peerInfo.AuthInfo)
 
 	err = s.onConnectedHandler(connection)
 	if err != nil {
@@ -123,6 +137,21 @@ func (s *gRPCServer) handleInboundConnection(ctx context.Context, stream grpcStr
 	return nil
 }
 
+// authTokenFromContext extracts the bearer token sent by the client in the
+// "authorization" metadata header, if any
+func authTokenFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get(AuthorizationMetadataKey) {
+		if strings.HasPrefix(value, authorizationBearerPrefix) {
+			return strings.TrimPrefix(value, authorizationBearerPrefix)
+		}
+	}
+	return ""
+}
+
 func (s *gRPCServer) incrementInboundConnectionCountAndLimitIfRequired() (int, error) {
 	s.inboundConnectionCountLock.Lock()
 	defer s.inboundConnectionCountLock.Unlock()
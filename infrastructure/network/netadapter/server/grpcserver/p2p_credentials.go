@@ -0,0 +1,115 @@
+package grpcserver
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"time"
+
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/id"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+)
+
+const p2pEncryptionProtocol = "htnd-p2p-encryption"
+
+// p2pEncryptionHandshakeTimeout is the time a node has to complete the encryption handshake
+const p2pEncryptionHandshakeTimeout = 10 * time.Second
+
+// p2pAuthInfo is the credentials.AuthInfo of inbound P2P connections
+type p2pAuthInfo struct {
+	credentials.CommonAuthInfo
+
+	// peerPublicKey is the node public key the peer authenticated with. It is nil
+	// if the connection is unencrypted.
+	peerPublicKey *id.NodePublicKey
+}
+
+// AuthType returns the type of the p2pAuthInfo
+func (p2pAuthInfo) AuthType() string {
+	return p2pEncryptionProtocol
+}
+
+// p2pServerCredentials are the credentials.TransportCredentials of the P2P server. They run
+// the encryption handshake over inbound connections, and let through connections that are
+// unencrypted only if allowUnencrypted is set.
+type p2pServerCredentials struct {
+	nodeKey          *id.NodeKey
+	allowUnencrypted bool
+}
+
+// ClientHandshake is not supported by p2pServerCredentials, since outbound P2P connections
+// run the encryption handshake before they're handed to gRPC
+func (c *p2pServerCredentials) ClientHandshake(context.Context, string, net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, errors.New("p2pServerCredentials don't support client handshakes")
+}
+
+// ServerHandshake runs the encryption handshake over an inbound connection
+func (c *p2pServerCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	err := rawConn.SetDeadline(time.Now().Add(p2pEncryptionHandshakeTimeout))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	remoteHello := make([]byte, p2pHelloLength)
+	_, err = io.ReadFull(rawConn, remoteHello[:len(p2pEncryptionMagic)])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var conn net.Conn
+	authInfo := p2pAuthInfo{}
+	if bytes.Equal(remoteHello[:len(p2pEncryptionMagic)], p2pEncryptionMagic) {
+		_, err = io.ReadFull(rawConn, remoteHello[len(p2pEncryptionMagic):])
+		if err != nil {
+			return nil, nil, err
+		}
+		result, err := listenerEncryptionHandshake(rawConn, c.nodeKey, remoteHello)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "error in the encryption handshake with %s", rawConn.RemoteAddr())
+		}
+		conn = result.conn
+		authInfo.SecurityLevel = credentials.PrivacyAndIntegrity
+		authInfo.peerPublicKey = result.peerPublicKey
+	} else {
+		if !c.allowUnencrypted {
+			return nil, nil, errors.Errorf("rejected unencrypted P2P connection from %s", rawConn.RemoteAddr())
+		}
+		log.Warnf("Accepted an unencrypted P2P connection from %s, which doesn't support the encrypted transport",
+			rawConn.RemoteAddr())
+		conn = &prefixedConn{Conn: rawConn, prefix: remoteHello[:len(p2pEncryptionMagic)]}
+		authInfo.SecurityLevel = credentials.NoSecurity
+	}
+
+	err = rawConn.SetDeadline(time.Time{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return conn, authInfo, nil
+}
+
+// Info returns the protocol info of p2pServerCredentials
+func (c *p2pServerCredentials) Info() credentials.ProtocolInfo {
+	return credentials.ProtocolInfo{SecurityProtocol: p2pEncryptionProtocol}
+}
+
+// Clone returns a copy of p2pServerCredentials
+func (c *p2pServerCredentials) Clone() credentials.TransportCredentials {
+	return &p2pServerCredentials{nodeKey: c.nodeKey, allowUnencrypted: c.allowUnencrypted}
+}
+
+// OverrideServerName is not supported by p2pServerCredentials
+func (c *p2pServerCredentials) OverrideServerName(string) error {
+	return nil
+}
+
+// peerPublicKeyFromAuthInfo returns the node public key the peer of a connection with the
+// given AuthInfo authenticated with, or nil if it's not an encrypted P2P connection
+func peerPublicKeyFromAuthInfo(authInfo credentials.AuthInfo) *id.NodePublicKey {
+	p2pAuthInfo, ok := authInfo.(p2pAuthInfo)
+	if !ok {
+		return nil
+	}
+	return p2pAuthInfo.peerPublicKey
+}
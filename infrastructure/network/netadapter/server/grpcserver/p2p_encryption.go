@@ -0,0 +1,292 @@
+package grpcserver
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"sync"
+	"syscall"
+
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/id"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/chacha20poly1305"
+	"lukechampine.com/blake3"
+)
+
+// The encrypted P2P transport runs below gRPC. The dialing node opens the connection with a hello
+// carrying an ephemeral X25519 public key, and the listening node answers with a hello of its own.
+// Both nodes derive a ChaCha20-Poly1305 key per direction from the shared secret and the hellos,
+// then authenticate with their static node keys by signing the hellos over the encrypted channel.
+// Everything that follows, gRPC included, is sent in encrypted frames.
+
+var p2pEncryptionMagic = []byte("HTNE")
+
+const (
+	p2pEncryptionVersion = 1
+
+	// p2pHelloLength is the length of a hello: magic, version and ephemeral public key
+	p2pHelloLength = 4 + 1 + 32
+
+	// maxEncryptedFramePayloadSize is the max size of the plaintext carried by a single frame
+	maxEncryptedFramePayloadSize = 64 * 1024
+
+	encryptedFrameHeaderSize = 4
+
+	dialerKeyDerivationContext   = "htnd p2p encryption dialer to listener key"
+	listenerKeyDerivationContext = "htnd p2p encryption listener to dialer key"
+	authenticationDomain         = "htnd p2p encryption authentication"
+)
+
+// errUnencryptedPeer is returned from dialerEncryptionHandshake when the remote
+// node answers the hello with anything other than a hello of its own
+var errUnencryptedPeer = errors.New("peer does not support the encrypted P2P transport")
+
+// encryptionHandshakeResult is the outcome of a successful encryption handshake
+type encryptionHandshakeResult struct {
+	conn          *encryptedConn
+	peerPublicKey *id.NodePublicKey
+}
+
+// dialerEncryptionHandshake runs the encryption handshake over a connection the local node dialed.
+// If expectedPeerPublicKey is not nil, the remote node must authenticate with it.
+func dialerEncryptionHandshake(conn net.Conn, nodeKey *id.NodeKey,
+	expectedPeerPublicKey *id.NodePublicKey) (*encryptionHandshakeResult, error) {
+
+	ephemeralKey, localHello, err := newP2PHello()
+	if err != nil {
+		return nil, err
+	}
+	_, err = conn.Write(localHello)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteHello := make([]byte, p2pHelloLength)
+	_, err = io.ReadFull(conn, remoteHello[:len(p2pEncryptionMagic)])
+	if err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) {
+			return nil, errUnencryptedPeer
+		}
+		return nil, err
+	}
+	if !bytes.Equal(remoteHello[:len(p2pEncryptionMagic)], p2pEncryptionMagic) {
+		return nil, errUnencryptedPeer
+	}
+	_, err = io.ReadFull(conn, remoteHello[len(p2pEncryptionMagic):])
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := completeEncryptionHandshake(conn, nodeKey, ephemeralKey, localHello, remoteHello, true)
+	if err != nil {
+		return nil, err
+	}
+	if expectedPeerPublicKey != nil && !result.peerPublicKey.IsEqual(expectedPeerPublicKey) {
+		return nil, errors.Errorf("peer authenticated with node public key %s while %s is pinned",
+			result.peerPublicKey, expectedPeerPublicKey)
+	}
+	return result, nil
+}
+
+// listenerEncryptionHandshake runs the encryption handshake over a connection the local node
+// accepted, after the dialer's hello was read
+func listenerEncryptionHandshake(conn net.Conn, nodeKey *id.NodeKey, remoteHello []byte) (*encryptionHandshakeResult, error) {
+	ephemeralKey, localHello, err := newP2PHello()
+	if err != nil {
+		return nil, err
+	}
+	_, err = conn.Write(localHello)
+	if err != nil {
+		return nil, err
+	}
+
+	return completeEncryptionHandshake(conn, nodeKey, ephemeralKey, localHello, remoteHello, false)
+}
+
+func newP2PHello() (*ecdh.PrivateKey, []byte, error) {
+	ephemeralKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	hello := make([]byte, 0, p2pHelloLength)
+	hello = append(hello, p2pEncryptionMagic...)
+	hello = append(hello, p2pEncryptionVersion)
+	hello = append(hello, ephemeralKey.PublicKey().Bytes()...)
+	return ephemeralKey, hello, nil
+}
+
+func completeEncryptionHandshake(conn net.Conn, nodeKey *id.NodeKey, ephemeralKey *ecdh.PrivateKey,
+	localHello []byte, remoteHello []byte, isDialer bool) (*encryptionHandshakeResult, error) {
+
+	remoteVersion := remoteHello[len(p2pEncryptionMagic)]
+	if remoteVersion != p2pEncryptionVersion {
+		return nil, errors.Errorf("unsupported P2P encryption version %d", remoteVersion)
+	}
+	remoteEphemeralPublicKey, err := ecdh.X25519().NewPublicKey(remoteHello[len(p2pEncryptionMagic)+1:])
+	if err != nil {
+		return nil, err
+	}
+	sharedSecret, err := ephemeralKey.ECDH(remoteEphemeralPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	dialerHello, listenerHello := localHello, remoteHello
+	if !isDialer {
+		dialerHello, listenerHello = remoteHello, localHello
+	}
+	keyMaterial := make([]byte, 0, len(sharedSecret)+2*p2pHelloLength)
+	keyMaterial = append(keyMaterial, sharedSecret...)
+	keyMaterial = append(keyMaterial, dialerHello...)
+	keyMaterial = append(keyMaterial, listenerHello...)
+
+	dialerCipher, err := deriveP2PCipher(dialerKeyDerivationContext, keyMaterial)
+	if err != nil {
+		return nil, err
+	}
+	listenerCipher, err := deriveP2PCipher(listenerKeyDerivationContext, keyMaterial)
+	if err != nil {
+		return nil, err
+	}
+	encrypted := &encryptedConn{Conn: conn, writeCipher: dialerCipher, readCipher: listenerCipher}
+	if !isDialer {
+		encrypted.writeCipher, encrypted.readCipher = listenerCipher, dialerCipher
+	}
+
+	// Each node signs both hellos, so that the signature can't be replayed in another handshake
+	transcript := blake3.Sum256(append(append([]byte{}, dialerHello...), listenerHello...))
+	authentication := append(nodeKey.PublicKey()[:], nodeKey.Sign(authenticationMessage(transcript, isDialer))...)
+	_, err = encrypted.Write(authentication)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteAuthentication := make([]byte, len(authentication))
+	_, err = io.ReadFull(encrypted, remoteAuthentication)
+	if err != nil {
+		return nil, err
+	}
+	peerPublicKey, err := id.NodePublicKeyFromBytes(remoteAuthentication[:id.NodePublicKeyLength])
+	if err != nil {
+		return nil, err
+	}
+	if !peerPublicKey.Verify(authenticationMessage(transcript, !isDialer), remoteAuthentication[id.NodePublicKeyLength:]) {
+		return nil, errors.Errorf("invalid P2P authentication signature for node public key %s", peerPublicKey)
+	}
+
+	return &encryptionHandshakeResult{conn: encrypted, peerPublicKey: peerPublicKey}, nil
+}
+
+func deriveP2PCipher(context string, keyMaterial []byte) (cipher.AEAD, error) {
+	key := make([]byte, chacha20poly1305.KeySize)
+	blake3.DeriveKey(key, context, keyMaterial)
+	return chacha20poly1305.New(key)
+}
+
+func authenticationMessage(transcript [32]byte, isDialer bool) []byte {
+	role := byte('l')
+	if isDialer {
+		role = 'd'
+	}
+	message := append([]byte(authenticationDomain), role)
+	return append(message, transcript[:]...)
+}
+
+// encryptedConn is a net.Conn that encrypts everything written to it, and decrypts everything
+// read from it, in length-prefixed ChaCha20-Poly1305 frames
+type encryptedConn struct {
+	net.Conn
+
+	readLock    sync.Mutex
+	readCipher  cipher.AEAD
+	readNonce   uint64
+	readPending []byte
+
+	writeLock   sync.Mutex
+	writeCipher cipher.AEAD
+	writeNonce  uint64
+}
+
+func (c *encryptedConn) Read(p []byte) (int, error) {
+	c.readLock.Lock()
+	defer c.readLock.Unlock()
+
+	if len(c.readPending) == 0 {
+		header := make([]byte, encryptedFrameHeaderSize)
+		_, err := io.ReadFull(c.Conn, header)
+		if err != nil {
+			return 0, err
+		}
+		frameSize := binary.LittleEndian.Uint32(header)
+		if frameSize < uint32(c.readCipher.Overhead()) ||
+			frameSize > maxEncryptedFramePayloadSize+uint32(c.readCipher.Overhead()) {
+
+			return 0, errors.Errorf("invalid encrypted frame size %d", frameSize)
+		}
+		frame := make([]byte, frameSize)
+		_, err = io.ReadFull(c.Conn, frame)
+		if err != nil {
+			return 0, err
+		}
+		c.readPending, err = c.readCipher.Open(frame[:0], frameNonce(c.readNonce), frame, header)
+		if err != nil {
+			return 0, errors.Wrapf(err, "error decrypting frame")
+		}
+		c.readNonce++
+	}
+
+	n := copy(p, c.readPending)
+	c.readPending = c.readPending[n:]
+	return n, nil
+}
+
+func (c *encryptedConn) Write(p []byte) (int, error) {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	written := 0
+	for written < len(p) {
+		payloadSize := len(p) - written
+		if payloadSize > maxEncryptedFramePayloadSize {
+			payloadSize = maxEncryptedFramePayloadSize
+		}
+
+		frame := make([]byte, encryptedFrameHeaderSize, encryptedFrameHeaderSize+payloadSize+c.writeCipher.Overhead())
+		binary.LittleEndian.PutUint32(frame, uint32(payloadSize+c.writeCipher.Overhead()))
+		frame = c.writeCipher.Seal(frame, frameNonce(c.writeNonce), p[written:written+payloadSize], frame[:encryptedFrameHeaderSize])
+		c.writeNonce++
+
+		_, err := c.Conn.Write(frame)
+		if err != nil {
+			return written, err
+		}
+		written += payloadSize
+	}
+	return written, nil
+}
+
+func frameNonce(counter uint64) []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	binary.LittleEndian.PutUint64(nonce, counter)
+	return nonce
+}
+
+// prefixedConn is a net.Conn whose first bytes were already read, and are returned by Read
+// before the rest of the connection
+type prefixedConn struct {
+	net.Conn
+	prefix []byte
+}
+
+func (c *prefixedConn) Read(p []byte) (int, error) {
+	if len(c.prefix) > 0 {
+		n := copy(p, c.prefix)
+		c.prefix = c.prefix[n:]
+		return n, nil
+	}
+	return c.Conn.Read(p)
+}
@@ -0,0 +1,184 @@
+package grpcserver
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/id"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+)
+
+func newTestNodeKey(t *testing.T) *id.NodeKey {
+	nodeKey, err := id.GenerateNodeKey()
+	if err != nil {
+		t.Fatalf("GenerateNodeKey: %+v", err)
+	}
+	return nodeKey
+}
+
+// connectedTCPPair returns both ends of a loopback TCP connection
+func connectedTCPPair(t *testing.T) (dialerConn net.Conn, listenerConn net.Conn) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %+v", err)
+	}
+	defer listener.Close()
+
+	accepted := make(chan net.Conn)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			accepted <- nil
+			return
+		}
+		accepted <- conn
+	}()
+
+	dialerConn, err = net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial: %+v", err)
+	}
+	listenerConn = <-accepted
+	if listenerConn == nil {
+		t.Fatalf("Accept failed")
+	}
+	t.Cleanup(func() {
+		dialerConn.Close()
+		listenerConn.Close()
+	})
+	return dialerConn, listenerConn
+}
+
+type serverHandshakeResult struct {
+	conn     net.Conn
+	authInfo credentials.AuthInfo
+	err      error
+}
+
+func runServerHandshake(serverCredentials *p2pServerCredentials, conn net.Conn) chan serverHandshakeResult {
+	resultChan := make(chan serverHandshakeResult, 1)
+	go func() {
+		conn, authInfo, err := serverCredentials.ServerHandshake(conn)
+		resultChan <- serverHandshakeResult{conn: conn, authInfo: authInfo, err: err}
+	}()
+	return resultChan
+}
+
+func TestEncryptionHandshake(t *testing.T) {
+	dialerKey := newTestNodeKey(t)
+	listenerKey := newTestNodeKey(t)
+	dialerConn, listenerConn := connectedTCPPair(t)
+
+	serverResultChan := runServerHandshake(&p2pServerCredentials{nodeKey: listenerKey}, listenerConn)
+	dialerResult, err := dialerEncryptionHandshake(dialerConn, dialerKey, listenerKey.PublicKey())
+	if err != nil {
+		t.Fatalf("dialerEncryptionHandshake: %+v", err)
+	}
+	serverResult := <-serverResultChan
+	if serverResult.err != nil {
+		t.Fatalf("ServerHandshake: %+v", serverResult.err)
+	}
+
+	if !dialerResult.peerPublicKey.IsEqual(listenerKey.PublicKey()) {
+		t.Fatalf("dialer authenticated %s, expected %s", dialerResult.peerPublicKey, listenerKey.PublicKey())
+	}
+	serverPeerPublicKey := peerPublicKeyFromAuthInfo(serverResult.authInfo)
+	if serverPeerPublicKey == nil || !serverPeerPublicKey.IsEqual(dialerKey.PublicKey()) {
+		t.Fatalf("listener authenticated %v, expected %s", serverPeerPublicKey, dialerKey.PublicKey())
+	}
+
+	// Send more than a single frame in each direction
+	message := bytes.Repeat([]byte("htnd"), maxEncryptedFramePayloadSize)
+	for _, pair := range []struct{ writer, reader net.Conn }{
+		{dialerResult.conn, serverResult.conn},
+		{serverResult.conn, dialerResult.conn},
+	} {
+		go func(writer net.Conn) {
+			_, _ = writer.Write(message)
+		}(pair.writer)
+		received := make([]byte, len(message))
+		_, err := io.ReadFull(pair.reader, received)
+		if err != nil {
+			t.Fatalf("ReadFull: %+v", err)
+		}
+		if !bytes.Equal(received, message) {
+			t.Fatalf("received message is different from the sent one")
+		}
+	}
+}
+
+func TestEncryptionHandshakePinnedKeyMismatch(t *testing.T) {
+	dialerKey := newTestNodeKey(t)
+	listenerKey := newTestNodeKey(t)
+	pinnedKey := newTestNodeKey(t)
+	dialerConn, listenerConn := connectedTCPPair(t)
+
+	runServerHandshake(&p2pServerCredentials{nodeKey: listenerKey}, listenerConn)
+	_, err := dialerEncryptionHandshake(dialerConn, dialerKey, pinnedKey.PublicKey())
+	if err == nil {
+		t.Fatalf("dialerEncryptionHandshake unexpectedly succeeded with a mismatching pinned key")
+	}
+}
+
+func TestEncryptionHandshakeUnencryptedPeer(t *testing.T) {
+	dialerConn, listenerConn := connectedTCPPair(t)
+
+	// A peer that doesn't support encryption answers with something that isn't a hello
+	go func() {
+		hello := make([]byte, p2pHelloLength)
+		_, _ = io.ReadFull(listenerConn, hello)
+		_, _ = listenerConn.Write([]byte("PRI * HTTP/2.0"))
+	}()
+	_, err := dialerEncryptionHandshake(dialerConn, newTestNodeKey(t), nil)
+	if !errors.Is(err, errUnencryptedPeer) {
+		t.Fatalf("expected errUnencryptedPeer, got %+v", err)
+	}
+}
+
+func TestServerHandshakeUnencryptedPeer(t *testing.T) {
+	plaintext := []byte("PRI * HTTP/2.0")
+
+	tests := []struct {
+		allowUnencrypted bool
+		expectsSuccess   bool
+	}{
+		{allowUnencrypted: false, expectsSuccess: false},
+		{allowUnencrypted: true, expectsSuccess: true},
+	}
+	for _, test := range tests {
+		dialerConn, listenerConn := connectedTCPPair(t)
+		serverCredentials := &p2pServerCredentials{nodeKey: newTestNodeKey(t), allowUnencrypted: test.allowUnencrypted}
+		serverResultChan := runServerHandshake(serverCredentials, listenerConn)
+		_, err := dialerConn.Write(plaintext)
+		if err != nil {
+			t.Fatalf("Write: %+v", err)
+		}
+
+		serverResult := <-serverResultChan
+		if !test.expectsSuccess {
+			if serverResult.err == nil {
+				t.Fatalf("ServerHandshake unexpectedly accepted an unencrypted connection")
+			}
+			continue
+		}
+		if serverResult.err != nil {
+			t.Fatalf("ServerHandshake: %+v", serverResult.err)
+		}
+		if peerPublicKeyFromAuthInfo(serverResult.authInfo) != nil {
+			t.Fatalf("unencrypted connection unexpectedly has a peer public key")
+		}
+
+		// The bytes read while looking for a hello must still be readable by gRPC
+		received := make([]byte, len(plaintext))
+		_, err = io.ReadFull(serverResult.conn, received)
+		if err != nil {
+			t.Fatalf("ReadFull: %+v", err)
+		}
+		if !bytes.Equal(received, plaintext) {
+			t.Fatalf("expected %q, got %q", plaintext, received)
+		}
+	}
+}
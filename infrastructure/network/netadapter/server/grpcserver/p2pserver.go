@@ -5,12 +5,12 @@ import (
 	"net"
 	"time"
 
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/id"
 	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/server"
 	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/server/grpcserver/protowire"
 	"github.com/Hoosat-Oy/HTND/util/panics"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/peer"
@@ -19,6 +19,10 @@ import (
 type p2pServer struct {
 	protowire.UnimplementedP2PServer
 	gRPCServer
+
+	nodeKey              *id.NodeKey
+	allowUnencrypted     bool
+	pinnedPeerPublicKeys map[string]*id.NodePublicKey
 }
 
 const p2pMaxMessageSize = 1024 * 1024 * 1024 * 4 // 1GB
@@ -29,10 +33,22 @@ const p2pMaxMessageSize = 1024 * 1024 * 1024 * 4 // 1GB
 // is handled in the ConnectionManager instead.
 const p2pMaxInboundConnections = 0
 
-// NewP2PServer creates a new P2PServer
-func NewP2PServer(listeningAddresses []string) (server.P2PServer, error) {
-	gRPCServer := newGRPCServer(listeningAddresses, p2pMaxMessageSize, p2pMaxInboundConnections, "P2P")
-	p2pServer := &p2pServer{gRPCServer: *gRPCServer}
+// NewP2PServer creates a new P2PServer. Connections are encrypted and authenticated with
+// nodeKey, and connections with peers that don't support encryption are made only if
+// allowUnencrypted is set. Peers whose addresses are in pinnedPeerPublicKeys must
+// authenticate with the node public key they're mapped to.
+func NewP2PServer(listeningAddresses []string, nodeKey *id.NodeKey, allowUnencrypted bool,
+	pinnedPeerPublicKeys map[string]*id.NodePublicKey) (server.P2PServer, error) {
+
+	serverCredentials := &p2pServerCredentials{nodeKey: nodeKey, allowUnencrypted: allowUnencrypted}
+	gRPCServer := newGRPCServer(listeningAddresses, p2pMaxMessageSize, p2pMaxInboundConnections, "P2P",
+		grpc.Creds(serverCredentials))
+	p2pServer := &p2pServer{
+		gRPCServer:           *gRPCServer,
+		nodeKey:              nodeKey,
+		allowUnencrypted:     allowUnencrypted,
+		pinnedPeerPublicKeys: pinnedPeerPublicKeys,
+	}
 	protowire.RegisterP2PServer(gRPCServer.server, p2pServer)
 	return p2pServer, nil
 }
@@ -52,26 +68,26 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), dialTimeout)
 	defer cancel()
 
-	gRPCClientConnection, err := grpc.DialContext(ctx, address, grpc.WithInsecure(), grpc.WithBlock())
+	conn, peerPublicKey, err := p.dial(ctx, address)
 	if err != nil {
-		// Use modern gRPC client with better connection management and backoff
-		connectParams := grpc.ConnectParams{
-			Backoff: backoff.Config{
-				BaseDelay:  1.0 * time.Second,
-				Multiplier: 1.6,
-				Jitter:     0.2,
-				MaxDelay:   120 * time.Second,
-			},
-			MinConnectTimeout: 5 * time.Second,
-		}
+		return nil, errors.Wrapf(err, "%s error connecting to %s", p.name, address)
+	}
 
-		gRPCClientConnection, err = grpc.NewClient(address,
-			grpc.WithConnectParams(connectParams),
-			grpc.WithTransportCredentials(insecure.NewCredentials()),
-		)
-		if err != nil {
-			return nil, errors.Wrapf(err, "%s error connecting to %s", p.name, address)
+	// The connection is already established, so gRPC is given a dialer that returns it,
+	// and no transport credentials of its own
+	isConnUsed := false
+	dialer := func(context.Context, string) (net.Conn, error) {
+		if isConnUsed {
+			return nil, errors.Errorf("connection to %s was closed", address)
 		}
+		isConnUsed = true
+		return conn, nil
+	}
+	gRPCClientConnection, err := grpc.DialContext(ctx, address, grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Wrapf(err, "%s error connecting to %s", p.name, address)
 	}
 
 	client := protowire.NewP2PClient(gRPCClientConnection)
@@ -91,6 +107,7 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 	}
 
 	connection := newConnection(&p.gRPCServer, tcpAddress, stream, gRPCClientConnection)
+	connection.peerPublicKey = peerPublicKey
 
 	err = p.onConnectedHandler(connection)
 	if err != nil {
@@ -101,3 +118,45 @@ func (p *p2pServer) Connect(address string) (server.Connection, error) {
 
 	return connection, nil
 }
+
+// dial opens a TCP connection to the given address and runs the encryption handshake over it.
+// If the peer doesn't support encryption, it reconnects unencrypted when that's allowed and
+// the peer's public key is not pinned. The returned public key is nil if the connection is
+// unencrypted.
+func (p *p2pServer) dial(ctx context.Context, address string) (net.Conn, *id.NodePublicKey, error) {
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	deadline, _ := ctx.Deadline()
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+
+	pinnedPeerPublicKey := p.pinnedPeerPublicKeys[address]
+	result, err := dialerEncryptionHandshake(conn, p.nodeKey, pinnedPeerPublicKey)
+	if err != nil {
+		_ = conn.Close()
+		if !errors.Is(err, errUnencryptedPeer) || !p.allowUnencrypted || pinnedPeerPublicKey != nil {
+			return nil, nil, err
+		}
+
+		log.Warnf("%s %s doesn't support the encrypted transport, connecting unencrypted", p.name, address)
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return nil, nil, err
+		}
+		return conn, nil, nil
+	}
+
+	err = conn.SetDeadline(time.Time{})
+	if err != nil {
+		_ = conn.Close()
+		return nil, nil, err
+	}
+	return result.conn, result.peerPublicKey, nil
+}
@@ -68,6 +68,9 @@ type HoosatdMessage struct {
 	//	*HoosatdMessage_IbdChainBlockLocator
 	//	*HoosatdMessage_RequestAnticone
 	//	*HoosatdMessage_RequestNextPruningPointAndItsAnticoneBlocks
+	//	*HoosatdMessage_CompactBlock
+	//	*HoosatdMessage_RequestBlockTransactions
+	//	*HoosatdMessage_BlockTransactions
 	//	*HoosatdMessage_GetCurrentNetworkRequest
 	//	*HoosatdMessage_GetCurrentNetworkResponse
 	//	*HoosatdMessage_SubmitBlockRequest
@@ -157,6 +160,35 @@ type HoosatdMessage struct {
 	//	*HoosatdMessage_GetCoinSupplyResponse
 	//	*HoosatdMessage_GetBlockByTransactionIdRequest
 	//	*HoosatdMessage_GetBlockByTransactionIdResponse
+	//	*HoosatdMessage_GetCoinbaseSplitRequest
+	//	*HoosatdMessage_GetCoinbaseSplitResponse
+	//	*HoosatdMessage_GetEmissionInfoRequest
+	//	*HoosatdMessage_GetEmissionInfoResponse
+	//	*HoosatdMessage_TestMempoolAcceptRequest
+	//	*HoosatdMessage_TestMempoolAcceptResponse
+	//	*HoosatdMessage_DecodeTransactionRequest
+	//	*HoosatdMessage_DecodeTransactionResponse
+	//	*HoosatdMessage_DecodeScriptRequest
+	//	*HoosatdMessage_DecodeScriptResponse
+	//	*HoosatdMessage_GetUtxoEntriesByOutpointsRequest
+	//	*HoosatdMessage_GetUtxoEntriesByOutpointsResponse
+	//	*HoosatdMessage_GetSyncStatusRequest
+	//	*HoosatdMessage_GetSyncStatusResponse
+	//	*HoosatdMessage_NotifySyncStatusChangedRequest
+	//	*HoosatdMessage_NotifySyncStatusChangedResponse
+	//	*HoosatdMessage_SyncStatusChangedNotification
+	//	*HoosatdMessage_VerifyMessageRequest
+	//	*HoosatdMessage_VerifyMessageResponse
+	//	*HoosatdMessage_GetTransactionInclusionProofRequest
+	//	*HoosatdMessage_GetTransactionInclusionProofResponse
+	//	*HoosatdMessage_InvalidateBlockRequest
+	//	*HoosatdMessage_InvalidateBlockResponse
+	//	*HoosatdMessage_ReconsiderBlockRequest
+	//	*HoosatdMessage_ReconsiderBlockResponse
+	//	*HoosatdMessage_GetDagSnapshotRequest
+	//	*HoosatdMessage_GetDagSnapshotResponse
+	//	*HoosatdMessage_GetTransactionStatusRequest
+	//	*HoosatdMessage_GetTransactionStatusResponse
 	Payload       isHoosatdMessage_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -586,6 +618,33 @@ func (x *HoosatdMessage) GetRequestNextPruningPointAndItsAnticoneBlocks() *Reque
 	return nil
 }
 
+func (x *HoosatdMessage) GetCompactBlock() *CompactBlockMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_CompactBlock); ok {
+			return x.CompactBlock
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetRequestBlockTransactions() *RequestBlockTransactionsMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_RequestBlockTransactions); ok {
+			return x.RequestBlockTransactions
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetBlockTransactions() *BlockTransactionsMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_BlockTransactions); ok {
+			return x.BlockTransactions
+		}
+	}
+	return nil
+}
+
 func (x *HoosatdMessage) GetGetCurrentNetworkRequest() *GetCurrentNetworkRequestMessage {
 	if x != nil {
 		if x, ok := x.Payload.(*HoosatdMessage_GetCurrentNetworkRequest); ok {
@@ -1387,6 +1446,267 @@ func (x *HoosatdMessage) GetGetBlockByTransactionIdResponse() *GetBlockByTransac
 	return nil
 }
 
+func (x *HoosatdMessage) GetGetCoinbaseSplitRequest() *GetCoinbaseSplitRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetCoinbaseSplitRequest); ok {
+			return x.GetCoinbaseSplitRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetCoinbaseSplitResponse() *GetCoinbaseSplitResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetCoinbaseSplitResponse); ok {
+			return x.GetCoinbaseSplitResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetEmissionInfoRequest() *GetEmissionInfoRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetEmissionInfoRequest); ok {
+			return x.GetEmissionInfoRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetEmissionInfoResponse() *GetEmissionInfoResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetEmissionInfoResponse); ok {
+			return x.GetEmissionInfoResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetTestMempoolAcceptRequest() *TestMempoolAcceptRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_TestMempoolAcceptRequest); ok {
+			return x.TestMempoolAcceptRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetTestMempoolAcceptResponse() *TestMempoolAcceptResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_TestMempoolAcceptResponse); ok {
+			return x.TestMempoolAcceptResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetDecodeTransactionRequest() *DecodeTransactionRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_DecodeTransactionRequest); ok {
+			return x.DecodeTransactionRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetDecodeTransactionResponse() *DecodeTransactionResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_DecodeTransactionResponse); ok {
+			return x.DecodeTransactionResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetDecodeScriptRequest() *DecodeScriptRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_DecodeScriptRequest); ok {
+			return x.DecodeScriptRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetDecodeScriptResponse() *DecodeScriptResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_DecodeScriptResponse); ok {
+			return x.DecodeScriptResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetUtxoEntriesByOutpointsRequest() *GetUtxoEntriesByOutpointsRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetUtxoEntriesByOutpointsRequest); ok {
+			return x.GetUtxoEntriesByOutpointsRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetUtxoEntriesByOutpointsResponse() *GetUtxoEntriesByOutpointsResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetUtxoEntriesByOutpointsResponse); ok {
+			return x.GetUtxoEntriesByOutpointsResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetSyncStatusRequest() *GetSyncStatusRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetSyncStatusRequest); ok {
+			return x.GetSyncStatusRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetSyncStatusResponse() *GetSyncStatusResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetSyncStatusResponse); ok {
+			return x.GetSyncStatusResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetNotifySyncStatusChangedRequest() *NotifySyncStatusChangedRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_NotifySyncStatusChangedRequest); ok {
+			return x.NotifySyncStatusChangedRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetNotifySyncStatusChangedResponse() *NotifySyncStatusChangedResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_NotifySyncStatusChangedResponse); ok {
+			return x.NotifySyncStatusChangedResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetSyncStatusChangedNotification() *SyncStatusChangedNotificationMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_SyncStatusChangedNotification); ok {
+			return x.SyncStatusChangedNotification
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetVerifyMessageRequest() *VerifyMessageRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_VerifyMessageRequest); ok {
+			return x.VerifyMessageRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetVerifyMessageResponse() *VerifyMessageResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_VerifyMessageResponse); ok {
+			return x.VerifyMessageResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetTransactionInclusionProofRequest() *GetTransactionInclusionProofRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetTransactionInclusionProofRequest); ok {
+			return x.GetTransactionInclusionProofRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetTransactionInclusionProofResponse() *GetTransactionInclusionProofResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetTransactionInclusionProofResponse); ok {
+			return x.GetTransactionInclusionProofResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetInvalidateBlockRequest() *InvalidateBlockRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_InvalidateBlockRequest); ok {
+			return x.InvalidateBlockRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetInvalidateBlockResponse() *InvalidateBlockResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_InvalidateBlockResponse); ok {
+			return x.InvalidateBlockResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetReconsiderBlockRequest() *ReconsiderBlockRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_ReconsiderBlockRequest); ok {
+			return x.ReconsiderBlockRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetReconsiderBlockResponse() *ReconsiderBlockResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_ReconsiderBlockResponse); ok {
+			return x.ReconsiderBlockResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetDagSnapshotRequest() *GetDagSnapshotRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetDagSnapshotRequest); ok {
+			return x.GetDagSnapshotRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetDagSnapshotResponse() *GetDagSnapshotResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetDagSnapshotResponse); ok {
+			return x.GetDagSnapshotResponse
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetTransactionStatusRequest() *GetTransactionStatusRequestMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetTransactionStatusRequest); ok {
+			return x.GetTransactionStatusRequest
+		}
+	}
+	return nil
+}
+
+func (x *HoosatdMessage) GetGetTransactionStatusResponse() *GetTransactionStatusResponseMessage {
+	if x != nil {
+		if x, ok := x.Payload.(*HoosatdMessage_GetTransactionStatusResponse); ok {
+			return x.GetTransactionStatusResponse
+		}
+	}
+	return nil
+}
+
 type isHoosatdMessage_Payload interface {
 	isHoosatdMessage_Payload()
 }
@@ -1563,6 +1883,18 @@ type HoosatdMessage_RequestNextPruningPointAndItsAnticoneBlocks struct {
 	RequestNextPruningPointAndItsAnticoneBlocks *RequestNextPruningPointAndItsAnticoneBlocksMessage `protobuf:"bytes,56,opt,name=requestNextPruningPointAndItsAnticoneBlocks,proto3,oneof"`
 }
 
+type HoosatdMessage_CompactBlock struct {
+	CompactBlock *CompactBlockMessage `protobuf:"bytes,57,opt,name=compactBlock,proto3,oneof"`
+}
+
+type HoosatdMessage_RequestBlockTransactions struct {
+	RequestBlockTransactions *RequestBlockTransactionsMessage `protobuf:"bytes,58,opt,name=requestBlockTransactions,proto3,oneof"`
+}
+
+type HoosatdMessage_BlockTransactions struct {
+	BlockTransactions *BlockTransactionsMessage `protobuf:"bytes,59,opt,name=blockTransactions,proto3,oneof"`
+}
+
 type HoosatdMessage_GetCurrentNetworkRequest struct {
 	GetCurrentNetworkRequest *GetCurrentNetworkRequestMessage `protobuf:"bytes,1001,opt,name=getCurrentNetworkRequest,proto3,oneof"`
 }
@@ -1919,6 +2251,122 @@ type HoosatdMessage_GetBlockByTransactionIdResponse struct {
 	GetBlockByTransactionIdResponse *GetBlockByTransactionIDResponseMessage `protobuf:"bytes,1089,opt,name=getBlockByTransactionIdResponse,proto3,oneof"`
 }
 
+type HoosatdMessage_GetCoinbaseSplitRequest struct {
+	GetCoinbaseSplitRequest *GetCoinbaseSplitRequestMessage `protobuf:"bytes,1090,opt,name=getCoinbaseSplitRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_GetCoinbaseSplitResponse struct {
+	GetCoinbaseSplitResponse *GetCoinbaseSplitResponseMessage `protobuf:"bytes,1091,opt,name=getCoinbaseSplitResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_GetEmissionInfoRequest struct {
+	GetEmissionInfoRequest *GetEmissionInfoRequestMessage `protobuf:"bytes,1092,opt,name=getEmissionInfoRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_GetEmissionInfoResponse struct {
+	GetEmissionInfoResponse *GetEmissionInfoResponseMessage `protobuf:"bytes,1093,opt,name=getEmissionInfoResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_TestMempoolAcceptRequest struct {
+	TestMempoolAcceptRequest *TestMempoolAcceptRequestMessage `protobuf:"bytes,1094,opt,name=testMempoolAcceptRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_TestMempoolAcceptResponse struct {
+	TestMempoolAcceptResponse *TestMempoolAcceptResponseMessage `protobuf:"bytes,1095,opt,name=testMempoolAcceptResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_DecodeTransactionRequest struct {
+	DecodeTransactionRequest *DecodeTransactionRequestMessage `protobuf:"bytes,1096,opt,name=decodeTransactionRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_DecodeTransactionResponse struct {
+	DecodeTransactionResponse *DecodeTransactionResponseMessage `protobuf:"bytes,1097,opt,name=decodeTransactionResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_DecodeScriptRequest struct {
+	DecodeScriptRequest *DecodeScriptRequestMessage `protobuf:"bytes,1098,opt,name=decodeScriptRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_DecodeScriptResponse struct {
+	DecodeScriptResponse *DecodeScriptResponseMessage `protobuf:"bytes,1099,opt,name=decodeScriptResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_GetUtxoEntriesByOutpointsRequest struct {
+	GetUtxoEntriesByOutpointsRequest *GetUtxoEntriesByOutpointsRequestMessage `protobuf:"bytes,1100,opt,name=getUtxoEntriesByOutpointsRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_GetUtxoEntriesByOutpointsResponse struct {
+	GetUtxoEntriesByOutpointsResponse *GetUtxoEntriesByOutpointsResponseMessage `protobuf:"bytes,1101,opt,name=getUtxoEntriesByOutpointsResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_GetSyncStatusRequest struct {
+	GetSyncStatusRequest *GetSyncStatusRequestMessage `protobuf:"bytes,1102,opt,name=getSyncStatusRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_GetSyncStatusResponse struct {
+	GetSyncStatusResponse *GetSyncStatusResponseMessage `protobuf:"bytes,1103,opt,name=getSyncStatusResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_NotifySyncStatusChangedRequest struct {
+	NotifySyncStatusChangedRequest *NotifySyncStatusChangedRequestMessage `protobuf:"bytes,1104,opt,name=notifySyncStatusChangedRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_NotifySyncStatusChangedResponse struct {
+	NotifySyncStatusChangedResponse *NotifySyncStatusChangedResponseMessage `protobuf:"bytes,1105,opt,name=notifySyncStatusChangedResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_SyncStatusChangedNotification struct {
+	SyncStatusChangedNotification *SyncStatusChangedNotificationMessage `protobuf:"bytes,1106,opt,name=syncStatusChangedNotification,proto3,oneof"`
+}
+
+type HoosatdMessage_VerifyMessageRequest struct {
+	VerifyMessageRequest *VerifyMessageRequestMessage `protobuf:"bytes,1107,opt,name=verifyMessageRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_VerifyMessageResponse struct {
+	VerifyMessageResponse *VerifyMessageResponseMessage `protobuf:"bytes,1108,opt,name=verifyMessageResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_GetTransactionInclusionProofRequest struct {
+	GetTransactionInclusionProofRequest *GetTransactionInclusionProofRequestMessage `protobuf:"bytes,1109,opt,name=getTransactionInclusionProofRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_GetTransactionInclusionProofResponse struct {
+	GetTransactionInclusionProofResponse *GetTransactionInclusionProofResponseMessage `protobuf:"bytes,1110,opt,name=getTransactionInclusionProofResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_InvalidateBlockRequest struct {
+	InvalidateBlockRequest *InvalidateBlockRequestMessage `protobuf:"bytes,1111,opt,name=invalidateBlockRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_InvalidateBlockResponse struct {
+	InvalidateBlockResponse *InvalidateBlockResponseMessage `protobuf:"bytes,1112,opt,name=invalidateBlockResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_ReconsiderBlockRequest struct {
+	ReconsiderBlockRequest *ReconsiderBlockRequestMessage `protobuf:"bytes,1113,opt,name=reconsiderBlockRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_ReconsiderBlockResponse struct {
+	ReconsiderBlockResponse *ReconsiderBlockResponseMessage `protobuf:"bytes,1114,opt,name=reconsiderBlockResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_GetDagSnapshotRequest struct {
+	GetDagSnapshotRequest *GetDagSnapshotRequestMessage `protobuf:"bytes,1115,opt,name=getDagSnapshotRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_GetDagSnapshotResponse struct {
+	GetDagSnapshotResponse *GetDagSnapshotResponseMessage `protobuf:"bytes,1116,opt,name=getDagSnapshotResponse,proto3,oneof"`
+}
+
+type HoosatdMessage_GetTransactionStatusRequest struct {
+	GetTransactionStatusRequest *GetTransactionStatusRequestMessage `protobuf:"bytes,1117,opt,name=getTransactionStatusRequest,proto3,oneof"`
+}
+
+type HoosatdMessage_GetTransactionStatusResponse struct {
+	GetTransactionStatusResponse *GetTransactionStatusResponseMessage `protobuf:"bytes,1118,opt,name=getTransactionStatusResponse,proto3,oneof"`
+}
+
 func (*HoosatdMessage_Addresses) isHoosatdMessage_Payload() {}
 
 func (*HoosatdMessage_Block) isHoosatdMessage_Payload() {}
@@ -2005,6 +2453,12 @@ func (*HoosatdMessage_RequestAnticone) isHoosatdMessage_Payload() {}
 
 func (*HoosatdMessage_RequestNextPruningPointAndItsAnticoneBlocks) isHoosatdMessage_Payload() {}
 
+func (*HoosatdMessage_CompactBlock) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_RequestBlockTransactions) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_BlockTransactions) isHoosatdMessage_Payload() {}
+
 func (*HoosatdMessage_GetCurrentNetworkRequest) isHoosatdMessage_Payload() {}
 
 func (*HoosatdMessage_GetCurrentNetworkResponse) isHoosatdMessage_Payload() {}
@@ -2185,11 +2639,69 @@ func (*HoosatdMessage_GetBlockByTransactionIdRequest) isHoosatdMessage_Payload()
 
 func (*HoosatdMessage_GetBlockByTransactionIdResponse) isHoosatdMessage_Payload() {}
 
+func (*HoosatdMessage_GetCoinbaseSplitRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetCoinbaseSplitResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetEmissionInfoRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetEmissionInfoResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_TestMempoolAcceptRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_TestMempoolAcceptResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_DecodeTransactionRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_DecodeTransactionResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_DecodeScriptRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_DecodeScriptResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetUtxoEntriesByOutpointsRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetUtxoEntriesByOutpointsResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetSyncStatusRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetSyncStatusResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_NotifySyncStatusChangedRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_NotifySyncStatusChangedResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_SyncStatusChangedNotification) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_VerifyMessageRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_VerifyMessageResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetTransactionInclusionProofRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetTransactionInclusionProofResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_InvalidateBlockRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_InvalidateBlockResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_ReconsiderBlockRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_ReconsiderBlockResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetDagSnapshotRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetDagSnapshotResponse) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetTransactionStatusRequest) isHoosatdMessage_Payload() {}
+
+func (*HoosatdMessage_GetTransactionStatusResponse) isHoosatdMessage_Payload() {}
+
 var File_messages_proto protoreflect.FileDescriptor
 
 const file_messages_proto_rawDesc = "" +
 	"\n" +
-	"\x0emessages.proto\x12\tprotowire\x1a\tp2p.proto\x1a\trpc.proto\"\xbdo\n" +
+	"\x0emessages.proto\x12\tprotowire\x1a\tp2p.proto\x1a\trpc.proto\"\xbc\x8a\x01\n" +
 	"\x0eHoosatdMessage\x12;\n" +
 	"\taddresses\x18\x01 \x01(\v2\x1b.protowire.AddressesMessageH\x00R\taddresses\x12/\n" +
 	"\x05block\x18\x02 \x01(\v2\x17.protowire.BlockMessageH\x00R\x05block\x12A\n" +
@@ -2234,7 +2746,10 @@ const file_messages_proto_rawDesc = "" +
 	"\x1brequestIBDChainBlockLocator\x185 \x01(\v2-.protowire.RequestIBDChainBlockLocatorMessageH\x00R\x1brequestIBDChainBlockLocator\x12\\\n" +
 	"\x14ibdChainBlockLocator\x186 \x01(\v2&.protowire.IbdChainBlockLocatorMessageH\x00R\x14ibdChainBlockLocator\x12M\n" +
 	"\x0frequestAnticone\x187 \x01(\v2!.protowire.RequestAnticoneMessageH\x00R\x0frequestAnticone\x12\xa1\x01\n" +
-	"+requestNextPruningPointAndItsAnticoneBlocks\x188 \x01(\v2=.protowire.RequestNextPruningPointAndItsAnticoneBlocksMessageH\x00R+requestNextPruningPointAndItsAnticoneBlocks\x12i\n" +
+	"+requestNextPruningPointAndItsAnticoneBlocks\x188 \x01(\v2=.protowire.RequestNextPruningPointAndItsAnticoneBlocksMessageH\x00R+requestNextPruningPointAndItsAnticoneBlocks\x12D\n" +
+	"\fcompactBlock\x189 \x01(\v2\x1e.protowire.CompactBlockMessageH\x00R\fcompactBlock\x12h\n" +
+	"\x18requestBlockTransactions\x18: \x01(\v2*.protowire.RequestBlockTransactionsMessageH\x00R\x18requestBlockTransactions\x12S\n" +
+	"\x11blockTransactions\x18; \x01(\v2#.protowire.BlockTransactionsMessageH\x00R\x11blockTransactions\x12i\n" +
 	"\x18getCurrentNetworkRequest\x18\xe9\a \x01(\v2*.protowire.GetCurrentNetworkRequestMessageH\x00R\x18getCurrentNetworkRequest\x12l\n" +
 	"\x19getCurrentNetworkResponse\x18\xea\a \x01(\v2+.protowire.GetCurrentNetworkResponseMessageH\x00R\x19getCurrentNetworkResponse\x12W\n" +
 	"\x12submitBlockRequest\x18\xeb\a \x01(\v2$.protowire.SubmitBlockRequestMessageH\x00R\x12submitBlockRequest\x12Z\n" +
@@ -2325,7 +2840,36 @@ const file_messages_proto_rawDesc = "" +
 	"\x14getCoinSupplyRequest\x18\xbe\b \x01(\v2&.protowire.GetCoinSupplyRequestMessageH\x00R\x14getCoinSupplyRequest\x12`\n" +
 	"\x15getCoinSupplyResponse\x18\xbf\b \x01(\v2'.protowire.GetCoinSupplyResponseMessageH\x00R\x15getCoinSupplyResponse\x12{\n" +
 	"\x1egetBlockByTransactionIdRequest\x18\xc0\b \x01(\v20.protowire.GetBlockByTransactionIDRequestMessageH\x00R\x1egetBlockByTransactionIdRequest\x12~\n" +
-	"\x1fgetBlockByTransactionIdResponse\x18\xc1\b \x01(\v21.protowire.GetBlockByTransactionIDResponseMessageH\x00R\x1fgetBlockByTransactionIdResponseB\t\n" +
+	"\x1fgetBlockByTransactionIdResponse\x18\xc1\b \x01(\v21.protowire.GetBlockByTransactionIDResponseMessageH\x00R\x1fgetBlockByTransactionIdResponse\x12f\n" +
+	"\x17getCoinbaseSplitRequest\x18\xc2\b \x01(\v2).protowire.GetCoinbaseSplitRequestMessageH\x00R\x17getCoinbaseSplitRequest\x12i\n" +
+	"\x18getCoinbaseSplitResponse\x18\xc3\b \x01(\v2*.protowire.GetCoinbaseSplitResponseMessageH\x00R\x18getCoinbaseSplitResponse\x12c\n" +
+	"\x16getEmissionInfoRequest\x18\xc4\b \x01(\v2(.protowire.GetEmissionInfoRequestMessageH\x00R\x16getEmissionInfoRequest\x12f\n" +
+	"\x17getEmissionInfoResponse\x18\xc5\b \x01(\v2).protowire.GetEmissionInfoResponseMessageH\x00R\x17getEmissionInfoResponse\x12i\n" +
+	"\x18testMempoolAcceptRequest\x18\xc6\b \x01(\v2*.protowire.TestMempoolAcceptRequestMessageH\x00R\x18testMempoolAcceptRequest\x12l\n" +
+	"\x19testMempoolAcceptResponse\x18\xc7\b \x01(\v2+.protowire.TestMempoolAcceptResponseMessageH\x00R\x19testMempoolAcceptResponse\x12i\n" +
+	"\x18decodeTransactionRequest\x18\xc8\b \x01(\v2*.protowire.DecodeTransactionRequestMessageH\x00R\x18decodeTransactionRequest\x12l\n" +
+	"\x19decodeTransactionResponse\x18\xc9\b \x01(\v2+.protowire.DecodeTransactionResponseMessageH\x00R\x19decodeTransactionResponse\x12Z\n" +
+	"\x13decodeScriptRequest\x18\xca\b \x01(\v2%.protowire.DecodeScriptRequestMessageH\x00R\x13decodeScriptRequest\x12]\n" +
+	"\x14decodeScriptResponse\x18\xcb\b \x01(\v2&.protowire.DecodeScriptResponseMessageH\x00R\x14decodeScriptResponse\x12\x81\x01\n" +
+	" getUtxoEntriesByOutpointsRequest\x18\xcc\b \x01(\v22.protowire.GetUtxoEntriesByOutpointsRequestMessageH\x00R getUtxoEntriesByOutpointsRequest\x12\x84\x01\n" +
+	"!getUtxoEntriesByOutpointsResponse\x18\xcd\b \x01(\v23.protowire.GetUtxoEntriesByOutpointsResponseMessageH\x00R!getUtxoEntriesByOutpointsResponse\x12]\n" +
+	"\x14getSyncStatusRequest\x18\xce\b \x01(\v2&.protowire.GetSyncStatusRequestMessageH\x00R\x14getSyncStatusRequest\x12`\n" +
+	"\x15getSyncStatusResponse\x18\xcf\b \x01(\v2'.protowire.GetSyncStatusResponseMessageH\x00R\x15getSyncStatusResponse\x12{\n" +
+	"\x1enotifySyncStatusChangedRequest\x18\xd0\b \x01(\v20.protowire.NotifySyncStatusChangedRequestMessageH\x00R\x1enotifySyncStatusChangedRequest\x12~\n" +
+	"\x1fnotifySyncStatusChangedResponse\x18\xd1\b \x01(\v21.protowire.NotifySyncStatusChangedResponseMessageH\x00R\x1fnotifySyncStatusChangedResponse\x12x\n" +
+	"\x1dsyncStatusChangedNotification\x18\xd2\b \x01(\v2/.protowire.SyncStatusChangedNotificationMessageH\x00R\x1dsyncStatusChangedNotification\x12]\n" +
+	"\x14verifyMessageRequest\x18\xd3\b \x01(\v2&.protowire.VerifyMessageRequestMessageH\x00R\x14verifyMessageRequest\x12`\n" +
+	"\x15verifyMessageResponse\x18\xd4\b \x01(\v2'.protowire.VerifyMessageResponseMessageH\x00R\x15verifyMessageResponse\x12\x8a\x01\n" +
+	"#getTransactionInclusionProofRequest\x18\xd5\b \x01(\v25.protowire.GetTransactionInclusionProofRequestMessageH\x00R#getTransactionInclusionProofRequest\x12\x8d\x01\n" +
+	"$getTransactionInclusionProofResponse\x18\xd6\b \x01(\v26.protowire.GetTransactionInclusionProofResponseMessageH\x00R$getTransactionInclusionProofResponse\x12c\n" +
+	"\x16invalidateBlockRequest\x18\xd7\b \x01(\v2(.protowire.InvalidateBlockRequestMessageH\x00R\x16invalidateBlockRequest\x12f\n" +
+	"\x17invalidateBlockResponse\x18\xd8\b \x01(\v2).protowire.InvalidateBlockResponseMessageH\x00R\x17invalidateBlockResponse\x12c\n" +
+	"\x16reconsiderBlockRequest\x18\xd9\b \x01(\v2(.protowire.ReconsiderBlockRequestMessageH\x00R\x16reconsiderBlockRequest\x12f\n" +
+	"\x17reconsiderBlockResponse\x18\xda\b \x01(\v2).protowire.ReconsiderBlockResponseMessageH\x00R\x17reconsiderBlockResponse\x12`\n" +
+	"\x15getDagSnapshotRequest\x18\xdb\b \x01(\v2'.protowire.GetDagSnapshotRequestMessageH\x00R\x15getDagSnapshotRequest\x12c\n" +
+	"\x16getDagSnapshotResponse\x18\xdc\b \x01(\v2(.protowire.GetDagSnapshotResponseMessageH\x00R\x16getDagSnapshotResponse\x12r\n" +
+	"\x1bgetTransactionStatusRequest\x18\xdd\b \x01(\v2-.protowire.GetTransactionStatusRequestMessageH\x00R\x1bgetTransactionStatusRequest\x12u\n" +
+	"\x1cgetTransactionStatusResponse\x18\xde\b \x01(\v2..protowire.GetTransactionStatusResponseMessageH\x00R\x1cgetTransactionStatusResponseB\t\n" +
 	"\apayload2R\n" +
 	"\x03P2P\x12K\n" +
 	"\rMessageStream\x12\x19.protowire.HoosatdMessage\x1a\x19.protowire.HoosatdMessage\"\x00(\x010\x012R\n" +
@@ -2389,95 +2933,127 @@ var file_messages_proto_goTypes = []any{
 	(*IbdChainBlockLocatorMessage)(nil),                                // 40: protowire.IbdChainBlockLocatorMessage
 	(*RequestAnticoneMessage)(nil),                                     // 41: protowire.RequestAnticoneMessage
 	(*RequestNextPruningPointAndItsAnticoneBlocksMessage)(nil),         // 42: protowire.RequestNextPruningPointAndItsAnticoneBlocksMessage
-	(*GetCurrentNetworkRequestMessage)(nil),                            // 43: protowire.GetCurrentNetworkRequestMessage
-	(*GetCurrentNetworkResponseMessage)(nil),                           // 44: protowire.GetCurrentNetworkResponseMessage
-	(*SubmitBlockRequestMessage)(nil),                                  // 45: protowire.SubmitBlockRequestMessage
-	(*SubmitBlockResponseMessage)(nil),                                 // 46: protowire.SubmitBlockResponseMessage
-	(*GetBlockTemplateRequestMessage)(nil),                             // 47: protowire.GetBlockTemplateRequestMessage
-	(*GetBlockTemplateResponseMessage)(nil),                            // 48: protowire.GetBlockTemplateResponseMessage
-	(*NotifyBlockAddedRequestMessage)(nil),                             // 49: protowire.NotifyBlockAddedRequestMessage
-	(*NotifyBlockAddedResponseMessage)(nil),                            // 50: protowire.NotifyBlockAddedResponseMessage
-	(*BlockAddedNotificationMessage)(nil),                              // 51: protowire.BlockAddedNotificationMessage
-	(*GetPeerAddressesRequestMessage)(nil),                             // 52: protowire.GetPeerAddressesRequestMessage
-	(*GetPeerAddressesResponseMessage)(nil),                            // 53: protowire.GetPeerAddressesResponseMessage
-	(*GetSelectedTipHashRequestMessage)(nil),                           // 54: protowire.GetSelectedTipHashRequestMessage
-	(*GetSelectedTipHashResponseMessage)(nil),                          // 55: protowire.GetSelectedTipHashResponseMessage
-	(*GetMempoolEntryRequestMessage)(nil),                              // 56: protowire.GetMempoolEntryRequestMessage
-	(*GetMempoolEntryResponseMessage)(nil),                             // 57: protowire.GetMempoolEntryResponseMessage
-	(*GetConnectedPeerInfoRequestMessage)(nil),                         // 58: protowire.GetConnectedPeerInfoRequestMessage
-	(*GetConnectedPeerInfoResponseMessage)(nil),                        // 59: protowire.GetConnectedPeerInfoResponseMessage
-	(*AddPeerRequestMessage)(nil),                                      // 60: protowire.AddPeerRequestMessage
-	(*AddPeerResponseMessage)(nil),                                     // 61: protowire.AddPeerResponseMessage
-	(*SubmitTransactionRequestMessage)(nil),                            // 62: protowire.SubmitTransactionRequestMessage
-	(*SubmitTransactionResponseMessage)(nil),                           // 63: protowire.SubmitTransactionResponseMessage
-	(*NotifyVirtualSelectedParentChainChangedRequestMessage)(nil),      // 64: protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
-	(*NotifyVirtualSelectedParentChainChangedResponseMessage)(nil),     // 65: protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
-	(*VirtualSelectedParentChainChangedNotificationMessage)(nil),       // 66: protowire.VirtualSelectedParentChainChangedNotificationMessage
-	(*GetBlockRequestMessage)(nil),                                     // 67: protowire.GetBlockRequestMessage
-	(*GetBlockResponseMessage)(nil),                                    // 68: protowire.GetBlockResponseMessage
-	(*GetSubnetworkRequestMessage)(nil),                                // 69: protowire.GetSubnetworkRequestMessage
-	(*GetSubnetworkResponseMessage)(nil),                               // 70: protowire.GetSubnetworkResponseMessage
-	(*GetVirtualSelectedParentChainFromBlockRequestMessage)(nil),       // 71: protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
-	(*GetVirtualSelectedParentChainFromBlockResponseMessage)(nil),      // 72: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
-	(*GetBlocksRequestMessage)(nil),                                    // 73: protowire.GetBlocksRequestMessage
-	(*GetBlocksResponseMessage)(nil),                                   // 74: protowire.GetBlocksResponseMessage
-	(*GetBlockCountRequestMessage)(nil),                                // 75: protowire.GetBlockCountRequestMessage
-	(*GetBlockCountResponseMessage)(nil),                               // 76: protowire.GetBlockCountResponseMessage
-	(*GetBlockDagInfoRequestMessage)(nil),                              // 77: protowire.GetBlockDagInfoRequestMessage
-	(*GetBlockDagInfoResponseMessage)(nil),                             // 78: protowire.GetBlockDagInfoResponseMessage
-	(*ResolveFinalityConflictRequestMessage)(nil),                      // 79: protowire.ResolveFinalityConflictRequestMessage
-	(*ResolveFinalityConflictResponseMessage)(nil),                     // 80: protowire.ResolveFinalityConflictResponseMessage
-	(*NotifyFinalityConflictsRequestMessage)(nil),                      // 81: protowire.NotifyFinalityConflictsRequestMessage
-	(*NotifyFinalityConflictsResponseMessage)(nil),                     // 82: protowire.NotifyFinalityConflictsResponseMessage
-	(*FinalityConflictNotificationMessage)(nil),                        // 83: protowire.FinalityConflictNotificationMessage
-	(*FinalityConflictResolvedNotificationMessage)(nil),                // 84: protowire.FinalityConflictResolvedNotificationMessage
-	(*GetMempoolEntriesRequestMessage)(nil),                            // 85: protowire.GetMempoolEntriesRequestMessage
-	(*GetMempoolEntriesResponseMessage)(nil),                           // 86: protowire.GetMempoolEntriesResponseMessage
-	(*ShutDownRequestMessage)(nil),                                     // 87: protowire.ShutDownRequestMessage
-	(*ShutDownResponseMessage)(nil),                                    // 88: protowire.ShutDownResponseMessage
-	(*GetHeadersRequestMessage)(nil),                                   // 89: protowire.GetHeadersRequestMessage
-	(*GetHeadersResponseMessage)(nil),                                  // 90: protowire.GetHeadersResponseMessage
-	(*NotifyUtxosChangedRequestMessage)(nil),                           // 91: protowire.NotifyUtxosChangedRequestMessage
-	(*NotifyUtxosChangedResponseMessage)(nil),                          // 92: protowire.NotifyUtxosChangedResponseMessage
-	(*UtxosChangedNotificationMessage)(nil),                            // 93: protowire.UtxosChangedNotificationMessage
-	(*GetUtxosByAddressesRequestMessage)(nil),                          // 94: protowire.GetUtxosByAddressesRequestMessage
-	(*GetUtxosByAddressesResponseMessage)(nil),                         // 95: protowire.GetUtxosByAddressesResponseMessage
-	(*GetVirtualSelectedParentBlueScoreRequestMessage)(nil),            // 96: protowire.GetVirtualSelectedParentBlueScoreRequestMessage
-	(*GetVirtualSelectedParentBlueScoreResponseMessage)(nil),           // 97: protowire.GetVirtualSelectedParentBlueScoreResponseMessage
-	(*NotifyVirtualSelectedParentBlueScoreChangedRequestMessage)(nil),  // 98: protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
-	(*NotifyVirtualSelectedParentBlueScoreChangedResponseMessage)(nil), // 99: protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
-	(*VirtualSelectedParentBlueScoreChangedNotificationMessage)(nil),   // 100: protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
-	(*BanRequestMessage)(nil),                                          // 101: protowire.BanRequestMessage
-	(*BanResponseMessage)(nil),                                         // 102: protowire.BanResponseMessage
-	(*UnbanRequestMessage)(nil),                                        // 103: protowire.UnbanRequestMessage
-	(*UnbanResponseMessage)(nil),                                       // 104: protowire.UnbanResponseMessage
-	(*GetInfoRequestMessage)(nil),                                      // 105: protowire.GetInfoRequestMessage
-	(*GetInfoResponseMessage)(nil),                                     // 106: protowire.GetInfoResponseMessage
-	(*StopNotifyingUtxosChangedRequestMessage)(nil),                    // 107: protowire.StopNotifyingUtxosChangedRequestMessage
-	(*StopNotifyingUtxosChangedResponseMessage)(nil),                   // 108: protowire.StopNotifyingUtxosChangedResponseMessage
-	(*NotifyPruningPointUTXOSetOverrideRequestMessage)(nil),            // 109: protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
-	(*NotifyPruningPointUTXOSetOverrideResponseMessage)(nil),           // 110: protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
-	(*PruningPointUTXOSetOverrideNotificationMessage)(nil),             // 111: protowire.PruningPointUTXOSetOverrideNotificationMessage
-	(*StopNotifyingPruningPointUTXOSetOverrideRequestMessage)(nil),     // 112: protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
-	(*StopNotifyingPruningPointUTXOSetOverrideResponseMessage)(nil),    // 113: protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
-	(*EstimateNetworkHashesPerSecondRequestMessage)(nil),               // 114: protowire.EstimateNetworkHashesPerSecondRequestMessage
-	(*EstimateNetworkHashesPerSecondResponseMessage)(nil),              // 115: protowire.EstimateNetworkHashesPerSecondResponseMessage
-	(*NotifyVirtualDaaScoreChangedRequestMessage)(nil),                 // 116: protowire.NotifyVirtualDaaScoreChangedRequestMessage
-	(*NotifyVirtualDaaScoreChangedResponseMessage)(nil),                // 117: protowire.NotifyVirtualDaaScoreChangedResponseMessage
-	(*VirtualDaaScoreChangedNotificationMessage)(nil),                  // 118: protowire.VirtualDaaScoreChangedNotificationMessage
-	(*GetBalanceByAddressRequestMessage)(nil),                          // 119: protowire.GetBalanceByAddressRequestMessage
-	(*GetBalanceByAddressResponseMessage)(nil),                         // 120: protowire.GetBalanceByAddressResponseMessage
-	(*GetBalancesByAddressesRequestMessage)(nil),                       // 121: protowire.GetBalancesByAddressesRequestMessage
-	(*GetBalancesByAddressesResponseMessage)(nil),                      // 122: protowire.GetBalancesByAddressesResponseMessage
-	(*NotifyNewBlockTemplateRequestMessage)(nil),                       // 123: protowire.NotifyNewBlockTemplateRequestMessage
-	(*NotifyNewBlockTemplateResponseMessage)(nil),                      // 124: protowire.NotifyNewBlockTemplateResponseMessage
-	(*NewBlockTemplateNotificationMessage)(nil),                        // 125: protowire.NewBlockTemplateNotificationMessage
-	(*GetMempoolEntriesByAddressesRequestMessage)(nil),                 // 126: protowire.GetMempoolEntriesByAddressesRequestMessage
-	(*GetMempoolEntriesByAddressesResponseMessage)(nil),                // 127: protowire.GetMempoolEntriesByAddressesResponseMessage
-	(*GetCoinSupplyRequestMessage)(nil),                                // 128: protowire.GetCoinSupplyRequestMessage
-	(*GetCoinSupplyResponseMessage)(nil),                               // 129: protowire.GetCoinSupplyResponseMessage
-	(*GetBlockByTransactionIDRequestMessage)(nil),                      // 130: protowire.GetBlockByTransactionIDRequestMessage
-	(*GetBlockByTransactionIDResponseMessage)(nil),                     // 131: protowire.GetBlockByTransactionIDResponseMessage
+	(*CompactBlockMessage)(nil),                                        // 43: protowire.CompactBlockMessage
+	(*RequestBlockTransactionsMessage)(nil),                            // 44: protowire.RequestBlockTransactionsMessage
+	(*BlockTransactionsMessage)(nil),                                   // 45: protowire.BlockTransactionsMessage
+	(*GetCurrentNetworkRequestMessage)(nil),                            // 46: protowire.GetCurrentNetworkRequestMessage
+	(*GetCurrentNetworkResponseMessage)(nil),                           // 47: protowire.GetCurrentNetworkResponseMessage
+	(*SubmitBlockRequestMessage)(nil),                                  // 48: protowire.SubmitBlockRequestMessage
+	(*SubmitBlockResponseMessage)(nil),                                 // 49: protowire.SubmitBlockResponseMessage
+	(*GetBlockTemplateRequestMessage)(nil),                             // 50: protowire.GetBlockTemplateRequestMessage
+	(*GetBlockTemplateResponseMessage)(nil),                            // 51: protowire.GetBlockTemplateResponseMessage
+	(*NotifyBlockAddedRequestMessage)(nil),                             // 52: protowire.NotifyBlockAddedRequestMessage
+	(*NotifyBlockAddedResponseMessage)(nil),                            // 53: protowire.NotifyBlockAddedResponseMessage
+	(*BlockAddedNotificationMessage)(nil),                              // 54: protowire.BlockAddedNotificationMessage
+	(*GetPeerAddressesRequestMessage)(nil),                             // 55: protowire.GetPeerAddressesRequestMessage
+	(*GetPeerAddressesResponseMessage)(nil),                            // 56: protowire.GetPeerAddressesResponseMessage
+	(*GetSelectedTipHashRequestMessage)(nil),                           // 57: protowire.GetSelectedTipHashRequestMessage
+	(*GetSelectedTipHashResponseMessage)(nil),                          // 58: protowire.GetSelectedTipHashResponseMessage
+	(*GetMempoolEntryRequestMessage)(nil),                              // 59: protowire.GetMempoolEntryRequestMessage
+	(*GetMempoolEntryResponseMessage)(nil),                             // 60: protowire.GetMempoolEntryResponseMessage
+	(*GetConnectedPeerInfoRequestMessage)(nil),                         // 61: protowire.GetConnectedPeerInfoRequestMessage
+	(*GetConnectedPeerInfoResponseMessage)(nil),                        // 62: protowire.GetConnectedPeerInfoResponseMessage
+	(*AddPeerRequestMessage)(nil),                                      // 63: protowire.AddPeerRequestMessage
+	(*AddPeerResponseMessage)(nil),                                     // 64: protowire.AddPeerResponseMessage
+	(*SubmitTransactionRequestMessage)(nil),                            // 65: protowire.SubmitTransactionRequestMessage
+	(*SubmitTransactionResponseMessage)(nil),                           // 66: protowire.SubmitTransactionResponseMessage
+	(*NotifyVirtualSelectedParentChainChangedRequestMessage)(nil),      // 67: protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
+	(*NotifyVirtualSelectedParentChainChangedResponseMessage)(nil),     // 68: protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
+	(*VirtualSelectedParentChainChangedNotificationMessage)(nil),       // 69: protowire.VirtualSelectedParentChainChangedNotificationMessage
+	(*GetBlockRequestMessage)(nil),                                     // 70: protowire.GetBlockRequestMessage
+	(*GetBlockResponseMessage)(nil),                                    // 71: protowire.GetBlockResponseMessage
+	(*GetSubnetworkRequestMessage)(nil),                                // 72: protowire.GetSubnetworkRequestMessage
+	(*GetSubnetworkResponseMessage)(nil),                               // 73: protowire.GetSubnetworkResponseMessage
+	(*GetVirtualSelectedParentChainFromBlockRequestMessage)(nil),       // 74: protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
+	(*GetVirtualSelectedParentChainFromBlockResponseMessage)(nil),      // 75: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
+	(*GetBlocksRequestMessage)(nil),                                    // 76: protowire.GetBlocksRequestMessage
+	(*GetBlocksResponseMessage)(nil),                                   // 77: protowire.GetBlocksResponseMessage
+	(*GetBlockCountRequestMessage)(nil),                                // 78: protowire.GetBlockCountRequestMessage
+	(*GetBlockCountResponseMessage)(nil),                               // 79: protowire.GetBlockCountResponseMessage
+	(*GetBlockDagInfoRequestMessage)(nil),                              // 80: protowire.GetBlockDagInfoRequestMessage
+	(*GetBlockDagInfoResponseMessage)(nil),                             // 81: protowire.GetBlockDagInfoResponseMessage
+	(*ResolveFinalityConflictRequestMessage)(nil),                      // 82: protowire.ResolveFinalityConflictRequestMessage
+	(*ResolveFinalityConflictResponseMessage)(nil),                     // 83: protowire.ResolveFinalityConflictResponseMessage
+	(*NotifyFinalityConflictsRequestMessage)(nil),                      // 84: protowire.NotifyFinalityConflictsRequestMessage
+	(*NotifyFinalityConflictsResponseMessage)(nil),                     // 85: protowire.NotifyFinalityConflictsResponseMessage
+	(*FinalityConflictNotificationMessage)(nil),                        // 86: protowire.FinalityConflictNotificationMessage
+	(*FinalityConflictResolvedNotificationMessage)(nil),                // 87: protowire.FinalityConflictResolvedNotificationMessage
+	(*GetMempoolEntriesRequestMessage)(nil),                            // 88: protowire.GetMempoolEntriesRequestMessage
+	(*GetMempoolEntriesResponseMessage)(nil),                           // 89: protowire.GetMempoolEntriesResponseMessage
+	(*ShutDownRequestMessage)(nil),                                     // 90: protowire.ShutDownRequestMessage
+	(*ShutDownResponseMessage)(nil),                                    // 91: protowire.ShutDownResponseMessage
+	(*GetHeadersRequestMessage)(nil),                                   // 92: protowire.GetHeadersRequestMessage
+	(*GetHeadersResponseMessage)(nil),                                  // 93: protowire.GetHeadersResponseMessage
+	(*NotifyUtxosChangedRequestMessage)(nil),                           // 94: protowire.NotifyUtxosChangedRequestMessage
+	(*NotifyUtxosChangedResponseMessage)(nil),                          // 95: protowire.NotifyUtxosChangedResponseMessage
+	(*UtxosChangedNotificationMessage)(nil),                            // 96: protowire.UtxosChangedNotificationMessage
+	(*GetUtxosByAddressesRequestMessage)(nil),                          // 97: protowire.GetUtxosByAddressesRequestMessage
+	(*GetUtxosByAddressesResponseMessage)(nil),                         // 98: protowire.GetUtxosByAddressesResponseMessage
+	(*GetVirtualSelectedParentBlueScoreRequestMessage)(nil),            // 99: protowire.GetVirtualSelectedParentBlueScoreRequestMessage
+	(*GetVirtualSelectedParentBlueScoreResponseMessage)(nil),           // 100: protowire.GetVirtualSelectedParentBlueScoreResponseMessage
+	(*NotifyVirtualSelectedParentBlueScoreChangedRequestMessage)(nil),  // 101: protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
+	(*NotifyVirtualSelectedParentBlueScoreChangedResponseMessage)(nil), // 102: protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
+	(*VirtualSelectedParentBlueScoreChangedNotificationMessage)(nil),   // 103: protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
+	(*BanRequestMessage)(nil),                                          // 104: protowire.BanRequestMessage
+	(*BanResponseMessage)(nil),                                         // 105: protowire.BanResponseMessage
+	(*UnbanRequestMessage)(nil),                                        // 106: protowire.UnbanRequestMessage
+	(*UnbanResponseMessage)(nil),                                       // 107: protowire.UnbanResponseMessage
+	(*GetInfoRequestMessage)(nil),                                      // 108: protowire.GetInfoRequestMessage
+	(*GetInfoResponseMessage)(nil),                                     // 109: protowire.GetInfoResponseMessage
+	(*StopNotifyingUtxosChangedRequestMessage)(nil),                    // 110: protowire.StopNotifyingUtxosChangedRequestMessage
+	(*StopNotifyingUtxosChangedResponseMessage)(nil),                   // 111: protowire.StopNotifyingUtxosChangedResponseMessage
+	(*NotifyPruningPointUTXOSetOverrideRequestMessage)(nil),            // 112: protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
+	(*NotifyPruningPointUTXOSetOverrideResponseMessage)(nil),           // 113: protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
+	(*PruningPointUTXOSetOverrideNotificationMessage)(nil),             // 114: protowire.PruningPointUTXOSetOverrideNotificationMessage
+	(*StopNotifyingPruningPointUTXOSetOverrideRequestMessage)(nil),     // 115: protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
+	(*StopNotifyingPruningPointUTXOSetOverrideResponseMessage)(nil),    // 116: protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
+	(*EstimateNetworkHashesPerSecondRequestMessage)(nil),               // 117: protowire.EstimateNetworkHashesPerSecondRequestMessage
+	(*EstimateNetworkHashesPerSecondResponseMessage)(nil),              // 118: protowire.EstimateNetworkHashesPerSecondResponseMessage
+	(*NotifyVirtualDaaScoreChangedRequestMessage)(nil),                 // 119: protowire.NotifyVirtualDaaScoreChangedRequestMessage
+	(*NotifyVirtualDaaScoreChangedResponseMessage)(nil),                // 120: protowire.NotifyVirtualDaaScoreChangedResponseMessage
+	(*VirtualDaaScoreChangedNotificationMessage)(nil),                  // 121: protowire.VirtualDaaScoreChangedNotificationMessage
+	(*GetBalanceByAddressRequestMessage)(nil),                          // 122: protowire.GetBalanceByAddressRequestMessage
+	(*GetBalanceByAddressResponseMessage)(nil),                         // 123: protowire.GetBalanceByAddressResponseMessage
+	(*GetBalancesByAddressesRequestMessage)(nil),                       // 124: protowire.GetBalancesByAddressesRequestMessage
+	(*GetBalancesByAddressesResponseMessage)(nil),                      // 125: protowire.GetBalancesByAddressesResponseMessage
+	(*NotifyNewBlockTemplateRequestMessage)(nil),                       // 126: protowire.NotifyNewBlockTemplateRequestMessage
+	(*NotifyNewBlockTemplateResponseMessage)(nil),                      // 127: protowire.NotifyNewBlockTemplateResponseMessage
+	(*NewBlockTemplateNotificationMessage)(nil),                        // 128: protowire.NewBlockTemplateNotificationMessage
+	(*GetMempoolEntriesByAddressesRequestMessage)(nil),                 // 129: protowire.GetMempoolEntriesByAddressesRequestMessage
+	(*GetMempoolEntriesByAddressesResponseMessage)(nil),                // 130: protowire.GetMempoolEntriesByAddressesResponseMessage
+	(*GetCoinSupplyRequestMessage)(nil),                                // 131: protowire.GetCoinSupplyRequestMessage
+	(*GetCoinSupplyResponseMessage)(nil),                               // 132: protowire.GetCoinSupplyResponseMessage
+	(*GetBlockByTransactionIDRequestMessage)(nil),                      // 133: protowire.GetBlockByTransactionIDRequestMessage
+	(*GetBlockByTransactionIDResponseMessage)(nil),                     // 134: protowire.GetBlockByTransactionIDResponseMessage
+	(*GetCoinbaseSplitRequestMessage)(nil),                             // 135: protowire.GetCoinbaseSplitRequestMessage
+	(*GetCoinbaseSplitResponseMessage)(nil),                            // 136: protowire.GetCoinbaseSplitResponseMessage
+	(*GetEmissionInfoRequestMessage)(nil),                              // 137: protowire.GetEmissionInfoRequestMessage
+	(*GetEmissionInfoResponseMessage)(nil),                             // 138: protowire.GetEmissionInfoResponseMessage
+	(*TestMempoolAcceptRequestMessage)(nil),                            // 139: protowire.TestMempoolAcceptRequestMessage
+	(*TestMempoolAcceptResponseMessage)(nil),                           // 140: protowire.TestMempoolAcceptResponseMessage
+	(*DecodeTransactionRequestMessage)(nil),                            // 141: protowire.DecodeTransactionRequestMessage
+	(*DecodeTransactionResponseMessage)(nil),                           // 142: protowire.DecodeTransactionResponseMessage
+	(*DecodeScriptRequestMessage)(nil),                                 // 143: protowire.DecodeScriptRequestMessage
+	(*DecodeScriptResponseMessage)(nil),                                // 144: protowire.DecodeScriptResponseMessage
+	(*GetUtxoEntriesByOutpointsRequestMessage)(nil),                    // 145: protowire.GetUtxoEntriesByOutpointsRequestMessage
+	(*GetUtxoEntriesByOutpointsResponseMessage)(nil),                   // 146: protowire.GetUtxoEntriesByOutpointsResponseMessage
+	(*GetSyncStatusRequestMessage)(nil),                                // 147: protowire.GetSyncStatusRequestMessage
+	(*GetSyncStatusResponseMessage)(nil),                               // 148: protowire.GetSyncStatusResponseMessage
+	(*NotifySyncStatusChangedRequestMessage)(nil),                      // 149: protowire.NotifySyncStatusChangedRequestMessage
+	(*NotifySyncStatusChangedResponseMessage)(nil),                     // 150: protowire.NotifySyncStatusChangedResponseMessage
+	(*SyncStatusChangedNotificationMessage)(nil),                       // 151: protowire.SyncStatusChangedNotificationMessage
+	(*VerifyMessageRequestMessage)(nil),                                // 152: protowire.VerifyMessageRequestMessage
+	(*VerifyMessageResponseMessage)(nil),                               // 153: protowire.VerifyMessageResponseMessage
+	(*GetTransactionInclusionProofRequestMessage)(nil),                 // 154: protowire.GetTransactionInclusionProofRequestMessage
+	(*GetTransactionInclusionProofResponseMessage)(nil),                // 155: protowire.GetTransactionInclusionProofResponseMessage
+	(*InvalidateBlockRequestMessage)(nil),                              // 156: protowire.InvalidateBlockRequestMessage
+	(*InvalidateBlockResponseMessage)(nil),                             // 157: protowire.InvalidateBlockResponseMessage
+	(*ReconsiderBlockRequestMessage)(nil),                              // 158: protowire.ReconsiderBlockRequestMessage
+	(*ReconsiderBlockResponseMessage)(nil),                             // 159: protowire.ReconsiderBlockResponseMessage
+	(*GetDagSnapshotRequestMessage)(nil),                               // 160: protowire.GetDagSnapshotRequestMessage
+	(*GetDagSnapshotResponseMessage)(nil),                              // 161: protowire.GetDagSnapshotResponseMessage
+	(*GetTransactionStatusRequestMessage)(nil),                         // 162: protowire.GetTransactionStatusRequestMessage
+	(*GetTransactionStatusResponseMessage)(nil),                        // 163: protowire.GetTransactionStatusResponseMessage
 }
 var file_messages_proto_depIdxs = []int32{
 	1,   // 0: protowire.HoosatdMessage.addresses:type_name -> protowire.AddressesMessage
@@ -2523,104 +3099,136 @@ var file_messages_proto_depIdxs = []int32{
 	40,  // 40: protowire.HoosatdMessage.ibdChainBlockLocator:type_name -> protowire.IbdChainBlockLocatorMessage
 	41,  // 41: protowire.HoosatdMessage.requestAnticone:type_name -> protowire.RequestAnticoneMessage
 	42,  // 42: protowire.HoosatdMessage.requestNextPruningPointAndItsAnticoneBlocks:type_name -> protowire.RequestNextPruningPointAndItsAnticoneBlocksMessage
-	43,  // 43: protowire.HoosatdMessage.getCurrentNetworkRequest:type_name -> protowire.GetCurrentNetworkRequestMessage
-	44,  // 44: protowire.HoosatdMessage.getCurrentNetworkResponse:type_name -> protowire.GetCurrentNetworkResponseMessage
-	45,  // 45: protowire.HoosatdMessage.submitBlockRequest:type_name -> protowire.SubmitBlockRequestMessage
-	46,  // 46: protowire.HoosatdMessage.submitBlockResponse:type_name -> protowire.SubmitBlockResponseMessage
-	47,  // 47: protowire.HoosatdMessage.getBlockTemplateRequest:type_name -> protowire.GetBlockTemplateRequestMessage
-	48,  // 48: protowire.HoosatdMessage.getBlockTemplateResponse:type_name -> protowire.GetBlockTemplateResponseMessage
-	49,  // 49: protowire.HoosatdMessage.notifyBlockAddedRequest:type_name -> protowire.NotifyBlockAddedRequestMessage
-	50,  // 50: protowire.HoosatdMessage.notifyBlockAddedResponse:type_name -> protowire.NotifyBlockAddedResponseMessage
-	51,  // 51: protowire.HoosatdMessage.blockAddedNotification:type_name -> protowire.BlockAddedNotificationMessage
-	52,  // 52: protowire.HoosatdMessage.getPeerAddressesRequest:type_name -> protowire.GetPeerAddressesRequestMessage
-	53,  // 53: protowire.HoosatdMessage.getPeerAddressesResponse:type_name -> protowire.GetPeerAddressesResponseMessage
-	54,  // 54: protowire.HoosatdMessage.getSelectedTipHashRequest:type_name -> protowire.GetSelectedTipHashRequestMessage
-	55,  // 55: protowire.HoosatdMessage.getSelectedTipHashResponse:type_name -> protowire.GetSelectedTipHashResponseMessage
-	56,  // 56: protowire.HoosatdMessage.getMempoolEntryRequest:type_name -> protowire.GetMempoolEntryRequestMessage
-	57,  // 57: protowire.HoosatdMessage.getMempoolEntryResponse:type_name -> protowire.GetMempoolEntryResponseMessage
-	58,  // 58: protowire.HoosatdMessage.getConnectedPeerInfoRequest:type_name -> protowire.GetConnectedPeerInfoRequestMessage
-	59,  // 59: protowire.HoosatdMessage.getConnectedPeerInfoResponse:type_name -> protowire.GetConnectedPeerInfoResponseMessage
-	60,  // 60: protowire.HoosatdMessage.addPeerRequest:type_name -> protowire.AddPeerRequestMessage
-	61,  // 61: protowire.HoosatdMessage.addPeerResponse:type_name -> protowire.AddPeerResponseMessage
-	62,  // 62: protowire.HoosatdMessage.submitTransactionRequest:type_name -> protowire.SubmitTransactionRequestMessage
-	63,  // 63: protowire.HoosatdMessage.submitTransactionResponse:type_name -> protowire.SubmitTransactionResponseMessage
-	64,  // 64: protowire.HoosatdMessage.notifyVirtualSelectedParentChainChangedRequest:type_name -> protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
-	65,  // 65: protowire.HoosatdMessage.notifyVirtualSelectedParentChainChangedResponse:type_name -> protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
-	66,  // 66: protowire.HoosatdMessage.virtualSelectedParentChainChangedNotification:type_name -> protowire.VirtualSelectedParentChainChangedNotificationMessage
-	67,  // 67: protowire.HoosatdMessage.getBlockRequest:type_name -> protowire.GetBlockRequestMessage
-	68,  // 68: protowire.HoosatdMessage.getBlockResponse:type_name -> protowire.GetBlockResponseMessage
-	69,  // 69: protowire.HoosatdMessage.getSubnetworkRequest:type_name -> protowire.GetSubnetworkRequestMessage
-	70,  // 70: protowire.HoosatdMessage.getSubnetworkResponse:type_name -> protowire.GetSubnetworkResponseMessage
-	71,  // 71: protowire.HoosatdMessage.getVirtualSelectedParentChainFromBlockRequest:type_name -> protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
-	72,  // 72: protowire.HoosatdMessage.getVirtualSelectedParentChainFromBlockResponse:type_name -> protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
-	73,  // 73: protowire.HoosatdMessage.getBlocksRequest:type_name -> protowire.GetBlocksRequestMessage
-	74,  // 74: protowire.HoosatdMessage.getBlocksResponse:type_name -> protowire.GetBlocksResponseMessage
-	75,  // 75: protowire.HoosatdMessage.getBlockCountRequest:type_name -> protowire.GetBlockCountRequestMessage
-	76,  // 76: protowire.HoosatdMessage.getBlockCountResponse:type_name -> protowire.GetBlockCountResponseMessage
-	77,  // 77: protowire.HoosatdMessage.getBlockDagInfoRequest:type_name -> protowire.GetBlockDagInfoRequestMessage
-	78,  // 78: protowire.HoosatdMessage.getBlockDagInfoResponse:type_name -> protowire.GetBlockDagInfoResponseMessage
-	79,  // 79: protowire.HoosatdMessage.resolveFinalityConflictRequest:type_name -> protowire.ResolveFinalityConflictRequestMessage
-	80,  // 80: protowire.HoosatdMessage.resolveFinalityConflictResponse:type_name -> protowire.ResolveFinalityConflictResponseMessage
-	81,  // 81: protowire.HoosatdMessage.notifyFinalityConflictsRequest:type_name -> protowire.NotifyFinalityConflictsRequestMessage
-	82,  // 82: protowire.HoosatdMessage.notifyFinalityConflictsResponse:type_name -> protowire.NotifyFinalityConflictsResponseMessage
-	83,  // 83: protowire.HoosatdMessage.finalityConflictNotification:type_name -> protowire.FinalityConflictNotificationMessage
-	84,  // 84: protowire.HoosatdMessage.finalityConflictResolvedNotification:type_name -> protowire.FinalityConflictResolvedNotificationMessage
-	85,  // 85: protowire.HoosatdMessage.getMempoolEntriesRequest:type_name -> protowire.GetMempoolEntriesRequestMessage
-	86,  // 86: protowire.HoosatdMessage.getMempoolEntriesResponse:type_name -> protowire.GetMempoolEntriesResponseMessage
-	87,  // 87: protowire.HoosatdMessage.shutDownRequest:type_name -> protowire.ShutDownRequestMessage
-	88,  // 88: protowire.HoosatdMessage.shutDownResponse:type_name -> protowire.ShutDownResponseMessage
-	89,  // 89: protowire.HoosatdMessage.getHeadersRequest:type_name -> protowire.GetHeadersRequestMessage
-	90,  // 90: protowire.HoosatdMessage.getHeadersResponse:type_name -> protowire.GetHeadersResponseMessage
-	91,  // 91: protowire.HoosatdMessage.notifyUtxosChangedRequest:type_name -> protowire.NotifyUtxosChangedRequestMessage
-	92,  // 92: protowire.HoosatdMessage.notifyUtxosChangedResponse:type_name -> protowire.NotifyUtxosChangedResponseMessage
-	93,  // 93: protowire.HoosatdMessage.utxosChangedNotification:type_name -> protowire.UtxosChangedNotificationMessage
-	94,  // 94: protowire.HoosatdMessage.getUtxosByAddressesRequest:type_name -> protowire.GetUtxosByAddressesRequestMessage
-	95,  // 95: protowire.HoosatdMessage.getUtxosByAddressesResponse:type_name -> protowire.GetUtxosByAddressesResponseMessage
-	96,  // 96: protowire.HoosatdMessage.getVirtualSelectedParentBlueScoreRequest:type_name -> protowire.GetVirtualSelectedParentBlueScoreRequestMessage
-	97,  // 97: protowire.HoosatdMessage.getVirtualSelectedParentBlueScoreResponse:type_name -> protowire.GetVirtualSelectedParentBlueScoreResponseMessage
-	98,  // 98: protowire.HoosatdMessage.notifyVirtualSelectedParentBlueScoreChangedRequest:type_name -> protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
-	99,  // 99: protowire.HoosatdMessage.notifyVirtualSelectedParentBlueScoreChangedResponse:type_name -> protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
-	100, // 100: protowire.HoosatdMessage.virtualSelectedParentBlueScoreChangedNotification:type_name -> protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
-	101, // 101: protowire.HoosatdMessage.banRequest:type_name -> protowire.BanRequestMessage
-	102, // 102: protowire.HoosatdMessage.banResponse:type_name -> protowire.BanResponseMessage
-	103, // 103: protowire.HoosatdMessage.unbanRequest:type_name -> protowire.UnbanRequestMessage
-	104, // 104: protowire.HoosatdMessage.unbanResponse:type_name -> protowire.UnbanResponseMessage
-	105, // 105: protowire.HoosatdMessage.getInfoRequest:type_name -> protowire.GetInfoRequestMessage
-	106, // 106: protowire.HoosatdMessage.getInfoResponse:type_name -> protowire.GetInfoResponseMessage
-	107, // 107: protowire.HoosatdMessage.stopNotifyingUtxosChangedRequest:type_name -> protowire.StopNotifyingUtxosChangedRequestMessage
-	108, // 108: protowire.HoosatdMessage.stopNotifyingUtxosChangedResponse:type_name -> protowire.StopNotifyingUtxosChangedResponseMessage
-	109, // 109: protowire.HoosatdMessage.notifyPruningPointUTXOSetOverrideRequest:type_name -> protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
-	110, // 110: protowire.HoosatdMessage.notifyPruningPointUTXOSetOverrideResponse:type_name -> protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
-	111, // 111: protowire.HoosatdMessage.pruningPointUTXOSetOverrideNotification:type_name -> protowire.PruningPointUTXOSetOverrideNotificationMessage
-	112, // 112: protowire.HoosatdMessage.stopNotifyingPruningPointUTXOSetOverrideRequest:type_name -> protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
-	113, // 113: protowire.HoosatdMessage.stopNotifyingPruningPointUTXOSetOverrideResponse:type_name -> protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
-	114, // 114: protowire.HoosatdMessage.estimateNetworkHashesPerSecondRequest:type_name -> protowire.EstimateNetworkHashesPerSecondRequestMessage
-	115, // 115: protowire.HoosatdMessage.estimateNetworkHashesPerSecondResponse:type_name -> protowire.EstimateNetworkHashesPerSecondResponseMessage
-	116, // 116: protowire.HoosatdMessage.notifyVirtualDaaScoreChangedRequest:type_name -> protowire.NotifyVirtualDaaScoreChangedRequestMessage
-	117, // 117: protowire.HoosatdMessage.notifyVirtualDaaScoreChangedResponse:type_name -> protowire.NotifyVirtualDaaScoreChangedResponseMessage
-	118, // 118: protowire.HoosatdMessage.virtualDaaScoreChangedNotification:type_name -> protowire.VirtualDaaScoreChangedNotificationMessage
-	119, // 119: protowire.HoosatdMessage.getBalanceByAddressRequest:type_name -> protowire.GetBalanceByAddressRequestMessage
-	120, // 120: protowire.HoosatdMessage.getBalanceByAddressResponse:type_name -> protowire.GetBalanceByAddressResponseMessage
-	121, // 121: protowire.HoosatdMessage.getBalancesByAddressesRequest:type_name -> protowire.GetBalancesByAddressesRequestMessage
-	122, // 122: protowire.HoosatdMessage.getBalancesByAddressesResponse:type_name -> protowire.GetBalancesByAddressesResponseMessage
-	123, // 123: protowire.HoosatdMessage.notifyNewBlockTemplateRequest:type_name -> protowire.NotifyNewBlockTemplateRequestMessage
-	124, // 124: protowire.HoosatdMessage.notifyNewBlockTemplateResponse:type_name -> protowire.NotifyNewBlockTemplateResponseMessage
-	125, // 125: protowire.HoosatdMessage.newBlockTemplateNotification:type_name -> protowire.NewBlockTemplateNotificationMessage
-	126, // 126: protowire.HoosatdMessage.getMempoolEntriesByAddressesRequest:type_name -> protowire.GetMempoolEntriesByAddressesRequestMessage
-	127, // 127: protowire.HoosatdMessage.getMempoolEntriesByAddressesResponse:type_name -> protowire.GetMempoolEntriesByAddressesResponseMessage
-	128, // 128: protowire.HoosatdMessage.getCoinSupplyRequest:type_name -> protowire.GetCoinSupplyRequestMessage
-	129, // 129: protowire.HoosatdMessage.getCoinSupplyResponse:type_name -> protowire.GetCoinSupplyResponseMessage
-	130, // 130: protowire.HoosatdMessage.getBlockByTransactionIdRequest:type_name -> protowire.GetBlockByTransactionIDRequestMessage
-	131, // 131: protowire.HoosatdMessage.getBlockByTransactionIdResponse:type_name -> protowire.GetBlockByTransactionIDResponseMessage
-	0,   // 132: protowire.P2P.MessageStream:input_type -> protowire.HoosatdMessage
-	0,   // 133: protowire.RPC.MessageStream:input_type -> protowire.HoosatdMessage
-	0,   // 134: protowire.P2P.MessageStream:output_type -> protowire.HoosatdMessage
-	0,   // 135: protowire.RPC.MessageStream:output_type -> protowire.HoosatdMessage
-	134, // [134:136] is the sub-list for method output_type
-	132, // [132:134] is the sub-list for method input_type
-	132, // [132:132] is the sub-list for extension type_name
-	132, // [132:132] is the sub-list for extension extendee
-	0,   // [0:132] is the sub-list for field type_name
+	43,  // 43: protowire.HoosatdMessage.compactBlock:type_name -> protowire.CompactBlockMessage
+	44,  // 44: protowire.HoosatdMessage.requestBlockTransactions:type_name -> protowire.RequestBlockTransactionsMessage
+	45,  // 45: protowire.HoosatdMessage.blockTransactions:type_name -> protowire.BlockTransactionsMessage
+	46,  // 46: protowire.HoosatdMessage.getCurrentNetworkRequest:type_name -> protowire.GetCurrentNetworkRequestMessage
+	47,  // 47: protowire.HoosatdMessage.getCurrentNetworkResponse:type_name -> protowire.GetCurrentNetworkResponseMessage
+	48,  // 48: protowire.HoosatdMessage.submitBlockRequest:type_name -> protowire.SubmitBlockRequestMessage
+	49,  // 49: protowire.HoosatdMessage.submitBlockResponse:type_name -> protowire.SubmitBlockResponseMessage
+	50,  // 50: protowire.HoosatdMessage.getBlockTemplateRequest:type_name -> protowire.GetBlockTemplateRequestMessage
+	51,  // 51: protowire.HoosatdMessage.getBlockTemplateResponse:type_name -> protowire.GetBlockTemplateResponseMessage
+	52,  // 52: protowire.HoosatdMessage.notifyBlockAddedRequest:type_name -> protowire.NotifyBlockAddedRequestMessage
+	53,  // 53: protowire.HoosatdMessage.notifyBlockAddedResponse:type_name -> protowire.NotifyBlockAddedResponseMessage
+	54,  // 54: protowire.HoosatdMessage.blockAddedNotification:type_name -> protowire.BlockAddedNotificationMessage
+	55,  // 55: protowire.HoosatdMessage.getPeerAddressesRequest:type_name -> protowire.GetPeerAddressesRequestMessage
+	56,  // 56: protowire.HoosatdMessage.getPeerAddressesResponse:type_name -> protowire.GetPeerAddressesResponseMessage
+	57,  // 57: protowire.HoosatdMessage.getSelectedTipHashRequest:type_name -> protowire.GetSelectedTipHashRequestMessage
+	58,  // 58: protowire.HoosatdMessage.getSelectedTipHashResponse:type_name -> protowire.GetSelectedTipHashResponseMessage
+	59,  // 59: protowire.HoosatdMessage.getMempoolEntryRequest:type_name -> protowire.GetMempoolEntryRequestMessage
+	60,  // 60: protowire.HoosatdMessage.getMempoolEntryResponse:type_name -> protowire.GetMempoolEntryResponseMessage
+	61,  // 61: protowire.HoosatdMessage.getConnectedPeerInfoRequest:type_name -> protowire.GetConnectedPeerInfoRequestMessage
+	62,  // 62: protowire.HoosatdMessage.getConnectedPeerInfoResponse:type_name -> protowire.GetConnectedPeerInfoResponseMessage
+	63,  // 63: protowire.HoosatdMessage.addPeerRequest:type_name -> protowire.AddPeerRequestMessage
+	64,  // 64: protowire.HoosatdMessage.addPeerResponse:type_name -> protowire.AddPeerResponseMessage
+	65,  // 65: protowire.HoosatdMessage.submitTransactionRequest:type_name -> protowire.SubmitTransactionRequestMessage
+	66,  // 66: protowire.HoosatdMessage.submitTransactionResponse:type_name -> protowire.SubmitTransactionResponseMessage
+	67,  // 67: protowire.HoosatdMessage.notifyVirtualSelectedParentChainChangedRequest:type_name -> protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
+	68,  // 68: protowire.HoosatdMessage.notifyVirtualSelectedParentChainChangedResponse:type_name -> protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
+	69,  // 69: protowire.HoosatdMessage.virtualSelectedParentChainChangedNotification:type_name -> protowire.VirtualSelectedParentChainChangedNotificationMessage
+	70,  // 70: protowire.HoosatdMessage.getBlockRequest:type_name -> protowire.GetBlockRequestMessage
+	71,  // 71: protowire.HoosatdMessage.getBlockResponse:type_name -> protowire.GetBlockResponseMessage
+	72,  // 72: protowire.HoosatdMessage.getSubnetworkRequest:type_name -> protowire.GetSubnetworkRequestMessage
+	73,  // 73: protowire.HoosatdMessage.getSubnetworkResponse:type_name -> protowire.GetSubnetworkResponseMessage
+	74,  // 74: protowire.HoosatdMessage.getVirtualSelectedParentChainFromBlockRequest:type_name -> protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
+	75,  // 75: protowire.HoosatdMessage.getVirtualSelectedParentChainFromBlockResponse:type_name -> protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
+	76,  // 76: protowire.HoosatdMessage.getBlocksRequest:type_name -> protowire.GetBlocksRequestMessage
+	77,  // 77: protowire.HoosatdMessage.getBlocksResponse:type_name -> protowire.GetBlocksResponseMessage
+	78,  // 78: protowire.HoosatdMessage.getBlockCountRequest:type_name -> protowire.GetBlockCountRequestMessage
+	79,  // 79: protowire.HoosatdMessage.getBlockCountResponse:type_name -> protowire.GetBlockCountResponseMessage
+	80,  // 80: protowire.HoosatdMessage.getBlockDagInfoRequest:type_name -> protowire.GetBlockDagInfoRequestMessage
+	81,  // 81: protowire.HoosatdMessage.getBlockDagInfoResponse:type_name -> protowire.GetBlockDagInfoResponseMessage
+	82,  // 82: protowire.HoosatdMessage.resolveFinalityConflictRequest:type_name -> protowire.ResolveFinalityConflictRequestMessage
+	83,  // 83: protowire.HoosatdMessage.resolveFinalityConflictResponse:type_name -> protowire.ResolveFinalityConflictResponseMessage
+	84,  // 84: protowire.HoosatdMessage.notifyFinalityConflictsRequest:type_name -> protowire.NotifyFinalityConflictsRequestMessage
+	85,  // 85: protowire.HoosatdMessage.notifyFinalityConflictsResponse:type_name -> protowire.NotifyFinalityConflictsResponseMessage
+	86,  // 86: protowire.HoosatdMessage.finalityConflictNotification:type_name -> protowire.FinalityConflictNotificationMessage
+	87,  // 87: protowire.HoosatdMessage.finalityConflictResolvedNotification:type_name -> protowire.FinalityConflictResolvedNotificationMessage
+	88,  // 88: protowire.HoosatdMessage.getMempoolEntriesRequest:type_name -> protowire.GetMempoolEntriesRequestMessage
+	89,  // 89: protowire.HoosatdMessage.getMempoolEntriesResponse:type_name -> protowire.GetMempoolEntriesResponseMessage
+	90,  // 90: protowire.HoosatdMessage.shutDownRequest:type_name -> protowire.ShutDownRequestMessage
+	91,  // 91: protowire.HoosatdMessage.shutDownResponse:type_name -> protowire.ShutDownResponseMessage
+	92,  // 92: protowire.HoosatdMessage.getHeadersRequest:type_name -> protowire.GetHeadersRequestMessage
+	93,  // 93: protowire.HoosatdMessage.getHeadersResponse:type_name -> protowire.GetHeadersResponseMessage
+	94,  // 94: protowire.HoosatdMessage.notifyUtxosChangedRequest:type_name -> protowire.NotifyUtxosChangedRequestMessage
+	95,  // 95: protowire.HoosatdMessage.notifyUtxosChangedResponse:type_name -> protowire.NotifyUtxosChangedResponseMessage
+	96,  // 96: protowire.HoosatdMessage.utxosChangedNotification:type_name -> protowire.UtxosChangedNotificationMessage
+	97,  // 97: protowire.HoosatdMessage.getUtxosByAddressesRequest:type_name -> protowire.GetUtxosByAddressesRequestMessage
+	98,  // 98: protowire.HoosatdMessage.getUtxosByAddressesResponse:type_name -> protowire.GetUtxosByAddressesResponseMessage
+	99,  // 99: protowire.HoosatdMessage.getVirtualSelectedParentBlueScoreRequest:type_name -> protowire.GetVirtualSelectedParentBlueScoreRequestMessage
+	100, // 100: protowire.HoosatdMessage.getVirtualSelectedParentBlueScoreResponse:type_name -> protowire.GetVirtualSelectedParentBlueScoreResponseMessage
+	101, // 101: protowire.HoosatdMessage.notifyVirtualSelectedParentBlueScoreChangedRequest:type_name -> protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
+	102, // 102: protowire.HoosatdMessage.notifyVirtualSelectedParentBlueScoreChangedResponse:type_name -> protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
+	103, // 103: protowire.HoosatdMessage.virtualSelectedParentBlueScoreChangedNotification:type_name -> protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
+	104, // 104: protowire.HoosatdMessage.banRequest:type_name -> protowire.BanRequestMessage
+	105, // 105: protowire.HoosatdMessage.banResponse:type_name -> protowire.BanResponseMessage
+	106, // 106: protowire.HoosatdMessage.unbanRequest:type_name -> protowire.UnbanRequestMessage
+	107, // 107: protowire.HoosatdMessage.unbanResponse:type_name -> protowire.UnbanResponseMessage
+	108, // 108: protowire.HoosatdMessage.getInfoRequest:type_name -> protowire.GetInfoRequestMessage
+	109, // 109: protowire.HoosatdMessage.getInfoResponse:type_name -> protowire.GetInfoResponseMessage
+	110, // 110: protowire.HoosatdMessage.stopNotifyingUtxosChangedRequest:type_name -> protowire.StopNotifyingUtxosChangedRequestMessage
+	111, // 111: protowire.HoosatdMessage.stopNotifyingUtxosChangedResponse:type_name -> protowire.StopNotifyingUtxosChangedResponseMessage
+	112, // 112: protowire.HoosatdMessage.notifyPruningPointUTXOSetOverrideRequest:type_name -> protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
+	113, // 113: protowire.HoosatdMessage.notifyPruningPointUTXOSetOverrideResponse:type_name -> protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
+	114, // 114: protowire.HoosatdMessage.pruningPointUTXOSetOverrideNotification:type_name -> protowire.PruningPointUTXOSetOverrideNotificationMessage
+	115, // 115: protowire.HoosatdMessage.stopNotifyingPruningPointUTXOSetOverrideRequest:type_name -> protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
+	116, // 116: protowire.HoosatdMessage.stopNotifyingPruningPointUTXOSetOverrideResponse:type_name -> protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
+	117, // 117: protowire.HoosatdMessage.estimateNetworkHashesPerSecondRequest:type_name -> protowire.EstimateNetworkHashesPerSecondRequestMessage
+	118, // 118: protowire.HoosatdMessage.estimateNetworkHashesPerSecondResponse:type_name -> protowire.EstimateNetworkHashesPerSecondResponseMessage
+	119, // 119: protowire.HoosatdMessage.notifyVirtualDaaScoreChangedRequest:type_name -> protowire.NotifyVirtualDaaScoreChangedRequestMessage
+	120, // 120: protowire.HoosatdMessage.notifyVirtualDaaScoreChangedResponse:type_name -> protowire.NotifyVirtualDaaScoreChangedResponseMessage
+	121, // 121: protowire.HoosatdMessage.virtualDaaScoreChangedNotification:type_name -> protowire.VirtualDaaScoreChangedNotificationMessage
+	122, // 122: protowire.HoosatdMessage.getBalanceByAddressRequest:type_name -> protowire.GetBalanceByAddressRequestMessage
+	123, // 123: protowire.HoosatdMessage.getBalanceByAddressResponse:type_name -> protowire.GetBalanceByAddressResponseMessage
+	124, // 124: protowire.HoosatdMessage.getBalancesByAddressesRequest:type_name -> protowire.GetBalancesByAddressesRequestMessage
+	125, // 125: protowire.HoosatdMessage.getBalancesByAddressesResponse:type_name -> protowire.GetBalancesByAddressesResponseMessage
+	126, // 126: protowire.HoosatdMessage.notifyNewBlockTemplateRequest:type_name -> protowire.NotifyNewBlockTemplateRequestMessage
+	127, // 127: protowire.HoosatdMessage.notifyNewBlockTemplateResponse:type_name -> protowire.NotifyNewBlockTemplateResponseMessage
+	128, // 128: protowire.HoosatdMessage.newBlockTemplateNotification:type_name -> protowire.NewBlockTemplateNotificationMessage
+	129, // 129: protowire.HoosatdMessage.getMempoolEntriesByAddressesRequest:type_name -> protowire.GetMempoolEntriesByAddressesRequestMessage
+	130, // 130: protowire.HoosatdMessage.getMempoolEntriesByAddressesResponse:type_name -> protowire.GetMempoolEntriesByAddressesResponseMessage
+	131, // 131: protowire.HoosatdMessage.getCoinSupplyRequest:type_name -> protowire.GetCoinSupplyRequestMessage
+	132, // 132: protowire.HoosatdMessage.getCoinSupplyResponse:type_name -> protowire.GetCoinSupplyResponseMessage
+	133, // 133: protowire.HoosatdMessage.getBlockByTransactionIdRequest:type_name -> protowire.GetBlockByTransactionIDRequestMessage
+	134, // 134: protowire.HoosatdMessage.getBlockByTransactionIdResponse:type_name -> protowire.GetBlockByTransactionIDResponseMessage
+	135, // 135: protowire.HoosatdMessage.getCoinbaseSplitRequest:type_name -> protowire.GetCoinbaseSplitRequestMessage
+	136, // 136: protowire.HoosatdMessage.getCoinbaseSplitResponse:type_name -> protowire.GetCoinbaseSplitResponseMessage
+	137, // 137: protowire.HoosatdMessage.getEmissionInfoRequest:type_name -> protowire.GetEmissionInfoRequestMessage
+	138, // 138: protowire.HoosatdMessage.getEmissionInfoResponse:type_name -> protowire.GetEmissionInfoResponseMessage
+	139, // 139: protowire.HoosatdMessage.testMempoolAcceptRequest:type_name -> protowire.TestMempoolAcceptRequestMessage
+	140, // 140: protowire.HoosatdMessage.testMempoolAcceptResponse:type_name -> protowire.TestMempoolAcceptResponseMessage
+	141, // 141: protowire.HoosatdMessage.decodeTransactionRequest:type_name -> protowire.DecodeTransactionRequestMessage
+	142, // 142: protowire.HoosatdMessage.decodeTransactionResponse:type_name -> protowire.DecodeTransactionResponseMessage
+	143, // 143: protowire.HoosatdMessage.decodeScriptRequest:type_name -> protowire.DecodeScriptRequestMessage
+	144, // 144: protowire.HoosatdMessage.decodeScriptResponse:type_name -> protowire.DecodeScriptResponseMessage
+	145, // 145: protowire.HoosatdMessage.getUtxoEntriesByOutpointsRequest:type_name -> protowire.GetUtxoEntriesByOutpointsRequestMessage
+	146, // 146: protowire.HoosatdMessage.getUtxoEntriesByOutpointsResponse:type_name -> protowire.GetUtxoEntriesByOutpointsResponseMessage
+	147, // 147: protowire.HoosatdMessage.getSyncStatusRequest:type_name -> protowire.GetSyncStatusRequestMessage
+	148, // 148: protowire.HoosatdMessage.getSyncStatusResponse:type_name -> protowire.GetSyncStatusResponseMessage
+	149, // 149: protowire.HoosatdMessage.notifySyncStatusChangedRequest:type_name -> protowire.NotifySyncStatusChangedRequestMessage
+	150, // 150: protowire.HoosatdMessage.notifySyncStatusChangedResponse:type_name -> protowire.NotifySyncStatusChangedResponseMessage
+	151, // 151: protowire.HoosatdMessage.syncStatusChangedNotification:type_name -> protowire.SyncStatusChangedNotificationMessage
+	152, // 152: protowire.HoosatdMessage.verifyMessageRequest:type_name -> protowire.VerifyMessageRequestMessage
+	153, // 153: protowire.HoosatdMessage.verifyMessageResponse:type_name -> protowire.VerifyMessageResponseMessage
+	154, // 154: protowire.HoosatdMessage.getTransactionInclusionProofRequest:type_name -> protowire.GetTransactionInclusionProofRequestMessage
+	155, // 155: protowire.HoosatdMessage.getTransactionInclusionProofResponse:type_name -> protowire.GetTransactionInclusionProofResponseMessage
+	156, // 156: protowire.HoosatdMessage.invalidateBlockRequest:type_name -> protowire.InvalidateBlockRequestMessage
+	157, // 157: protowire.HoosatdMessage.invalidateBlockResponse:type_name -> protowire.InvalidateBlockResponseMessage
+	158, // 158: protowire.HoosatdMessage.reconsiderBlockRequest:type_name -> protowire.ReconsiderBlockRequestMessage
+	159, // 159: protowire.HoosatdMessage.reconsiderBlockResponse:type_name -> protowire.ReconsiderBlockResponseMessage
+	160, // 160: protowire.HoosatdMessage.getDagSnapshotRequest:type_name -> protowire.GetDagSnapshotRequestMessage
+	161, // 161: protowire.HoosatdMessage.getDagSnapshotResponse:type_name -> protowire.GetDagSnapshotResponseMessage
+	162, // 162: protowire.HoosatdMessage.getTransactionStatusRequest:type_name -> protowire.GetTransactionStatusRequestMessage
+	163, // 163: protowire.HoosatdMessage.getTransactionStatusResponse:type_name -> protowire.GetTransactionStatusResponseMessage
+	0,   // 164: protowire.P2P.MessageStream:input_type -> protowire.HoosatdMessage
+	0,   // 165: protowire.RPC.MessageStream:input_type -> protowire.HoosatdMessage
+	0,   // 166: protowire.P2P.MessageStream:output_type -> protowire.HoosatdMessage
+	0,   // 167: protowire.RPC.MessageStream:output_type -> protowire.HoosatdMessage
+	166, // [166:168] is the sub-list for method output_type
+	164, // [164:166] is the sub-list for method input_type
+	164, // [164:164] is the sub-list for extension type_name
+	164, // [164:164] is the sub-list for extension extendee
+	0,   // [0:164] is the sub-list for field type_name
 }
 
 func init() { file_messages_proto_init() }
@@ -2674,6 +3282,9 @@ func file_messages_proto_init() {
 		(*HoosatdMessage_IbdChainBlockLocator)(nil),
 		(*HoosatdMessage_RequestAnticone)(nil),
 		(*HoosatdMessage_RequestNextPruningPointAndItsAnticoneBlocks)(nil),
+		(*HoosatdMessage_CompactBlock)(nil),
+		(*HoosatdMessage_RequestBlockTransactions)(nil),
+		(*HoosatdMessage_BlockTransactions)(nil),
 		(*HoosatdMessage_GetCurrentNetworkRequest)(nil),
 		(*HoosatdMessage_GetCurrentNetworkResponse)(nil),
 		(*HoosatdMessage_SubmitBlockRequest)(nil),
@@ -2763,6 +3374,35 @@ func file_messages_proto_init() {
 		(*HoosatdMessage_GetCoinSupplyResponse)(nil),
 		(*HoosatdMessage_GetBlockByTransactionIdRequest)(nil),
 		(*HoosatdMessage_GetBlockByTransactionIdResponse)(nil),
+		(*HoosatdMessage_GetCoinbaseSplitRequest)(nil),
+		(*HoosatdMessage_GetCoinbaseSplitResponse)(nil),
+		(*HoosatdMessage_GetEmissionInfoRequest)(nil),
+		(*HoosatdMessage_GetEmissionInfoResponse)(nil),
+		(*HoosatdMessage_TestMempoolAcceptRequest)(nil),
+		(*HoosatdMessage_TestMempoolAcceptResponse)(nil),
+		(*HoosatdMessage_DecodeTransactionRequest)(nil),
+		(*HoosatdMessage_DecodeTransactionResponse)(nil),
+		(*HoosatdMessage_DecodeScriptRequest)(nil),
+		(*HoosatdMessage_DecodeScriptResponse)(nil),
+		(*HoosatdMessage_GetUtxoEntriesByOutpointsRequest)(nil),
+		(*HoosatdMessage_GetUtxoEntriesByOutpointsResponse)(nil),
+		(*HoosatdMessage_GetSyncStatusRequest)(nil),
+		(*HoosatdMessage_GetSyncStatusResponse)(nil),
+		(*HoosatdMessage_NotifySyncStatusChangedRequest)(nil),
+		(*HoosatdMessage_NotifySyncStatusChangedResponse)(nil),
+		(*HoosatdMessage_SyncStatusChangedNotification)(nil),
+		(*HoosatdMessage_VerifyMessageRequest)(nil),
+		(*HoosatdMessage_VerifyMessageResponse)(nil),
+		(*HoosatdMessage_GetTransactionInclusionProofRequest)(nil),
+		(*HoosatdMessage_GetTransactionInclusionProofResponse)(nil),
+		(*HoosatdMessage_InvalidateBlockRequest)(nil),
+		(*HoosatdMessage_InvalidateBlockResponse)(nil),
+		(*HoosatdMessage_ReconsiderBlockRequest)(nil),
+		(*HoosatdMessage_ReconsiderBlockResponse)(nil),
+		(*HoosatdMessage_GetDagSnapshotRequest)(nil),
+		(*HoosatdMessage_GetDagSnapshotResponse)(nil),
+		(*HoosatdMessage_GetTransactionStatusRequest)(nil),
+		(*HoosatdMessage_GetTransactionStatusResponse)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
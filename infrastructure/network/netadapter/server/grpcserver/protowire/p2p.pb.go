@@ -3045,6 +3045,230 @@ func (x *TrustedDataMessage) GetGhostdagData() []*BlockGhostdagDataHashPair {
 	return nil
 }
 
+type CompactBlockMessage struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Header                *BlockHeader            `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	PowHash               string                  `protobuf:"bytes,2,opt,name=powHash,proto3" json:"powHash,omitempty"`
+	ShortIds              []uint64                `protobuf:"varint,3,rep,packed,name=shortIds,proto3" json:"shortIds,omitempty"`
+	PrefilledTransactions []*PrefilledTransaction `protobuf:"bytes,4,rep,name=prefilledTransactions,proto3" json:"prefilledTransactions,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CompactBlockMessage) Reset() {
+	*x = CompactBlockMessage{}
+	mi := &file_p2p_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompactBlockMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactBlockMessage) ProtoMessage() {}
+
+func (x *CompactBlockMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactBlockMessage.ProtoReflect.Descriptor instead.
+func (*CompactBlockMessage) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CompactBlockMessage) GetHeader() *BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CompactBlockMessage) GetPowHash() string {
+	if x != nil {
+		return x.PowHash
+	}
+	return ""
+}
+
+func (x *CompactBlockMessage) GetShortIds() []uint64 {
+	if x != nil {
+		return x.ShortIds
+	}
+	return nil
+}
+
+func (x *CompactBlockMessage) GetPrefilledTransactions() []*PrefilledTransaction {
+	if x != nil {
+		return x.PrefilledTransactions
+	}
+	return nil
+}
+
+type PrefilledTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint32                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Transaction   *TransactionMessage    `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrefilledTransaction) Reset() {
+	*x = PrefilledTransaction{}
+	mi := &file_p2p_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrefilledTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefilledTransaction) ProtoMessage() {}
+
+func (x *PrefilledTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefilledTransaction.ProtoReflect.Descriptor instead.
+func (*PrefilledTransaction) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *PrefilledTransaction) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PrefilledTransaction) GetTransaction() *TransactionMessage {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type RequestBlockTransactionsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     *Hash                  `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Indexes       []uint32               `protobuf:"varint,2,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestBlockTransactionsMessage) Reset() {
+	*x = RequestBlockTransactionsMessage{}
+	mi := &file_p2p_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestBlockTransactionsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestBlockTransactionsMessage) ProtoMessage() {}
+
+func (x *RequestBlockTransactionsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestBlockTransactionsMessage.ProtoReflect.Descriptor instead.
+func (*RequestBlockTransactionsMessage) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RequestBlockTransactionsMessage) GetBlockHash() *Hash {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *RequestBlockTransactionsMessage) GetIndexes() []uint32 {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+type BlockTransactionsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     *Hash                  `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Transactions  []*TransactionMessage  `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockTransactionsMessage) Reset() {
+	*x = BlockTransactionsMessage{}
+	mi := &file_p2p_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockTransactionsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTransactionsMessage) ProtoMessage() {}
+
+func (x *BlockTransactionsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_p2p_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTransactionsMessage.ProtoReflect.Descriptor instead.
+func (*BlockTransactionsMessage) Descriptor() ([]byte, []int) {
+	return file_p2p_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *BlockTransactionsMessage) GetBlockHash() *Hash {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *BlockTransactionsMessage) GetTransactions() []*TransactionMessage {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
 var File_p2p_proto protoreflect.FileDescriptor
 
 const file_p2p_proto_rawDesc = "" +
@@ -3229,7 +3453,21 @@ const file_p2p_proto_rawDesc = "" +
 	"\x13ghostdagDataIndices\x18\x03 \x03(\x04R\x13ghostdagDataIndices\"\x93\x01\n" +
 	"\x12TrustedDataMessage\x123\n" +
 	"\tdaaWindow\x18\x01 \x03(\v2\x15.protowire.DaaBlockV4R\tdaaWindow\x12H\n" +
-	"\fghostdagData\x18\x02 \x03(\v2$.protowire.BlockGhostdagDataHashPairR\fghostdagDataB%Z#github.com/Hoosat-Oy/HTND/protowireb\x06proto3"
+	"\fghostdagData\x18\x02 \x03(\v2$.protowire.BlockGhostdagDataHashPairR\fghostdagData\"\xd2\x01\n" +
+	"\x13CompactBlockMessage\x12.\n" +
+	"\x06header\x18\x01 \x01(\v2\x16.protowire.BlockHeaderR\x06header\x12\x18\n" +
+	"\apowHash\x18\x02 \x01(\tR\apowHash\x12\x1a\n" +
+	"\bshortIds\x18\x03 \x03(\x04R\bshortIds\x12U\n" +
+	"\x15prefilledTransactions\x18\x04 \x03(\v2\x1f.protowire.PrefilledTransactionR\x15prefilledTransactions\"m\n" +
+	"\x14PrefilledTransaction\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\rR\x05index\x12?\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x1d.protowire.TransactionMessageR\vtransaction\"j\n" +
+	"\x1fRequestBlockTransactionsMessage\x12-\n" +
+	"\tblockHash\x18\x01 \x01(\v2\x0f.protowire.HashR\tblockHash\x12\x18\n" +
+	"\aindexes\x18\x02 \x03(\rR\aindexes\"\x8c\x01\n" +
+	"\x18BlockTransactionsMessage\x12-\n" +
+	"\tblockHash\x18\x01 \x01(\v2\x0f.protowire.HashR\tblockHash\x12A\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x1d.protowire.TransactionMessageR\ftransactionsB%Z#github.com/Hoosat-Oy/HTND/protowireb\x06proto3"
 
 var (
 	file_p2p_proto_rawDescOnce sync.Once
@@ -3243,7 +3481,7 @@ func file_p2p_proto_rawDescGZIP() []byte {
 	return file_p2p_proto_rawDescData
 }
 
-var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_p2p_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_p2p_proto_goTypes = []any{
 	(*RequestAddressesMessage)(nil),                            // 0: protowire.RequestAddressesMessage
 	(*AddressesMessage)(nil),                                   // 1: protowire.AddressesMessage
@@ -3305,6 +3543,10 @@ var file_p2p_proto_goTypes = []any{
 	(*ReadyMessage)(nil),                                       // 57: protowire.ReadyMessage
 	(*BlockWithTrustedDataV4Message)(nil),                      // 58: protowire.BlockWithTrustedDataV4Message
 	(*TrustedDataMessage)(nil),                                 // 59: protowire.TrustedDataMessage
+	(*CompactBlockMessage)(nil),                                // 60: protowire.CompactBlockMessage
+	(*PrefilledTransaction)(nil),                               // 61: protowire.PrefilledTransaction
+	(*RequestBlockTransactionsMessage)(nil),                    // 62: protowire.RequestBlockTransactionsMessage
+	(*BlockTransactionsMessage)(nil),                           // 63: protowire.BlockTransactionsMessage
 }
 var file_p2p_proto_depIdxs = []int32{
 	3,  // 0: protowire.RequestAddressesMessage.subnetworkId:type_name -> protowire.SubnetworkId
@@ -3369,11 +3611,17 @@ var file_p2p_proto_depIdxs = []int32{
 	10, // 59: protowire.BlockWithTrustedDataV4Message.block:type_name -> protowire.BlockMessage
 	48, // 60: protowire.TrustedDataMessage.daaWindow:type_name -> protowire.DaaBlockV4
 	49, // 61: protowire.TrustedDataMessage.ghostdagData:type_name -> protowire.BlockGhostdagDataHashPair
-	62, // [62:62] is the sub-list for method output_type
-	62, // [62:62] is the sub-list for method input_type
-	62, // [62:62] is the sub-list for extension type_name
-	62, // [62:62] is the sub-list for extension extendee
-	0,  // [0:62] is the sub-list for field type_name
+	11, // 62: protowire.CompactBlockMessage.header:type_name -> protowire.BlockHeader
+	61, // 63: protowire.CompactBlockMessage.prefilledTransactions:type_name -> protowire.PrefilledTransaction
+	4,  // 64: protowire.PrefilledTransaction.transaction:type_name -> protowire.TransactionMessage
+	13, // 65: protowire.RequestBlockTransactionsMessage.blockHash:type_name -> protowire.Hash
+	13, // 66: protowire.BlockTransactionsMessage.blockHash:type_name -> protowire.Hash
+	4,  // 67: protowire.BlockTransactionsMessage.transactions:type_name -> protowire.TransactionMessage
+	68, // [68:68] is the sub-list for method output_type
+	68, // [68:68] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_p2p_proto_init() }
@@ -3388,7 +3636,7 @@ func file_p2p_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_p2p_proto_rawDesc), len(file_p2p_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   60,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
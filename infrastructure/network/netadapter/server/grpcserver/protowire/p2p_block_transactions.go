@@ -0,0 +1,52 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_BlockTransactions) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_BlockTransactions is nil")
+	}
+	return x.BlockTransactions.toAppMessage()
+}
+
+func (x *BlockTransactionsMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "BlockTransactionsMessage is nil")
+	}
+	blockHash, err := x.BlockHash.toDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*appmessage.MsgTx, len(x.Transactions))
+	for i, protoTx := range x.Transactions {
+		msgTx, err := protoTx.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		transactions[i] = msgTx.(*appmessage.MsgTx)
+	}
+
+	return &appmessage.MsgBlockTransactions{
+		BlockHash:    blockHash,
+		Transactions: transactions,
+	}, nil
+}
+
+func (x *HoosatdMessage_BlockTransactions) fromAppMessage(msgBlockTransactions *appmessage.MsgBlockTransactions) error {
+	protoTransactions := make([]*TransactionMessage, len(msgBlockTransactions.Transactions))
+	for i, tx := range msgBlockTransactions.Transactions {
+		protoTx := new(TransactionMessage)
+		protoTx.fromAppMessage(tx)
+		protoTransactions[i] = protoTx
+	}
+
+	x.BlockTransactions = &BlockTransactionsMessage{
+		BlockHash:    domainHashToProto(msgBlockTransactions.BlockHash),
+		Transactions: protoTransactions,
+	}
+	return nil
+}
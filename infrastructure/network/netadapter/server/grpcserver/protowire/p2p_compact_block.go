@@ -0,0 +1,71 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_CompactBlock) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_CompactBlock is nil")
+	}
+	return x.CompactBlock.toAppMessage()
+}
+
+func (x *CompactBlockMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "CompactBlockMessage is nil")
+	}
+	header, err := x.Header.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	prefilledTransactions := make([]*appmessage.PrefilledTransaction, len(x.PrefilledTransactions))
+	for i, prefilledTransaction := range x.PrefilledTransactions {
+		if prefilledTransaction == nil {
+			return nil, errors.Wrapf(errorNil, "PrefilledTransaction is nil")
+		}
+		msgTx, err := prefilledTransaction.Transaction.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		prefilledTransactions[i] = &appmessage.PrefilledTransaction{
+			Index:       prefilledTransaction.Index,
+			Transaction: msgTx.(*appmessage.MsgTx),
+		}
+	}
+
+	return &appmessage.MsgCompactBlock{
+		Header:                *header,
+		PoWHash:               x.PowHash,
+		ShortIDs:              x.ShortIds,
+		PrefilledTransactions: prefilledTransactions,
+	}, nil
+}
+
+func (x *HoosatdMessage_CompactBlock) fromAppMessage(msgCompactBlock *appmessage.MsgCompactBlock) error {
+	protoHeader := new(BlockHeader)
+	err := protoHeader.fromAppMessage(&msgCompactBlock.Header)
+	if err != nil {
+		return err
+	}
+
+	prefilledTransactions := make([]*PrefilledTransaction, len(msgCompactBlock.PrefilledTransactions))
+	for i, prefilledTransaction := range msgCompactBlock.PrefilledTransactions {
+		protoTx := new(TransactionMessage)
+		protoTx.fromAppMessage(prefilledTransaction.Transaction)
+		prefilledTransactions[i] = &PrefilledTransaction{
+			Index:       prefilledTransaction.Index,
+			Transaction: protoTx,
+		}
+	}
+
+	x.CompactBlock = &CompactBlockMessage{
+		Header:                protoHeader,
+		PowHash:               msgCompactBlock.PoWHash,
+		ShortIds:              msgCompactBlock.ShortIDs,
+		PrefilledTransactions: prefilledTransactions,
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_RequestBlockTransactions) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_RequestBlockTransactions is nil")
+	}
+	return x.RequestBlockTransactions.toAppMessage()
+}
+
+func (x *RequestBlockTransactionsMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RequestBlockTransactionsMessage is nil")
+	}
+	blockHash, err := x.BlockHash.toDomain()
+	if err != nil {
+		return nil, err
+	}
+
+	return &appmessage.MsgRequestBlockTransactions{
+		BlockHash: blockHash,
+		Indexes:   x.Indexes,
+	}, nil
+}
+
+func (x *HoosatdMessage_RequestBlockTransactions) fromAppMessage(
+	msgRequestBlockTransactions *appmessage.MsgRequestBlockTransactions) error {
+
+	x.RequestBlockTransactions = &RequestBlockTransactionsMessage{
+		BlockHash: domainHashToProto(msgRequestBlockTransactions.BlockHash),
+		Indexes:   msgRequestBlockTransactions.Indexes,
+	}
+	return nil
+}
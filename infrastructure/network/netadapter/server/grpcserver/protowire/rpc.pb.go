@@ -80,14 +80,197 @@ func (SubmitBlockResponseMessage_RejectReason) EnumDescriptor() ([]byte, []int)
 	return file_rpc_proto_rawDescGZIP(), []int{17, 0}
 }
 
+type DecodeTransactionRequestMessage_Encoding int32
+
+const (
+	// A serialized RpcTransaction
+	DecodeTransactionRequestMessage_RPC DecodeTransactionRequestMessage_Encoding = 0
+	// A serialized domain transaction, as stored by the node and serialized by htnwallet
+	DecodeTransactionRequestMessage_DOMAIN DecodeTransactionRequestMessage_Encoding = 1
+)
+
+// Enum value maps for DecodeTransactionRequestMessage_Encoding.
+var (
+	DecodeTransactionRequestMessage_Encoding_name = map[int32]string{
+		0: "RPC",
+		1: "DOMAIN",
+	}
+	DecodeTransactionRequestMessage_Encoding_value = map[string]int32{
+		"RPC":    0,
+		"DOMAIN": 1,
+	}
+)
+
+func (x DecodeTransactionRequestMessage_Encoding) Enum() *DecodeTransactionRequestMessage_Encoding {
+	p := new(DecodeTransactionRequestMessage_Encoding)
+	*p = x
+	return p
+}
+
+func (x DecodeTransactionRequestMessage_Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DecodeTransactionRequestMessage_Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[1].Descriptor()
+}
+
+func (DecodeTransactionRequestMessage_Encoding) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[1]
+}
+
+func (x DecodeTransactionRequestMessage_Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DecodeTransactionRequestMessage_Encoding.Descriptor instead.
+func (DecodeTransactionRequestMessage_Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{118, 0}
+}
+
+type RpcSyncStatus_IbdPhase int32
+
+const (
+	// IBD isn't running
+	RpcSyncStatus_NONE RpcSyncStatus_IbdPhase = 0
+	// Searching for the highest chain block shared with the syncer
+	RpcSyncStatus_CHAIN_NEGOTIATION RpcSyncStatus_IbdPhase = 1
+	// Downloading and validating the pruning point proof and the pruning point anticone
+	RpcSyncStatus_HEADERS_PROOF RpcSyncStatus_IbdPhase = 2
+	// Downloading the headers in the future of the pruning point
+	RpcSyncStatus_HEADERS RpcSyncStatus_IbdPhase = 3
+	// Downloading the pruning point UTXO set
+	RpcSyncStatus_PRUNING_POINT_UTXO_SET RpcSyncStatus_IbdPhase = 4
+	// Downloading the missing block bodies
+	RpcSyncStatus_BLOCKS RpcSyncStatus_IbdPhase = 5
+	// Resolving the virtual after the blocks were downloaded
+	RpcSyncStatus_VIRTUAL_RESOLUTION RpcSyncStatus_IbdPhase = 6
+)
+
+// Enum value maps for RpcSyncStatus_IbdPhase.
+var (
+	RpcSyncStatus_IbdPhase_name = map[int32]string{
+		0: "NONE",
+		1: "CHAIN_NEGOTIATION",
+		2: "HEADERS_PROOF",
+		3: "HEADERS",
+		4: "PRUNING_POINT_UTXO_SET",
+		5: "BLOCKS",
+		6: "VIRTUAL_RESOLUTION",
+	}
+	RpcSyncStatus_IbdPhase_value = map[string]int32{
+		"NONE":                   0,
+		"CHAIN_NEGOTIATION":      1,
+		"HEADERS_PROOF":          2,
+		"HEADERS":                3,
+		"PRUNING_POINT_UTXO_SET": 4,
+		"BLOCKS":                 5,
+		"VIRTUAL_RESOLUTION":     6,
+	}
+)
+
+func (x RpcSyncStatus_IbdPhase) Enum() *RpcSyncStatus_IbdPhase {
+	p := new(RpcSyncStatus_IbdPhase)
+	*p = x
+	return p
+}
+
+func (x RpcSyncStatus_IbdPhase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RpcSyncStatus_IbdPhase) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[2].Descriptor()
+}
+
+func (RpcSyncStatus_IbdPhase) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[2]
+}
+
+func (x RpcSyncStatus_IbdPhase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RpcSyncStatus_IbdPhase.Descriptor instead.
+func (RpcSyncStatus_IbdPhase) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{125, 0}
+}
+
+type GetTransactionStatusResponseMessage_TransactionStatus int32
+
+const (
+	// The transaction is neither in the mempool nor in any block
+	GetTransactionStatusResponseMessage_UNKNOWN    GetTransactionStatusResponseMessage_TransactionStatus = 0
+	GetTransactionStatusResponseMessage_IN_MEMPOOL GetTransactionStatusResponseMessage_TransactionStatus = 1
+	// The transaction is in the orphan pool, waiting for the transactions it spends
+	GetTransactionStatusResponseMessage_ORPHAN GetTransactionStatusResponseMessage_TransactionStatus = 2
+	// The transaction is included in a block, but no selected chain block accepted it.
+	// Either the block wasn't merged by the selected chain yet, or the merging chain
+	// block rejected the transaction, for example because it lost to a double spend
+	GetTransactionStatusResponseMessage_INCLUDED_NOT_ACCEPTED GetTransactionStatusResponseMessage_TransactionStatus = 3
+	GetTransactionStatusResponseMessage_ACCEPTED              GetTransactionStatusResponseMessage_TransactionStatus = 4
+)
+
+// Enum value maps for GetTransactionStatusResponseMessage_TransactionStatus.
+var (
+	GetTransactionStatusResponseMessage_TransactionStatus_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "IN_MEMPOOL",
+		2: "ORPHAN",
+		3: "INCLUDED_NOT_ACCEPTED",
+		4: "ACCEPTED",
+	}
+	GetTransactionStatusResponseMessage_TransactionStatus_value = map[string]int32{
+		"UNKNOWN":               0,
+		"IN_MEMPOOL":            1,
+		"ORPHAN":                2,
+		"INCLUDED_NOT_ACCEPTED": 3,
+		"ACCEPTED":              4,
+	}
+)
+
+func (x GetTransactionStatusResponseMessage_TransactionStatus) Enum() *GetTransactionStatusResponseMessage_TransactionStatus {
+	p := new(GetTransactionStatusResponseMessage_TransactionStatus)
+	*p = x
+	return p
+}
+
+func (x GetTransactionStatusResponseMessage_TransactionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetTransactionStatusResponseMessage_TransactionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_proto_enumTypes[3].Descriptor()
+}
+
+func (GetTransactionStatusResponseMessage_TransactionStatus) Type() protoreflect.EnumType {
+	return &file_rpc_proto_enumTypes[3]
+}
+
+func (x GetTransactionStatusResponseMessage_TransactionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetTransactionStatusResponseMessage_TransactionStatus.Descriptor instead.
+func (GetTransactionStatusResponseMessage_TransactionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{146, 0}
+}
+
 // RPCError represents a generic non-internal error.
 //
 // Receivers of any ResponseMessage are expected to check whether its error field is not null.
 type RPCError struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Message string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// code classifies errors that clients may want to handle programmatically.
+	// 0 means a generic error, 1 means the request was rate limited and 2 means
+	// the client lacks the permission required for the request.
+	Code uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	// retryAfterMilliseconds is set for rate limited requests and tells the client
+	// how long to wait before the request is expected to be accepted.
+	RetryAfterMilliseconds uint64 `protobuf:"varint,3,opt,name=retryAfterMilliseconds,proto3" json:"retryAfterMilliseconds,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *RPCError) Reset() {
@@ -127,6 +310,20 @@ func (x *RPCError) GetMessage() string {
 	return ""
 }
 
+func (x *RPCError) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *RPCError) GetRetryAfterMilliseconds() uint64 {
+	if x != nil {
+		return x.RetryAfterMilliseconds
+	}
+	return 0
+}
+
 type RpcBlock struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Header        *RpcBlockHeader        `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
@@ -5923,171 +6120,2578 @@ func (x *GetCoinSupplyResponseMessage) GetError() *RPCError {
 	return nil
 }
 
-var File_rpc_proto protoreflect.FileDescriptor
+// GetCoinbaseSplitRequestMessage requests how the coinbase transaction of a chain block divides
+// the rewards of its merge set between the miners, the dev fee and the merged red blocks
+type GetCoinbaseSplitRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     string                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_rpc_proto_rawDesc = "" +
-	"\n" +
-	"\trpc.proto\x12\tprotowire\"$\n" +
-	"\bRPCError\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\xbe\x01\n" +
-	"\bRpcBlock\x121\n" +
-	"\x06header\x18\x01 \x01(\v2\x19.protowire.RpcBlockHeaderR\x06header\x12=\n" +
-	"\ftransactions\x18\x02 \x03(\v2\x19.protowire.RpcTransactionR\ftransactions\x12@\n" +
-	"\vverboseData\x18\x03 \x01(\v2\x1e.protowire.RpcBlockVerboseDataR\vverboseData\"\xab\x03\n" +
-	"\x0eRpcBlockHeader\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\rR\aversion\x129\n" +
-	"\aparents\x18\f \x03(\v2\x1f.protowire.RpcBlockLevelParentsR\aparents\x12&\n" +
-	"\x0ehashMerkleRoot\x18\x03 \x01(\tR\x0ehashMerkleRoot\x122\n" +
-	"\x14acceptedIdMerkleRoot\x18\x04 \x01(\tR\x14acceptedIdMerkleRoot\x12&\n" +
-	"\x0eutxoCommitment\x18\x05 \x01(\tR\x0eutxoCommitment\x12\x1c\n" +
-	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x12\x12\n" +
-	"\x04bits\x18\a \x01(\rR\x04bits\x12\x14\n" +
-	"\x05nonce\x18\b \x01(\x04R\x05nonce\x12\x1a\n" +
-	"\bdaaScore\x18\t \x01(\x04R\bdaaScore\x12\x1a\n" +
-	"\bblueWork\x18\n" +
-	" \x01(\tR\bblueWork\x12\"\n" +
-	"\fpruningPoint\x18\x0e \x01(\tR\fpruningPoint\x12\x1c\n" +
-	"\tblueScore\x18\r \x01(\x04R\tblueScore\":\n" +
-	"\x14RpcBlockLevelParents\x12\"\n" +
-	"\fparentHashes\x18\x01 \x03(\tR\fparentHashes\"\x91\x03\n" +
-	"\x13RpcBlockVerboseData\x12\x12\n" +
-	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1e\n" +
-	"\n" +
-	"difficulty\x18\v \x01(\x01R\n" +
-	"difficulty\x12.\n" +
-	"\x12selectedParentHash\x18\r \x01(\tR\x12selectedParentHash\x12&\n" +
-	"\x0etransactionIds\x18\x0e \x03(\tR\x0etransactionIds\x12\"\n" +
-	"\fisHeaderOnly\x18\x0f \x01(\bR\fisHeaderOnly\x12\x1c\n" +
-	"\tblueScore\x18\x10 \x01(\x04R\tblueScore\x12&\n" +
-	"\x0echildrenHashes\x18\x11 \x03(\tR\x0echildrenHashes\x120\n" +
-	"\x13mergeSetBluesHashes\x18\x12 \x03(\tR\x13mergeSetBluesHashes\x12.\n" +
-	"\x12mergeSetRedsHashes\x18\x13 \x03(\tR\x12mergeSetRedsHashes\x12\"\n" +
-	"\fisChainBlock\x18\x14 \x01(\bR\fisChainBlock\"\xd1\x02\n" +
-	"\x0eRpcTransaction\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\rR\aversion\x126\n" +
-	"\x06inputs\x18\x02 \x03(\v2\x1e.protowire.RpcTransactionInputR\x06inputs\x129\n" +
-	"\aoutputs\x18\x03 \x03(\v2\x1f.protowire.RpcTransactionOutputR\aoutputs\x12\x1a\n" +
-	"\blockTime\x18\x04 \x01(\x04R\blockTime\x12\"\n" +
-	"\fsubnetworkId\x18\x05 \x01(\tR\fsubnetworkId\x12\x10\n" +
-	"\x03gas\x18\x06 \x01(\x04R\x03gas\x12\x18\n" +
-	"\apayload\x18\b \x01(\tR\apayload\x12F\n" +
-	"\vverboseData\x18\t \x01(\v2$.protowire.RpcTransactionVerboseDataR\vverboseData\"\x8c\x02\n" +
-	"\x13RpcTransactionInput\x12B\n" +
-	"\x10previousOutpoint\x18\x01 \x01(\v2\x16.protowire.RpcOutpointR\x10previousOutpoint\x12(\n" +
-	"\x0fsignatureScript\x18\x02 \x01(\tR\x0fsignatureScript\x12\x1a\n" +
-	"\bsequence\x18\x03 \x01(\x04R\bsequence\x12\x1e\n" +
-	"\n" +
-	"sigOpCount\x18\x05 \x01(\rR\n" +
-	"sigOpCount\x12K\n" +
-	"\vverboseData\x18\x04 \x01(\v2).protowire.RpcTransactionInputVerboseDataR\vverboseData\"X\n" +
-	"\x12RpcScriptPublicKey\x12\x18\n" +
-	"\aversion\x18\x01 \x01(\rR\aversion\x12(\n" +
-	"\x0fscriptPublicKey\x18\x02 \x01(\tR\x0fscriptPublicKey\"\xc5\x01\n" +
-	"\x14RpcTransactionOutput\x12\x16\n" +
-	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12G\n" +
-	"\x0fscriptPublicKey\x18\x02 \x01(\v2\x1d.protowire.RpcScriptPublicKeyR\x0fscriptPublicKey\x12L\n" +
-	"\vverboseData\x18\x03 \x01(\v2*.protowire.RpcTransactionOutputVerboseDataR\vverboseData\"I\n" +
-	"\vRpcOutpoint\x12$\n" +
-	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x14\n" +
-	"\x05index\x18\x02 \x01(\rR\x05index\"\xb5\x01\n" +
-	"\fRpcUtxoEntry\x12\x16\n" +
-	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12G\n" +
-	"\x0fscriptPublicKey\x18\x02 \x01(\v2\x1d.protowire.RpcScriptPublicKeyR\x0fscriptPublicKey\x12$\n" +
-	"\rblockDaaScore\x18\x03 \x01(\x04R\rblockDaaScore\x12\x1e\n" +
-	"\n" +
-	"isCoinbase\x18\x04 \x01(\bR\n" +
-	"isCoinbase\"\xa5\x01\n" +
-	"\x19RpcTransactionVerboseData\x12$\n" +
-	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x12\n" +
-	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x12\n" +
-	"\x04mass\x18\x04 \x01(\x04R\x04mass\x12\x1c\n" +
-	"\tblockHash\x18\f \x01(\tR\tblockHash\x12\x1c\n" +
-	"\tblockTime\x18\x0e \x01(\x04R\tblockTime\" \n" +
-	"\x1eRpcTransactionInputVerboseData\"\x8b\x01\n" +
-	"\x1fRpcTransactionOutputVerboseData\x120\n" +
-	"\x13scriptPublicKeyType\x18\x05 \x01(\tR\x13scriptPublicKeyType\x126\n" +
-	"\x16scriptPublicKeyAddress\x18\x06 \x01(\tR\x16scriptPublicKeyAddress\"!\n" +
-	"\x1fGetCurrentNetworkRequestMessage\"v\n" +
-	" GetCurrentNetworkResponseMessage\x12&\n" +
-	"\x0ecurrentNetwork\x18\x01 \x01(\tR\x0ecurrentNetwork\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x8e\x01\n" +
-	"\x19SubmitBlockRequestMessage\x12)\n" +
-	"\x05block\x18\x02 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12,\n" +
-	"\x11allowNonDAABlocks\x18\x03 \x01(\bR\x11allowNonDAABlocks\x12\x18\n" +
-	"\apowHash\x18\x04 \x01(\tR\apowHash\"\xdc\x01\n" +
-	"\x1aSubmitBlockResponseMessage\x12V\n" +
-	"\frejectReason\x18\x01 \x01(\x0e22.protowire.SubmitBlockResponseMessage.RejectReasonR\frejectReason\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\":\n" +
-	"\fRejectReason\x12\b\n" +
-	"\x04NONE\x10\x00\x12\x11\n" +
-	"\rBLOCK_INVALID\x10\x01\x12\r\n" +
-	"\tIS_IN_IBD\x10\x02\"^\n" +
-	"\x1eGetBlockTemplateRequestMessage\x12\x1e\n" +
-	"\n" +
-	"payAddress\x18\x01 \x01(\tR\n" +
-	"payAddress\x12\x1c\n" +
-	"\textraData\x18\x02 \x01(\tR\textraData\"\x94\x01\n" +
-	"\x1fGetBlockTemplateResponseMessage\x12)\n" +
-	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12\x1a\n" +
-	"\bisSynced\x18\x02 \x01(\bR\bisSynced\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\" \n" +
-	"\x1eNotifyBlockAddedRequestMessage\"M\n" +
-	"\x1fNotifyBlockAddedResponseMessage\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"J\n" +
-	"\x1dBlockAddedNotificationMessage\x12)\n" +
-	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\" \n" +
-	"\x1eGetPeerAddressesRequestMessage\"\xf5\x01\n" +
-	"\x1fGetPeerAddressesResponseMessage\x12L\n" +
-	"\taddresses\x18\x01 \x03(\v2..protowire.GetPeerAddressesKnownAddressMessageR\taddresses\x12X\n" +
-	"\x0fbannedAddresses\x18\x02 \x03(\v2..protowire.GetPeerAddressesKnownAddressMessageR\x0fbannedAddresses\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"9\n" +
-	"#GetPeerAddressesKnownAddressMessage\x12\x12\n" +
-	"\x04Addr\x18\x01 \x01(\tR\x04Addr\"\"\n" +
-	" GetSelectedTipHashRequestMessage\"y\n" +
-	"!GetSelectedTipHashResponseMessage\x12(\n" +
-	"\x0fselectedTipHash\x18\x01 \x01(\tR\x0fselectedTipHash\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x97\x01\n" +
-	"\x1dGetMempoolEntryRequestMessage\x12\x12\n" +
-	"\x04txId\x18\x01 \x01(\tR\x04txId\x12,\n" +
-	"\x11includeOrphanPool\x18\x02 \x01(\bR\x11includeOrphanPool\x124\n" +
-	"\x15filterTransactionPool\x18\x03 \x01(\bR\x15filterTransactionPool\"{\n" +
-	"\x1eGetMempoolEntryResponseMessage\x12-\n" +
-	"\x05entry\x18\x01 \x01(\v2\x17.protowire.MempoolEntryR\x05entry\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x85\x01\n" +
-	"\x1fGetMempoolEntriesRequestMessage\x12,\n" +
-	"\x11includeOrphanPool\x18\x01 \x01(\bR\x11includeOrphanPool\x124\n" +
-	"\x15filterTransactionPool\x18\x02 \x01(\bR\x15filterTransactionPool\"\x81\x01\n" +
-	" GetMempoolEntriesResponseMessage\x121\n" +
-	"\aentries\x18\x01 \x03(\v2\x17.protowire.MempoolEntryR\aentries\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"y\n" +
-	"\fMempoolEntry\x12\x10\n" +
-	"\x03fee\x18\x01 \x01(\x04R\x03fee\x12;\n" +
-	"\vtransaction\x18\x03 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\x12\x1a\n" +
-	"\bisOrphan\x18\x04 \x01(\bR\bisOrphan\"$\n" +
-	"\"GetConnectedPeerInfoRequestMessage\"\x8f\x01\n" +
-	"#GetConnectedPeerInfoResponseMessage\x12<\n" +
-	"\x05infos\x18\x01 \x03(\v2&.protowire.GetConnectedPeerInfoMessageR\x05infos\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xd3\x02\n" +
-	"\x1bGetConnectedPeerInfoMessage\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
-	"\aaddress\x18\x02 \x01(\tR\aaddress\x12*\n" +
-	"\x10lastPingDuration\x18\x03 \x01(\x03R\x10lastPingDuration\x12\x1e\n" +
-	"\n" +
-	"isOutbound\x18\x06 \x01(\bR\n" +
-	"isOutbound\x12\x1e\n" +
-	"\n" +
-	"timeOffset\x18\a \x01(\x03R\n" +
-	"timeOffset\x12\x1c\n" +
-	"\tuserAgent\x18\b \x01(\tR\tuserAgent\x12<\n" +
-	"\x19advertisedProtocolVersion\x18\t \x01(\rR\x19advertisedProtocolVersion\x12$\n" +
-	"\rtimeConnected\x18\n" +
-	" \x01(\x03R\rtimeConnected\x12\x1c\n" +
-	"\tisIbdPeer\x18\v \x01(\bR\tisIbdPeer\"S\n" +
-	"\x15AddPeerRequestMessage\x12\x18\n" +
-	"\aaddress\x18\x01 \x01(\tR\aaddress\x12 \n" +
-	"\visPermanent\x18\x02 \x01(\bR\visPermanent\"D\n" +
-	"\x16AddPeerResponseMessage\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x80\x01\n" +
+func (x *GetCoinbaseSplitRequestMessage) Reset() {
+	*x = GetCoinbaseSplitRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoinbaseSplitRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinbaseSplitRequestMessage) ProtoMessage() {}
+
+func (x *GetCoinbaseSplitRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinbaseSplitRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetCoinbaseSplitRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetCoinbaseSplitRequestMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type CoinbaseReward struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The merged blue block the reward is for. Empty for the merged red blocks' reward
+	BlockHash     string `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	MinerAddress  string `protobuf:"bytes,2,opt,name=minerAddress,proto3" json:"minerAddress,omitempty"`
+	MinerReward   uint64 `protobuf:"varint,3,opt,name=minerReward,proto3" json:"minerReward,omitempty"`
+	DevFee        uint64 `protobuf:"varint,4,opt,name=devFee,proto3" json:"devFee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CoinbaseReward) Reset() {
+	*x = CoinbaseReward{}
+	mi := &file_rpc_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CoinbaseReward) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoinbaseReward) ProtoMessage() {}
+
+func (x *CoinbaseReward) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoinbaseReward.ProtoReflect.Descriptor instead.
+func (*CoinbaseReward) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *CoinbaseReward) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *CoinbaseReward) GetMinerAddress() string {
+	if x != nil {
+		return x.MinerAddress
+	}
+	return ""
+}
+
+func (x *CoinbaseReward) GetMinerReward() uint64 {
+	if x != nil {
+		return x.MinerReward
+	}
+	return 0
+}
+
+func (x *CoinbaseReward) GetDevFee() uint64 {
+	if x != nil {
+		return x.DevFee
+	}
+	return 0
+}
+
+type GetCoinbaseSplitResponseMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash        string                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	BlockVersion     uint32                 `protobuf:"varint,2,opt,name=blockVersion,proto3" json:"blockVersion,omitempty"`
+	IsDevFeeActive   bool                   `protobuf:"varint,3,opt,name=isDevFeeActive,proto3" json:"isDevFeeActive,omitempty"`
+	DevFeeAddress    string                 `protobuf:"bytes,4,opt,name=devFeeAddress,proto3" json:"devFeeAddress,omitempty"`
+	DevFeePercentage uint64                 `protobuf:"varint,5,opt,name=devFeePercentage,proto3" json:"devFeePercentage,omitempty"`
+	BlueRewards      []*CoinbaseReward      `protobuf:"bytes,6,rep,name=blueRewards,proto3" json:"blueRewards,omitempty"`
+	// Not set if the merged red blocks have no reward
+	RedReward        *CoinbaseReward `protobuf:"bytes,7,opt,name=redReward,proto3" json:"redReward,omitempty"`
+	RedBlockHashes   []string        `protobuf:"bytes,8,rep,name=redBlockHashes,proto3" json:"redBlockHashes,omitempty"`
+	TotalMinerReward uint64          `protobuf:"varint,9,opt,name=totalMinerReward,proto3" json:"totalMinerReward,omitempty"`
+	TotalDevFee      uint64          `protobuf:"varint,10,opt,name=totalDevFee,proto3" json:"totalDevFee,omitempty"`
+	Error            *RPCError       `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetCoinbaseSplitResponseMessage) Reset() {
+	*x = GetCoinbaseSplitResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCoinbaseSplitResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCoinbaseSplitResponseMessage) ProtoMessage() {}
+
+func (x *GetCoinbaseSplitResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCoinbaseSplitResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetCoinbaseSplitResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetBlockVersion() uint32 {
+	if x != nil {
+		return x.BlockVersion
+	}
+	return 0
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetIsDevFeeActive() bool {
+	if x != nil {
+		return x.IsDevFeeActive
+	}
+	return false
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetDevFeeAddress() string {
+	if x != nil {
+		return x.DevFeeAddress
+	}
+	return ""
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetDevFeePercentage() uint64 {
+	if x != nil {
+		return x.DevFeePercentage
+	}
+	return 0
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetBlueRewards() []*CoinbaseReward {
+	if x != nil {
+		return x.BlueRewards
+	}
+	return nil
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetRedReward() *CoinbaseReward {
+	if x != nil {
+		return x.RedReward
+	}
+	return nil
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetRedBlockHashes() []string {
+	if x != nil {
+		return x.RedBlockHashes
+	}
+	return nil
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetTotalMinerReward() uint64 {
+	if x != nil {
+		return x.TotalMinerReward
+	}
+	return 0
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetTotalDevFee() uint64 {
+	if x != nil {
+		return x.TotalDevFee
+	}
+	return 0
+}
+
+func (x *GetCoinbaseSplitResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetEmissionInfoRequestMessage requests the emission schedule as seen from the virtual's DAA score,
+// along with the block subsidies at the given DAA scores
+type GetEmissionInfoRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DaaScores     []uint64               `protobuf:"varint,1,rep,packed,name=daaScores,proto3" json:"daaScores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEmissionInfoRequestMessage) Reset() {
+	*x = GetEmissionInfoRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmissionInfoRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmissionInfoRequestMessage) ProtoMessage() {}
+
+func (x *GetEmissionInfoRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmissionInfoRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetEmissionInfoRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetEmissionInfoRequestMessage) GetDaaScores() []uint64 {
+	if x != nil {
+		return x.DaaScores
+	}
+	return nil
+}
+
+type BlockSubsidy struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DaaScore     uint64                 `protobuf:"varint,1,opt,name=daaScore,proto3" json:"daaScore,omitempty"`
+	BlockVersion uint32                 `protobuf:"varint,2,opt,name=blockVersion,proto3" json:"blockVersion,omitempty"`
+	Subsidy      uint64                 `protobuf:"varint,3,opt,name=subsidy,proto3" json:"subsidy,omitempty"`
+	// The projected amount of sompi created by the subsidies of all the DAA scores before daaScore
+	EmittedSompi  uint64 `protobuf:"varint,4,opt,name=emittedSompi,proto3" json:"emittedSompi,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockSubsidy) Reset() {
+	*x = BlockSubsidy{}
+	mi := &file_rpc_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSubsidy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSubsidy) ProtoMessage() {}
+
+func (x *BlockSubsidy) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSubsidy.ProtoReflect.Descriptor instead.
+func (*BlockSubsidy) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *BlockSubsidy) GetDaaScore() uint64 {
+	if x != nil {
+		return x.DaaScore
+	}
+	return 0
+}
+
+func (x *BlockSubsidy) GetBlockVersion() uint32 {
+	if x != nil {
+		return x.BlockVersion
+	}
+	return 0
+}
+
+func (x *BlockSubsidy) GetSubsidy() uint64 {
+	if x != nil {
+		return x.Subsidy
+	}
+	return 0
+}
+
+func (x *BlockSubsidy) GetEmittedSompi() uint64 {
+	if x != nil {
+		return x.EmittedSompi
+	}
+	return 0
+}
+
+type GetEmissionInfoResponseMessage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	VirtualDaaScore uint64                 `protobuf:"varint,1,opt,name=virtualDaaScore,proto3" json:"virtualDaaScore,omitempty"`
+	CurrentSubsidy  *BlockSubsidy          `protobuf:"bytes,2,opt,name=currentSubsidy,proto3" json:"currentSubsidy,omitempty"`
+	// The first DAA score after the virtual's with a lower subsidy. Not set if the subsidy is never reduced again
+	NextReduction *BlockSubsidy   `protobuf:"bytes,3,opt,name=nextReduction,proto3" json:"nextReduction,omitempty"`
+	Subsidies     []*BlockSubsidy `protobuf:"bytes,4,rep,name=subsidies,proto3" json:"subsidies,omitempty"`
+	// The amount of sompi created by the subsidies of all DAA scores, once the subsidy runs out
+	ProjectedTotalSupply uint64    `protobuf:"varint,5,opt,name=projectedTotalSupply,proto3" json:"projectedTotalSupply,omitempty"`
+	MaxSompi             uint64    `protobuf:"varint,6,opt,name=maxSompi,proto3" json:"maxSompi,omitempty"`
+	Error                *RPCError `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetEmissionInfoResponseMessage) Reset() {
+	*x = GetEmissionInfoResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEmissionInfoResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEmissionInfoResponseMessage) ProtoMessage() {}
+
+func (x *GetEmissionInfoResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEmissionInfoResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetEmissionInfoResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetEmissionInfoResponseMessage) GetVirtualDaaScore() uint64 {
+	if x != nil {
+		return x.VirtualDaaScore
+	}
+	return 0
+}
+
+func (x *GetEmissionInfoResponseMessage) GetCurrentSubsidy() *BlockSubsidy {
+	if x != nil {
+		return x.CurrentSubsidy
+	}
+	return nil
+}
+
+func (x *GetEmissionInfoResponseMessage) GetNextReduction() *BlockSubsidy {
+	if x != nil {
+		return x.NextReduction
+	}
+	return nil
+}
+
+func (x *GetEmissionInfoResponseMessage) GetSubsidies() []*BlockSubsidy {
+	if x != nil {
+		return x.Subsidies
+	}
+	return nil
+}
+
+func (x *GetEmissionInfoResponseMessage) GetProjectedTotalSupply() uint64 {
+	if x != nil {
+		return x.ProjectedTotalSupply
+	}
+	return 0
+}
+
+func (x *GetEmissionInfoResponseMessage) GetMaxSompi() uint64 {
+	if x != nil {
+		return x.MaxSompi
+	}
+	return 0
+}
+
+func (x *GetEmissionInfoResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// TestMempoolAcceptRequestMessage validates a transaction the same way SubmitTransaction does,
+// without adding it to the mempool or relaying it
+type TestMempoolAcceptRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *RpcTransaction        `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestMempoolAcceptRequestMessage) Reset() {
+	*x = TestMempoolAcceptRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestMempoolAcceptRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestMempoolAcceptRequestMessage) ProtoMessage() {}
+
+func (x *TestMempoolAcceptRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestMempoolAcceptRequestMessage.ProtoReflect.Descriptor instead.
+func (*TestMempoolAcceptRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *TestMempoolAcceptRequestMessage) GetTransaction() *RpcTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type TestMempoolAcceptResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	IsAccepted    bool                   `protobuf:"varint,2,opt,name=isAccepted,proto3" json:"isAccepted,omitempty"`
+	// The reason the mempool would reject the transaction. Empty if it would be accepted
+	RejectReason string `protobuf:"bytes,3,opt,name=rejectReason,proto3" json:"rejectReason,omitempty"`
+	Mass         uint64 `protobuf:"varint,4,opt,name=mass,proto3" json:"mass,omitempty"`
+	// The fee in sompi. Zero if the transaction was rejected before its inputs were resolved
+	Fee uint64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	// The fee in sompi per gram of mass
+	FeeRate       float64   `protobuf:"fixed64,6,opt,name=feeRate,proto3" json:"feeRate,omitempty"`
+	Error         *RPCError `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TestMempoolAcceptResponseMessage) Reset() {
+	*x = TestMempoolAcceptResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TestMempoolAcceptResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestMempoolAcceptResponseMessage) ProtoMessage() {}
+
+func (x *TestMempoolAcceptResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestMempoolAcceptResponseMessage.ProtoReflect.Descriptor instead.
+func (*TestMempoolAcceptResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *TestMempoolAcceptResponseMessage) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *TestMempoolAcceptResponseMessage) GetIsAccepted() bool {
+	if x != nil {
+		return x.IsAccepted
+	}
+	return false
+}
+
+func (x *TestMempoolAcceptResponseMessage) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *TestMempoolAcceptResponseMessage) GetMass() uint64 {
+	if x != nil {
+		return x.Mass
+	}
+	return 0
+}
+
+func (x *TestMempoolAcceptResponseMessage) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TestMempoolAcceptResponseMessage) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *TestMempoolAcceptResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// DecodeTransactionRequestMessage decodes a hex encoded serialized transaction
+type DecodeTransactionRequestMessage struct {
+	state          protoimpl.MessageState                   `protogen:"open.v1"`
+	TransactionHex string                                   `protobuf:"bytes,1,opt,name=transactionHex,proto3" json:"transactionHex,omitempty"`
+	Encoding       DecodeTransactionRequestMessage_Encoding `protobuf:"varint,2,opt,name=encoding,proto3,enum=protowire.DecodeTransactionRequestMessage_Encoding" json:"encoding,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DecodeTransactionRequestMessage) Reset() {
+	*x = DecodeTransactionRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeTransactionRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTransactionRequestMessage) ProtoMessage() {}
+
+func (x *DecodeTransactionRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeTransactionRequestMessage.ProtoReflect.Descriptor instead.
+func (*DecodeTransactionRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *DecodeTransactionRequestMessage) GetTransactionHex() string {
+	if x != nil {
+		return x.TransactionHex
+	}
+	return ""
+}
+
+func (x *DecodeTransactionRequestMessage) GetEncoding() DecodeTransactionRequestMessage_Encoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return DecodeTransactionRequestMessage_RPC
+}
+
+type DecodeTransactionResponseMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The decoded transaction, including its verbose data
+	Transaction   *RpcTransaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Error         *RPCError       `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeTransactionResponseMessage) Reset() {
+	*x = DecodeTransactionResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeTransactionResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeTransactionResponseMessage) ProtoMessage() {}
+
+func (x *DecodeTransactionResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeTransactionResponseMessage.ProtoReflect.Descriptor instead.
+func (*DecodeTransactionResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *DecodeTransactionResponseMessage) GetTransaction() *RpcTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *DecodeTransactionResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// DecodeScriptRequestMessage disassembles a hex encoded script
+type DecodeScriptRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScriptHex     string                 `protobuf:"bytes,1,opt,name=scriptHex,proto3" json:"scriptHex,omitempty"`
+	Version       uint32                 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeScriptRequestMessage) Reset() {
+	*x = DecodeScriptRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeScriptRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeScriptRequestMessage) ProtoMessage() {}
+
+func (x *DecodeScriptRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeScriptRequestMessage.ProtoReflect.Descriptor instead.
+func (*DecodeScriptRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DecodeScriptRequestMessage) GetScriptHex() string {
+	if x != nil {
+		return x.ScriptHex
+	}
+	return ""
+}
+
+func (x *DecodeScriptRequestMessage) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DecodeScriptResponseMessage struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Disassembly string                 `protobuf:"bytes,1,opt,name=disassembly,proto3" json:"disassembly,omitempty"`
+	ScriptClass string                 `protobuf:"bytes,2,opt,name=scriptClass,proto3" json:"scriptClass,omitempty"`
+	// The addresses the script pays to, if it's a standard script public key
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The address of a pay-to-script-hash script public key that pays to the script
+	P2ShAddress   string    `protobuf:"bytes,4,opt,name=p2shAddress,proto3" json:"p2shAddress,omitempty"`
+	Error         *RPCError `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeScriptResponseMessage) Reset() {
+	*x = DecodeScriptResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeScriptResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeScriptResponseMessage) ProtoMessage() {}
+
+func (x *DecodeScriptResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeScriptResponseMessage.ProtoReflect.Descriptor instead.
+func (*DecodeScriptResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *DecodeScriptResponseMessage) GetDisassembly() string {
+	if x != nil {
+		return x.Disassembly
+	}
+	return ""
+}
+
+func (x *DecodeScriptResponseMessage) GetScriptClass() string {
+	if x != nil {
+		return x.ScriptClass
+	}
+	return ""
+}
+
+func (x *DecodeScriptResponseMessage) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *DecodeScriptResponseMessage) GetP2ShAddress() string {
+	if x != nil {
+		return x.P2ShAddress
+	}
+	return ""
+}
+
+func (x *DecodeScriptResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetUtxoEntriesByOutpointsRequestMessage requests the virtual UTXO entries of the given outpoints
+//
+// Unlike GetUtxosByAddressesRequestMessage, this call doesn't require `--utxoindex`
+type GetUtxoEntriesByOutpointsRequestMessage struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Outpoints []*RpcOutpoint         `protobuf:"bytes,1,rep,name=outpoints,proto3" json:"outpoints,omitempty"`
+	// Whether to report which transaction pool transactions spend the outpoints
+	IncludeMempoolSpends bool `protobuf:"varint,2,opt,name=includeMempoolSpends,proto3" json:"includeMempoolSpends,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *GetUtxoEntriesByOutpointsRequestMessage) Reset() {
+	*x = GetUtxoEntriesByOutpointsRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUtxoEntriesByOutpointsRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUtxoEntriesByOutpointsRequestMessage) ProtoMessage() {}
+
+func (x *GetUtxoEntriesByOutpointsRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUtxoEntriesByOutpointsRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetUtxoEntriesByOutpointsRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *GetUtxoEntriesByOutpointsRequestMessage) GetOutpoints() []*RpcOutpoint {
+	if x != nil {
+		return x.Outpoints
+	}
+	return nil
+}
+
+func (x *GetUtxoEntriesByOutpointsRequestMessage) GetIncludeMempoolSpends() bool {
+	if x != nil {
+		return x.IncludeMempoolSpends
+	}
+	return false
+}
+
+type UtxoEntryByOutpoint struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Outpoint *RpcOutpoint           `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// The UTXO entry of the outpoint. Unset if the outpoint isn't in the virtual UTXO set
+	UtxoEntry *RpcUtxoEntry `protobuf:"bytes,2,opt,name=utxoEntry,proto3" json:"utxoEntry,omitempty"`
+	// Only set if includeMempoolSpends is true
+	IsSpentInMempool             bool   `protobuf:"varint,3,opt,name=isSpentInMempool,proto3" json:"isSpentInMempool,omitempty"`
+	MempoolSpendingTransactionId string `protobuf:"bytes,4,opt,name=mempoolSpendingTransactionId,proto3" json:"mempoolSpendingTransactionId,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *UtxoEntryByOutpoint) Reset() {
+	*x = UtxoEntryByOutpoint{}
+	mi := &file_rpc_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UtxoEntryByOutpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoEntryByOutpoint) ProtoMessage() {}
+
+func (x *UtxoEntryByOutpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoEntryByOutpoint.ProtoReflect.Descriptor instead.
+func (*UtxoEntryByOutpoint) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *UtxoEntryByOutpoint) GetOutpoint() *RpcOutpoint {
+	if x != nil {
+		return x.Outpoint
+	}
+	return nil
+}
+
+func (x *UtxoEntryByOutpoint) GetUtxoEntry() *RpcUtxoEntry {
+	if x != nil {
+		return x.UtxoEntry
+	}
+	return nil
+}
+
+func (x *UtxoEntryByOutpoint) GetIsSpentInMempool() bool {
+	if x != nil {
+		return x.IsSpentInMempool
+	}
+	return false
+}
+
+func (x *UtxoEntryByOutpoint) GetMempoolSpendingTransactionId() string {
+	if x != nil {
+		return x.MempoolSpendingTransactionId
+	}
+	return ""
+}
+
+type GetUtxoEntriesByOutpointsResponseMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entries, in the same order as the requested outpoints
+	Entries       []*UtxoEntryByOutpoint `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUtxoEntriesByOutpointsResponseMessage) Reset() {
+	*x = GetUtxoEntriesByOutpointsResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUtxoEntriesByOutpointsResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUtxoEntriesByOutpointsResponseMessage) ProtoMessage() {}
+
+func (x *GetUtxoEntriesByOutpointsResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUtxoEntriesByOutpointsResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetUtxoEntriesByOutpointsResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetUtxoEntriesByOutpointsResponseMessage) GetEntries() []*UtxoEntryByOutpoint {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *GetUtxoEntriesByOutpointsResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RpcSyncStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether this node is synced and connected to peers, as in GetInfoResponseMessage
+	IsSynced     bool                   `protobuf:"varint,1,opt,name=isSynced,proto3" json:"isSynced,omitempty"`
+	IsIbdRunning bool                   `protobuf:"varint,2,opt,name=isIbdRunning,proto3" json:"isIbdRunning,omitempty"`
+	IbdPhase     RpcSyncStatus_IbdPhase `protobuf:"varint,3,opt,name=ibdPhase,proto3,enum=protowire.RpcSyncStatus_IbdPhase" json:"ibdPhase,omitempty"`
+	// The peer IBD is running with
+	SyncPeerId                 string `protobuf:"bytes,4,opt,name=syncPeerId,proto3" json:"syncPeerId,omitempty"`
+	SyncPeerAddress            string `protobuf:"bytes,5,opt,name=syncPeerAddress,proto3" json:"syncPeerAddress,omitempty"`
+	PhaseStartTimeMilliseconds uint64 `protobuf:"varint,6,opt,name=phaseStartTimeMilliseconds,proto3" json:"phaseStartTimeMilliseconds,omitempty"`
+	// The amount of objects (headers, UTXOs, blocks or DAA score units) processed so far in the current phase
+	Processed uint64 `protobuf:"varint,7,opt,name=processed,proto3" json:"processed,omitempty"`
+	// The amount of objects expected in the current phase, or 0 if it isn't known in advance
+	Total           uint64 `protobuf:"varint,8,opt,name=total,proto3" json:"total,omitempty"`
+	ProgressPercent uint32 `protobuf:"varint,9,opt,name=progressPercent,proto3" json:"progressPercent,omitempty"`
+	// The estimated time left until the current phase is done. Only set if hasEta is true
+	EtaMilliseconds uint64 `protobuf:"varint,10,opt,name=etaMilliseconds,proto3" json:"etaMilliseconds,omitempty"`
+	HasEta          bool   `protobuf:"varint,11,opt,name=hasEta,proto3" json:"hasEta,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RpcSyncStatus) Reset() {
+	*x = RpcSyncStatus{}
+	mi := &file_rpc_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcSyncStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcSyncStatus) ProtoMessage() {}
+
+func (x *RpcSyncStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcSyncStatus.ProtoReflect.Descriptor instead.
+func (*RpcSyncStatus) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *RpcSyncStatus) GetIsSynced() bool {
+	if x != nil {
+		return x.IsSynced
+	}
+	return false
+}
+
+func (x *RpcSyncStatus) GetIsIbdRunning() bool {
+	if x != nil {
+		return x.IsIbdRunning
+	}
+	return false
+}
+
+func (x *RpcSyncStatus) GetIbdPhase() RpcSyncStatus_IbdPhase {
+	if x != nil {
+		return x.IbdPhase
+	}
+	return RpcSyncStatus_NONE
+}
+
+func (x *RpcSyncStatus) GetSyncPeerId() string {
+	if x != nil {
+		return x.SyncPeerId
+	}
+	return ""
+}
+
+func (x *RpcSyncStatus) GetSyncPeerAddress() string {
+	if x != nil {
+		return x.SyncPeerAddress
+	}
+	return ""
+}
+
+func (x *RpcSyncStatus) GetPhaseStartTimeMilliseconds() uint64 {
+	if x != nil {
+		return x.PhaseStartTimeMilliseconds
+	}
+	return 0
+}
+
+func (x *RpcSyncStatus) GetProcessed() uint64 {
+	if x != nil {
+		return x.Processed
+	}
+	return 0
+}
+
+func (x *RpcSyncStatus) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RpcSyncStatus) GetProgressPercent() uint32 {
+	if x != nil {
+		return x.ProgressPercent
+	}
+	return 0
+}
+
+func (x *RpcSyncStatus) GetEtaMilliseconds() uint64 {
+	if x != nil {
+		return x.EtaMilliseconds
+	}
+	return 0
+}
+
+func (x *RpcSyncStatus) GetHasEta() bool {
+	if x != nil {
+		return x.HasEta
+	}
+	return false
+}
+
+// GetSyncStatusRequestMessage requests the phase and the progress of the initial block download
+type GetSyncStatusRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncStatusRequestMessage) Reset() {
+	*x = GetSyncStatusRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncStatusRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusRequestMessage) ProtoMessage() {}
+
+func (x *GetSyncStatusRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{126}
+}
+
+type GetSyncStatusResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyncStatus    *RpcSyncStatus         `protobuf:"bytes,1,opt,name=syncStatus,proto3" json:"syncStatus,omitempty"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSyncStatusResponseMessage) Reset() {
+	*x = GetSyncStatusResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSyncStatusResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSyncStatusResponseMessage) ProtoMessage() {}
+
+func (x *GetSyncStatusResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSyncStatusResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetSyncStatusResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetSyncStatusResponseMessage) GetSyncStatus() *RpcSyncStatus {
+	if x != nil {
+		return x.SyncStatus
+	}
+	return nil
+}
+
+func (x *GetSyncStatusResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// NotifySyncStatusChangedRequestMessage registers this connection for
+// syncStatusChanged notifications.
+//
+// See: SyncStatusChangedNotificationMessage
+type NotifySyncStatusChangedRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifySyncStatusChangedRequestMessage) Reset() {
+	*x = NotifySyncStatusChangedRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySyncStatusChangedRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySyncStatusChangedRequestMessage) ProtoMessage() {}
+
+func (x *NotifySyncStatusChangedRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySyncStatusChangedRequestMessage.ProtoReflect.Descriptor instead.
+func (*NotifySyncStatusChangedRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{128}
+}
+
+type NotifySyncStatusChangedResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotifySyncStatusChangedResponseMessage) Reset() {
+	*x = NotifySyncStatusChangedResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotifySyncStatusChangedResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifySyncStatusChangedResponseMessage) ProtoMessage() {}
+
+func (x *NotifySyncStatusChangedResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifySyncStatusChangedResponseMessage.ProtoReflect.Descriptor instead.
+func (*NotifySyncStatusChangedResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *NotifySyncStatusChangedResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// SyncStatusChangedNotificationMessage is sent whenever the phase or the progress
+// of the initial block download changes.
+//
+// See: NotifySyncStatusChangedRequestMessage
+type SyncStatusChangedNotificationMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SyncStatus    *RpcSyncStatus         `protobuf:"bytes,1,opt,name=syncStatus,proto3" json:"syncStatus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncStatusChangedNotificationMessage) Reset() {
+	*x = SyncStatusChangedNotificationMessage{}
+	mi := &file_rpc_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncStatusChangedNotificationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncStatusChangedNotificationMessage) ProtoMessage() {}
+
+func (x *SyncStatusChangedNotificationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncStatusChangedNotificationMessage.ProtoReflect.Descriptor instead.
+func (*SyncStatusChangedNotificationMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SyncStatusChangedNotificationMessage) GetSyncStatus() *RpcSyncStatus {
+	if x != nil {
+		return x.SyncStatus
+	}
+	return nil
+}
+
+// VerifyMessageRequestMessage verifies a message signature made by `htnwallet sign-message`,
+// proving that the signer owns the given pay-to-pubkey address
+type VerifyMessageRequestMessage struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Address string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The Schnorr signature, or the ECDSA signature for ECDSA addresses, encoded in hex
+	SignatureHex  string `protobuf:"bytes,3,opt,name=signatureHex,proto3" json:"signatureHex,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMessageRequestMessage) Reset() {
+	*x = VerifyMessageRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMessageRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMessageRequestMessage) ProtoMessage() {}
+
+func (x *VerifyMessageRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMessageRequestMessage.ProtoReflect.Descriptor instead.
+func (*VerifyMessageRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *VerifyMessageRequestMessage) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *VerifyMessageRequestMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyMessageRequestMessage) GetSignatureHex() string {
+	if x != nil {
+		return x.SignatureHex
+	}
+	return ""
+}
+
+type VerifyMessageResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsValid       bool                   `protobuf:"varint,1,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMessageResponseMessage) Reset() {
+	*x = VerifyMessageResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMessageResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMessageResponseMessage) ProtoMessage() {}
+
+func (x *VerifyMessageResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMessageResponseMessage.ProtoReflect.Descriptor instead.
+func (*VerifyMessageResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *VerifyMessageResponseMessage) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *VerifyMessageResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetTransactionInclusionProofRequestMessage requests a proof that a transaction was accepted
+// by the selected chain, which light clients can verify against a selected chain block they trust
+// without downloading any block bodies
+type GetTransactionInclusionProofRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	// The block that contains the transaction. If empty, the node looks it up in
+	// its transaction index
+	BlockHash string `protobuf:"bytes,2,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	// A selected chain block to link the proof to. If empty, the proof is linked
+	// to the chain block that accepted the transaction
+	TargetBlockHash string `protobuf:"bytes,3,opt,name=targetBlockHash,proto3" json:"targetBlockHash,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetTransactionInclusionProofRequestMessage) Reset() {
+	*x = GetTransactionInclusionProofRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionInclusionProofRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionInclusionProofRequestMessage) ProtoMessage() {}
+
+func (x *GetTransactionInclusionProofRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionInclusionProofRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetTransactionInclusionProofRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetTransactionInclusionProofRequestMessage) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *GetTransactionInclusionProofRequestMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *GetTransactionInclusionProofRequestMessage) GetTargetBlockHash() string {
+	if x != nil {
+		return x.TargetBlockHash
+	}
+	return ""
+}
+
+type GetTransactionInclusionProofResponseMessage struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Proof         *RpcTransactionInclusionProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	Error         *RPCError                     `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionInclusionProofResponseMessage) Reset() {
+	*x = GetTransactionInclusionProofResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionInclusionProofResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionInclusionProofResponseMessage) ProtoMessage() {}
+
+func (x *GetTransactionInclusionProofResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionInclusionProofResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetTransactionInclusionProofResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetTransactionInclusionProofResponseMessage) GetProof() *RpcTransactionInclusionProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+func (x *GetTransactionInclusionProofResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// RpcMerkleBranch proves that a hash is a leaf of a merkle tree. hashes are the siblings
+// of the leaf and of its ancestors, ordered from the leaf up to the root, and index is the
+// position of the leaf in the tree
+type RpcMerkleBranch struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         uint64                 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Hashes        []string               `protobuf:"bytes,2,rep,name=hashes,proto3" json:"hashes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcMerkleBranch) Reset() {
+	*x = RpcMerkleBranch{}
+	mi := &file_rpc_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcMerkleBranch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcMerkleBranch) ProtoMessage() {}
+
+func (x *RpcMerkleBranch) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcMerkleBranch.ProtoReflect.Descriptor instead.
+func (*RpcMerkleBranch) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *RpcMerkleBranch) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *RpcMerkleBranch) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+// RpcTransactionInclusionProof links a transaction to a selected chain block:
+// the transaction hash to the including block's hashMerkleRoot, the including block
+// through mergeSetPath to the accepting block, the transaction ID to the accepting
+// block's acceptedIdMerkleRoot, and the accepting block through chainPath to the target block
+type RpcTransactionInclusionProof struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	IncludingBlockHeader   *RpcBlockHeader        `protobuf:"bytes,1,opt,name=includingBlockHeader,proto3" json:"includingBlockHeader,omitempty"`
+	HashMerkleBranch       *RpcMerkleBranch       `protobuf:"bytes,2,opt,name=hashMerkleBranch,proto3" json:"hashMerkleBranch,omitempty"`
+	MergeSetPath           []*RpcBlockHeader      `protobuf:"bytes,3,rep,name=mergeSetPath,proto3" json:"mergeSetPath,omitempty"`
+	AcceptingBlockHeader   *RpcBlockHeader        `protobuf:"bytes,4,opt,name=acceptingBlockHeader,proto3" json:"acceptingBlockHeader,omitempty"`
+	AcceptedIdMerkleBranch *RpcMerkleBranch       `protobuf:"bytes,5,opt,name=acceptedIdMerkleBranch,proto3" json:"acceptedIdMerkleBranch,omitempty"`
+	ChainPath              []*RpcBlockHeader      `protobuf:"bytes,6,rep,name=chainPath,proto3" json:"chainPath,omitempty"`
+	// For every block in chainPath, the headers of its direct parents other than the previous
+	// block in the path, which prove that the previous block is its selected parent
+	ChainPathOtherParents []*RpcBlockHeaders `protobuf:"bytes,7,rep,name=chainPathOtherParents,proto3" json:"chainPathOtherParents,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RpcTransactionInclusionProof) Reset() {
+	*x = RpcTransactionInclusionProof{}
+	mi := &file_rpc_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcTransactionInclusionProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcTransactionInclusionProof) ProtoMessage() {}
+
+func (x *RpcTransactionInclusionProof) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcTransactionInclusionProof.ProtoReflect.Descriptor instead.
+func (*RpcTransactionInclusionProof) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *RpcTransactionInclusionProof) GetIncludingBlockHeader() *RpcBlockHeader {
+	if x != nil {
+		return x.IncludingBlockHeader
+	}
+	return nil
+}
+
+func (x *RpcTransactionInclusionProof) GetHashMerkleBranch() *RpcMerkleBranch {
+	if x != nil {
+		return x.HashMerkleBranch
+	}
+	return nil
+}
+
+func (x *RpcTransactionInclusionProof) GetMergeSetPath() []*RpcBlockHeader {
+	if x != nil {
+		return x.MergeSetPath
+	}
+	return nil
+}
+
+func (x *RpcTransactionInclusionProof) GetAcceptingBlockHeader() *RpcBlockHeader {
+	if x != nil {
+		return x.AcceptingBlockHeader
+	}
+	return nil
+}
+
+func (x *RpcTransactionInclusionProof) GetAcceptedIdMerkleBranch() *RpcMerkleBranch {
+	if x != nil {
+		return x.AcceptedIdMerkleBranch
+	}
+	return nil
+}
+
+func (x *RpcTransactionInclusionProof) GetChainPath() []*RpcBlockHeader {
+	if x != nil {
+		return x.ChainPath
+	}
+	return nil
+}
+
+func (x *RpcTransactionInclusionProof) GetChainPathOtherParents() []*RpcBlockHeaders {
+	if x != nil {
+		return x.ChainPathOtherParents
+	}
+	return nil
+}
+
+// RpcBlockHeaders is a list of block headers
+type RpcBlockHeaders struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Headers       []*RpcBlockHeader      `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RpcBlockHeaders) Reset() {
+	*x = RpcBlockHeaders{}
+	mi := &file_rpc_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcBlockHeaders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcBlockHeaders) ProtoMessage() {}
+
+func (x *RpcBlockHeaders) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcBlockHeaders.ProtoReflect.Descriptor instead.
+func (*RpcBlockHeaders) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *RpcBlockHeaders) GetHeaders() []*RpcBlockHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+// InvalidateBlockRequestMessage marks the given block and all the blocks in its future
+// as invalid, and reorganizes the virtual away from them. The decision is persisted
+// until the block is reconsidered with ReconsiderBlockRequestMessage
+type InvalidateBlockRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     string                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateBlockRequestMessage) Reset() {
+	*x = InvalidateBlockRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateBlockRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateBlockRequestMessage) ProtoMessage() {}
+
+func (x *InvalidateBlockRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateBlockRequestMessage.ProtoReflect.Descriptor instead.
+func (*InvalidateBlockRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *InvalidateBlockRequestMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type InvalidateBlockResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateBlockResponseMessage) Reset() {
+	*x = InvalidateBlockResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateBlockResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateBlockResponseMessage) ProtoMessage() {}
+
+func (x *InvalidateBlockResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateBlockResponseMessage.ProtoReflect.Descriptor instead.
+func (*InvalidateBlockResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *InvalidateBlockResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// ReconsiderBlockRequestMessage removes the invalid status that was set by
+// InvalidateBlockRequestMessage from the given block, from the invalidated blocks
+// in its past and from the future of all of them
+type ReconsiderBlockRequestMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockHash     string                 `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconsiderBlockRequestMessage) Reset() {
+	*x = ReconsiderBlockRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconsiderBlockRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconsiderBlockRequestMessage) ProtoMessage() {}
+
+func (x *ReconsiderBlockRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconsiderBlockRequestMessage.ProtoReflect.Descriptor instead.
+func (*ReconsiderBlockRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ReconsiderBlockRequestMessage) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+type ReconsiderBlockResponseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Error         *RPCError              `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconsiderBlockResponseMessage) Reset() {
+	*x = ReconsiderBlockResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconsiderBlockResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconsiderBlockResponseMessage) ProtoMessage() {}
+
+func (x *ReconsiderBlockResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconsiderBlockResponseMessage.ProtoReflect.Descriptor instead.
+func (*ReconsiderBlockResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ReconsiderBlockResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetDagSnapshotRequestMessage requests the blocks whose blue score, or DAA score if
+// useDaaScore is set, is between lowScore and highScore inclusive, along with their
+// GHOSTDAG classification. The blocks are returned in pages, from the selected tip
+// down to lowScore. The first page is requested with an empty pageCursor, and every
+// following page with the nextPageCursor of the previous response. A page may hold
+// fewer blocks than pageSize, or none at all, while nextPageCursor is still set
+type GetDagSnapshotRequestMessage struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	LowScore    uint64                 `protobuf:"varint,1,opt,name=lowScore,proto3" json:"lowScore,omitempty"`
+	HighScore   uint64                 `protobuf:"varint,2,opt,name=highScore,proto3" json:"highScore,omitempty"`
+	UseDaaScore bool                   `protobuf:"varint,3,opt,name=useDaaScore,proto3" json:"useDaaScore,omitempty"`
+	PageCursor  string                 `protobuf:"bytes,4,opt,name=pageCursor,proto3" json:"pageCursor,omitempty"`
+	// The approximate maximum number of blocks in a page. Zero means the default of 1000
+	PageSize uint32 `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// Whether to render the page as a GraphViz DOT script as well
+	IncludeDot    bool `protobuf:"varint,6,opt,name=includeDot,proto3" json:"includeDot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDagSnapshotRequestMessage) Reset() {
+	*x = GetDagSnapshotRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDagSnapshotRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDagSnapshotRequestMessage) ProtoMessage() {}
+
+func (x *GetDagSnapshotRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDagSnapshotRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetDagSnapshotRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *GetDagSnapshotRequestMessage) GetLowScore() uint64 {
+	if x != nil {
+		return x.LowScore
+	}
+	return 0
+}
+
+func (x *GetDagSnapshotRequestMessage) GetHighScore() uint64 {
+	if x != nil {
+		return x.HighScore
+	}
+	return 0
+}
+
+func (x *GetDagSnapshotRequestMessage) GetUseDaaScore() bool {
+	if x != nil {
+		return x.UseDaaScore
+	}
+	return false
+}
+
+func (x *GetDagSnapshotRequestMessage) GetPageCursor() string {
+	if x != nil {
+		return x.PageCursor
+	}
+	return ""
+}
+
+func (x *GetDagSnapshotRequestMessage) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetDagSnapshotRequestMessage) GetIncludeDot() bool {
+	if x != nil {
+		return x.IncludeDot
+	}
+	return false
+}
+
+type GetDagSnapshotResponseMessage struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Blocks []*RpcDagSnapshotBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// Empty if this is the last page
+	NextPageCursor string    `protobuf:"bytes,2,opt,name=nextPageCursor,proto3" json:"nextPageCursor,omitempty"`
+	Dot            string    `protobuf:"bytes,3,opt,name=dot,proto3" json:"dot,omitempty"`
+	Error          *RPCError `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDagSnapshotResponseMessage) Reset() {
+	*x = GetDagSnapshotResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDagSnapshotResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDagSnapshotResponseMessage) ProtoMessage() {}
+
+func (x *GetDagSnapshotResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDagSnapshotResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetDagSnapshotResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetDagSnapshotResponseMessage) GetBlocks() []*RpcDagSnapshotBlock {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *GetDagSnapshotResponseMessage) GetNextPageCursor() string {
+	if x != nil {
+		return x.NextPageCursor
+	}
+	return ""
+}
+
+func (x *GetDagSnapshotResponseMessage) GetDot() string {
+	if x != nil {
+		return x.Dot
+	}
+	return ""
+}
+
+func (x *GetDagSnapshotResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// RpcDagSnapshotBlock describes a block in a DAG snapshot. mergingChainBlockHash is the
+// selected chain block whose merge set contains the block, and isBlue tells whether the
+// block is blue in that merge set. mergingChainBlockHash is empty for the blocks that
+// no selected chain block merged yet, in which case isBlue refers to the merge set of
+// the virtual
+type RpcDagSnapshotBlock struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Hash                  string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHashes          []string               `protobuf:"bytes,2,rep,name=parentHashes,proto3" json:"parentHashes,omitempty"`
+	SelectedParentHash    string                 `protobuf:"bytes,3,opt,name=selectedParentHash,proto3" json:"selectedParentHash,omitempty"`
+	MergeSetBluesHashes   []string               `protobuf:"bytes,4,rep,name=mergeSetBluesHashes,proto3" json:"mergeSetBluesHashes,omitempty"`
+	MergeSetRedsHashes    []string               `protobuf:"bytes,5,rep,name=mergeSetRedsHashes,proto3" json:"mergeSetRedsHashes,omitempty"`
+	BlueScore             uint64                 `protobuf:"varint,6,opt,name=blueScore,proto3" json:"blueScore,omitempty"`
+	DaaScore              uint64                 `protobuf:"varint,7,opt,name=daaScore,proto3" json:"daaScore,omitempty"`
+	Status                string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	IsChainBlock          bool                   `protobuf:"varint,9,opt,name=isChainBlock,proto3" json:"isChainBlock,omitempty"`
+	MergingChainBlockHash string                 `protobuf:"bytes,10,opt,name=mergingChainBlockHash,proto3" json:"mergingChainBlockHash,omitempty"`
+	IsBlue                bool                   `protobuf:"varint,11,opt,name=isBlue,proto3" json:"isBlue,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *RpcDagSnapshotBlock) Reset() {
+	*x = RpcDagSnapshotBlock{}
+	mi := &file_rpc_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RpcDagSnapshotBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RpcDagSnapshotBlock) ProtoMessage() {}
+
+func (x *RpcDagSnapshotBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RpcDagSnapshotBlock.ProtoReflect.Descriptor instead.
+func (*RpcDagSnapshotBlock) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *RpcDagSnapshotBlock) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *RpcDagSnapshotBlock) GetParentHashes() []string {
+	if x != nil {
+		return x.ParentHashes
+	}
+	return nil
+}
+
+func (x *RpcDagSnapshotBlock) GetSelectedParentHash() string {
+	if x != nil {
+		return x.SelectedParentHash
+	}
+	return ""
+}
+
+func (x *RpcDagSnapshotBlock) GetMergeSetBluesHashes() []string {
+	if x != nil {
+		return x.MergeSetBluesHashes
+	}
+	return nil
+}
+
+func (x *RpcDagSnapshotBlock) GetMergeSetRedsHashes() []string {
+	if x != nil {
+		return x.MergeSetRedsHashes
+	}
+	return nil
+}
+
+func (x *RpcDagSnapshotBlock) GetBlueScore() uint64 {
+	if x != nil {
+		return x.BlueScore
+	}
+	return 0
+}
+
+func (x *RpcDagSnapshotBlock) GetDaaScore() uint64 {
+	if x != nil {
+		return x.DaaScore
+	}
+	return 0
+}
+
+func (x *RpcDagSnapshotBlock) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RpcDagSnapshotBlock) GetIsChainBlock() bool {
+	if x != nil {
+		return x.IsChainBlock
+	}
+	return false
+}
+
+func (x *RpcDagSnapshotBlock) GetMergingChainBlockHash() string {
+	if x != nil {
+		return x.MergingChainBlockHash
+	}
+	return ""
+}
+
+func (x *RpcDagSnapshotBlock) GetIsBlue() bool {
+	if x != nil {
+		return x.IsBlue
+	}
+	return false
+}
+
+// GetTransactionStatusRequestMessage requests whether the given transaction is in the
+// mempool, or was included in a block and accepted by the selected chain. Blocks aren't
+// indexed by the transactions they include, so includingBlockHash is required once the
+// transaction left the mempool
+type GetTransactionStatusRequestMessage struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId      string                 `protobuf:"bytes,1,opt,name=transactionId,proto3" json:"transactionId,omitempty"`
+	IncludingBlockHash string                 `protobuf:"bytes,2,opt,name=includingBlockHash,proto3" json:"includingBlockHash,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetTransactionStatusRequestMessage) Reset() {
+	*x = GetTransactionStatusRequestMessage{}
+	mi := &file_rpc_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionStatusRequestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionStatusRequestMessage) ProtoMessage() {}
+
+func (x *GetTransactionStatusRequestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionStatusRequestMessage.ProtoReflect.Descriptor instead.
+func (*GetTransactionStatusRequestMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GetTransactionStatusRequestMessage) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *GetTransactionStatusRequestMessage) GetIncludingBlockHash() string {
+	if x != nil {
+		return x.IncludingBlockHash
+	}
+	return ""
+}
+
+type GetTransactionStatusResponseMessage struct {
+	state  protoimpl.MessageState                                `protogen:"open.v1"`
+	Status GetTransactionStatusResponseMessage_TransactionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=protowire.GetTransactionStatusResponseMessage_TransactionStatus" json:"status,omitempty"`
+	// Only set if the transaction is included in a block. If the transaction was accepted
+	// from another block that was merged together with the requested one, that block is
+	// set instead
+	IncludingBlockHash string `protobuf:"bytes,2,opt,name=includingBlockHash,proto3" json:"includingBlockHash,omitempty"`
+	// The selected chain block that accepted the transaction. Only set if status is ACCEPTED
+	AcceptingBlockHash     string `protobuf:"bytes,3,opt,name=acceptingBlockHash,proto3" json:"acceptingBlockHash,omitempty"`
+	AcceptingBlockDaaScore uint64 `protobuf:"varint,4,opt,name=acceptingBlockDaaScore,proto3" json:"acceptingBlockDaaScore,omitempty"`
+	// The virtual DAA score minus acceptingBlockDaaScore
+	Confirmations uint64    `protobuf:"varint,5,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	Error         *RPCError `protobuf:"bytes,1000,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionStatusResponseMessage) Reset() {
+	*x = GetTransactionStatusResponseMessage{}
+	mi := &file_rpc_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionStatusResponseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionStatusResponseMessage) ProtoMessage() {}
+
+func (x *GetTransactionStatusResponseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionStatusResponseMessage.ProtoReflect.Descriptor instead.
+func (*GetTransactionStatusResponseMessage) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GetTransactionStatusResponseMessage) GetStatus() GetTransactionStatusResponseMessage_TransactionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return GetTransactionStatusResponseMessage_UNKNOWN
+}
+
+func (x *GetTransactionStatusResponseMessage) GetIncludingBlockHash() string {
+	if x != nil {
+		return x.IncludingBlockHash
+	}
+	return ""
+}
+
+func (x *GetTransactionStatusResponseMessage) GetAcceptingBlockHash() string {
+	if x != nil {
+		return x.AcceptingBlockHash
+	}
+	return ""
+}
+
+func (x *GetTransactionStatusResponseMessage) GetAcceptingBlockDaaScore() uint64 {
+	if x != nil {
+		return x.AcceptingBlockDaaScore
+	}
+	return 0
+}
+
+func (x *GetTransactionStatusResponseMessage) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *GetTransactionStatusResponseMessage) GetError() *RPCError {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+var File_rpc_proto protoreflect.FileDescriptor
+
+const file_rpc_proto_rawDesc = "" +
+	"\n" +
+	"\trpc.proto\x12\tprotowire\"p\n" +
+	"\bRPCError\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\rR\x04code\x126\n" +
+	"\x16retryAfterMilliseconds\x18\x03 \x01(\x04R\x16retryAfterMilliseconds\"\xbe\x01\n" +
+	"\bRpcBlock\x121\n" +
+	"\x06header\x18\x01 \x01(\v2\x19.protowire.RpcBlockHeaderR\x06header\x12=\n" +
+	"\ftransactions\x18\x02 \x03(\v2\x19.protowire.RpcTransactionR\ftransactions\x12@\n" +
+	"\vverboseData\x18\x03 \x01(\v2\x1e.protowire.RpcBlockVerboseDataR\vverboseData\"\xab\x03\n" +
+	"\x0eRpcBlockHeader\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x129\n" +
+	"\aparents\x18\f \x03(\v2\x1f.protowire.RpcBlockLevelParentsR\aparents\x12&\n" +
+	"\x0ehashMerkleRoot\x18\x03 \x01(\tR\x0ehashMerkleRoot\x122\n" +
+	"\x14acceptedIdMerkleRoot\x18\x04 \x01(\tR\x14acceptedIdMerkleRoot\x12&\n" +
+	"\x0eutxoCommitment\x18\x05 \x01(\tR\x0eutxoCommitment\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x12\x12\n" +
+	"\x04bits\x18\a \x01(\rR\x04bits\x12\x14\n" +
+	"\x05nonce\x18\b \x01(\x04R\x05nonce\x12\x1a\n" +
+	"\bdaaScore\x18\t \x01(\x04R\bdaaScore\x12\x1a\n" +
+	"\bblueWork\x18\n" +
+	" \x01(\tR\bblueWork\x12\"\n" +
+	"\fpruningPoint\x18\x0e \x01(\tR\fpruningPoint\x12\x1c\n" +
+	"\tblueScore\x18\r \x01(\x04R\tblueScore\":\n" +
+	"\x14RpcBlockLevelParents\x12\"\n" +
+	"\fparentHashes\x18\x01 \x03(\tR\fparentHashes\"\x91\x03\n" +
+	"\x13RpcBlockVerboseData\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\v \x01(\x01R\n" +
+	"difficulty\x12.\n" +
+	"\x12selectedParentHash\x18\r \x01(\tR\x12selectedParentHash\x12&\n" +
+	"\x0etransactionIds\x18\x0e \x03(\tR\x0etransactionIds\x12\"\n" +
+	"\fisHeaderOnly\x18\x0f \x01(\bR\fisHeaderOnly\x12\x1c\n" +
+	"\tblueScore\x18\x10 \x01(\x04R\tblueScore\x12&\n" +
+	"\x0echildrenHashes\x18\x11 \x03(\tR\x0echildrenHashes\x120\n" +
+	"\x13mergeSetBluesHashes\x18\x12 \x03(\tR\x13mergeSetBluesHashes\x12.\n" +
+	"\x12mergeSetRedsHashes\x18\x13 \x03(\tR\x12mergeSetRedsHashes\x12\"\n" +
+	"\fisChainBlock\x18\x14 \x01(\bR\fisChainBlock\"\xd1\x02\n" +
+	"\x0eRpcTransaction\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x126\n" +
+	"\x06inputs\x18\x02 \x03(\v2\x1e.protowire.RpcTransactionInputR\x06inputs\x129\n" +
+	"\aoutputs\x18\x03 \x03(\v2\x1f.protowire.RpcTransactionOutputR\aoutputs\x12\x1a\n" +
+	"\blockTime\x18\x04 \x01(\x04R\blockTime\x12\"\n" +
+	"\fsubnetworkId\x18\x05 \x01(\tR\fsubnetworkId\x12\x10\n" +
+	"\x03gas\x18\x06 \x01(\x04R\x03gas\x12\x18\n" +
+	"\apayload\x18\b \x01(\tR\apayload\x12F\n" +
+	"\vverboseData\x18\t \x01(\v2$.protowire.RpcTransactionVerboseDataR\vverboseData\"\x8c\x02\n" +
+	"\x13RpcTransactionInput\x12B\n" +
+	"\x10previousOutpoint\x18\x01 \x01(\v2\x16.protowire.RpcOutpointR\x10previousOutpoint\x12(\n" +
+	"\x0fsignatureScript\x18\x02 \x01(\tR\x0fsignatureScript\x12\x1a\n" +
+	"\bsequence\x18\x03 \x01(\x04R\bsequence\x12\x1e\n" +
+	"\n" +
+	"sigOpCount\x18\x05 \x01(\rR\n" +
+	"sigOpCount\x12K\n" +
+	"\vverboseData\x18\x04 \x01(\v2).protowire.RpcTransactionInputVerboseDataR\vverboseData\"X\n" +
+	"\x12RpcScriptPublicKey\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x12(\n" +
+	"\x0fscriptPublicKey\x18\x02 \x01(\tR\x0fscriptPublicKey\"\xc5\x01\n" +
+	"\x14RpcTransactionOutput\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12G\n" +
+	"\x0fscriptPublicKey\x18\x02 \x01(\v2\x1d.protowire.RpcScriptPublicKeyR\x0fscriptPublicKey\x12L\n" +
+	"\vverboseData\x18\x03 \x01(\v2*.protowire.RpcTransactionOutputVerboseDataR\vverboseData\"I\n" +
+	"\vRpcOutpoint\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x14\n" +
+	"\x05index\x18\x02 \x01(\rR\x05index\"\xb5\x01\n" +
+	"\fRpcUtxoEntry\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x04R\x06amount\x12G\n" +
+	"\x0fscriptPublicKey\x18\x02 \x01(\v2\x1d.protowire.RpcScriptPublicKeyR\x0fscriptPublicKey\x12$\n" +
+	"\rblockDaaScore\x18\x03 \x01(\x04R\rblockDaaScore\x12\x1e\n" +
+	"\n" +
+	"isCoinbase\x18\x04 \x01(\bR\n" +
+	"isCoinbase\"\xa5\x01\n" +
+	"\x19RpcTransactionVerboseData\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\tR\x04hash\x12\x12\n" +
+	"\x04mass\x18\x04 \x01(\x04R\x04mass\x12\x1c\n" +
+	"\tblockHash\x18\f \x01(\tR\tblockHash\x12\x1c\n" +
+	"\tblockTime\x18\x0e \x01(\x04R\tblockTime\" \n" +
+	"\x1eRpcTransactionInputVerboseData\"\x8b\x01\n" +
+	"\x1fRpcTransactionOutputVerboseData\x120\n" +
+	"\x13scriptPublicKeyType\x18\x05 \x01(\tR\x13scriptPublicKeyType\x126\n" +
+	"\x16scriptPublicKeyAddress\x18\x06 \x01(\tR\x16scriptPublicKeyAddress\"!\n" +
+	"\x1fGetCurrentNetworkRequestMessage\"v\n" +
+	" GetCurrentNetworkResponseMessage\x12&\n" +
+	"\x0ecurrentNetwork\x18\x01 \x01(\tR\x0ecurrentNetwork\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x8e\x01\n" +
+	"\x19SubmitBlockRequestMessage\x12)\n" +
+	"\x05block\x18\x02 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12,\n" +
+	"\x11allowNonDAABlocks\x18\x03 \x01(\bR\x11allowNonDAABlocks\x12\x18\n" +
+	"\apowHash\x18\x04 \x01(\tR\apowHash\"\xdc\x01\n" +
+	"\x1aSubmitBlockResponseMessage\x12V\n" +
+	"\frejectReason\x18\x01 \x01(\x0e22.protowire.SubmitBlockResponseMessage.RejectReasonR\frejectReason\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\":\n" +
+	"\fRejectReason\x12\b\n" +
+	"\x04NONE\x10\x00\x12\x11\n" +
+	"\rBLOCK_INVALID\x10\x01\x12\r\n" +
+	"\tIS_IN_IBD\x10\x02\"^\n" +
+	"\x1eGetBlockTemplateRequestMessage\x12\x1e\n" +
+	"\n" +
+	"payAddress\x18\x01 \x01(\tR\n" +
+	"payAddress\x12\x1c\n" +
+	"\textraData\x18\x02 \x01(\tR\textraData\"\x94\x01\n" +
+	"\x1fGetBlockTemplateResponseMessage\x12)\n" +
+	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\x12\x1a\n" +
+	"\bisSynced\x18\x02 \x01(\bR\bisSynced\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\" \n" +
+	"\x1eNotifyBlockAddedRequestMessage\"M\n" +
+	"\x1fNotifyBlockAddedResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"J\n" +
+	"\x1dBlockAddedNotificationMessage\x12)\n" +
+	"\x05block\x18\x03 \x01(\v2\x13.protowire.RpcBlockR\x05block\" \n" +
+	"\x1eGetPeerAddressesRequestMessage\"\xf5\x01\n" +
+	"\x1fGetPeerAddressesResponseMessage\x12L\n" +
+	"\taddresses\x18\x01 \x03(\v2..protowire.GetPeerAddressesKnownAddressMessageR\taddresses\x12X\n" +
+	"\x0fbannedAddresses\x18\x02 \x03(\v2..protowire.GetPeerAddressesKnownAddressMessageR\x0fbannedAddresses\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"9\n" +
+	"#GetPeerAddressesKnownAddressMessage\x12\x12\n" +
+	"\x04Addr\x18\x01 \x01(\tR\x04Addr\"\"\n" +
+	" GetSelectedTipHashRequestMessage\"y\n" +
+	"!GetSelectedTipHashResponseMessage\x12(\n" +
+	"\x0fselectedTipHash\x18\x01 \x01(\tR\x0fselectedTipHash\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x97\x01\n" +
+	"\x1dGetMempoolEntryRequestMessage\x12\x12\n" +
+	"\x04txId\x18\x01 \x01(\tR\x04txId\x12,\n" +
+	"\x11includeOrphanPool\x18\x02 \x01(\bR\x11includeOrphanPool\x124\n" +
+	"\x15filterTransactionPool\x18\x03 \x01(\bR\x15filterTransactionPool\"{\n" +
+	"\x1eGetMempoolEntryResponseMessage\x12-\n" +
+	"\x05entry\x18\x01 \x01(\v2\x17.protowire.MempoolEntryR\x05entry\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x85\x01\n" +
+	"\x1fGetMempoolEntriesRequestMessage\x12,\n" +
+	"\x11includeOrphanPool\x18\x01 \x01(\bR\x11includeOrphanPool\x124\n" +
+	"\x15filterTransactionPool\x18\x02 \x01(\bR\x15filterTransactionPool\"\x81\x01\n" +
+	" GetMempoolEntriesResponseMessage\x121\n" +
+	"\aentries\x18\x01 \x03(\v2\x17.protowire.MempoolEntryR\aentries\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"y\n" +
+	"\fMempoolEntry\x12\x10\n" +
+	"\x03fee\x18\x01 \x01(\x04R\x03fee\x12;\n" +
+	"\vtransaction\x18\x03 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\x12\x1a\n" +
+	"\bisOrphan\x18\x04 \x01(\bR\bisOrphan\"$\n" +
+	"\"GetConnectedPeerInfoRequestMessage\"\x8f\x01\n" +
+	"#GetConnectedPeerInfoResponseMessage\x12<\n" +
+	"\x05infos\x18\x01 \x03(\v2&.protowire.GetConnectedPeerInfoMessageR\x05infos\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xd3\x02\n" +
+	"\x1bGetConnectedPeerInfoMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12*\n" +
+	"\x10lastPingDuration\x18\x03 \x01(\x03R\x10lastPingDuration\x12\x1e\n" +
+	"\n" +
+	"isOutbound\x18\x06 \x01(\bR\n" +
+	"isOutbound\x12\x1e\n" +
+	"\n" +
+	"timeOffset\x18\a \x01(\x03R\n" +
+	"timeOffset\x12\x1c\n" +
+	"\tuserAgent\x18\b \x01(\tR\tuserAgent\x12<\n" +
+	"\x19advertisedProtocolVersion\x18\t \x01(\rR\x19advertisedProtocolVersion\x12$\n" +
+	"\rtimeConnected\x18\n" +
+	" \x01(\x03R\rtimeConnected\x12\x1c\n" +
+	"\tisIbdPeer\x18\v \x01(\bR\tisIbdPeer\"S\n" +
+	"\x15AddPeerRequestMessage\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12 \n" +
+	"\visPermanent\x18\x02 \x01(\bR\visPermanent\"D\n" +
+	"\x16AddPeerResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x80\x01\n" +
 	"\x1fSubmitTransactionRequestMessage\x12;\n" +
 	"\vtransaction\x18\x01 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\x12 \n" +
 	"\vallowOrphan\x18\x02 \x01(\bR\vallowOrphan\"t\n" +
@@ -6280,7 +8884,203 @@ const file_rpc_proto_rawDesc = "" +
 	"\x1cGetCoinSupplyResponseMessage\x12\x1a\n" +
 	"\bmaxSompi\x18\x01 \x01(\x04R\bmaxSompi\x12*\n" +
 	"\x10circulatingSompi\x18\x02 \x01(\x04R\x10circulatingSompi\x12*\n" +
-	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05errorB%Z#github.com/Hoosat-Oy/HTND/protowireb\x06proto3"
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\">\n" +
+	"\x1eGetCoinbaseSplitRequestMessage\x12\x1c\n" +
+	"\tblockHash\x18\x01 \x01(\tR\tblockHash\"\x8c\x01\n" +
+	"\x0eCoinbaseReward\x12\x1c\n" +
+	"\tblockHash\x18\x01 \x01(\tR\tblockHash\x12\"\n" +
+	"\fminerAddress\x18\x02 \x01(\tR\fminerAddress\x12 \n" +
+	"\vminerReward\x18\x03 \x01(\x04R\vminerReward\x12\x16\n" +
+	"\x06devFee\x18\x04 \x01(\x04R\x06devFee\"\xf5\x03\n" +
+	"\x1fGetCoinbaseSplitResponseMessage\x12\x1c\n" +
+	"\tblockHash\x18\x01 \x01(\tR\tblockHash\x12\"\n" +
+	"\fblockVersion\x18\x02 \x01(\rR\fblockVersion\x12&\n" +
+	"\x0eisDevFeeActive\x18\x03 \x01(\bR\x0eisDevFeeActive\x12$\n" +
+	"\rdevFeeAddress\x18\x04 \x01(\tR\rdevFeeAddress\x12*\n" +
+	"\x10devFeePercentage\x18\x05 \x01(\x04R\x10devFeePercentage\x12;\n" +
+	"\vblueRewards\x18\x06 \x03(\v2\x19.protowire.CoinbaseRewardR\vblueRewards\x127\n" +
+	"\tredReward\x18\a \x01(\v2\x19.protowire.CoinbaseRewardR\tredReward\x12&\n" +
+	"\x0eredBlockHashes\x18\b \x03(\tR\x0eredBlockHashes\x12*\n" +
+	"\x10totalMinerReward\x18\t \x01(\x04R\x10totalMinerReward\x12 \n" +
+	"\vtotalDevFee\x18\n" +
+	" \x01(\x04R\vtotalDevFee\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"=\n" +
+	"\x1dGetEmissionInfoRequestMessage\x12\x1c\n" +
+	"\tdaaScores\x18\x01 \x03(\x04R\tdaaScores\"\x8c\x01\n" +
+	"\fBlockSubsidy\x12\x1a\n" +
+	"\bdaaScore\x18\x01 \x01(\x04R\bdaaScore\x12\"\n" +
+	"\fblockVersion\x18\x02 \x01(\rR\fblockVersion\x12\x18\n" +
+	"\asubsidy\x18\x03 \x01(\x04R\asubsidy\x12\"\n" +
+	"\femittedSompi\x18\x04 \x01(\x04R\femittedSompi\"\xfd\x02\n" +
+	"\x1eGetEmissionInfoResponseMessage\x12(\n" +
+	"\x0fvirtualDaaScore\x18\x01 \x01(\x04R\x0fvirtualDaaScore\x12?\n" +
+	"\x0ecurrentSubsidy\x18\x02 \x01(\v2\x17.protowire.BlockSubsidyR\x0ecurrentSubsidy\x12=\n" +
+	"\rnextReduction\x18\x03 \x01(\v2\x17.protowire.BlockSubsidyR\rnextReduction\x125\n" +
+	"\tsubsidies\x18\x04 \x03(\v2\x17.protowire.BlockSubsidyR\tsubsidies\x122\n" +
+	"\x14projectedTotalSupply\x18\x05 \x01(\x04R\x14projectedTotalSupply\x12\x1a\n" +
+	"\bmaxSompi\x18\x06 \x01(\x04R\bmaxSompi\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"^\n" +
+	"\x1fTestMempoolAcceptRequestMessage\x12;\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\"\xf8\x01\n" +
+	" TestMempoolAcceptResponseMessage\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x1e\n" +
+	"\n" +
+	"isAccepted\x18\x02 \x01(\bR\n" +
+	"isAccepted\x12\"\n" +
+	"\frejectReason\x18\x03 \x01(\tR\frejectReason\x12\x12\n" +
+	"\x04mass\x18\x04 \x01(\x04R\x04mass\x12\x10\n" +
+	"\x03fee\x18\x05 \x01(\x04R\x03fee\x12\x18\n" +
+	"\afeeRate\x18\x06 \x01(\x01R\afeeRate\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xbb\x01\n" +
+	"\x1fDecodeTransactionRequestMessage\x12&\n" +
+	"\x0etransactionHex\x18\x01 \x01(\tR\x0etransactionHex\x12O\n" +
+	"\bencoding\x18\x02 \x01(\x0e23.protowire.DecodeTransactionRequestMessage.EncodingR\bencoding\"\x1f\n" +
+	"\bEncoding\x12\a\n" +
+	"\x03RPC\x10\x00\x12\n" +
+	"\n" +
+	"\x06DOMAIN\x10\x01\"\x8b\x01\n" +
+	" DecodeTransactionResponseMessage\x12;\n" +
+	"\vtransaction\x18\x01 \x01(\v2\x19.protowire.RpcTransactionR\vtransaction\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"T\n" +
+	"\x1aDecodeScriptRequestMessage\x12\x1c\n" +
+	"\tscriptHex\x18\x01 \x01(\tR\tscriptHex\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\rR\aversion\"\xcd\x01\n" +
+	"\x1bDecodeScriptResponseMessage\x12 \n" +
+	"\vdisassembly\x18\x01 \x01(\tR\vdisassembly\x12 \n" +
+	"\vscriptClass\x18\x02 \x01(\tR\vscriptClass\x12\x1c\n" +
+	"\taddresses\x18\x03 \x03(\tR\taddresses\x12 \n" +
+	"\vp2shAddress\x18\x04 \x01(\tR\vp2shAddress\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x93\x01\n" +
+	"'GetUtxoEntriesByOutpointsRequestMessage\x124\n" +
+	"\toutpoints\x18\x01 \x03(\v2\x16.protowire.RpcOutpointR\toutpoints\x122\n" +
+	"\x14includeMempoolSpends\x18\x02 \x01(\bR\x14includeMempoolSpends\"\xf0\x01\n" +
+	"\x13UtxoEntryByOutpoint\x122\n" +
+	"\boutpoint\x18\x01 \x01(\v2\x16.protowire.RpcOutpointR\boutpoint\x125\n" +
+	"\tutxoEntry\x18\x02 \x01(\v2\x17.protowire.RpcUtxoEntryR\tutxoEntry\x12*\n" +
+	"\x10isSpentInMempool\x18\x03 \x01(\bR\x10isSpentInMempool\x12B\n" +
+	"\x1cmempoolSpendingTransactionId\x18\x04 \x01(\tR\x1cmempoolSpendingTransactionId\"\x90\x01\n" +
+	"(GetUtxoEntriesByOutpointsResponseMessage\x128\n" +
+	"\aentries\x18\x01 \x03(\v2\x1e.protowire.UtxoEntryByOutpointR\aentries\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xc6\x04\n" +
+	"\rRpcSyncStatus\x12\x1a\n" +
+	"\bisSynced\x18\x01 \x01(\bR\bisSynced\x12\"\n" +
+	"\fisIbdRunning\x18\x02 \x01(\bR\fisIbdRunning\x12=\n" +
+	"\bibdPhase\x18\x03 \x01(\x0e2!.protowire.RpcSyncStatus.IbdPhaseR\bibdPhase\x12\x1e\n" +
+	"\n" +
+	"syncPeerId\x18\x04 \x01(\tR\n" +
+	"syncPeerId\x12(\n" +
+	"\x0fsyncPeerAddress\x18\x05 \x01(\tR\x0fsyncPeerAddress\x12>\n" +
+	"\x1aphaseStartTimeMilliseconds\x18\x06 \x01(\x04R\x1aphaseStartTimeMilliseconds\x12\x1c\n" +
+	"\tprocessed\x18\a \x01(\x04R\tprocessed\x12\x14\n" +
+	"\x05total\x18\b \x01(\x04R\x05total\x12(\n" +
+	"\x0fprogressPercent\x18\t \x01(\rR\x0fprogressPercent\x12(\n" +
+	"\x0fetaMilliseconds\x18\n" +
+	" \x01(\x04R\x0fetaMilliseconds\x12\x16\n" +
+	"\x06hasEta\x18\v \x01(\bR\x06hasEta\"\x8b\x01\n" +
+	"\bIbdPhase\x12\b\n" +
+	"\x04NONE\x10\x00\x12\x15\n" +
+	"\x11CHAIN_NEGOTIATION\x10\x01\x12\x11\n" +
+	"\rHEADERS_PROOF\x10\x02\x12\v\n" +
+	"\aHEADERS\x10\x03\x12\x1a\n" +
+	"\x16PRUNING_POINT_UTXO_SET\x10\x04\x12\n" +
+	"\n" +
+	"\x06BLOCKS\x10\x05\x12\x16\n" +
+	"\x12VIRTUAL_RESOLUTION\x10\x06\"\x1d\n" +
+	"\x1bGetSyncStatusRequestMessage\"\x84\x01\n" +
+	"\x1cGetSyncStatusResponseMessage\x128\n" +
+	"\n" +
+	"syncStatus\x18\x01 \x01(\v2\x18.protowire.RpcSyncStatusR\n" +
+	"syncStatus\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"'\n" +
+	"%NotifySyncStatusChangedRequestMessage\"T\n" +
+	"&NotifySyncStatusChangedResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"`\n" +
+	"$SyncStatusChangedNotificationMessage\x128\n" +
+	"\n" +
+	"syncStatus\x18\x01 \x01(\v2\x18.protowire.RpcSyncStatusR\n" +
+	"syncStatus\"u\n" +
+	"\x1bVerifyMessageRequestMessage\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\"\n" +
+	"\fsignatureHex\x18\x03 \x01(\tR\fsignatureHex\"d\n" +
+	"\x1cVerifyMessageResponseMessage\x12\x18\n" +
+	"\aisValid\x18\x01 \x01(\bR\aisValid\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\x9a\x01\n" +
+	"*GetTransactionInclusionProofRequestMessage\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12\x1c\n" +
+	"\tblockHash\x18\x02 \x01(\tR\tblockHash\x12(\n" +
+	"\x0ftargetBlockHash\x18\x03 \x01(\tR\x0ftargetBlockHash\"\x98\x01\n" +
+	"+GetTransactionInclusionProofResponseMessage\x12=\n" +
+	"\x05proof\x18\x01 \x01(\v2'.protowire.RpcTransactionInclusionProofR\x05proof\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"?\n" +
+	"\x0fRpcMerkleBranch\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x04R\x05index\x12\x16\n" +
+	"\x06hashes\x18\x02 \x03(\tR\x06hashes\"\xa2\x04\n" +
+	"\x1cRpcTransactionInclusionProof\x12M\n" +
+	"\x14includingBlockHeader\x18\x01 \x01(\v2\x19.protowire.RpcBlockHeaderR\x14includingBlockHeader\x12F\n" +
+	"\x10hashMerkleBranch\x18\x02 \x01(\v2\x1a.protowire.RpcMerkleBranchR\x10hashMerkleBranch\x12=\n" +
+	"\fmergeSetPath\x18\x03 \x03(\v2\x19.protowire.RpcBlockHeaderR\fmergeSetPath\x12M\n" +
+	"\x14acceptingBlockHeader\x18\x04 \x01(\v2\x19.protowire.RpcBlockHeaderR\x14acceptingBlockHeader\x12R\n" +
+	"\x16acceptedIdMerkleBranch\x18\x05 \x01(\v2\x1a.protowire.RpcMerkleBranchR\x16acceptedIdMerkleBranch\x127\n" +
+	"\tchainPath\x18\x06 \x03(\v2\x19.protowire.RpcBlockHeaderR\tchainPath\x12P\n" +
+	"\x15chainPathOtherParents\x18\a \x03(\v2\x1a.protowire.RpcBlockHeadersR\x15chainPathOtherParents\"F\n" +
+	"\x0fRpcBlockHeaders\x123\n" +
+	"\aheaders\x18\x01 \x03(\v2\x19.protowire.RpcBlockHeaderR\aheaders\"=\n" +
+	"\x1dInvalidateBlockRequestMessage\x12\x1c\n" +
+	"\tblockHash\x18\x01 \x01(\tR\tblockHash\"L\n" +
+	"\x1eInvalidateBlockResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"=\n" +
+	"\x1dReconsiderBlockRequestMessage\x12\x1c\n" +
+	"\tblockHash\x18\x01 \x01(\tR\tblockHash\"L\n" +
+	"\x1eReconsiderBlockResponseMessage\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xd6\x01\n" +
+	"\x1cGetDagSnapshotRequestMessage\x12\x1a\n" +
+	"\blowScore\x18\x01 \x01(\x04R\blowScore\x12\x1c\n" +
+	"\thighScore\x18\x02 \x01(\x04R\thighScore\x12 \n" +
+	"\vuseDaaScore\x18\x03 \x01(\bR\vuseDaaScore\x12\x1e\n" +
+	"\n" +
+	"pageCursor\x18\x04 \x01(\tR\n" +
+	"pageCursor\x12\x1a\n" +
+	"\bpageSize\x18\x05 \x01(\rR\bpageSize\x12\x1e\n" +
+	"\n" +
+	"includeDot\x18\x06 \x01(\bR\n" +
+	"includeDot\"\xbd\x01\n" +
+	"\x1dGetDagSnapshotResponseMessage\x126\n" +
+	"\x06blocks\x18\x01 \x03(\v2\x1e.protowire.RpcDagSnapshotBlockR\x06blocks\x12&\n" +
+	"\x0enextPageCursor\x18\x02 \x01(\tR\x0enextPageCursor\x12\x10\n" +
+	"\x03dot\x18\x03 \x01(\tR\x03dot\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"\xa3\x03\n" +
+	"\x13RpcDagSnapshotBlock\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\tR\x04hash\x12\"\n" +
+	"\fparentHashes\x18\x02 \x03(\tR\fparentHashes\x12.\n" +
+	"\x12selectedParentHash\x18\x03 \x01(\tR\x12selectedParentHash\x120\n" +
+	"\x13mergeSetBluesHashes\x18\x04 \x03(\tR\x13mergeSetBluesHashes\x12.\n" +
+	"\x12mergeSetRedsHashes\x18\x05 \x03(\tR\x12mergeSetRedsHashes\x12\x1c\n" +
+	"\tblueScore\x18\x06 \x01(\x04R\tblueScore\x12\x1a\n" +
+	"\bdaaScore\x18\a \x01(\x04R\bdaaScore\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\"\n" +
+	"\fisChainBlock\x18\t \x01(\bR\fisChainBlock\x124\n" +
+	"\x15mergingChainBlockHash\x18\n" +
+	" \x01(\tR\x15mergingChainBlockHash\x12\x16\n" +
+	"\x06isBlue\x18\v \x01(\bR\x06isBlue\"z\n" +
+	"\"GetTransactionStatusRequestMessage\x12$\n" +
+	"\rtransactionId\x18\x01 \x01(\tR\rtransactionId\x12.\n" +
+	"\x12includingBlockHash\x18\x02 \x01(\tR\x12includingBlockHash\"\xd0\x03\n" +
+	"#GetTransactionStatusResponseMessage\x12X\n" +
+	"\x06status\x18\x01 \x01(\x0e2@.protowire.GetTransactionStatusResponseMessage.TransactionStatusR\x06status\x12.\n" +
+	"\x12includingBlockHash\x18\x02 \x01(\tR\x12includingBlockHash\x12.\n" +
+	"\x12acceptingBlockHash\x18\x03 \x01(\tR\x12acceptingBlockHash\x126\n" +
+	"\x16acceptingBlockDaaScore\x18\x04 \x01(\x04R\x16acceptingBlockDaaScore\x12$\n" +
+	"\rconfirmations\x18\x05 \x01(\x04R\rconfirmations\x12*\n" +
+	"\x05error\x18\xe8\a \x01(\v2\x13.protowire.RPCErrorR\x05error\"e\n" +
+	"\x11TransactionStatus\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\x0e\n" +
+	"\n" +
+	"IN_MEMPOOL\x10\x01\x12\n" +
+	"\n" +
+	"\x06ORPHAN\x10\x02\x12\x19\n" +
+	"\x15INCLUDED_NOT_ACCEPTED\x10\x03\x12\f\n" +
+	"\bACCEPTED\x10\x04B%Z#github.com/Hoosat-Oy/HTND/protowireb\x06proto3"
 
 var (
 	file_rpc_proto_rawDescOnce sync.Once
@@ -6294,205 +9094,285 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 110)
+var file_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 147)
 var file_rpc_proto_goTypes = []any{
-	(SubmitBlockResponseMessage_RejectReason)(0), // 0: protowire.SubmitBlockResponseMessage.RejectReason
-	(*RPCError)(nil),                                                   // 1: protowire.RPCError
-	(*RpcBlock)(nil),                                                   // 2: protowire.RpcBlock
-	(*RpcBlockHeader)(nil),                                             // 3: protowire.RpcBlockHeader
-	(*RpcBlockLevelParents)(nil),                                       // 4: protowire.RpcBlockLevelParents
-	(*RpcBlockVerboseData)(nil),                                        // 5: protowire.RpcBlockVerboseData
-	(*RpcTransaction)(nil),                                             // 6: protowire.RpcTransaction
-	(*RpcTransactionInput)(nil),                                        // 7: protowire.RpcTransactionInput
-	(*RpcScriptPublicKey)(nil),                                         // 8: protowire.RpcScriptPublicKey
-	(*RpcTransactionOutput)(nil),                                       // 9: protowire.RpcTransactionOutput
-	(*RpcOutpoint)(nil),                                                // 10: protowire.RpcOutpoint
-	(*RpcUtxoEntry)(nil),                                               // 11: protowire.RpcUtxoEntry
-	(*RpcTransactionVerboseData)(nil),                                  // 12: protowire.RpcTransactionVerboseData
-	(*RpcTransactionInputVerboseData)(nil),                             // 13: protowire.RpcTransactionInputVerboseData
-	(*RpcTransactionOutputVerboseData)(nil),                            // 14: protowire.RpcTransactionOutputVerboseData
-	(*GetCurrentNetworkRequestMessage)(nil),                            // 15: protowire.GetCurrentNetworkRequestMessage
-	(*GetCurrentNetworkResponseMessage)(nil),                           // 16: protowire.GetCurrentNetworkResponseMessage
-	(*SubmitBlockRequestMessage)(nil),                                  // 17: protowire.SubmitBlockRequestMessage
-	(*SubmitBlockResponseMessage)(nil),                                 // 18: protowire.SubmitBlockResponseMessage
-	(*GetBlockTemplateRequestMessage)(nil),                             // 19: protowire.GetBlockTemplateRequestMessage
-	(*GetBlockTemplateResponseMessage)(nil),                            // 20: protowire.GetBlockTemplateResponseMessage
-	(*NotifyBlockAddedRequestMessage)(nil),                             // 21: protowire.NotifyBlockAddedRequestMessage
-	(*NotifyBlockAddedResponseMessage)(nil),                            // 22: protowire.NotifyBlockAddedResponseMessage
-	(*BlockAddedNotificationMessage)(nil),                              // 23: protowire.BlockAddedNotificationMessage
-	(*GetPeerAddressesRequestMessage)(nil),                             // 24: protowire.GetPeerAddressesRequestMessage
-	(*GetPeerAddressesResponseMessage)(nil),                            // 25: protowire.GetPeerAddressesResponseMessage
-	(*GetPeerAddressesKnownAddressMessage)(nil),                        // 26: protowire.GetPeerAddressesKnownAddressMessage
-	(*GetSelectedTipHashRequestMessage)(nil),                           // 27: protowire.GetSelectedTipHashRequestMessage
-	(*GetSelectedTipHashResponseMessage)(nil),                          // 28: protowire.GetSelectedTipHashResponseMessage
-	(*GetMempoolEntryRequestMessage)(nil),                              // 29: protowire.GetMempoolEntryRequestMessage
-	(*GetMempoolEntryResponseMessage)(nil),                             // 30: protowire.GetMempoolEntryResponseMessage
-	(*GetMempoolEntriesRequestMessage)(nil),                            // 31: protowire.GetMempoolEntriesRequestMessage
-	(*GetMempoolEntriesResponseMessage)(nil),                           // 32: protowire.GetMempoolEntriesResponseMessage
-	(*MempoolEntry)(nil),                                               // 33: protowire.MempoolEntry
-	(*GetConnectedPeerInfoRequestMessage)(nil),                         // 34: protowire.GetConnectedPeerInfoRequestMessage
-	(*GetConnectedPeerInfoResponseMessage)(nil),                        // 35: protowire.GetConnectedPeerInfoResponseMessage
-	(*GetConnectedPeerInfoMessage)(nil),                                // 36: protowire.GetConnectedPeerInfoMessage
-	(*AddPeerRequestMessage)(nil),                                      // 37: protowire.AddPeerRequestMessage
-	(*AddPeerResponseMessage)(nil),                                     // 38: protowire.AddPeerResponseMessage
-	(*SubmitTransactionRequestMessage)(nil),                            // 39: protowire.SubmitTransactionRequestMessage
-	(*SubmitTransactionResponseMessage)(nil),                           // 40: protowire.SubmitTransactionResponseMessage
-	(*NotifyVirtualSelectedParentChainChangedRequestMessage)(nil),      // 41: protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
-	(*NotifyVirtualSelectedParentChainChangedResponseMessage)(nil),     // 42: protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
-	(*VirtualSelectedParentChainChangedNotificationMessage)(nil),       // 43: protowire.VirtualSelectedParentChainChangedNotificationMessage
-	(*GetBlockRequestMessage)(nil),                                     // 44: protowire.GetBlockRequestMessage
-	(*GetBlockResponseMessage)(nil),                                    // 45: protowire.GetBlockResponseMessage
-	(*GetBlockByTransactionIDRequestMessage)(nil),                      // 46: protowire.GetBlockByTransactionIDRequestMessage
-	(*GetBlockByTransactionIDResponseMessage)(nil),                     // 47: protowire.GetBlockByTransactionIDResponseMessage
-	(*GetSubnetworkRequestMessage)(nil),                                // 48: protowire.GetSubnetworkRequestMessage
-	(*GetSubnetworkResponseMessage)(nil),                               // 49: protowire.GetSubnetworkResponseMessage
-	(*GetVirtualSelectedParentChainFromBlockRequestMessage)(nil),       // 50: protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
-	(*AcceptedTransactionIds)(nil),                                     // 51: protowire.AcceptedTransactionIds
-	(*GetVirtualSelectedParentChainFromBlockResponseMessage)(nil),      // 52: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
-	(*GetBlocksRequestMessage)(nil),                                    // 53: protowire.GetBlocksRequestMessage
-	(*GetBlocksResponseMessage)(nil),                                   // 54: protowire.GetBlocksResponseMessage
-	(*GetBlockCountRequestMessage)(nil),                                // 55: protowire.GetBlockCountRequestMessage
-	(*GetBlockCountResponseMessage)(nil),                               // 56: protowire.GetBlockCountResponseMessage
-	(*GetBlockDagInfoRequestMessage)(nil),                              // 57: protowire.GetBlockDagInfoRequestMessage
-	(*GetBlockDagInfoResponseMessage)(nil),                             // 58: protowire.GetBlockDagInfoResponseMessage
-	(*ResolveFinalityConflictRequestMessage)(nil),                      // 59: protowire.ResolveFinalityConflictRequestMessage
-	(*ResolveFinalityConflictResponseMessage)(nil),                     // 60: protowire.ResolveFinalityConflictResponseMessage
-	(*NotifyFinalityConflictsRequestMessage)(nil),                      // 61: protowire.NotifyFinalityConflictsRequestMessage
-	(*NotifyFinalityConflictsResponseMessage)(nil),                     // 62: protowire.NotifyFinalityConflictsResponseMessage
-	(*FinalityConflictNotificationMessage)(nil),                        // 63: protowire.FinalityConflictNotificationMessage
-	(*FinalityConflictResolvedNotificationMessage)(nil),                // 64: protowire.FinalityConflictResolvedNotificationMessage
-	(*ShutDownRequestMessage)(nil),                                     // 65: protowire.ShutDownRequestMessage
-	(*ShutDownResponseMessage)(nil),                                    // 66: protowire.ShutDownResponseMessage
-	(*GetHeadersRequestMessage)(nil),                                   // 67: protowire.GetHeadersRequestMessage
-	(*GetHeadersResponseMessage)(nil),                                  // 68: protowire.GetHeadersResponseMessage
-	(*NotifyUtxosChangedRequestMessage)(nil),                           // 69: protowire.NotifyUtxosChangedRequestMessage
-	(*NotifyUtxosChangedResponseMessage)(nil),                          // 70: protowire.NotifyUtxosChangedResponseMessage
-	(*UtxosChangedNotificationMessage)(nil),                            // 71: protowire.UtxosChangedNotificationMessage
-	(*UtxosByAddressesEntry)(nil),                                      // 72: protowire.UtxosByAddressesEntry
-	(*StopNotifyingUtxosChangedRequestMessage)(nil),                    // 73: protowire.StopNotifyingUtxosChangedRequestMessage
-	(*StopNotifyingUtxosChangedResponseMessage)(nil),                   // 74: protowire.StopNotifyingUtxosChangedResponseMessage
-	(*GetUtxosByAddressesRequestMessage)(nil),                          // 75: protowire.GetUtxosByAddressesRequestMessage
-	(*GetUtxosByAddressesResponseMessage)(nil),                         // 76: protowire.GetUtxosByAddressesResponseMessage
-	(*GetBalanceByAddressRequestMessage)(nil),                          // 77: protowire.GetBalanceByAddressRequestMessage
-	(*GetBalanceByAddressResponseMessage)(nil),                         // 78: protowire.GetBalanceByAddressResponseMessage
-	(*GetBalancesByAddressesRequestMessage)(nil),                       // 79: protowire.GetBalancesByAddressesRequestMessage
-	(*BalancesByAddressEntry)(nil),                                     // 80: protowire.BalancesByAddressEntry
-	(*GetBalancesByAddressesResponseMessage)(nil),                      // 81: protowire.GetBalancesByAddressesResponseMessage
-	(*GetVirtualSelectedParentBlueScoreRequestMessage)(nil),            // 82: protowire.GetVirtualSelectedParentBlueScoreRequestMessage
-	(*GetVirtualSelectedParentBlueScoreResponseMessage)(nil),           // 83: protowire.GetVirtualSelectedParentBlueScoreResponseMessage
-	(*NotifyVirtualSelectedParentBlueScoreChangedRequestMessage)(nil),  // 84: protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
-	(*NotifyVirtualSelectedParentBlueScoreChangedResponseMessage)(nil), // 85: protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
-	(*VirtualSelectedParentBlueScoreChangedNotificationMessage)(nil),   // 86: protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
-	(*NotifyVirtualDaaScoreChangedRequestMessage)(nil),                 // 87: protowire.NotifyVirtualDaaScoreChangedRequestMessage
-	(*NotifyVirtualDaaScoreChangedResponseMessage)(nil),                // 88: protowire.NotifyVirtualDaaScoreChangedResponseMessage
-	(*VirtualDaaScoreChangedNotificationMessage)(nil),                  // 89: protowire.VirtualDaaScoreChangedNotificationMessage
-	(*NotifyPruningPointUTXOSetOverrideRequestMessage)(nil),            // 90: protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
-	(*NotifyPruningPointUTXOSetOverrideResponseMessage)(nil),           // 91: protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
-	(*PruningPointUTXOSetOverrideNotificationMessage)(nil),             // 92: protowire.PruningPointUTXOSetOverrideNotificationMessage
-	(*StopNotifyingPruningPointUTXOSetOverrideRequestMessage)(nil),     // 93: protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
-	(*StopNotifyingPruningPointUTXOSetOverrideResponseMessage)(nil),    // 94: protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
-	(*BanRequestMessage)(nil),                                          // 95: protowire.BanRequestMessage
-	(*BanResponseMessage)(nil),                                         // 96: protowire.BanResponseMessage
-	(*UnbanRequestMessage)(nil),                                        // 97: protowire.UnbanRequestMessage
-	(*UnbanResponseMessage)(nil),                                       // 98: protowire.UnbanResponseMessage
-	(*GetInfoRequestMessage)(nil),                                      // 99: protowire.GetInfoRequestMessage
-	(*GetInfoResponseMessage)(nil),                                     // 100: protowire.GetInfoResponseMessage
-	(*EstimateNetworkHashesPerSecondRequestMessage)(nil),               // 101: protowire.EstimateNetworkHashesPerSecondRequestMessage
-	(*EstimateNetworkHashesPerSecondResponseMessage)(nil),              // 102: protowire.EstimateNetworkHashesPerSecondResponseMessage
-	(*NotifyNewBlockTemplateRequestMessage)(nil),                       // 103: protowire.NotifyNewBlockTemplateRequestMessage
-	(*NotifyNewBlockTemplateResponseMessage)(nil),                      // 104: protowire.NotifyNewBlockTemplateResponseMessage
-	(*NewBlockTemplateNotificationMessage)(nil),                        // 105: protowire.NewBlockTemplateNotificationMessage
-	(*MempoolEntryByAddress)(nil),                                      // 106: protowire.MempoolEntryByAddress
-	(*GetMempoolEntriesByAddressesRequestMessage)(nil),                 // 107: protowire.GetMempoolEntriesByAddressesRequestMessage
-	(*GetMempoolEntriesByAddressesResponseMessage)(nil),                // 108: protowire.GetMempoolEntriesByAddressesResponseMessage
-	(*GetCoinSupplyRequestMessage)(nil),                                // 109: protowire.GetCoinSupplyRequestMessage
-	(*GetCoinSupplyResponseMessage)(nil),                               // 110: protowire.GetCoinSupplyResponseMessage
+	(SubmitBlockResponseMessage_RejectReason)(0),               // 0: protowire.SubmitBlockResponseMessage.RejectReason
+	(DecodeTransactionRequestMessage_Encoding)(0),              // 1: protowire.DecodeTransactionRequestMessage.Encoding
+	(RpcSyncStatus_IbdPhase)(0),                                // 2: protowire.RpcSyncStatus.IbdPhase
+	(GetTransactionStatusResponseMessage_TransactionStatus)(0), // 3: protowire.GetTransactionStatusResponseMessage.TransactionStatus
+	(*RPCError)(nil),                                                   // 4: protowire.RPCError
+	(*RpcBlock)(nil),                                                   // 5: protowire.RpcBlock
+	(*RpcBlockHeader)(nil),                                             // 6: protowire.RpcBlockHeader
+	(*RpcBlockLevelParents)(nil),                                       // 7: protowire.RpcBlockLevelParents
+	(*RpcBlockVerboseData)(nil),                                        // 8: protowire.RpcBlockVerboseData
+	(*RpcTransaction)(nil),                                             // 9: protowire.RpcTransaction
+	(*RpcTransactionInput)(nil),                                        // 10: protowire.RpcTransactionInput
+	(*RpcScriptPublicKey)(nil),                                         // 11: protowire.RpcScriptPublicKey
+	(*RpcTransactionOutput)(nil),                                       // 12: protowire.RpcTransactionOutput
+	(*RpcOutpoint)(nil),                                                // 13: protowire.RpcOutpoint
+	(*RpcUtxoEntry)(nil),                                               // 14: protowire.RpcUtxoEntry
+	(*RpcTransactionVerboseData)(nil),                                  // 15: protowire.RpcTransactionVerboseData
+	(*RpcTransactionInputVerboseData)(nil),                             // 16: protowire.RpcTransactionInputVerboseData
+	(*RpcTransactionOutputVerboseData)(nil),                            // 17: protowire.RpcTransactionOutputVerboseData
+	(*GetCurrentNetworkRequestMessage)(nil),                            // 18: protowire.GetCurrentNetworkRequestMessage
+	(*GetCurrentNetworkResponseMessage)(nil),                           // 19: protowire.GetCurrentNetworkResponseMessage
+	(*SubmitBlockRequestMessage)(nil),                                  // 20: protowire.SubmitBlockRequestMessage
+	(*SubmitBlockResponseMessage)(nil),                                 // 21: protowire.SubmitBlockResponseMessage
+	(*GetBlockTemplateRequestMessage)(nil),                             // 22: protowire.GetBlockTemplateRequestMessage
+	(*GetBlockTemplateResponseMessage)(nil),                            // 23: protowire.GetBlockTemplateResponseMessage
+	(*NotifyBlockAddedRequestMessage)(nil),                             // 24: protowire.NotifyBlockAddedRequestMessage
+	(*NotifyBlockAddedResponseMessage)(nil),                            // 25: protowire.NotifyBlockAddedResponseMessage
+	(*BlockAddedNotificationMessage)(nil),                              // 26: protowire.BlockAddedNotificationMessage
+	(*GetPeerAddressesRequestMessage)(nil),                             // 27: protowire.GetPeerAddressesRequestMessage
+	(*GetPeerAddressesResponseMessage)(nil),                            // 28: protowire.GetPeerAddressesResponseMessage
+	(*GetPeerAddressesKnownAddressMessage)(nil),                        // 29: protowire.GetPeerAddressesKnownAddressMessage
+	(*GetSelectedTipHashRequestMessage)(nil),                           // 30: protowire.GetSelectedTipHashRequestMessage
+	(*GetSelectedTipHashResponseMessage)(nil),                          // 31: protowire.GetSelectedTipHashResponseMessage
+	(*GetMempoolEntryRequestMessage)(nil),                              // 32: protowire.GetMempoolEntryRequestMessage
+	(*GetMempoolEntryResponseMessage)(nil),                             // 33: protowire.GetMempoolEntryResponseMessage
+	(*GetMempoolEntriesRequestMessage)(nil),                            // 34: protowire.GetMempoolEntriesRequestMessage
+	(*GetMempoolEntriesResponseMessage)(nil),                           // 35: protowire.GetMempoolEntriesResponseMessage
+	(*MempoolEntry)(nil),                                               // 36: protowire.MempoolEntry
+	(*GetConnectedPeerInfoRequestMessage)(nil),                         // 37: protowire.GetConnectedPeerInfoRequestMessage
+	(*GetConnectedPeerInfoResponseMessage)(nil),                        // 38: protowire.GetConnectedPeerInfoResponseMessage
+	(*GetConnectedPeerInfoMessage)(nil),                                // 39: protowire.GetConnectedPeerInfoMessage
+	(*AddPeerRequestMessage)(nil),                                      // 40: protowire.AddPeerRequestMessage
+	(*AddPeerResponseMessage)(nil),                                     // 41: protowire.AddPeerResponseMessage
+	(*SubmitTransactionRequestMessage)(nil),                            // 42: protowire.SubmitTransactionRequestMessage
+	(*SubmitTransactionResponseMessage)(nil),                           // 43: protowire.SubmitTransactionResponseMessage
+	(*NotifyVirtualSelectedParentChainChangedRequestMessage)(nil),      // 44: protowire.NotifyVirtualSelectedParentChainChangedRequestMessage
+	(*NotifyVirtualSelectedParentChainChangedResponseMessage)(nil),     // 45: protowire.NotifyVirtualSelectedParentChainChangedResponseMessage
+	(*VirtualSelectedParentChainChangedNotificationMessage)(nil),       // 46: protowire.VirtualSelectedParentChainChangedNotificationMessage
+	(*GetBlockRequestMessage)(nil),                                     // 47: protowire.GetBlockRequestMessage
+	(*GetBlockResponseMessage)(nil),                                    // 48: protowire.GetBlockResponseMessage
+	(*GetBlockByTransactionIDRequestMessage)(nil),                      // 49: protowire.GetBlockByTransactionIDRequestMessage
+	(*GetBlockByTransactionIDResponseMessage)(nil),                     // 50: protowire.GetBlockByTransactionIDResponseMessage
+	(*GetSubnetworkRequestMessage)(nil),                                // 51: protowire.GetSubnetworkRequestMessage
+	(*GetSubnetworkResponseMessage)(nil),                               // 52: protowire.GetSubnetworkResponseMessage
+	(*GetVirtualSelectedParentChainFromBlockRequestMessage)(nil),       // 53: protowire.GetVirtualSelectedParentChainFromBlockRequestMessage
+	(*AcceptedTransactionIds)(nil),                                     // 54: protowire.AcceptedTransactionIds
+	(*GetVirtualSelectedParentChainFromBlockResponseMessage)(nil),      // 55: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage
+	(*GetBlocksRequestMessage)(nil),                                    // 56: protowire.GetBlocksRequestMessage
+	(*GetBlocksResponseMessage)(nil),                                   // 57: protowire.GetBlocksResponseMessage
+	(*GetBlockCountRequestMessage)(nil),                                // 58: protowire.GetBlockCountRequestMessage
+	(*GetBlockCountResponseMessage)(nil),                               // 59: protowire.GetBlockCountResponseMessage
+	(*GetBlockDagInfoRequestMessage)(nil),                              // 60: protowire.GetBlockDagInfoRequestMessage
+	(*GetBlockDagInfoResponseMessage)(nil),                             // 61: protowire.GetBlockDagInfoResponseMessage
+	(*ResolveFinalityConflictRequestMessage)(nil),                      // 62: protowire.ResolveFinalityConflictRequestMessage
+	(*ResolveFinalityConflictResponseMessage)(nil),                     // 63: protowire.ResolveFinalityConflictResponseMessage
+	(*NotifyFinalityConflictsRequestMessage)(nil),                      // 64: protowire.NotifyFinalityConflictsRequestMessage
+	(*NotifyFinalityConflictsResponseMessage)(nil),                     // 65: protowire.NotifyFinalityConflictsResponseMessage
+	(*FinalityConflictNotificationMessage)(nil),                        // 66: protowire.FinalityConflictNotificationMessage
+	(*FinalityConflictResolvedNotificationMessage)(nil),                // 67: protowire.FinalityConflictResolvedNotificationMessage
+	(*ShutDownRequestMessage)(nil),                                     // 68: protowire.ShutDownRequestMessage
+	(*ShutDownResponseMessage)(nil),                                    // 69: protowire.ShutDownResponseMessage
+	(*GetHeadersRequestMessage)(nil),                                   // 70: protowire.GetHeadersRequestMessage
+	(*GetHeadersResponseMessage)(nil),                                  // 71: protowire.GetHeadersResponseMessage
+	(*NotifyUtxosChangedRequestMessage)(nil),                           // 72: protowire.NotifyUtxosChangedRequestMessage
+	(*NotifyUtxosChangedResponseMessage)(nil),                          // 73: protowire.NotifyUtxosChangedResponseMessage
+	(*UtxosChangedNotificationMessage)(nil),                            // 74: protowire.UtxosChangedNotificationMessage
+	(*UtxosByAddressesEntry)(nil),                                      // 75: protowire.UtxosByAddressesEntry
+	(*StopNotifyingUtxosChangedRequestMessage)(nil),                    // 76: protowire.StopNotifyingUtxosChangedRequestMessage
+	(*StopNotifyingUtxosChangedResponseMessage)(nil),                   // 77: protowire.StopNotifyingUtxosChangedResponseMessage
+	(*GetUtxosByAddressesRequestMessage)(nil),                          // 78: protowire.GetUtxosByAddressesRequestMessage
+	(*GetUtxosByAddressesResponseMessage)(nil),                         // 79: protowire.GetUtxosByAddressesResponseMessage
+	(*GetBalanceByAddressRequestMessage)(nil),                          // 80: protowire.GetBalanceByAddressRequestMessage
+	(*GetBalanceByAddressResponseMessage)(nil),                         // 81: protowire.GetBalanceByAddressResponseMessage
+	(*GetBalancesByAddressesRequestMessage)(nil),                       // 82: protowire.GetBalancesByAddressesRequestMessage
+	(*BalancesByAddressEntry)(nil),                                     // 83: protowire.BalancesByAddressEntry
+	(*GetBalancesByAddressesResponseMessage)(nil),                      // 84: protowire.GetBalancesByAddressesResponseMessage
+	(*GetVirtualSelectedParentBlueScoreRequestMessage)(nil),            // 85: protowire.GetVirtualSelectedParentBlueScoreRequestMessage
+	(*GetVirtualSelectedParentBlueScoreResponseMessage)(nil),           // 86: protowire.GetVirtualSelectedParentBlueScoreResponseMessage
+	(*NotifyVirtualSelectedParentBlueScoreChangedRequestMessage)(nil),  // 87: protowire.NotifyVirtualSelectedParentBlueScoreChangedRequestMessage
+	(*NotifyVirtualSelectedParentBlueScoreChangedResponseMessage)(nil), // 88: protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage
+	(*VirtualSelectedParentBlueScoreChangedNotificationMessage)(nil),   // 89: protowire.VirtualSelectedParentBlueScoreChangedNotificationMessage
+	(*NotifyVirtualDaaScoreChangedRequestMessage)(nil),                 // 90: protowire.NotifyVirtualDaaScoreChangedRequestMessage
+	(*NotifyVirtualDaaScoreChangedResponseMessage)(nil),                // 91: protowire.NotifyVirtualDaaScoreChangedResponseMessage
+	(*VirtualDaaScoreChangedNotificationMessage)(nil),                  // 92: protowire.VirtualDaaScoreChangedNotificationMessage
+	(*NotifyPruningPointUTXOSetOverrideRequestMessage)(nil),            // 93: protowire.NotifyPruningPointUTXOSetOverrideRequestMessage
+	(*NotifyPruningPointUTXOSetOverrideResponseMessage)(nil),           // 94: protowire.NotifyPruningPointUTXOSetOverrideResponseMessage
+	(*PruningPointUTXOSetOverrideNotificationMessage)(nil),             // 95: protowire.PruningPointUTXOSetOverrideNotificationMessage
+	(*StopNotifyingPruningPointUTXOSetOverrideRequestMessage)(nil),     // 96: protowire.StopNotifyingPruningPointUTXOSetOverrideRequestMessage
+	(*StopNotifyingPruningPointUTXOSetOverrideResponseMessage)(nil),    // 97: protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage
+	(*BanRequestMessage)(nil),                                          // 98: protowire.BanRequestMessage
+	(*BanResponseMessage)(nil),                                         // 99: protowire.BanResponseMessage
+	(*UnbanRequestMessage)(nil),                                        // 100: protowire.UnbanRequestMessage
+	(*UnbanResponseMessage)(nil),                                       // 101: protowire.UnbanResponseMessage
+	(*GetInfoRequestMessage)(nil),                                      // 102: protowire.GetInfoRequestMessage
+	(*GetInfoResponseMessage)(nil),                                     // 103: protowire.GetInfoResponseMessage
+	(*EstimateNetworkHashesPerSecondRequestMessage)(nil),               // 104: protowire.EstimateNetworkHashesPerSecondRequestMessage
+	(*EstimateNetworkHashesPerSecondResponseMessage)(nil),              // 105: protowire.EstimateNetworkHashesPerSecondResponseMessage
+	(*NotifyNewBlockTemplateRequestMessage)(nil),                       // 106: protowire.NotifyNewBlockTemplateRequestMessage
+	(*NotifyNewBlockTemplateResponseMessage)(nil),                      // 107: protowire.NotifyNewBlockTemplateResponseMessage
+	(*NewBlockTemplateNotificationMessage)(nil),                        // 108: protowire.NewBlockTemplateNotificationMessage
+	(*MempoolEntryByAddress)(nil),                                      // 109: protowire.MempoolEntryByAddress
+	(*GetMempoolEntriesByAddressesRequestMessage)(nil),                 // 110: protowire.GetMempoolEntriesByAddressesRequestMessage
+	(*GetMempoolEntriesByAddressesResponseMessage)(nil),                // 111: protowire.GetMempoolEntriesByAddressesResponseMessage
+	(*GetCoinSupplyRequestMessage)(nil),                                // 112: protowire.GetCoinSupplyRequestMessage
+	(*GetCoinSupplyResponseMessage)(nil),                               // 113: protowire.GetCoinSupplyResponseMessage
+	(*GetCoinbaseSplitRequestMessage)(nil),                             // 114: protowire.GetCoinbaseSplitRequestMessage
+	(*CoinbaseReward)(nil),                                             // 115: protowire.CoinbaseReward
+	(*GetCoinbaseSplitResponseMessage)(nil),                            // 116: protowire.GetCoinbaseSplitResponseMessage
+	(*GetEmissionInfoRequestMessage)(nil),                              // 117: protowire.GetEmissionInfoRequestMessage
+	(*BlockSubsidy)(nil),                                               // 118: protowire.BlockSubsidy
+	(*GetEmissionInfoResponseMessage)(nil),                             // 119: protowire.GetEmissionInfoResponseMessage
+	(*TestMempoolAcceptRequestMessage)(nil),                            // 120: protowire.TestMempoolAcceptRequestMessage
+	(*TestMempoolAcceptResponseMessage)(nil),                           // 121: protowire.TestMempoolAcceptResponseMessage
+	(*DecodeTransactionRequestMessage)(nil),                            // 122: protowire.DecodeTransactionRequestMessage
+	(*DecodeTransactionResponseMessage)(nil),                           // 123: protowire.DecodeTransactionResponseMessage
+	(*DecodeScriptRequestMessage)(nil),                                 // 124: protowire.DecodeScriptRequestMessage
+	(*DecodeScriptResponseMessage)(nil),                                // 125: protowire.DecodeScriptResponseMessage
+	(*GetUtxoEntriesByOutpointsRequestMessage)(nil),                    // 126: protowire.GetUtxoEntriesByOutpointsRequestMessage
+	(*UtxoEntryByOutpoint)(nil),                                        // 127: protowire.UtxoEntryByOutpoint
+	(*GetUtxoEntriesByOutpointsResponseMessage)(nil),                   // 128: protowire.GetUtxoEntriesByOutpointsResponseMessage
+	(*RpcSyncStatus)(nil),                                              // 129: protowire.RpcSyncStatus
+	(*GetSyncStatusRequestMessage)(nil),                                // 130: protowire.GetSyncStatusRequestMessage
+	(*GetSyncStatusResponseMessage)(nil),                               // 131: protowire.GetSyncStatusResponseMessage
+	(*NotifySyncStatusChangedRequestMessage)(nil),                      // 132: protowire.NotifySyncStatusChangedRequestMessage
+	(*NotifySyncStatusChangedResponseMessage)(nil),                     // 133: protowire.NotifySyncStatusChangedResponseMessage
+	(*SyncStatusChangedNotificationMessage)(nil),                       // 134: protowire.SyncStatusChangedNotificationMessage
+	(*VerifyMessageRequestMessage)(nil),                                // 135: protowire.VerifyMessageRequestMessage
+	(*VerifyMessageResponseMessage)(nil),                               // 136: protowire.VerifyMessageResponseMessage
+	(*GetTransactionInclusionProofRequestMessage)(nil),                 // 137: protowire.GetTransactionInclusionProofRequestMessage
+	(*GetTransactionInclusionProofResponseMessage)(nil),                // 138: protowire.GetTransactionInclusionProofResponseMessage
+	(*RpcMerkleBranch)(nil),                                            // 139: protowire.RpcMerkleBranch
+	(*RpcTransactionInclusionProof)(nil),                               // 140: protowire.RpcTransactionInclusionProof
+	(*RpcBlockHeaders)(nil),                                            // 141: protowire.RpcBlockHeaders
+	(*InvalidateBlockRequestMessage)(nil),                              // 142: protowire.InvalidateBlockRequestMessage
+	(*InvalidateBlockResponseMessage)(nil),                             // 143: protowire.InvalidateBlockResponseMessage
+	(*ReconsiderBlockRequestMessage)(nil),                              // 144: protowire.ReconsiderBlockRequestMessage
+	(*ReconsiderBlockResponseMessage)(nil),                             // 145: protowire.ReconsiderBlockResponseMessage
+	(*GetDagSnapshotRequestMessage)(nil),                               // 146: protowire.GetDagSnapshotRequestMessage
+	(*GetDagSnapshotResponseMessage)(nil),                              // 147: protowire.GetDagSnapshotResponseMessage
+	(*RpcDagSnapshotBlock)(nil),                                        // 148: protowire.RpcDagSnapshotBlock
+	(*GetTransactionStatusRequestMessage)(nil),                         // 149: protowire.GetTransactionStatusRequestMessage
+	(*GetTransactionStatusResponseMessage)(nil),                        // 150: protowire.GetTransactionStatusResponseMessage
 }
 var file_rpc_proto_depIdxs = []int32{
-	3,   // 0: protowire.RpcBlock.header:type_name -> protowire.RpcBlockHeader
-	6,   // 1: protowire.RpcBlock.transactions:type_name -> protowire.RpcTransaction
-	5,   // 2: protowire.RpcBlock.verboseData:type_name -> protowire.RpcBlockVerboseData
-	4,   // 3: protowire.RpcBlockHeader.parents:type_name -> protowire.RpcBlockLevelParents
-	7,   // 4: protowire.RpcTransaction.inputs:type_name -> protowire.RpcTransactionInput
-	9,   // 5: protowire.RpcTransaction.outputs:type_name -> protowire.RpcTransactionOutput
-	12,  // 6: protowire.RpcTransaction.verboseData:type_name -> protowire.RpcTransactionVerboseData
-	10,  // 7: protowire.RpcTransactionInput.previousOutpoint:type_name -> protowire.RpcOutpoint
-	13,  // 8: protowire.RpcTransactionInput.verboseData:type_name -> protowire.RpcTransactionInputVerboseData
-	8,   // 9: protowire.RpcTransactionOutput.scriptPublicKey:type_name -> protowire.RpcScriptPublicKey
-	14,  // 10: protowire.RpcTransactionOutput.verboseData:type_name -> protowire.RpcTransactionOutputVerboseData
-	8,   // 11: protowire.RpcUtxoEntry.scriptPublicKey:type_name -> protowire.RpcScriptPublicKey
-	1,   // 12: protowire.GetCurrentNetworkResponseMessage.error:type_name -> protowire.RPCError
-	2,   // 13: protowire.SubmitBlockRequestMessage.block:type_name -> protowire.RpcBlock
+	6,   // 0: protowire.RpcBlock.header:type_name -> protowire.RpcBlockHeader
+	9,   // 1: protowire.RpcBlock.transactions:type_name -> protowire.RpcTransaction
+	8,   // 2: protowire.RpcBlock.verboseData:type_name -> protowire.RpcBlockVerboseData
+	7,   // 3: protowire.RpcBlockHeader.parents:type_name -> protowire.RpcBlockLevelParents
+	10,  // 4: protowire.RpcTransaction.inputs:type_name -> protowire.RpcTransactionInput
+	12,  // 5: protowire.RpcTransaction.outputs:type_name -> protowire.RpcTransactionOutput
+	15,  // 6: protowire.RpcTransaction.verboseData:type_name -> protowire.RpcTransactionVerboseData
+	13,  // 7: protowire.RpcTransactionInput.previousOutpoint:type_name -> protowire.RpcOutpoint
+	16,  // 8: protowire.RpcTransactionInput.verboseData:type_name -> protowire.RpcTransactionInputVerboseData
+	11,  // 9: protowire.RpcTransactionOutput.scriptPublicKey:type_name -> protowire.RpcScriptPublicKey
+	17,  // 10: protowire.RpcTransactionOutput.verboseData:type_name -> protowire.RpcTransactionOutputVerboseData
+	11,  // 11: protowire.RpcUtxoEntry.scriptPublicKey:type_name -> protowire.RpcScriptPublicKey
+	4,   // 12: protowire.GetCurrentNetworkResponseMessage.error:type_name -> protowire.RPCError
+	5,   // 13: protowire.SubmitBlockRequestMessage.block:type_name -> protowire.RpcBlock
 	0,   // 14: protowire.SubmitBlockResponseMessage.rejectReason:type_name -> protowire.SubmitBlockResponseMessage.RejectReason
-	1,   // 15: protowire.SubmitBlockResponseMessage.error:type_name -> protowire.RPCError
-	2,   // 16: protowire.GetBlockTemplateResponseMessage.block:type_name -> protowire.RpcBlock
-	1,   // 17: protowire.GetBlockTemplateResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 18: protowire.NotifyBlockAddedResponseMessage.error:type_name -> protowire.RPCError
-	2,   // 19: protowire.BlockAddedNotificationMessage.block:type_name -> protowire.RpcBlock
-	26,  // 20: protowire.GetPeerAddressesResponseMessage.addresses:type_name -> protowire.GetPeerAddressesKnownAddressMessage
-	26,  // 21: protowire.GetPeerAddressesResponseMessage.bannedAddresses:type_name -> protowire.GetPeerAddressesKnownAddressMessage
-	1,   // 22: protowire.GetPeerAddressesResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 23: protowire.GetSelectedTipHashResponseMessage.error:type_name -> protowire.RPCError
-	33,  // 24: protowire.GetMempoolEntryResponseMessage.entry:type_name -> protowire.MempoolEntry
-	1,   // 25: protowire.GetMempoolEntryResponseMessage.error:type_name -> protowire.RPCError
-	33,  // 26: protowire.GetMempoolEntriesResponseMessage.entries:type_name -> protowire.MempoolEntry
-	1,   // 27: protowire.GetMempoolEntriesResponseMessage.error:type_name -> protowire.RPCError
-	6,   // 28: protowire.MempoolEntry.transaction:type_name -> protowire.RpcTransaction
-	36,  // 29: protowire.GetConnectedPeerInfoResponseMessage.infos:type_name -> protowire.GetConnectedPeerInfoMessage
-	1,   // 30: protowire.GetConnectedPeerInfoResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 31: protowire.AddPeerResponseMessage.error:type_name -> protowire.RPCError
-	6,   // 32: protowire.SubmitTransactionRequestMessage.transaction:type_name -> protowire.RpcTransaction
-	1,   // 33: protowire.SubmitTransactionResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 34: protowire.NotifyVirtualSelectedParentChainChangedResponseMessage.error:type_name -> protowire.RPCError
-	51,  // 35: protowire.VirtualSelectedParentChainChangedNotificationMessage.acceptedTransactionIds:type_name -> protowire.AcceptedTransactionIds
-	2,   // 36: protowire.GetBlockResponseMessage.block:type_name -> protowire.RpcBlock
-	1,   // 37: protowire.GetBlockResponseMessage.error:type_name -> protowire.RPCError
-	2,   // 38: protowire.GetBlockByTransactionIDResponseMessage.block:type_name -> protowire.RpcBlock
-	1,   // 39: protowire.GetBlockByTransactionIDResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 40: protowire.GetSubnetworkResponseMessage.error:type_name -> protowire.RPCError
-	51,  // 41: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage.acceptedTransactionIds:type_name -> protowire.AcceptedTransactionIds
-	1,   // 42: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage.error:type_name -> protowire.RPCError
-	2,   // 43: protowire.GetBlocksResponseMessage.blocks:type_name -> protowire.RpcBlock
-	1,   // 44: protowire.GetBlocksResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 45: protowire.GetBlockCountResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 46: protowire.GetBlockDagInfoResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 47: protowire.ResolveFinalityConflictResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 48: protowire.NotifyFinalityConflictsResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 49: protowire.ShutDownResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 50: protowire.GetHeadersResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 51: protowire.NotifyUtxosChangedResponseMessage.error:type_name -> protowire.RPCError
-	72,  // 52: protowire.UtxosChangedNotificationMessage.added:type_name -> protowire.UtxosByAddressesEntry
-	72,  // 53: protowire.UtxosChangedNotificationMessage.removed:type_name -> protowire.UtxosByAddressesEntry
-	10,  // 54: protowire.UtxosByAddressesEntry.outpoint:type_name -> protowire.RpcOutpoint
-	11,  // 55: protowire.UtxosByAddressesEntry.utxoEntry:type_name -> protowire.RpcUtxoEntry
-	1,   // 56: protowire.StopNotifyingUtxosChangedResponseMessage.error:type_name -> protowire.RPCError
-	72,  // 57: protowire.GetUtxosByAddressesResponseMessage.entries:type_name -> protowire.UtxosByAddressesEntry
-	1,   // 58: protowire.GetUtxosByAddressesResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 59: protowire.GetBalanceByAddressResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 60: protowire.BalancesByAddressEntry.error:type_name -> protowire.RPCError
-	80,  // 61: protowire.GetBalancesByAddressesResponseMessage.entries:type_name -> protowire.BalancesByAddressEntry
-	1,   // 62: protowire.GetBalancesByAddressesResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 63: protowire.GetVirtualSelectedParentBlueScoreResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 64: protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 65: protowire.NotifyVirtualDaaScoreChangedResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 66: protowire.NotifyPruningPointUTXOSetOverrideResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 67: protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 68: protowire.BanResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 69: protowire.UnbanResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 70: protowire.GetInfoResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 71: protowire.EstimateNetworkHashesPerSecondResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 72: protowire.NotifyNewBlockTemplateResponseMessage.error:type_name -> protowire.RPCError
-	33,  // 73: protowire.MempoolEntryByAddress.sending:type_name -> protowire.MempoolEntry
-	33,  // 74: protowire.MempoolEntryByAddress.receiving:type_name -> protowire.MempoolEntry
-	106, // 75: protowire.GetMempoolEntriesByAddressesResponseMessage.entries:type_name -> protowire.MempoolEntryByAddress
-	1,   // 76: protowire.GetMempoolEntriesByAddressesResponseMessage.error:type_name -> protowire.RPCError
-	1,   // 77: protowire.GetCoinSupplyResponseMessage.error:type_name -> protowire.RPCError
-	78,  // [78:78] is the sub-list for method output_type
-	78,  // [78:78] is the sub-list for method input_type
-	78,  // [78:78] is the sub-list for extension type_name
-	78,  // [78:78] is the sub-list for extension extendee
-	0,   // [0:78] is the sub-list for field type_name
+	4,   // 15: protowire.SubmitBlockResponseMessage.error:type_name -> protowire.RPCError
+	5,   // 16: protowire.GetBlockTemplateResponseMessage.block:type_name -> protowire.RpcBlock
+	4,   // 17: protowire.GetBlockTemplateResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 18: protowire.NotifyBlockAddedResponseMessage.error:type_name -> protowire.RPCError
+	5,   // 19: protowire.BlockAddedNotificationMessage.block:type_name -> protowire.RpcBlock
+	29,  // 20: protowire.GetPeerAddressesResponseMessage.addresses:type_name -> protowire.GetPeerAddressesKnownAddressMessage
+	29,  // 21: protowire.GetPeerAddressesResponseMessage.bannedAddresses:type_name -> protowire.GetPeerAddressesKnownAddressMessage
+	4,   // 22: protowire.GetPeerAddressesResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 23: protowire.GetSelectedTipHashResponseMessage.error:type_name -> protowire.RPCError
+	36,  // 24: protowire.GetMempoolEntryResponseMessage.entry:type_name -> protowire.MempoolEntry
+	4,   // 25: protowire.GetMempoolEntryResponseMessage.error:type_name -> protowire.RPCError
+	36,  // 26: protowire.GetMempoolEntriesResponseMessage.entries:type_name -> protowire.MempoolEntry
+	4,   // 27: protowire.GetMempoolEntriesResponseMessage.error:type_name -> protowire.RPCError
+	9,   // 28: protowire.MempoolEntry.transaction:type_name -> protowire.RpcTransaction
+	39,  // 29: protowire.GetConnectedPeerInfoResponseMessage.infos:type_name -> protowire.GetConnectedPeerInfoMessage
+	4,   // 30: protowire.GetConnectedPeerInfoResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 31: protowire.AddPeerResponseMessage.error:type_name -> protowire.RPCError
+	9,   // 32: protowire.SubmitTransactionRequestMessage.transaction:type_name -> protowire.RpcTransaction
+	4,   // 33: protowire.SubmitTransactionResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 34: protowire.NotifyVirtualSelectedParentChainChangedResponseMessage.error:type_name -> protowire.RPCError
+	54,  // 35: protowire.VirtualSelectedParentChainChangedNotificationMessage.acceptedTransactionIds:type_name -> protowire.AcceptedTransactionIds
+	5,   // 36: protowire.GetBlockResponseMessage.block:type_name -> protowire.RpcBlock
+	4,   // 37: protowire.GetBlockResponseMessage.error:type_name -> protowire.RPCError
+	5,   // 38: protowire.GetBlockByTransactionIDResponseMessage.block:type_name -> protowire.RpcBlock
+	4,   // 39: protowire.GetBlockByTransactionIDResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 40: protowire.GetSubnetworkResponseMessage.error:type_name -> protowire.RPCError
+	54,  // 41: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage.acceptedTransactionIds:type_name -> protowire.AcceptedTransactionIds
+	4,   // 42: protowire.GetVirtualSelectedParentChainFromBlockResponseMessage.error:type_name -> protowire.RPCError
+	5,   // 43: protowire.GetBlocksResponseMessage.blocks:type_name -> protowire.RpcBlock
+	4,   // 44: protowire.GetBlocksResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 45: protowire.GetBlockCountResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 46: protowire.GetBlockDagInfoResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 47: protowire.ResolveFinalityConflictResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 48: protowire.NotifyFinalityConflictsResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 49: protowire.ShutDownResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 50: protowire.GetHeadersResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 51: protowire.NotifyUtxosChangedResponseMessage.error:type_name -> protowire.RPCError
+	75,  // 52: protowire.UtxosChangedNotificationMessage.added:type_name -> protowire.UtxosByAddressesEntry
+	75,  // 53: protowire.UtxosChangedNotificationMessage.removed:type_name -> protowire.UtxosByAddressesEntry
+	13,  // 54: protowire.UtxosByAddressesEntry.outpoint:type_name -> protowire.RpcOutpoint
+	14,  // 55: protowire.UtxosByAddressesEntry.utxoEntry:type_name -> protowire.RpcUtxoEntry
+	4,   // 56: protowire.StopNotifyingUtxosChangedResponseMessage.error:type_name -> protowire.RPCError
+	75,  // 57: protowire.GetUtxosByAddressesResponseMessage.entries:type_name -> protowire.UtxosByAddressesEntry
+	4,   // 58: protowire.GetUtxosByAddressesResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 59: protowire.GetBalanceByAddressResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 60: protowire.BalancesByAddressEntry.error:type_name -> protowire.RPCError
+	83,  // 61: protowire.GetBalancesByAddressesResponseMessage.entries:type_name -> protowire.BalancesByAddressEntry
+	4,   // 62: protowire.GetBalancesByAddressesResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 63: protowire.GetVirtualSelectedParentBlueScoreResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 64: protowire.NotifyVirtualSelectedParentBlueScoreChangedResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 65: protowire.NotifyVirtualDaaScoreChangedResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 66: protowire.NotifyPruningPointUTXOSetOverrideResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 67: protowire.StopNotifyingPruningPointUTXOSetOverrideResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 68: protowire.BanResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 69: protowire.UnbanResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 70: protowire.GetInfoResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 71: protowire.EstimateNetworkHashesPerSecondResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 72: protowire.NotifyNewBlockTemplateResponseMessage.error:type_name -> protowire.RPCError
+	36,  // 73: protowire.MempoolEntryByAddress.sending:type_name -> protowire.MempoolEntry
+	36,  // 74: protowire.MempoolEntryByAddress.receiving:type_name -> protowire.MempoolEntry
+	109, // 75: protowire.GetMempoolEntriesByAddressesResponseMessage.entries:type_name -> protowire.MempoolEntryByAddress
+	4,   // 76: protowire.GetMempoolEntriesByAddressesResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 77: protowire.GetCoinSupplyResponseMessage.error:type_name -> protowire.RPCError
+	115, // 78: protowire.GetCoinbaseSplitResponseMessage.blueRewards:type_name -> protowire.CoinbaseReward
+	115, // 79: protowire.GetCoinbaseSplitResponseMessage.redReward:type_name -> protowire.CoinbaseReward
+	4,   // 80: protowire.GetCoinbaseSplitResponseMessage.error:type_name -> protowire.RPCError
+	118, // 81: protowire.GetEmissionInfoResponseMessage.currentSubsidy:type_name -> protowire.BlockSubsidy
+	118, // 82: protowire.GetEmissionInfoResponseMessage.nextReduction:type_name -> protowire.BlockSubsidy
+	118, // 83: protowire.GetEmissionInfoResponseMessage.subsidies:type_name -> protowire.BlockSubsidy
+	4,   // 84: protowire.GetEmissionInfoResponseMessage.error:type_name -> protowire.RPCError
+	9,   // 85: protowire.TestMempoolAcceptRequestMessage.transaction:type_name -> protowire.RpcTransaction
+	4,   // 86: protowire.TestMempoolAcceptResponseMessage.error:type_name -> protowire.RPCError
+	1,   // 87: protowire.DecodeTransactionRequestMessage.encoding:type_name -> protowire.DecodeTransactionRequestMessage.Encoding
+	9,   // 88: protowire.DecodeTransactionResponseMessage.transaction:type_name -> protowire.RpcTransaction
+	4,   // 89: protowire.DecodeTransactionResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 90: protowire.DecodeScriptResponseMessage.error:type_name -> protowire.RPCError
+	13,  // 91: protowire.GetUtxoEntriesByOutpointsRequestMessage.outpoints:type_name -> protowire.RpcOutpoint
+	13,  // 92: protowire.UtxoEntryByOutpoint.outpoint:type_name -> protowire.RpcOutpoint
+	14,  // 93: protowire.UtxoEntryByOutpoint.utxoEntry:type_name -> protowire.RpcUtxoEntry
+	127, // 94: protowire.GetUtxoEntriesByOutpointsResponseMessage.entries:type_name -> protowire.UtxoEntryByOutpoint
+	4,   // 95: protowire.GetUtxoEntriesByOutpointsResponseMessage.error:type_name -> protowire.RPCError
+	2,   // 96: protowire.RpcSyncStatus.ibdPhase:type_name -> protowire.RpcSyncStatus.IbdPhase
+	129, // 97: protowire.GetSyncStatusResponseMessage.syncStatus:type_name -> protowire.RpcSyncStatus
+	4,   // 98: protowire.GetSyncStatusResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 99: protowire.NotifySyncStatusChangedResponseMessage.error:type_name -> protowire.RPCError
+	129, // 100: protowire.SyncStatusChangedNotificationMessage.syncStatus:type_name -> protowire.RpcSyncStatus
+	4,   // 101: protowire.VerifyMessageResponseMessage.error:type_name -> protowire.RPCError
+	140, // 102: protowire.GetTransactionInclusionProofResponseMessage.proof:type_name -> protowire.RpcTransactionInclusionProof
+	4,   // 103: protowire.GetTransactionInclusionProofResponseMessage.error:type_name -> protowire.RPCError
+	6,   // 104: protowire.RpcTransactionInclusionProof.includingBlockHeader:type_name -> protowire.RpcBlockHeader
+	139, // 105: protowire.RpcTransactionInclusionProof.hashMerkleBranch:type_name -> protowire.RpcMerkleBranch
+	6,   // 106: protowire.RpcTransactionInclusionProof.mergeSetPath:type_name -> protowire.RpcBlockHeader
+	6,   // 107: protowire.RpcTransactionInclusionProof.acceptingBlockHeader:type_name -> protowire.RpcBlockHeader
+	139, // 108: protowire.RpcTransactionInclusionProof.acceptedIdMerkleBranch:type_name -> protowire.RpcMerkleBranch
+	6,   // 109: protowire.RpcTransactionInclusionProof.chainPath:type_name -> protowire.RpcBlockHeader
+	141, // 110: protowire.RpcTransactionInclusionProof.chainPathOtherParents:type_name -> protowire.RpcBlockHeaders
+	6,   // 111: protowire.RpcBlockHeaders.headers:type_name -> protowire.RpcBlockHeader
+	4,   // 112: protowire.InvalidateBlockResponseMessage.error:type_name -> protowire.RPCError
+	4,   // 113: protowire.ReconsiderBlockResponseMessage.error:type_name -> protowire.RPCError
+	148, // 114: protowire.GetDagSnapshotResponseMessage.blocks:type_name -> protowire.RpcDagSnapshotBlock
+	4,   // 115: protowire.GetDagSnapshotResponseMessage.error:type_name -> protowire.RPCError
+	3,   // 116: protowire.GetTransactionStatusResponseMessage.status:type_name -> protowire.GetTransactionStatusResponseMessage.TransactionStatus
+	4,   // 117: protowire.GetTransactionStatusResponseMessage.error:type_name -> protowire.RPCError
+	118, // [118:118] is the sub-list for method output_type
+	118, // [118:118] is the sub-list for method input_type
+	118, // [118:118] is the sub-list for extension type_name
+	118, // [118:118] is the sub-list for extension extendee
+	0,   // [0:118] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -6505,8 +9385,8 @@ func file_rpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   110,
+			NumEnums:      4,
+			NumMessages:   147,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -40,7 +40,7 @@ func (x *HoosatdMessage_AddPeerResponse) toAppMessage() (appmessage.Message, err
 func (x *HoosatdMessage_AddPeerResponse) fromAppMessage(message *appmessage.AddPeerResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.AddPeerResponse = &AddPeerResponseMessage{
 		Error: err,
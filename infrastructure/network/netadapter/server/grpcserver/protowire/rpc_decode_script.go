@@ -0,0 +1,76 @@
+package protowire
+
+import (
+	"math"
+
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_DecodeScriptRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_DecodeScriptRequest is nil")
+	}
+	return x.DecodeScriptRequest.toAppMessage()
+}
+
+func (x *DecodeScriptRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "DecodeScriptRequestMessage is nil")
+	}
+	if x.Version > math.MaxUint16 {
+		return nil, errors.Errorf("Invalid script version - bigger then uint16")
+	}
+	return &appmessage.DecodeScriptRequestMessage{
+		ScriptHex: x.ScriptHex,
+		Version:   uint16(x.Version),
+	}, nil
+}
+
+func (x *HoosatdMessage_DecodeScriptRequest) fromAppMessage(message *appmessage.DecodeScriptRequestMessage) error {
+	x.DecodeScriptRequest = &DecodeScriptRequestMessage{
+		ScriptHex: message.ScriptHex,
+		Version:   uint32(message.Version),
+	}
+	return nil
+}
+
+func (x *HoosatdMessage_DecodeScriptResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_DecodeScriptResponse is nil")
+	}
+	return x.DecodeScriptResponse.toAppMessage()
+}
+
+func (x *DecodeScriptResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "DecodeScriptResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.DecodeScriptResponseMessage{
+		Disassembly: x.Disassembly,
+		ScriptClass: x.ScriptClass,
+		Addresses:   x.Addresses,
+		P2SHAddress: x.P2ShAddress,
+		Error:       rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_DecodeScriptResponse) fromAppMessage(message *appmessage.DecodeScriptResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	x.DecodeScriptResponse = &DecodeScriptResponseMessage{
+		Disassembly: message.Disassembly,
+		ScriptClass: message.ScriptClass,
+		Addresses:   message.Addresses,
+		P2ShAddress: message.P2SHAddress,
+		Error:       err,
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+func (x *HoosatdMessage_DecodeTransactionRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_DecodeTransactionRequest is nil")
+	}
+	return x.DecodeTransactionRequest.toAppMessage()
+}
+
+func (x *DecodeTransactionRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "DecodeTransactionRequestMessage is nil")
+	}
+	return &appmessage.DecodeTransactionRequestMessage{
+		TransactionHex: x.TransactionHex,
+		Encoding:       appmessage.TransactionEncoding(x.Encoding),
+	}, nil
+}
+
+func (x *HoosatdMessage_DecodeTransactionRequest) fromAppMessage(message *appmessage.DecodeTransactionRequestMessage) error {
+	x.DecodeTransactionRequest = &DecodeTransactionRequestMessage{
+		TransactionHex: message.TransactionHex,
+		Encoding:       DecodeTransactionRequestMessage_Encoding(message.Encoding),
+	}
+	return nil
+}
+
+func (x *HoosatdMessage_DecodeTransactionResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_DecodeTransactionResponse is nil")
+	}
+	return x.DecodeTransactionResponse.toAppMessage()
+}
+
+func (x *DecodeTransactionResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "DecodeTransactionResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	var transaction *appmessage.RPCTransaction
+	if rpcErr == nil {
+		transaction, err = x.Transaction.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &appmessage.DecodeTransactionResponseMessage{
+		Transaction: transaction,
+		Error:       rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_DecodeTransactionResponse) fromAppMessage(message *appmessage.DecodeTransactionResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	var transaction *RpcTransaction
+	if message.Transaction != nil {
+		transaction = &RpcTransaction{}
+		transaction.fromAppMessage(message.Transaction)
+	}
+	x.DecodeTransactionResponse = &DecodeTransactionResponseMessage{
+		Transaction: transaction,
+		Error:       err,
+	}
+	return nil
+}
+
+// SerializeRPCTransaction serializes the given transaction in the RPC serialization
+// that DecodeTransaction accepts
+func SerializeRPCTransaction(transaction *appmessage.RPCTransaction) ([]byte, error) {
+	protoTransaction := &RpcTransaction{}
+	protoTransaction.fromAppMessage(transaction)
+	return proto.Marshal(protoTransaction)
+}
+
+// DeserializeRPCTransaction deserializes a transaction serialized with SerializeRPCTransaction
+func DeserializeRPCTransaction(serializedTransaction []byte) (*appmessage.RPCTransaction, error) {
+	protoTransaction := &RpcTransaction{}
+	err := proto.Unmarshal(serializedTransaction, protoTransaction)
+	if err != nil {
+		return nil, err
+	}
+	return protoTransaction.toAppMessage()
+}
@@ -9,5 +9,17 @@ func (x *RPCError) toAppMessage() (*appmessage.RPCError, error) {
 	if x == nil {
 		return nil, errors.Wrapf(errorNil, "RPCError is nil")
 	}
-	return &appmessage.RPCError{Message: x.Message}, nil
+	return &appmessage.RPCError{
+		Message:                x.Message,
+		Code:                   appmessage.RPCErrorCode(x.Code),
+		RetryAfterMilliseconds: x.RetryAfterMilliseconds,
+	}, nil
+}
+
+func newRPCError(rpcError *appmessage.RPCError) *RPCError {
+	return &RPCError{
+		Message:                rpcError.Message,
+		Code:                   uint32(rpcError.Code),
+		RetryAfterMilliseconds: rpcError.RetryAfterMilliseconds,
+	}
 }
@@ -38,7 +38,7 @@ func (x *HoosatdMessage_GetBalanceByAddressResponse) toAppMessage() (appmessage.
 func (x *HoosatdMessage_GetBalanceByAddressResponse) fromAppMessage(message *appmessage.GetBalanceByAddressResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.GetBalanceByAddressResponse = &GetBalanceByAddressResponseMessage{
 		Balance: message.Balance,
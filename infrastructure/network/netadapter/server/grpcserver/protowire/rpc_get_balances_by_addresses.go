@@ -38,7 +38,7 @@ func (x *HoosatdMessage_GetBalancesByAddressesResponse) toAppMessage() (appmessa
 func (x *HoosatdMessage_GetBalancesByAddressesResponse) fromAppMessage(message *appmessage.GetBalancesByAddressesResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	entries := make([]*BalancesByAddressEntry, len(message.Entries))
 	for i, entry := range message.Entries {
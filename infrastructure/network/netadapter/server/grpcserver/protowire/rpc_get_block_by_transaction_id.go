@@ -40,7 +40,7 @@ func (x *HoosatdMessage_GetBlockByTransactionIdResponse) toAppMessage() (appmess
 func (x *HoosatdMessage_GetBlockByTransactionIdResponse) fromAppMessage(message *appmessage.GetBlockByTransactionIDResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	var block *RpcBlock
 	if message.Block != nil {
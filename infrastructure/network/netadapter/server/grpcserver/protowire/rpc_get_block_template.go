@@ -40,7 +40,7 @@ func (x *HoosatdMessage_GetBlockTemplateResponse) toAppMessage() (appmessage.Mes
 func (x *HoosatdMessage_GetBlockTemplateResponse) fromAppMessage(message *appmessage.GetBlockTemplateResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 
 	var block *RpcBlock
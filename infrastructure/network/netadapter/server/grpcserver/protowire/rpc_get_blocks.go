@@ -42,7 +42,7 @@ func (x *HoosatdMessage_GetBlocksResponse) toAppMessage() (appmessage.Message, e
 func (x *HoosatdMessage_GetBlocksResponse) fromAppMessage(message *appmessage.GetBlocksResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.GetBlocksResponse = &GetBlocksResponseMessage{
 		Error: err,
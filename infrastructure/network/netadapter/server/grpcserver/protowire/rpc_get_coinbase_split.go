@@ -0,0 +1,117 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_GetCoinbaseSplitRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetCoinbaseSplitRequest is nil")
+	}
+	return x.GetCoinbaseSplitRequest.toAppMessage()
+}
+
+func (x *GetCoinbaseSplitRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetCoinbaseSplitRequestMessage is nil")
+	}
+	return &appmessage.GetCoinbaseSplitRequestMessage{
+		BlockHash: x.BlockHash,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetCoinbaseSplitRequest) fromAppMessage(message *appmessage.GetCoinbaseSplitRequestMessage) error {
+	x.GetCoinbaseSplitRequest = &GetCoinbaseSplitRequestMessage{
+		BlockHash: message.BlockHash,
+	}
+	return nil
+}
+
+func (x *HoosatdMessage_GetCoinbaseSplitResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetCoinbaseSplitResponse is nil")
+	}
+	return x.GetCoinbaseSplitResponse.toAppMessage()
+}
+
+func (x *GetCoinbaseSplitResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetCoinbaseSplitResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	blueRewards := make([]*appmessage.CoinbaseReward, len(x.BlueRewards))
+	for i, blueReward := range x.BlueRewards {
+		blueRewards[i] = blueReward.toAppMessage()
+	}
+
+	return &appmessage.GetCoinbaseSplitResponseMessage{
+		BlockHash:        x.BlockHash,
+		BlockVersion:     x.BlockVersion,
+		IsDevFeeActive:   x.IsDevFeeActive,
+		DevFeeAddress:    x.DevFeeAddress,
+		DevFeePercentage: x.DevFeePercentage,
+		BlueRewards:      blueRewards,
+		RedReward:        x.RedReward.toAppMessage(),
+		RedBlockHashes:   x.RedBlockHashes,
+		TotalMinerReward: x.TotalMinerReward,
+		TotalDevFee:      x.TotalDevFee,
+		Error:            rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetCoinbaseSplitResponse) fromAppMessage(message *appmessage.GetCoinbaseSplitResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+
+	blueRewards := make([]*CoinbaseReward, len(message.BlueRewards))
+	for i, blueReward := range message.BlueRewards {
+		blueRewards[i] = newCoinbaseReward(blueReward)
+	}
+
+	x.GetCoinbaseSplitResponse = &GetCoinbaseSplitResponseMessage{
+		BlockHash:        message.BlockHash,
+		BlockVersion:     message.BlockVersion,
+		IsDevFeeActive:   message.IsDevFeeActive,
+		DevFeeAddress:    message.DevFeeAddress,
+		DevFeePercentage: message.DevFeePercentage,
+		BlueRewards:      blueRewards,
+		RedReward:        newCoinbaseReward(message.RedReward),
+		RedBlockHashes:   message.RedBlockHashes,
+		TotalMinerReward: message.TotalMinerReward,
+		TotalDevFee:      message.TotalDevFee,
+		Error:            err,
+	}
+	return nil
+}
+
+func (x *CoinbaseReward) toAppMessage() *appmessage.CoinbaseReward {
+	if x == nil {
+		return nil
+	}
+	return &appmessage.CoinbaseReward{
+		BlockHash:    x.BlockHash,
+		MinerAddress: x.MinerAddress,
+		MinerReward:  x.MinerReward,
+		DevFee:       x.DevFee,
+	}
+}
+
+func newCoinbaseReward(reward *appmessage.CoinbaseReward) *CoinbaseReward {
+	if reward == nil {
+		return nil
+	}
+	return &CoinbaseReward{
+		BlockHash:    reward.BlockHash,
+		MinerAddress: reward.MinerAddress,
+		MinerReward:  reward.MinerReward,
+		DevFee:       reward.DevFee,
+	}
+}
@@ -23,7 +23,7 @@ func (x *HoosatdMessage_GetConnectedPeerInfoResponse) toAppMessage() (appmessage
 func (x *HoosatdMessage_GetConnectedPeerInfoResponse) fromAppMessage(message *appmessage.GetConnectedPeerInfoResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	infos := make([]*GetConnectedPeerInfoMessage, len(message.Infos))
 	for i, info := range message.Infos {
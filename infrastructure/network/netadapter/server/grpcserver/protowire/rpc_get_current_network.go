@@ -23,7 +23,7 @@ func (x *HoosatdMessage_GetCurrentNetworkResponse) toAppMessage() (appmessage.Me
 func (x *HoosatdMessage_GetCurrentNetworkResponse) fromAppMessage(message *appmessage.GetCurrentNetworkResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.GetCurrentNetworkResponse = &GetCurrentNetworkResponseMessage{
 		CurrentNetwork: message.CurrentNetwork,
@@ -0,0 +1,129 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_GetDagSnapshotRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetDagSnapshotRequest is nil")
+	}
+	return x.GetDagSnapshotRequest.toAppMessage()
+}
+
+func (x *GetDagSnapshotRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetDagSnapshotRequestMessage is nil")
+	}
+	return &appmessage.GetDAGSnapshotRequestMessage{
+		LowScore:    x.LowScore,
+		HighScore:   x.HighScore,
+		UseDAAScore: x.UseDaaScore,
+		PageCursor:  x.PageCursor,
+		PageSize:    x.PageSize,
+		IncludeDOT:  x.IncludeDot,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetDagSnapshotRequest) fromAppMessage(message *appmessage.GetDAGSnapshotRequestMessage) error {
+	x.GetDagSnapshotRequest = &GetDagSnapshotRequestMessage{
+		LowScore:    message.LowScore,
+		HighScore:   message.HighScore,
+		UseDaaScore: message.UseDAAScore,
+		PageCursor:  message.PageCursor,
+		PageSize:    message.PageSize,
+		IncludeDot:  message.IncludeDOT,
+	}
+	return nil
+}
+
+func (x *HoosatdMessage_GetDagSnapshotResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetDagSnapshotResponse is nil")
+	}
+	return x.GetDagSnapshotResponse.toAppMessage()
+}
+
+func (x *GetDagSnapshotResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetDagSnapshotResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	// Return data only if there's no error
+	if rpcErr != nil && len(x.Blocks) != 0 {
+		return nil, errors.New("GetDagSnapshotResponseMessage contains both an error and a response")
+	}
+	blocks := make([]*appmessage.RPCDAGSnapshotBlock, len(x.Blocks))
+	for i, block := range x.Blocks {
+		appMessageBlock, err := block.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		blocks[i] = appMessageBlock
+	}
+	return &appmessage.GetDAGSnapshotResponseMessage{
+		Blocks:         blocks,
+		NextPageCursor: x.NextPageCursor,
+		DOT:            x.Dot,
+		Error:          rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetDagSnapshotResponse) fromAppMessage(message *appmessage.GetDAGSnapshotResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	blocks := make([]*RpcDagSnapshotBlock, len(message.Blocks))
+	for i, block := range message.Blocks {
+		blocks[i] = &RpcDagSnapshotBlock{}
+		blocks[i].fromAppMessage(block)
+	}
+	x.GetDagSnapshotResponse = &GetDagSnapshotResponseMessage{
+		Blocks:         blocks,
+		NextPageCursor: message.NextPageCursor,
+		Dot:            message.DOT,
+		Error:          err,
+	}
+	return nil
+}
+
+func (x *RpcDagSnapshotBlock) toAppMessage() (*appmessage.RPCDAGSnapshotBlock, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcDagSnapshotBlock is nil")
+	}
+	return &appmessage.RPCDAGSnapshotBlock{
+		Hash:                  x.Hash,
+		ParentHashes:          x.ParentHashes,
+		SelectedParentHash:    x.SelectedParentHash,
+		MergeSetBluesHashes:   x.MergeSetBluesHashes,
+		MergeSetRedsHashes:    x.MergeSetRedsHashes,
+		BlueScore:             x.BlueScore,
+		DAAScore:              x.DaaScore,
+		Status:                x.Status,
+		IsChainBlock:          x.IsChainBlock,
+		MergingChainBlockHash: x.MergingChainBlockHash,
+		IsBlue:                x.IsBlue,
+	}, nil
+}
+
+func (x *RpcDagSnapshotBlock) fromAppMessage(message *appmessage.RPCDAGSnapshotBlock) {
+	*x = RpcDagSnapshotBlock{
+		Hash:                  message.Hash,
+		ParentHashes:          message.ParentHashes,
+		SelectedParentHash:    message.SelectedParentHash,
+		MergeSetBluesHashes:   message.MergeSetBluesHashes,
+		MergeSetRedsHashes:    message.MergeSetRedsHashes,
+		BlueScore:             message.BlueScore,
+		DaaScore:              message.DAAScore,
+		Status:                message.Status,
+		IsChainBlock:          message.IsChainBlock,
+		MergingChainBlockHash: message.MergingChainBlockHash,
+		IsBlue:                message.IsBlue,
+	}
+}
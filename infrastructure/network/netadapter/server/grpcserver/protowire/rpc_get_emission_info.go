@@ -0,0 +1,109 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_GetEmissionInfoRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetEmissionInfoRequest is nil")
+	}
+	return x.GetEmissionInfoRequest.toAppMessage()
+}
+
+func (x *GetEmissionInfoRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetEmissionInfoRequestMessage is nil")
+	}
+	return &appmessage.GetEmissionInfoRequestMessage{
+		DAAScores: x.DaaScores,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetEmissionInfoRequest) fromAppMessage(message *appmessage.GetEmissionInfoRequestMessage) error {
+	x.GetEmissionInfoRequest = &GetEmissionInfoRequestMessage{
+		DaaScores: message.DAAScores,
+	}
+	return nil
+}
+
+func (x *HoosatdMessage_GetEmissionInfoResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetEmissionInfoResponse is nil")
+	}
+	return x.GetEmissionInfoResponse.toAppMessage()
+}
+
+func (x *GetEmissionInfoResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetEmissionInfoResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	subsidies := make([]*appmessage.BlockSubsidy, len(x.Subsidies))
+	for i, subsidy := range x.Subsidies {
+		subsidies[i] = subsidy.toAppMessage()
+	}
+
+	return &appmessage.GetEmissionInfoResponseMessage{
+		VirtualDAAScore:      x.VirtualDaaScore,
+		CurrentSubsidy:       x.CurrentSubsidy.toAppMessage(),
+		NextReduction:        x.NextReduction.toAppMessage(),
+		Subsidies:            subsidies,
+		ProjectedTotalSupply: x.ProjectedTotalSupply,
+		MaxSompi:             x.MaxSompi,
+		Error:                rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetEmissionInfoResponse) fromAppMessage(message *appmessage.GetEmissionInfoResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+
+	subsidies := make([]*BlockSubsidy, len(message.Subsidies))
+	for i, subsidy := range message.Subsidies {
+		subsidies[i] = newBlockSubsidy(subsidy)
+	}
+
+	x.GetEmissionInfoResponse = &GetEmissionInfoResponseMessage{
+		VirtualDaaScore:      message.VirtualDAAScore,
+		CurrentSubsidy:       newBlockSubsidy(message.CurrentSubsidy),
+		NextReduction:        newBlockSubsidy(message.NextReduction),
+		Subsidies:            subsidies,
+		ProjectedTotalSupply: message.ProjectedTotalSupply,
+		MaxSompi:             message.MaxSompi,
+		Error:                err,
+	}
+	return nil
+}
+
+func (x *BlockSubsidy) toAppMessage() *appmessage.BlockSubsidy {
+	if x == nil {
+		return nil
+	}
+	return &appmessage.BlockSubsidy{
+		DAAScore:     x.DaaScore,
+		BlockVersion: x.BlockVersion,
+		Subsidy:      x.Subsidy,
+		EmittedSompi: x.EmittedSompi,
+	}
+}
+
+func newBlockSubsidy(subsidy *appmessage.BlockSubsidy) *BlockSubsidy {
+	if subsidy == nil {
+		return nil
+	}
+	return &BlockSubsidy{
+		DaaScore:     subsidy.DAAScore,
+		BlockVersion: subsidy.BlockVersion,
+		Subsidy:      subsidy.Subsidy,
+		EmittedSompi: subsidy.EmittedSompi,
+	}
+}
@@ -40,7 +40,7 @@ func (x *HoosatdMessage_GetMempoolEntriesResponse) toAppMessage() (appmessage.Me
 func (x *HoosatdMessage_GetMempoolEntriesResponse) fromAppMessage(message *appmessage.GetMempoolEntriesResponseMessage) error {
 	var rpcErr *RPCError
 	if message.Error != nil {
-		rpcErr = &RPCError{Message: message.Error.Message}
+		rpcErr = newRPCError(message.Error)
 	}
 	entries := make([]*MempoolEntry, len(message.Entries))
 	for i, entry := range message.Entries {
@@ -42,7 +42,7 @@ func (x *HoosatdMessage_GetMempoolEntryResponse) toAppMessage() (appmessage.Mess
 func (x *HoosatdMessage_GetMempoolEntryResponse) fromAppMessage(message *appmessage.GetMempoolEntryResponseMessage) error {
 	var rpcErr *RPCError
 	if message.Error != nil {
-		rpcErr = &RPCError{Message: message.Error.Message}
+		rpcErr = newRPCError(message.Error)
 	}
 	var entry *MempoolEntry
 	if message.Entry != nil {
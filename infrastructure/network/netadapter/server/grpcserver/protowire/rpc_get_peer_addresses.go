@@ -26,7 +26,7 @@ func (x *HoosatdMessage_GetPeerAddressesResponse) toAppMessage() (appmessage.Mes
 func (x *HoosatdMessage_GetPeerAddressesResponse) fromAppMessage(message *appmessage.GetPeerAddressesResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	addresses := make([]*GetPeerAddressesKnownAddressMessage, len(message.Addresses))
 	for i, address := range message.Addresses {
@@ -23,7 +23,7 @@ func (x *HoosatdMessage_GetSelectedTipHashResponse) toAppMessage() (appmessage.M
 func (x *HoosatdMessage_GetSelectedTipHashResponse) fromAppMessage(message *appmessage.GetSelectedTipHashResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.GetSelectedTipHashResponse = &GetSelectedTipHashResponseMessage{
 		SelectedTipHash: message.SelectedTipHash,
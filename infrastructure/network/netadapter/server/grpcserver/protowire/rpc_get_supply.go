@@ -24,7 +24,7 @@ func (x *HoosatdMessage_GetCoinSupplyResponse) toAppMessage() (appmessage.Messag
 func (x *HoosatdMessage_GetCoinSupplyResponse) fromAppMessage(message *appmessage.GetCoinSupplyResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.GetCoinSupplyResponse = &GetCoinSupplyResponseMessage{
 		MaxSompi:         message.MaxSompi,
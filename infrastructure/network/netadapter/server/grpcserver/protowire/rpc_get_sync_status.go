@@ -0,0 +1,101 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_GetSyncStatusRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetSyncStatusRequest is nil")
+	}
+	return &appmessage.GetSyncStatusRequestMessage{}, nil
+}
+
+func (x *HoosatdMessage_GetSyncStatusRequest) fromAppMessage(_ *appmessage.GetSyncStatusRequestMessage) error {
+	x.GetSyncStatusRequest = &GetSyncStatusRequestMessage{}
+	return nil
+}
+
+func (x *HoosatdMessage_GetSyncStatusResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetSyncStatusResponse is nil")
+	}
+	return x.GetSyncStatusResponse.toAppMessage()
+}
+
+func (x *HoosatdMessage_GetSyncStatusResponse) fromAppMessage(message *appmessage.GetSyncStatusResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	var syncStatus *RpcSyncStatus
+	if message.SyncStatus != nil {
+		syncStatus = &RpcSyncStatus{}
+		syncStatus.fromAppMessage(message.SyncStatus)
+	}
+	x.GetSyncStatusResponse = &GetSyncStatusResponseMessage{
+		SyncStatus: syncStatus,
+		Error:      err,
+	}
+	return nil
+}
+
+func (x *GetSyncStatusResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetSyncStatusResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	var syncStatus *appmessage.RPCSyncStatus
+	if rpcErr == nil {
+		syncStatus, err = x.SyncStatus.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &appmessage.GetSyncStatusResponseMessage{
+		SyncStatus: syncStatus,
+		Error:      rpcErr,
+	}, nil
+}
+
+func (x *RpcSyncStatus) toAppMessage() (*appmessage.RPCSyncStatus, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcSyncStatus is nil")
+	}
+	return &appmessage.RPCSyncStatus{
+		IsSynced:                   x.IsSynced,
+		IsIBDRunning:               x.IsIbdRunning,
+		IBDPhase:                   appmessage.IBDPhase(x.IbdPhase),
+		SyncPeerID:                 x.SyncPeerId,
+		SyncPeerAddress:            x.SyncPeerAddress,
+		PhaseStartTimeMilliseconds: x.PhaseStartTimeMilliseconds,
+		Processed:                  x.Processed,
+		Total:                      x.Total,
+		ProgressPercent:            x.ProgressPercent,
+		ETAMilliseconds:            x.EtaMilliseconds,
+		HasETA:                     x.HasEta,
+	}, nil
+}
+
+func (x *RpcSyncStatus) fromAppMessage(message *appmessage.RPCSyncStatus) {
+	*x = RpcSyncStatus{
+		IsSynced:                   message.IsSynced,
+		IsIbdRunning:               message.IsIBDRunning,
+		IbdPhase:                   RpcSyncStatus_IbdPhase(message.IBDPhase),
+		SyncPeerId:                 message.SyncPeerID,
+		SyncPeerAddress:            message.SyncPeerAddress,
+		PhaseStartTimeMilliseconds: message.PhaseStartTimeMilliseconds,
+		Processed:                  message.Processed,
+		Total:                      message.Total,
+		ProgressPercent:            message.ProgressPercent,
+		EtaMilliseconds:            message.ETAMilliseconds,
+		HasEta:                     message.HasETA,
+	}
+}
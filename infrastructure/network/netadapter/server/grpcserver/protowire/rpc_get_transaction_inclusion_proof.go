@@ -0,0 +1,198 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_GetTransactionInclusionProofRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetTransactionInclusionProofRequest is nil")
+	}
+	return x.GetTransactionInclusionProofRequest.toAppMessage()
+}
+
+func (x *GetTransactionInclusionProofRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionInclusionProofRequestMessage is nil")
+	}
+	return &appmessage.GetTransactionInclusionProofRequestMessage{
+		TransactionID:   x.TransactionId,
+		BlockHash:       x.BlockHash,
+		TargetBlockHash: x.TargetBlockHash,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetTransactionInclusionProofRequest) fromAppMessage(
+	message *appmessage.GetTransactionInclusionProofRequestMessage) error {
+
+	x.GetTransactionInclusionProofRequest = &GetTransactionInclusionProofRequestMessage{
+		TransactionId:   message.TransactionID,
+		BlockHash:       message.BlockHash,
+		TargetBlockHash: message.TargetBlockHash,
+	}
+	return nil
+}
+
+func (x *HoosatdMessage_GetTransactionInclusionProofResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetTransactionInclusionProofResponse is nil")
+	}
+	return x.GetTransactionInclusionProofResponse.toAppMessage()
+}
+
+func (x *GetTransactionInclusionProofResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionInclusionProofResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	var proof *appmessage.RPCTransactionInclusionProof
+	// Return the proof only if there's no error
+	if rpcErr != nil && x.Proof != nil {
+		return nil, errors.New("GetTransactionInclusionProofResponseMessage contains both an error and a response")
+	}
+	if rpcErr == nil {
+		proof, err = x.Proof.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &appmessage.GetTransactionInclusionProofResponseMessage{
+		Proof: proof,
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetTransactionInclusionProofResponse) fromAppMessage(
+	message *appmessage.GetTransactionInclusionProofResponseMessage) error {
+
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	var proof *RpcTransactionInclusionProof
+	if message.Proof != nil {
+		proof = &RpcTransactionInclusionProof{}
+		proof.fromAppMessage(message.Proof)
+	}
+	x.GetTransactionInclusionProofResponse = &GetTransactionInclusionProofResponseMessage{
+		Proof: proof,
+		Error: err,
+	}
+	return nil
+}
+
+func (x *RpcTransactionInclusionProof) toAppMessage() (*appmessage.RPCTransactionInclusionProof, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcTransactionInclusionProof is nil")
+	}
+	includingBlockHeader, err := x.IncludingBlockHeader.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	hashMerkleBranch, err := x.HashMerkleBranch.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	mergeSetPath, err := rpcBlockHeadersToAppMessage(x.MergeSetPath)
+	if err != nil {
+		return nil, err
+	}
+	acceptingBlockHeader, err := x.AcceptingBlockHeader.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	acceptedIDMerkleBranch, err := x.AcceptedIdMerkleBranch.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	chainPath, err := rpcBlockHeadersToAppMessage(x.ChainPath)
+	if err != nil {
+		return nil, err
+	}
+	chainPathOtherParents := make([][]*appmessage.RPCBlockHeader, len(x.ChainPathOtherParents))
+	for i, otherParents := range x.ChainPathOtherParents {
+		if otherParents == nil {
+			return nil, errors.Wrapf(errorNil, "RpcBlockHeaders is nil")
+		}
+		chainPathOtherParents[i], err = rpcBlockHeadersToAppMessage(otherParents.Headers)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &appmessage.RPCTransactionInclusionProof{
+		IncludingBlockHeader:   includingBlockHeader,
+		HashMerkleBranch:       hashMerkleBranch,
+		MergeSetPath:           mergeSetPath,
+		AcceptingBlockHeader:   acceptingBlockHeader,
+		AcceptedIDMerkleBranch: acceptedIDMerkleBranch,
+		ChainPath:              chainPath,
+		ChainPathOtherParents:  chainPathOtherParents,
+	}, nil
+}
+
+func (x *RpcTransactionInclusionProof) fromAppMessage(message *appmessage.RPCTransactionInclusionProof) {
+	includingBlockHeader := &RpcBlockHeader{}
+	includingBlockHeader.fromAppMessage(message.IncludingBlockHeader)
+	hashMerkleBranch := &RpcMerkleBranch{}
+	hashMerkleBranch.fromAppMessage(message.HashMerkleBranch)
+	acceptingBlockHeader := &RpcBlockHeader{}
+	acceptingBlockHeader.fromAppMessage(message.AcceptingBlockHeader)
+	acceptedIDMerkleBranch := &RpcMerkleBranch{}
+	acceptedIDMerkleBranch.fromAppMessage(message.AcceptedIDMerkleBranch)
+	chainPathOtherParents := make([]*RpcBlockHeaders, len(message.ChainPathOtherParents))
+	for i, otherParents := range message.ChainPathOtherParents {
+		chainPathOtherParents[i] = &RpcBlockHeaders{Headers: rpcBlockHeadersFromAppMessage(otherParents)}
+	}
+	*x = RpcTransactionInclusionProof{
+		IncludingBlockHeader:   includingBlockHeader,
+		HashMerkleBranch:       hashMerkleBranch,
+		MergeSetPath:           rpcBlockHeadersFromAppMessage(message.MergeSetPath),
+		AcceptingBlockHeader:   acceptingBlockHeader,
+		AcceptedIdMerkleBranch: acceptedIDMerkleBranch,
+		ChainPath:              rpcBlockHeadersFromAppMessage(message.ChainPath),
+		ChainPathOtherParents:  chainPathOtherParents,
+	}
+}
+
+func (x *RpcMerkleBranch) toAppMessage() (*appmessage.RPCMerkleBranch, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "RpcMerkleBranch is nil")
+	}
+	return &appmessage.RPCMerkleBranch{
+		Index:  x.Index,
+		Hashes: x.Hashes,
+	}, nil
+}
+
+func (x *RpcMerkleBranch) fromAppMessage(message *appmessage.RPCMerkleBranch) {
+	*x = RpcMerkleBranch{
+		Index:  message.Index,
+		Hashes: message.Hashes,
+	}
+}
+
+func rpcBlockHeadersToAppMessage(headers []*RpcBlockHeader) ([]*appmessage.RPCBlockHeader, error) {
+	appMessageHeaders := make([]*appmessage.RPCBlockHeader, len(headers))
+	for i, header := range headers {
+		var err error
+		appMessageHeaders[i], err = header.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return appMessageHeaders, nil
+}
+
+func rpcBlockHeadersFromAppMessage(headers []*appmessage.RPCBlockHeader) []*RpcBlockHeader {
+	protoHeaders := make([]*RpcBlockHeader, len(headers))
+	for i, header := range headers {
+		protoHeaders[i] = &RpcBlockHeader{}
+		protoHeaders[i].fromAppMessage(header)
+	}
+	return protoHeaders
+}
@@ -0,0 +1,73 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_GetTransactionStatusRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetTransactionStatusRequest is nil")
+	}
+	return x.GetTransactionStatusRequest.toAppMessage()
+}
+
+func (x *GetTransactionStatusRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionStatusRequestMessage is nil")
+	}
+	return &appmessage.GetTransactionStatusRequestMessage{
+		TransactionID:      x.TransactionId,
+		IncludingBlockHash: x.IncludingBlockHash,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetTransactionStatusRequest) fromAppMessage(message *appmessage.GetTransactionStatusRequestMessage) error {
+	x.GetTransactionStatusRequest = &GetTransactionStatusRequestMessage{
+		TransactionId:      message.TransactionID,
+		IncludingBlockHash: message.IncludingBlockHash,
+	}
+	return nil
+}
+
+func (x *HoosatdMessage_GetTransactionStatusResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetTransactionStatusResponse is nil")
+	}
+	return x.GetTransactionStatusResponse.toAppMessage()
+}
+
+func (x *GetTransactionStatusResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetTransactionStatusResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.GetTransactionStatusResponseMessage{
+		Status:                 appmessage.TransactionStatus(x.Status),
+		IncludingBlockHash:     x.IncludingBlockHash,
+		AcceptingBlockHash:     x.AcceptingBlockHash,
+		AcceptingBlockDAAScore: x.AcceptingBlockDaaScore,
+		Confirmations:          x.Confirmations,
+		Error:                  rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetTransactionStatusResponse) fromAppMessage(message *appmessage.GetTransactionStatusResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	x.GetTransactionStatusResponse = &GetTransactionStatusResponseMessage{
+		Status:                 GetTransactionStatusResponseMessage_TransactionStatus(message.Status),
+		IncludingBlockHash:     message.IncludingBlockHash,
+		AcceptingBlockHash:     message.AcceptingBlockHash,
+		AcceptingBlockDaaScore: message.AcceptingBlockDAAScore,
+		Confirmations:          message.Confirmations,
+		Error:                  err,
+	}
+	return nil
+}
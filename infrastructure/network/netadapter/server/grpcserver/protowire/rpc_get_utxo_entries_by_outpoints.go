@@ -0,0 +1,134 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_GetUtxoEntriesByOutpointsRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetUtxoEntriesByOutpointsRequest is nil")
+	}
+	return x.GetUtxoEntriesByOutpointsRequest.toAppMessage()
+}
+
+func (x *HoosatdMessage_GetUtxoEntriesByOutpointsRequest) fromAppMessage(message *appmessage.GetUTXOEntriesByOutpointsRequestMessage) error {
+	outpoints := make([]*RpcOutpoint, len(message.Outpoints))
+	for i, outpoint := range message.Outpoints {
+		outpoints[i] = &RpcOutpoint{}
+		outpoints[i].fromAppMessage(outpoint)
+	}
+	x.GetUtxoEntriesByOutpointsRequest = &GetUtxoEntriesByOutpointsRequestMessage{
+		Outpoints:            outpoints,
+		IncludeMempoolSpends: message.IncludeMempoolSpends,
+	}
+	return nil
+}
+
+func (x *GetUtxoEntriesByOutpointsRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetUtxoEntriesByOutpointsRequestMessage is nil")
+	}
+	outpoints := make([]*appmessage.RPCOutpoint, len(x.Outpoints))
+	for i, outpoint := range x.Outpoints {
+		outpointAsAppMessage, err := outpoint.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		outpoints[i] = outpointAsAppMessage
+	}
+	return &appmessage.GetUTXOEntriesByOutpointsRequestMessage{
+		Outpoints:            outpoints,
+		IncludeMempoolSpends: x.IncludeMempoolSpends,
+	}, nil
+}
+
+func (x *HoosatdMessage_GetUtxoEntriesByOutpointsResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_GetUtxoEntriesByOutpointsResponse is nil")
+	}
+	return x.GetUtxoEntriesByOutpointsResponse.toAppMessage()
+}
+
+func (x *HoosatdMessage_GetUtxoEntriesByOutpointsResponse) fromAppMessage(message *appmessage.GetUTXOEntriesByOutpointsResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	entries := make([]*UtxoEntryByOutpoint, len(message.Entries))
+	for i, entry := range message.Entries {
+		entries[i] = &UtxoEntryByOutpoint{}
+		entries[i].fromAppMessage(entry)
+	}
+	x.GetUtxoEntriesByOutpointsResponse = &GetUtxoEntriesByOutpointsResponseMessage{
+		Entries: entries,
+		Error:   err,
+	}
+	return nil
+}
+
+func (x *GetUtxoEntriesByOutpointsResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "GetUtxoEntriesByOutpointsResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+
+	if rpcErr != nil && len(x.Entries) != 0 {
+		return nil, errors.New("GetUtxoEntriesByOutpointsResponseMessage contains both an error and a response")
+	}
+
+	entries := make([]*appmessage.UTXOEntryByOutpoint, len(x.Entries))
+	for i, entry := range x.Entries {
+		entryAsAppMessage, err := entry.toAppMessage()
+		if err != nil {
+			return nil, err
+		}
+		entries[i] = entryAsAppMessage
+	}
+
+	return &appmessage.GetUTXOEntriesByOutpointsResponseMessage{
+		Entries: entries,
+		Error:   rpcErr,
+	}, nil
+}
+
+func (x *UtxoEntryByOutpoint) toAppMessage() (*appmessage.UTXOEntryByOutpoint, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "UtxoEntryByOutpoint is nil")
+	}
+	outpoint, err := x.Outpoint.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	entry, err := x.UtxoEntry.toAppMessage()
+	// entry is unset for outpoints that aren't in the virtual UTXO set
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.UTXOEntryByOutpoint{
+		Outpoint:                     outpoint,
+		UTXOEntry:                    entry,
+		IsSpentInMempool:             x.IsSpentInMempool,
+		MempoolSpendingTransactionID: x.MempoolSpendingTransactionId,
+	}, nil
+}
+
+func (x *UtxoEntryByOutpoint) fromAppMessage(message *appmessage.UTXOEntryByOutpoint) {
+	outpoint := &RpcOutpoint{}
+	outpoint.fromAppMessage(message.Outpoint)
+	var utxoEntry *RpcUtxoEntry
+	if message.UTXOEntry != nil {
+		utxoEntry = &RpcUtxoEntry{}
+		utxoEntry.fromAppMessage(message.UTXOEntry)
+	}
+	*x = UtxoEntryByOutpoint{
+		Outpoint:                     outpoint,
+		UtxoEntry:                    utxoEntry,
+		IsSpentInMempool:             message.IsSpentInMempool,
+		MempoolSpendingTransactionId: message.MempoolSpendingTransactionID,
+	}
+}
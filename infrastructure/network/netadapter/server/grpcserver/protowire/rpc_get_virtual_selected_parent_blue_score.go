@@ -27,7 +27,7 @@ func (x *HoosatdMessage_GetVirtualSelectedParentBlueScoreResponse) toAppMessage(
 func (x *HoosatdMessage_GetVirtualSelectedParentBlueScoreResponse) fromAppMessage(message *appmessage.GetVirtualSelectedParentBlueScoreResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.GetVirtualSelectedParentBlueScoreResponse = &GetVirtualSelectedParentBlueScoreResponseMessage{
 		BlueScore: message.BlueScore,
@@ -0,0 +1,59 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_InvalidateBlockRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_InvalidateBlockRequest is nil")
+	}
+	return x.InvalidateBlockRequest.toAppMessage()
+}
+
+func (x *InvalidateBlockRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "InvalidateBlockRequestMessage is nil")
+	}
+	return &appmessage.InvalidateBlockRequestMessage{
+		BlockHash: x.BlockHash,
+	}, nil
+}
+
+func (x *HoosatdMessage_InvalidateBlockRequest) fromAppMessage(message *appmessage.InvalidateBlockRequestMessage) error {
+	x.InvalidateBlockRequest = &InvalidateBlockRequestMessage{BlockHash: message.BlockHash}
+	return nil
+}
+
+func (x *HoosatdMessage_InvalidateBlockResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_InvalidateBlockResponse is nil")
+	}
+	return x.InvalidateBlockResponse.toAppMessage()
+}
+
+func (x *InvalidateBlockResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "InvalidateBlockResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.InvalidateBlockResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_InvalidateBlockResponse) fromAppMessage(message *appmessage.InvalidateBlockResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	x.InvalidateBlockResponse = &InvalidateBlockResponseMessage{
+		Error: err,
+	}
+	return nil
+}
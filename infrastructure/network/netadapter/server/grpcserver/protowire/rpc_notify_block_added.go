@@ -24,7 +24,7 @@ func (x *HoosatdMessage_NotifyBlockAddedResponse) toAppMessage() (appmessage.Mes
 func (x *HoosatdMessage_NotifyBlockAddedResponse) fromAppMessage(message *appmessage.NotifyBlockAddedResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.NotifyBlockAddedResponse = &NotifyBlockAddedResponseMessage{
 		Error: err,
@@ -24,7 +24,7 @@ func (x *HoosatdMessage_NotifyFinalityConflictsResponse) toAppMessage() (appmess
 func (x *HoosatdMessage_NotifyFinalityConflictsResponse) fromAppMessage(message *appmessage.NotifyFinalityConflictsResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.NotifyFinalityConflictsResponse = &NotifyFinalityConflictsResponseMessage{
 		Error: err,
@@ -24,7 +24,7 @@ func (x *HoosatdMessage_NotifyNewBlockTemplateResponse) toAppMessage() (appmessa
 func (x *HoosatdMessage_NotifyNewBlockTemplateResponse) fromAppMessage(message *appmessage.NotifyNewBlockTemplateResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.NotifyNewBlockTemplateResponse = &NotifyNewBlockTemplateResponseMessage{
 		Error: err,
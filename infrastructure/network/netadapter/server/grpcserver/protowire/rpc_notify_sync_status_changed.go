@@ -0,0 +1,79 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_NotifySyncStatusChangedRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_NotifySyncStatusChangedRequest is nil")
+	}
+	return &appmessage.NotifySyncStatusChangedRequestMessage{}, nil
+}
+
+func (x *HoosatdMessage_NotifySyncStatusChangedRequest) fromAppMessage(_ *appmessage.NotifySyncStatusChangedRequestMessage) error {
+	x.NotifySyncStatusChangedRequest = &NotifySyncStatusChangedRequestMessage{}
+	return nil
+}
+
+func (x *HoosatdMessage_NotifySyncStatusChangedResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_NotifySyncStatusChangedResponse is nil")
+	}
+	return x.NotifySyncStatusChangedResponse.toAppMessage()
+}
+
+func (x *HoosatdMessage_NotifySyncStatusChangedResponse) fromAppMessage(message *appmessage.NotifySyncStatusChangedResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	x.NotifySyncStatusChangedResponse = &NotifySyncStatusChangedResponseMessage{
+		Error: err,
+	}
+	return nil
+}
+
+func (x *NotifySyncStatusChangedResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "NotifySyncStatusChangedResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.NotifySyncStatusChangedResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_SyncStatusChangedNotification) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_SyncStatusChangedNotification is nil")
+	}
+	return x.SyncStatusChangedNotification.toAppMessage()
+}
+
+func (x *HoosatdMessage_SyncStatusChangedNotification) fromAppMessage(message *appmessage.SyncStatusChangedNotificationMessage) error {
+	syncStatus := &RpcSyncStatus{}
+	syncStatus.fromAppMessage(message.SyncStatus)
+	x.SyncStatusChangedNotification = &SyncStatusChangedNotificationMessage{
+		SyncStatus: syncStatus,
+	}
+	return nil
+}
+
+func (x *SyncStatusChangedNotificationMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "SyncStatusChangedNotificationMessage is nil")
+	}
+	syncStatus, err := x.SyncStatus.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.SyncStatusChangedNotificationMessage{
+		SyncStatus: syncStatus,
+	}, nil
+}
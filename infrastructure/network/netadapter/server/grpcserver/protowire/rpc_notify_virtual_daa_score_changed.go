@@ -27,7 +27,7 @@ func (x *HoosatdMessage_NotifyVirtualDaaScoreChangedResponse) toAppMessage() (ap
 func (x *HoosatdMessage_NotifyVirtualDaaScoreChangedResponse) fromAppMessage(message *appmessage.NotifyVirtualDaaScoreChangedResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.NotifyVirtualDaaScoreChangedResponse = &NotifyVirtualDaaScoreChangedResponseMessage{
 		Error: err,
@@ -0,0 +1,59 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_ReconsiderBlockRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_ReconsiderBlockRequest is nil")
+	}
+	return x.ReconsiderBlockRequest.toAppMessage()
+}
+
+func (x *ReconsiderBlockRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ReconsiderBlockRequestMessage is nil")
+	}
+	return &appmessage.ReconsiderBlockRequestMessage{
+		BlockHash: x.BlockHash,
+	}, nil
+}
+
+func (x *HoosatdMessage_ReconsiderBlockRequest) fromAppMessage(message *appmessage.ReconsiderBlockRequestMessage) error {
+	x.ReconsiderBlockRequest = &ReconsiderBlockRequestMessage{BlockHash: message.BlockHash}
+	return nil
+}
+
+func (x *HoosatdMessage_ReconsiderBlockResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_ReconsiderBlockResponse is nil")
+	}
+	return x.ReconsiderBlockResponse.toAppMessage()
+}
+
+func (x *ReconsiderBlockResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "ReconsiderBlockResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.ReconsiderBlockResponseMessage{
+		Error: rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_ReconsiderBlockResponse) fromAppMessage(message *appmessage.ReconsiderBlockResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	x.ReconsiderBlockResponse = &ReconsiderBlockResponseMessage{
+		Error: err,
+	}
+	return nil
+}
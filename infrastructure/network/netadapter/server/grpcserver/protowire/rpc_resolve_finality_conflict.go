@@ -38,7 +38,7 @@ func (x *HoosatdMessage_ResolveFinalityConflictResponse) toAppMessage() (appmess
 func (x *HoosatdMessage_ResolveFinalityConflictResponse) fromAppMessage(message *appmessage.ResolveFinalityConflictResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.ResolveFinalityConflictResponse = &ResolveFinalityConflictResponseMessage{
 		Error: err,
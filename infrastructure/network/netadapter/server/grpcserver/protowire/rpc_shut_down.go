@@ -24,7 +24,7 @@ func (x *HoosatdMessage_ShutDownResponse) toAppMessage() (appmessage.Message, er
 func (x *HoosatdMessage_ShutDownResponse) fromAppMessage(message *appmessage.ShutDownResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.ShutDownResponse = &ShutDownResponseMessage{
 		Error: err,
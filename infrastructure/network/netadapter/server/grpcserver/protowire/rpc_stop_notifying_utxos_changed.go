@@ -38,7 +38,7 @@ func (x *HoosatdMessage_StopNotifyingUtxosChangedResponse) toAppMessage() (appme
 func (x *HoosatdMessage_StopNotifyingUtxosChangedResponse) fromAppMessage(message *appmessage.StopNotifyingUTXOsChangedResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.StopNotifyingUtxosChangedResponse = &StopNotifyingUtxosChangedResponseMessage{
 		Error: err,
@@ -46,7 +46,7 @@ func (x *HoosatdMessage_SubmitBlockResponse) toAppMessage() (appmessage.Message,
 func (x *HoosatdMessage_SubmitBlockResponse) fromAppMessage(message *appmessage.SubmitBlockResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.SubmitBlockResponse = &SubmitBlockResponseMessage{
 		RejectReason: SubmitBlockResponseMessage_RejectReason(message.RejectReason),
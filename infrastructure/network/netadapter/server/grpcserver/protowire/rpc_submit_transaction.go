@@ -47,7 +47,7 @@ func (x *HoosatdMessage_SubmitTransactionResponse) toAppMessage() (appmessage.Me
 func (x *HoosatdMessage_SubmitTransactionResponse) fromAppMessage(message *appmessage.SubmitTransactionResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.SubmitTransactionResponse = &SubmitTransactionResponseMessage{
 		TransactionId: message.TransactionID,
@@ -0,0 +1,78 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_TestMempoolAcceptRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_TestMempoolAcceptRequest is nil")
+	}
+	return x.TestMempoolAcceptRequest.toAppMessage()
+}
+
+func (x *TestMempoolAcceptRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "TestMempoolAcceptRequestMessage is nil")
+	}
+	rpcTransaction, err := x.Transaction.toAppMessage()
+	if err != nil {
+		return nil, err
+	}
+	return &appmessage.TestMempoolAcceptRequestMessage{
+		Transaction: rpcTransaction,
+	}, nil
+}
+
+func (x *HoosatdMessage_TestMempoolAcceptRequest) fromAppMessage(message *appmessage.TestMempoolAcceptRequestMessage) error {
+	x.TestMempoolAcceptRequest = &TestMempoolAcceptRequestMessage{
+		Transaction: &RpcTransaction{},
+	}
+	x.TestMempoolAcceptRequest.Transaction.fromAppMessage(message.Transaction)
+	return nil
+}
+
+func (x *HoosatdMessage_TestMempoolAcceptResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_TestMempoolAcceptResponse is nil")
+	}
+	return x.TestMempoolAcceptResponse.toAppMessage()
+}
+
+func (x *TestMempoolAcceptResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "TestMempoolAcceptResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.TestMempoolAcceptResponseMessage{
+		TransactionID: x.TransactionId,
+		IsAccepted:    x.IsAccepted,
+		RejectReason:  x.RejectReason,
+		Mass:          x.Mass,
+		Fee:           x.Fee,
+		FeeRate:       x.FeeRate,
+		Error:         rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_TestMempoolAcceptResponse) fromAppMessage(message *appmessage.TestMempoolAcceptResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	x.TestMempoolAcceptResponse = &TestMempoolAcceptResponseMessage{
+		TransactionId: message.TransactionID,
+		IsAccepted:    message.IsAccepted,
+		RejectReason:  message.RejectReason,
+		Mass:          message.Mass,
+		Fee:           message.Fee,
+		FeeRate:       message.FeeRate,
+		Error:         err,
+	}
+	return nil
+}
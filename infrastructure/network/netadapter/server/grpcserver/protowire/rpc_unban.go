@@ -36,7 +36,7 @@ func (x *HoosatdMessage_UnbanResponse) toAppMessage() (appmessage.Message, error
 func (x *HoosatdMessage_UnbanResponse) fromAppMessage(message *appmessage.UnbanResponseMessage) error {
 	var err *RPCError
 	if message.Error != nil {
-		err = &RPCError{Message: message.Error.Message}
+		err = newRPCError(message.Error)
 	}
 	x.UnbanResponse = &UnbanResponseMessage{
 		Error: err,
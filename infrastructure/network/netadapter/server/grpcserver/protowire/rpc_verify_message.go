@@ -0,0 +1,67 @@
+package protowire
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	"github.com/pkg/errors"
+)
+
+func (x *HoosatdMessage_VerifyMessageRequest) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_VerifyMessageRequest is nil")
+	}
+	return x.VerifyMessageRequest.toAppMessage()
+}
+
+func (x *VerifyMessageRequestMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "VerifyMessageRequestMessage is nil")
+	}
+	return &appmessage.VerifyMessageRequestMessage{
+		Address:      x.Address,
+		Message:      x.Message,
+		SignatureHex: x.SignatureHex,
+	}, nil
+}
+
+func (x *HoosatdMessage_VerifyMessageRequest) fromAppMessage(message *appmessage.VerifyMessageRequestMessage) error {
+	x.VerifyMessageRequest = &VerifyMessageRequestMessage{
+		Address:      message.Address,
+		Message:      message.Message,
+		SignatureHex: message.SignatureHex,
+	}
+	return nil
+}
+
+func (x *HoosatdMessage_VerifyMessageResponse) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "HoosatdMessage_VerifyMessageResponse is nil")
+	}
+	return x.VerifyMessageResponse.toAppMessage()
+}
+
+func (x *VerifyMessageResponseMessage) toAppMessage() (appmessage.Message, error) {
+	if x == nil {
+		return nil, errors.Wrapf(errorNil, "VerifyMessageResponseMessage is nil")
+	}
+	rpcErr, err := x.Error.toAppMessage()
+	// Error is an optional field
+	if err != nil && !errors.Is(err, errorNil) {
+		return nil, err
+	}
+	return &appmessage.VerifyMessageResponseMessage{
+		IsValid: x.IsValid,
+		Error:   rpcErr,
+	}, nil
+}
+
+func (x *HoosatdMessage_VerifyMessageResponse) fromAppMessage(message *appmessage.VerifyMessageResponseMessage) error {
+	var err *RPCError
+	if message.Error != nil {
+		err = newRPCError(message.Error)
+	}
+	x.VerifyMessageResponse = &VerifyMessageResponseMessage{
+		IsValid: message.IsValid,
+		Error:   err,
+	}
+	return nil
+}
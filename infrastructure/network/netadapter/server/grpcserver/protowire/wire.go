@@ -359,6 +359,27 @@ func toP2PPayload(message appmessage.Message) (isHoosatdMessage_Payload, error)
 			return nil, err
 		}
 		return payload, nil
+	case *appmessage.MsgCompactBlock:
+		payload := new(HoosatdMessage_CompactBlock)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgRequestBlockTransactions:
+		payload := new(HoosatdMessage_RequestBlockTransactions)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.MsgBlockTransactions:
+		payload := new(HoosatdMessage_BlockTransactions)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
 	default:
 		return nil, nil
 	}
@@ -982,6 +1003,209 @@ func toRPCPayload(message appmessage.Message) (isHoosatdMessage_Payload, error)
 			return nil, err
 		}
 		return payload, nil
+	case *appmessage.GetCoinbaseSplitRequestMessage:
+		payload := new(HoosatdMessage_GetCoinbaseSplitRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetCoinbaseSplitResponseMessage:
+		payload := new(HoosatdMessage_GetCoinbaseSplitResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetEmissionInfoRequestMessage:
+		payload := new(HoosatdMessage_GetEmissionInfoRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetEmissionInfoResponseMessage:
+		payload := new(HoosatdMessage_GetEmissionInfoResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.TestMempoolAcceptRequestMessage:
+		payload := new(HoosatdMessage_TestMempoolAcceptRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.TestMempoolAcceptResponseMessage:
+		payload := new(HoosatdMessage_TestMempoolAcceptResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.DecodeTransactionRequestMessage:
+		payload := new(HoosatdMessage_DecodeTransactionRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.DecodeTransactionResponseMessage:
+		payload := new(HoosatdMessage_DecodeTransactionResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.DecodeScriptRequestMessage:
+		payload := new(HoosatdMessage_DecodeScriptRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.DecodeScriptResponseMessage:
+		payload := new(HoosatdMessage_DecodeScriptResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetUTXOEntriesByOutpointsRequestMessage:
+		payload := new(HoosatdMessage_GetUtxoEntriesByOutpointsRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetUTXOEntriesByOutpointsResponseMessage:
+		payload := new(HoosatdMessage_GetUtxoEntriesByOutpointsResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetSyncStatusRequestMessage:
+		payload := new(HoosatdMessage_GetSyncStatusRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetSyncStatusResponseMessage:
+		payload := new(HoosatdMessage_GetSyncStatusResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifySyncStatusChangedRequestMessage:
+		payload := new(HoosatdMessage_NotifySyncStatusChangedRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.NotifySyncStatusChangedResponseMessage:
+		payload := new(HoosatdMessage_NotifySyncStatusChangedResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.SyncStatusChangedNotificationMessage:
+		payload := new(HoosatdMessage_SyncStatusChangedNotification)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.VerifyMessageRequestMessage:
+		payload := new(HoosatdMessage_VerifyMessageRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.VerifyMessageResponseMessage:
+		payload := new(HoosatdMessage_VerifyMessageResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionInclusionProofRequestMessage:
+		payload := new(HoosatdMessage_GetTransactionInclusionProofRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionInclusionProofResponseMessage:
+		payload := new(HoosatdMessage_GetTransactionInclusionProofResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.InvalidateBlockRequestMessage:
+		payload := new(HoosatdMessage_InvalidateBlockRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.InvalidateBlockResponseMessage:
+		payload := new(HoosatdMessage_InvalidateBlockResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ReconsiderBlockRequestMessage:
+		payload := new(HoosatdMessage_ReconsiderBlockRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.ReconsiderBlockResponseMessage:
+		payload := new(HoosatdMessage_ReconsiderBlockResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetDAGSnapshotRequestMessage:
+		payload := new(HoosatdMessage_GetDagSnapshotRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetDAGSnapshotResponseMessage:
+		payload := new(HoosatdMessage_GetDagSnapshotResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionStatusRequestMessage:
+		payload := new(HoosatdMessage_GetTransactionStatusRequest)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
+	case *appmessage.GetTransactionStatusResponseMessage:
+		payload := new(HoosatdMessage_GetTransactionStatusResponse)
+		err := payload.fromAppMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		return payload, nil
 	default:
 		return nil, nil
 	}
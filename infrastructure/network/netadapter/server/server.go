@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/id"
 	"github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
 )
 
@@ -43,4 +44,7 @@ type Connection interface {
 	SetOnDisconnectedHandler(onDisconnectedHandler OnDisconnectedHandler)
 	SetOnInvalidMessageHandler(onInvalidMessageHandler OnInvalidMessageHandler)
 	Address() *net.TCPAddr
+	LocalAddress() net.Addr
+	AuthToken() string
+	PeerPublicKey() *id.NodePublicKey
 }
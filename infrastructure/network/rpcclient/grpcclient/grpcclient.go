@@ -12,6 +12,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
 )
 
 // OnErrorHandler defines a handler function for when errors occur
@@ -30,13 +31,24 @@ type GRPCClient struct {
 
 // Connect connects to the RPC server with the given address
 func Connect(address string) (*GRPCClient, error) {
+	return ConnectWithAuthToken(address, "")
+}
+
+// ConnectWithAuthToken connects to the RPC server with the given address,
+// presenting the given authentication token. An empty token is not sent.
+func ConnectWithAuthToken(address string, authToken string) (*GRPCClient, error) {
 	gRPCConnection, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return nil, errors.Wrapf(err, "error connecting to %s", address)
 	}
 
+	ctx := context.Background()
+	if authToken != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpcserver.AuthorizationMetadataKey, "Bearer "+authToken)
+	}
+
 	grpcClient := protowire.NewRPCClient(gRPCConnection)
-	stream, err := grpcClient.MessageStream(context.Background(), grpc.UseCompressor(gzip.Name),
+	stream, err := grpcClient.MessageStream(ctx, grpc.UseCompressor(gzip.Name),
 		grpc.MaxCallRecvMsgSize(grpcserver.RPCMaxMessageSize), grpc.MaxCallSendMsgSize(grpcserver.RPCMaxMessageSize))
 	if err != nil {
 		return nil, errors.Wrapf(err, "error getting client stream for %s", address)
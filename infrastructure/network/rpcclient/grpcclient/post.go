@@ -62,3 +62,14 @@ func (c *GRPCClient) Post(request *protowire.HoosatdMessage) (*protowire.Hoosatd
 	}
 	return response, nil
 }
+
+// Receive waits for the next message from the RPC server and returns it.
+// It is meant to be used after subscribing to notifications with Post,
+// since notifications arrive without a corresponding request.
+func (c *GRPCClient) Receive() (*protowire.HoosatdMessage, error) {
+	message, err := c.stream.Recv()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error receiving a message from the RPC server")
+	}
+	return message, nil
+}
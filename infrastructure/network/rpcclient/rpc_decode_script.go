@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/Hoosat-Oy/HTND/app/appmessage"
+
+// DecodeScript sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) DecodeScript(scriptHex string, version uint16) (*appmessage.DecodeScriptResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewDecodeScriptRequestMessage(scriptHex, version))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdDecodeScriptResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	decodeScriptResponse := response.(*appmessage.DecodeScriptResponseMessage)
+	if decodeScriptResponse.Error != nil {
+		return nil, c.convertRPCError(decodeScriptResponse.Error)
+	}
+	return decodeScriptResponse, nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/Hoosat-Oy/HTND/app/appmessage"
+
+// DecodeTransaction sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) DecodeTransaction(transactionHex string, encoding appmessage.TransactionEncoding) (*appmessage.DecodeTransactionResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewDecodeTransactionRequestMessage(transactionHex, encoding))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdDecodeTransactionResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	decodeTransactionResponse := response.(*appmessage.DecodeTransactionResponseMessage)
+	if decodeTransactionResponse.Error != nil {
+		return nil, c.convertRPCError(decodeTransactionResponse.Error)
+	}
+	return decodeTransactionResponse, nil
+}
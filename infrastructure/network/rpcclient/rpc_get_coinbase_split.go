@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/Hoosat-Oy/HTND/app/appmessage"
+
+// GetCoinbaseSplit sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetCoinbaseSplit(blockHash string) (*appmessage.GetCoinbaseSplitResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetCoinbaseSplitRequestMessage(blockHash))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetCoinbaseSplitResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getCoinbaseSplitResponse := response.(*appmessage.GetCoinbaseSplitResponseMessage)
+	if getCoinbaseSplitResponse.Error != nil {
+		return nil, c.convertRPCError(getCoinbaseSplitResponse.Error)
+	}
+	return getCoinbaseSplitResponse, nil
+}
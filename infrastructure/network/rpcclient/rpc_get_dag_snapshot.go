@@ -0,0 +1,24 @@
+package rpcclient
+
+import "github.com/Hoosat-Oy/HTND/app/appmessage"
+
+// GetDAGSnapshot sends an RPC request respective to the function's name and returns the RPC server's response.
+// Further pages are requested by passing the NextPageCursor of the response as pageCursor, until it's empty
+func (c *RPCClient) GetDAGSnapshot(lowScore uint64, highScore uint64, useDAAScore bool, pageCursor string,
+	pageSize uint32, includeDOT bool) (*appmessage.GetDAGSnapshotResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(
+		appmessage.NewGetDAGSnapshotRequestMessage(lowScore, highScore, useDAAScore, pageCursor, pageSize, includeDOT))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetDAGSnapshotResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getDAGSnapshotResponse := response.(*appmessage.GetDAGSnapshotResponseMessage)
+	if getDAGSnapshotResponse.Error != nil {
+		return nil, c.convertRPCError(getDAGSnapshotResponse.Error)
+	}
+	return getDAGSnapshotResponse, nil
+}
@@ -0,0 +1,25 @@
+package rpcclient
+
+import "github.com/Hoosat-Oy/HTND/app/appmessage"
+
+// GetTransactionInclusionProof sends an RPC request respective to the function's name and returns the RPC server's response.
+// The returned proof can be converted with appmessage.RPCTransactionInclusionProofToDomainTransactionInclusionProof
+// and verified with inclusionproof.Verify
+func (c *RPCClient) GetTransactionInclusionProof(transactionID string, blockHash string,
+	targetBlockHash string) (*appmessage.GetTransactionInclusionProofResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(
+		appmessage.NewGetTransactionInclusionProofRequestMessage(transactionID, blockHash, targetBlockHash))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetTransactionInclusionProofResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getTransactionInclusionProofResponse := response.(*appmessage.GetTransactionInclusionProofResponseMessage)
+	if getTransactionInclusionProofResponse.Error != nil {
+		return nil, c.convertRPCError(getTransactionInclusionProofResponse.Error)
+	}
+	return getTransactionInclusionProofResponse, nil
+}
@@ -0,0 +1,23 @@
+package rpcclient
+
+import "github.com/Hoosat-Oy/HTND/app/appmessage"
+
+// GetTransactionStatus sends an RPC request respective to the function's name and returns the RPC server's response.
+// includingBlockHash may only be empty while the transaction is in the mempool
+func (c *RPCClient) GetTransactionStatus(transactionID string, includingBlockHash string) (
+	*appmessage.GetTransactionStatusResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetTransactionStatusRequestMessage(transactionID, includingBlockHash))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetTransactionStatusResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getTransactionStatusResponse := response.(*appmessage.GetTransactionStatusResponseMessage)
+	if getTransactionStatusResponse.Error != nil {
+		return nil, c.convertRPCError(getTransactionStatusResponse.Error)
+	}
+	return getTransactionStatusResponse, nil
+}
@@ -0,0 +1,22 @@
+package rpcclient
+
+import "github.com/Hoosat-Oy/HTND/app/appmessage"
+
+// GetUTXOEntriesByOutpoints sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) GetUTXOEntriesByOutpoints(outpoints []*appmessage.RPCOutpoint,
+	includeMempoolSpends bool) (*appmessage.GetUTXOEntriesByOutpointsResponseMessage, error) {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewGetUTXOEntriesByOutpointsRequestMessage(outpoints, includeMempoolSpends))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdGetUTXOEntriesByOutpointsResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	getUTXOEntriesByOutpointsResponse := response.(*appmessage.GetUTXOEntriesByOutpointsResponseMessage)
+	if getUTXOEntriesByOutpointsResponse.Error != nil {
+		return nil, c.convertRPCError(getUTXOEntriesByOutpointsResponse.Error)
+	}
+	return getUTXOEntriesByOutpointsResponse, nil
+}
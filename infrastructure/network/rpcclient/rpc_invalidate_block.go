@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/Hoosat-Oy/HTND/app/appmessage"
+
+// InvalidateBlock sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) InvalidateBlock(blockHash string) (*appmessage.InvalidateBlockResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewInvalidateBlockRequestMessage(blockHash))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdInvalidateBlockResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	invalidateBlockResponse := response.(*appmessage.InvalidateBlockResponseMessage)
+	if invalidateBlockResponse.Error != nil {
+		return nil, c.convertRPCError(invalidateBlockResponse.Error)
+	}
+	return invalidateBlockResponse, nil
+}
@@ -0,0 +1,41 @@
+package rpcclient
+
+import (
+	"github.com/Hoosat-Oy/HTND/app/appmessage"
+	routerpkg "github.com/Hoosat-Oy/HTND/infrastructure/network/netadapter/router"
+	"github.com/pkg/errors"
+)
+
+// RegisterForSyncStatusChangedNotifications sends an RPC request respective to the function's
+// name and returns the RPC server's response. Additionally, it starts listening for the appropriate notification
+// using the given handler function
+func (c *RPCClient) RegisterForSyncStatusChangedNotifications(
+	onSyncStatusChanged func(notification *appmessage.SyncStatusChangedNotificationMessage)) error {
+
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewNotifySyncStatusChangedRequestMessage())
+	if err != nil {
+		return err
+	}
+	response, err := c.route(appmessage.CmdNotifySyncStatusChangedResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return err
+	}
+	notifySyncStatusChangedResponse := response.(*appmessage.NotifySyncStatusChangedResponseMessage)
+	if notifySyncStatusChangedResponse.Error != nil {
+		return c.convertRPCError(notifySyncStatusChangedResponse.Error)
+	}
+	spawn("RegisterForSyncStatusChangedNotifications", func() {
+		for {
+			notification, err := c.route(appmessage.CmdSyncStatusChangedNotificationMessage).Dequeue()
+			if err != nil {
+				if errors.Is(err, routerpkg.ErrRouteClosed) {
+					break
+				}
+				panic(err)
+			}
+			syncStatusChangedNotification := notification.(*appmessage.SyncStatusChangedNotificationMessage)
+			onSyncStatusChanged(syncStatusChangedNotification)
+		}
+	})
+	return nil
+}
@@ -0,0 +1,20 @@
+package rpcclient
+
+import "github.com/Hoosat-Oy/HTND/app/appmessage"
+
+// TestMempoolAccept sends an RPC request respective to the function's name and returns the RPC server's response
+func (c *RPCClient) TestMempoolAccept(transaction *appmessage.RPCTransaction) (*appmessage.TestMempoolAcceptResponseMessage, error) {
+	err := c.rpcRouter.outgoingRoute().Enqueue(appmessage.NewTestMempoolAcceptRequestMessage(transaction))
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.route(appmessage.CmdTestMempoolAcceptResponseMessage).DequeueWithTimeout(c.timeout)
+	if err != nil {
+		return nil, err
+	}
+	testMempoolAcceptResponse := response.(*appmessage.TestMempoolAcceptResponseMessage)
+	if testMempoolAcceptResponse.Error != nil {
+		return nil, c.convertRPCError(testMempoolAcceptResponse.Error)
+	}
+	return testMempoolAcceptResponse, nil
+}
@@ -20,6 +20,7 @@ type RPCClient struct {
 	*grpcclient.GRPCClient
 
 	rpcAddress           string
+	authToken            string
 	rpcRouter            *rpcRouter
 	isConnected          uint32
 	isClosed             uint32
@@ -43,8 +44,24 @@ func NewRPCClient(rpcAddress string) (*RPCClient, error) {
 	return rpcClient, nil
 }
 
+// NewRPCClientWithAuthToken creates a new RPC client with a default call timeout value
+// that authenticates to the RPC server with the given token
+func NewRPCClientWithAuthToken(rpcAddress string, authToken string) (*RPCClient, error) {
+	rpcClient := &RPCClient{
+		rpcAddress: rpcAddress,
+		authToken:  authToken,
+		timeout:    defaultTimeout,
+	}
+	err := rpcClient.connect()
+	if err != nil {
+		return nil, err
+	}
+
+	return rpcClient, nil
+}
+
 func (c *RPCClient) connect() error {
-	rpcClient, err := grpcclient.Connect(c.rpcAddress)
+	rpcClient, err := grpcclient.ConnectWithAuthToken(c.rpcAddress, c.authToken)
 	if err != nil {
 		return errors.Wrapf(err, "error connecting to address %s", c.rpcAddress)
 	}
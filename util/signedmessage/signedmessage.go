@@ -0,0 +1,53 @@
+// Package signedmessage implements the hashing and verification of messages signed by
+// the owner of an address.
+package signedmessage
+
+import (
+	"github.com/Hoosat-Oy/HTND/domain/consensus/model/externalapi"
+	"github.com/Hoosat-Oy/HTND/domain/consensus/utils/hashes"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/kaspanet/go-secp256k1"
+	"github.com/pkg/errors"
+)
+
+// Hash returns the hash that is signed when signing on the given message.
+// It's domain separated from transaction signature hashes, so a message signature
+// can never be used as a transaction signature
+func Hash(message []byte) *externalapi.DomainHash {
+	hashWriter := hashes.NewPersonalMessageSigningHashWriter()
+	hashWriter.InfallibleWrite(message)
+	return hashWriter.Finalize()
+}
+
+// Verify returns whether the given signature is a valid signature on the given
+// message by the owner of the given address. Only pay-to-pubkey addresses are supported,
+// since other addresses aren't backed by a single public key
+func Verify(address util.Address, message []byte, signature []byte) (bool, error) {
+	secpHash := secp256k1.Hash(*Hash(message).ByteArray())
+
+	switch address := address.(type) {
+	case *util.AddressPublicKey:
+		publicKey, err := secp256k1.DeserializeSchnorrPubKey(address.ScriptAddress())
+		if err != nil {
+			return false, err
+		}
+		schnorrSignature, err := secp256k1.DeserializeSchnorrSignatureFromSlice(signature)
+		if err != nil {
+			return false, err
+		}
+		return publicKey.SchnorrVerify(&secpHash, schnorrSignature), nil
+	case *util.AddressPublicKeyECDSA:
+		publicKey, err := secp256k1.DeserializeECDSAPubKey(address.ScriptAddress())
+		if err != nil {
+			return false, err
+		}
+		ecdsaSignature, err := secp256k1.DeserializeECDSASignatureFromSlice(signature)
+		if err != nil {
+			return false, err
+		}
+		return publicKey.ECDSAVerify(&secpHash, ecdsaSignature), nil
+	default:
+		return false, errors.Errorf("messages can only be verified against pay-to-pubkey addresses, "+
+			"and %s isn't one", address)
+	}
+}
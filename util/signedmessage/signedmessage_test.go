@@ -0,0 +1,79 @@
+package signedmessage
+
+import (
+	"testing"
+
+	"github.com/Hoosat-Oy/HTND/domain/dagconfig"
+	"github.com/Hoosat-Oy/HTND/util"
+	"github.com/kaspanet/go-secp256k1"
+)
+
+func TestVerify(t *testing.T) {
+	prefix := dagconfig.DevnetParams.Prefix
+	message := []byte("I own this address")
+	hash := secp256k1.Hash(*Hash(message).ByteArray())
+
+	schnorrKeyPair, err := secp256k1.GenerateSchnorrKeyPair()
+	if err != nil {
+		t.Fatalf("GenerateSchnorrKeyPair: %+v", err)
+	}
+	schnorrPublicKey, err := schnorrKeyPair.SchnorrPublicKey()
+	if err != nil {
+		t.Fatalf("SchnorrPublicKey: %+v", err)
+	}
+	serializedSchnorrPublicKey, err := schnorrPublicKey.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %+v", err)
+	}
+	schnorrAddress, err := util.NewAddressPublicKey(serializedSchnorrPublicKey[:], prefix)
+	if err != nil {
+		t.Fatalf("NewAddressPublicKey: %+v", err)
+	}
+	schnorrSignature, err := schnorrKeyPair.SchnorrSign(&hash)
+	if err != nil {
+		t.Fatalf("SchnorrSign: %+v", err)
+	}
+
+	ecdsaPrivateKey, err := secp256k1.GenerateECDSAPrivateKey()
+	if err != nil {
+		t.Fatalf("GenerateECDSAPrivateKey: %+v", err)
+	}
+	ecdsaPublicKey, err := ecdsaPrivateKey.ECDSAPublicKey()
+	if err != nil {
+		t.Fatalf("ECDSAPublicKey: %+v", err)
+	}
+	serializedECDSAPublicKey, err := ecdsaPublicKey.Serialize()
+	if err != nil {
+		t.Fatalf("Serialize: %+v", err)
+	}
+	ecdsaAddress, err := util.NewAddressPublicKeyECDSA(serializedECDSAPublicKey[:], prefix)
+	if err != nil {
+		t.Fatalf("NewAddressPublicKeyECDSA: %+v", err)
+	}
+	ecdsaSignature, err := ecdsaPrivateKey.ECDSASign(&hash)
+	if err != nil {
+		t.Fatalf("ECDSASign: %+v", err)
+	}
+
+	tests := []struct {
+		name      string
+		address   util.Address
+		message   []byte
+		signature []byte
+		isValid   bool
+	}{
+		{name: "schnorr", address: schnorrAddress, message: message, signature: schnorrSignature.Serialize()[:], isValid: true},
+		{name: "ecdsa", address: ecdsaAddress, message: message, signature: ecdsaSignature.Serialize()[:], isValid: true},
+		{name: "different message", address: schnorrAddress, message: []byte("I own this address!"),
+			signature: schnorrSignature.Serialize()[:], isValid: false},
+	}
+	for _, test := range tests {
+		isValid, err := Verify(test.address, test.message, test.signature)
+		if err != nil {
+			t.Fatalf("%s: Verify: %+v", test.name, err)
+		}
+		if isValid != test.isValid {
+			t.Fatalf("%s: expected isValid %t, got %t", test.name, test.isValid, isValid)
+		}
+	}
+}